@@ -84,6 +84,11 @@ var _ = Describe("RBAC Test", func() {
 								Resources: []string{"pods", "services", "secrets", "endpoints", "configmaps", "namespaces"},
 								Verbs:     []string{"get", "list", "watch"},
 							},
+							{
+								APIGroups: []string{"discovery.k8s.io"},
+								Resources: []string{"endpointslices"},
+								Verbs:     []string{"get", "list", "watch"},
+							},
 						},
 						RoleRef: rbacv1.RoleRef{
 							APIGroup: "rbac.authorization.k8s.io",
@@ -104,6 +109,11 @@ var _ = Describe("RBAC Test", func() {
 				Context("cluster scope", func() {
 					It("role", func() {
 						resourceBuilder.Name += "-" + namespace
+						resourceBuilder.Rules = append(resourceBuilder.Rules, rbacv1.PolicyRule{
+							APIGroups: []string{""},
+							Resources: []string{"nodes"},
+							Verbs:     []string{"get", "list", "watch"},
+						})
 						prepareMakefile("global.glooRbac.namespaced=false")
 						testManifest.ExpectClusterRole(resourceBuilder.GetClusterRole())
 					})
@@ -205,7 +215,7 @@ var _ = Describe("RBAC Test", func() {
 						Rules: []rbacv1.PolicyRule{
 							{
 								APIGroups: []string{"gloo.solo.io", "enterprise.gloo.solo.io"},
-								Resources: []string{"upstreams", "upstreamgroups", "proxies", "authconfigs"},
+								Resources: []string{"upstreams", "upstreamgroups", "proxies", "authconfigs", "referencepolicies"},
 								Verbs:     []string{"get", "list", "watch", "update"},
 							},
 							{
@@ -271,7 +281,8 @@ var _ = Describe("RBAC Test", func() {
 							{
 								APIGroups: []string{"gloo.solo.io"},
 								Resources: []string{"settings"},
-								Verbs:     []string{"get", "list", "watch", "create"},
+								// gloo reports the availability of vault and the served git commit on the status of the settings
+								Verbs: []string{"get", "list", "watch", "create", "update"},
 							},
 						},
 						RoleRef: rbacv1.RoleRef{
@@ -404,6 +415,10 @@ var _ = Describe("RBAC Test", func() {
 								APIGroups: []string{"gateway.solo.io"},
 								Resources: []string{"gateways"},
 								Verbs:     []string{"get", "list", "watch", "create", "update"},
+							}, {
+								APIGroups: []string{"gloo.solo.io"},
+								Resources: []string{"referencepolicies"},
+								Verbs:     []string{"get", "list", "watch"},
 							},
 						},
 						RoleRef: rbacv1.RoleRef{
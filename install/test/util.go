@@ -11,7 +11,7 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		namespace,
 		[]string{"gloo.solo.io"},
 		[]string{"settings"},
-		[]string{"get", "list", "watch", "create"})
+		[]string{"get", "list", "watch", "create", "update"})
 	permissions.AddExpectedPermission(
 		"gloo-system.gateway",
 		namespace,
@@ -30,6 +30,12 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		[]string{"gateway.solo.io"},
 		[]string{"virtualservices", "routetables"},
 		[]string{"get", "list", "watch", "update"})
+	permissions.AddExpectedPermission(
+		"gloo-system.gateway",
+		namespace,
+		[]string{"gloo.solo.io"},
+		[]string{"referencepolicies"},
+		[]string{"get", "list", "watch"})
 
 	// Gloo
 	permissions.AddExpectedPermission(
@@ -38,6 +44,21 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		[]string{""},
 		[]string{"pods", "services", "configmaps", "namespaces", "secrets", "endpoints"},
 		[]string{"get", "list", "watch"})
+	permissions.AddExpectedPermission(
+		"gloo-system.gloo",
+		namespace,
+		[]string{"discovery.k8s.io"},
+		[]string{"endpointslices"},
+		[]string{"get", "list", "watch"})
+	if namespace == "" {
+		// nodes are cluster-scoped, so a namespaced install cannot read them
+		permissions.AddExpectedPermission(
+			"gloo-system.gloo",
+			namespace,
+			[]string{""},
+			[]string{"nodes"},
+			[]string{"get", "list", "watch"})
+	}
 	permissions.AddExpectedPermission(
 		"gloo-system.gloo",
 		namespace,
@@ -49,14 +70,14 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		"gloo-system.gloo",
 		namespace,
 		[]string{"gloo.solo.io", "enterprise.gloo.solo.io"},
-		[]string{"upstreams", "upstreamgroups", "proxies", "authconfigs"},
+		[]string{"upstreams", "upstreamgroups", "proxies", "authconfigs", "referencepolicies"},
 		[]string{"get", "list", "watch", "update"})
 	permissions.AddExpectedPermission(
 		"gloo-system.gloo",
 		namespace,
 		[]string{"gloo.solo.io"},
 		[]string{"settings"},
-		[]string{"get", "list", "watch", "create"})
+		[]string{"get", "list", "watch", "create", "update"})
 
 	// Discovery
 	permissions.AddExpectedPermission(
@@ -65,12 +86,27 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		[]string{""},
 		[]string{"pods", "services", "configmaps", "namespaces", "secrets", "endpoints"},
 		[]string{"get", "list", "watch"})
+	permissions.AddExpectedPermission(
+		"gloo-system.discovery",
+		namespace,
+		[]string{"discovery.k8s.io"},
+		[]string{"endpointslices"},
+		[]string{"get", "list", "watch"})
+	if namespace == "" {
+		// nodes are cluster-scoped, so a namespaced install cannot read them
+		permissions.AddExpectedPermission(
+			"gloo-system.discovery",
+			namespace,
+			[]string{""},
+			[]string{"nodes"},
+			[]string{"get", "list", "watch"})
+	}
 	permissions.AddExpectedPermission(
 		"gloo-system.discovery",
 		namespace,
 		[]string{"gloo.solo.io"},
 		[]string{"settings"},
-		[]string{"get", "list", "watch", "create"})
+		[]string{"get", "list", "watch", "create", "update"})
 	permissions.AddExpectedPermission(
 		"gloo-system.discovery",
 		namespace,
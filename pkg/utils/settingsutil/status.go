@@ -0,0 +1,57 @@
+package settingsutil
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"k8s.io/client-go/util/retry"
+)
+
+const statusReportedBy = "gloo"
+
+// ReportStatusDetail sets a field of the details of the status of the settings, keeping the rest of the status
+// written by other components. A nil value removes the field. The settings are only written if the field changes.
+func ReportStatusDetail(ctx context.Context, settingsClient v1.SettingsClient, settingsRef core.ResourceRef, field string, value *types.Value) error {
+	return errors.RetryOnConflict(retry.DefaultBackoff, func() error {
+		settings, err := settingsClient.Read(settingsRef.Namespace, settingsRef.Name, clients.ReadOpts{Ctx: ctx})
+		if err != nil {
+			return err
+		}
+		existing := StatusDetail(settings, field)
+		if existing.Equal(value) {
+			return nil
+		}
+
+		if settings.Status.ReportedBy == "" {
+			settings.Status.State = core.Status_Accepted
+			settings.Status.ReportedBy = statusReportedBy
+		}
+		details := settings.Status.Details
+		if details == nil {
+			details = &types.Struct{}
+		}
+		fields := map[string]*types.Value{}
+		for k, v := range details.Fields {
+			fields[k] = v
+		}
+		if value == nil {
+			delete(fields, field)
+		} else {
+			fields[field] = value
+		}
+		settings.Status.Details = &types.Struct{Fields: fields}
+
+		_, err = settingsClient.Write(settings, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+		return err
+	})
+}
+
+// StatusDetail returns a field of the details of the status of the settings, or nil if it is not set
+func StatusDetail(settings *v1.Settings, field string) *types.Value {
+	status := settings.GetStatus()
+	return status.GetDetails().GetFields()[field]
+}
@@ -0,0 +1,84 @@
+package settingsutil_test
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+
+	. "github.com/solo-io/gloo/pkg/utils/settingsutil"
+)
+
+var _ = Describe("Status", func() {
+
+	var (
+		ctx            context.Context
+		settingsClient v1.SettingsClient
+		settingsRef    core.ResourceRef
+	)
+
+	stringValue := func(s string) *types.Value {
+		return &types.Value{Kind: &types.Value_StringValue{StringValue: s}}
+	}
+
+	read := func() *v1.Settings {
+		settings, err := settingsClient.Read(settingsRef.Namespace, settingsRef.Name, clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		return settings
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		settingsClient, err = v1.NewSettingsClient(&factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		settingsRef = core.ResourceRef{Name: "default", Namespace: "gloo-system"}
+		_, err = settingsClient.Write(&v1.Settings{
+			Metadata: core.Metadata{Name: settingsRef.Name, Namespace: settingsRef.Namespace},
+			Status: core.Status{
+				State:      core.Status_Warning,
+				Reason:     "other",
+				ReportedBy: "other",
+				Details: &types.Struct{Fields: map[string]*types.Value{
+					"other": stringValue("value"),
+				}},
+			},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("merges the field into the existing status", func() {
+		Expect(ReportStatusDetail(ctx, settingsClient, settingsRef, "field", stringValue("value"))).NotTo(HaveOccurred())
+
+		settings := read()
+		Expect(settings.Status.State).To(Equal(core.Status_Warning))
+		Expect(settings.Status.Reason).To(Equal("other"))
+		Expect(settings.Status.ReportedBy).To(Equal("other"))
+		Expect(StatusDetail(settings, "field")).To(Equal(stringValue("value")))
+		Expect(StatusDetail(settings, "other")).To(Equal(stringValue("value")))
+	})
+
+	It("removes the field", func() {
+		Expect(ReportStatusDetail(ctx, settingsClient, settingsRef, "field", stringValue("value"))).NotTo(HaveOccurred())
+		Expect(ReportStatusDetail(ctx, settingsClient, settingsRef, "field", nil)).NotTo(HaveOccurred())
+
+		settings := read()
+		Expect(StatusDetail(settings, "field")).To(BeNil())
+		Expect(StatusDetail(settings, "other")).To(Equal(stringValue("value")))
+	})
+
+	It("only writes changes", func() {
+		Expect(ReportStatusDetail(ctx, settingsClient, settingsRef, "field", stringValue("value"))).NotTo(HaveOccurred())
+		resourceVersion := read().Metadata.ResourceVersion
+		Expect(ReportStatusDetail(ctx, settingsClient, settingsRef, "field", stringValue("value"))).NotTo(HaveOccurred())
+		Expect(read().Metadata.ResourceVersion).To(Equal(resourceVersion))
+	})
+})
@@ -93,6 +93,18 @@ func Main(opts SetupOpts) error {
 	return nil
 }
 
+// SettingsClient returns a registered client for the settings watched by Main
+func SettingsClient(ctx context.Context) (v1.SettingsClient, error) {
+	settingsClient, err := kubeOrFileSettingsClient(ctx, setupNamespace, setupDir)
+	if err != nil {
+		return nil, err
+	}
+	if err := settingsClient.Register(); err != nil {
+		return nil, err
+	}
+	return settingsClient, nil
+}
+
 func kubeOrFileSettingsClient(ctx context.Context, setupNamespace, settingsDir string) (v1.SettingsClient, error) {
 	if settingsDir != "" {
 		contextutils.LoggerFrom(ctx).Infow("using filesystem for settings", zap.String("directory", settingsDir))
@@ -0,0 +1,442 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/acme/pkg/issuer"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gwutils "github.com/solo-io/gloo/projects/gateway/pkg/utils"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"go.uber.org/multierr"
+)
+
+const (
+	// path under which HTTP-01 challenges are served, followed by the challenge token
+	ChallengePathPrefix = "/.well-known/acme-challenge/"
+	// name prefix of the temporary routes serving the challenges
+	ChallengeRouteNamePrefix = "acme-http01-challenge-"
+	// suffix of the name of the secret the certificate is written to, unless the virtual service references one
+	SecretNameSuffix = "-acme"
+
+	DefaultRenewBefore      = 30 * 24 * time.Hour
+	DefaultPropagationDelay = 10 * time.Second
+	DefaultRetryInterval    = time.Hour
+	DefaultIssueTimeout     = 5 * time.Minute
+	DefaultResyncInterval   = 10 * time.Minute
+)
+
+var (
+	NoIssuableDomainsError = eris.New("the virtual service has no domains a certificate can be issued for, " +
+		"wildcard domains require a DNS-01 challenge which is not supported")
+	SslConfigWithoutSecretError = eris.New("the ssl config of the virtual service must reference a secret " +
+		"to store the ACME certificate")
+)
+
+type Opts struct {
+	// renew certificates expiring within this duration
+	RenewBefore time.Duration
+	// how long to wait for the challenge routes to be served by the proxies before validating them
+	PropagationDelay time.Duration
+	// how long to wait before retrying a failed issuance
+	RetryInterval time.Duration
+	// maximum duration of a single issuance
+	IssueTimeout time.Duration
+	// maximum duration between two checks of the certificates, in the absence of changes to the virtual services
+	ResyncInterval time.Duration
+}
+
+func (o Opts) withDefaults() Opts {
+	if o.RenewBefore == 0 {
+		o.RenewBefore = DefaultRenewBefore
+	}
+	if o.PropagationDelay == 0 {
+		o.PropagationDelay = DefaultPropagationDelay
+	}
+	if o.RetryInterval == 0 {
+		o.RetryInterval = DefaultRetryInterval
+	}
+	if o.IssueTimeout == 0 {
+		o.IssueTimeout = DefaultIssueTimeout
+	}
+	if o.ResyncInterval == 0 {
+		o.ResyncInterval = DefaultResyncInterval
+	}
+	return o
+}
+
+type acmeSyncer struct {
+	issuer               issuer.Issuer
+	virtualServiceClient v1.VirtualServiceClient
+	secretClient         gloov1.SecretClient
+	opts                 Opts
+
+	// the latest snapshot received from the event loop, processed by the worker goroutine
+	lock     sync.Mutex
+	snapshot *v1.ApiSnapshot
+	resync   chan struct{}
+
+	// the earliest time a failed issuance is retried, by virtual service.
+	// only accessed from the worker goroutine.
+	retryAt map[string]time.Time
+	// the time the certificate of a virtual service enters the renewal window.
+	// only accessed from the worker goroutine.
+	renewAt map[string]time.Time
+}
+
+// NewSyncer creates a syncer that issues certificates for the domains of the virtual services annotated with
+// `acme.gloo.solo.io/enabled: "true"`.
+//
+// Challenges are served by temporary direct response routes on the virtual service itself. Virtual services
+// without an ssl config serve them on their own routes, virtual services serving TLS on the exclusions of their
+// https redirect. Once issued, the certificate is written to the secret referenced by the ssl config of the
+// virtual service, or to `<virtual service name>-acme` which the ssl config is then set to reference. An https
+// redirect is added if none is configured, as renewals are validated over plain HTTP.
+//
+// Issuances run on a worker goroutine bound to ctx rather than in Sync, as they can take minutes. The worker
+// processes the latest snapshot whenever it changes, when a failed issuance is due to be retried, when a
+// certificate enters its renewal window, and at least once per resync interval.
+func NewSyncer(ctx context.Context, issuer issuer.Issuer, virtualServiceClient v1.VirtualServiceClient, secretClient gloov1.SecretClient, opts Opts) v1.ApiSyncer {
+	s := &acmeSyncer{
+		issuer:               issuer,
+		virtualServiceClient: virtualServiceClient,
+		secretClient:         secretClient,
+		opts:                 opts.withDefaults(),
+		resync:               make(chan struct{}, 1),
+		retryAt:              map[string]time.Time{},
+		renewAt:              map[string]time.Time{},
+	}
+	go s.run(contextutils.WithLogger(ctx, "acmeSyncer"))
+	return s
+}
+
+func (s *acmeSyncer) Sync(_ context.Context, snap *v1.ApiSnapshot) error {
+	s.lock.Lock()
+	s.snapshot = snap
+	s.lock.Unlock()
+	select {
+	case s.resync <- struct{}{}:
+	default:
+		// the worker has not picked up the previous snapshot yet, and will pick up this one instead
+	}
+	return nil
+}
+
+func (s *acmeSyncer) run(ctx context.Context) {
+	timer := time.NewTimer(s.opts.ResyncInterval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.resync:
+		case <-timer.C:
+		}
+		next := s.syncVirtualServices(ctx)
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(time.Until(next))
+	}
+}
+
+// syncs the acme enabled virtual services of the latest snapshot and returns the time of the next sync
+func (s *acmeSyncer) syncVirtualServices(ctx context.Context) time.Time {
+	logger := contextutils.LoggerFrom(ctx)
+
+	s.lock.Lock()
+	snap := s.snapshot
+	s.lock.Unlock()
+
+	enabled := map[string]bool{}
+	if snap != nil {
+		for _, vs := range snap.VirtualServices {
+			if !gwutils.AcmeEnabled(vs) {
+				continue
+			}
+			key := vs.Metadata.Ref().Key()
+			enabled[key] = true
+			// the snapshot may predate our own writes to the virtual service
+			latest, err := s.virtualServiceClient.Read(vs.Metadata.Namespace, vs.Metadata.Name, clients.ReadOpts{Ctx: ctx})
+			if err != nil {
+				if !errors.IsNotExist(err) {
+					logger.Warnf("reading virtual service %v failed: %v", key, err)
+				}
+				continue
+			}
+			if err := s.syncVirtualService(ctx, latest); err != nil {
+				logger.Warnf("certificate issuance for virtual service %v failed: %v", key, err)
+			}
+		}
+	}
+
+	next := time.Now().Add(s.opts.ResyncInterval)
+	for _, times := range []map[string]time.Time{s.retryAt, s.renewAt} {
+		for key, t := range times {
+			if !enabled[key] {
+				delete(times, key)
+				continue
+			}
+			if t.Before(next) {
+				next = t
+			}
+		}
+	}
+	return next
+}
+
+func (s *acmeSyncer) syncVirtualService(ctx context.Context, vs *v1.VirtualService) error {
+	key := vs.Metadata.Ref().Key()
+	if retryAt, ok := s.retryAt[key]; ok && time.Now().Before(retryAt) {
+		return nil
+	}
+	delete(s.renewAt, key)
+
+	domains := certificateDomains(vs)
+	if len(domains) == 0 {
+		return s.fail(ctx, vs, NoIssuableDomainsError)
+	}
+	if vs.SslConfig != nil && vs.SslConfig.GetSecretRef() == nil {
+		return s.fail(ctx, vs, SslConfigWithoutSecretError)
+	}
+	secretRef := certificateSecretRef(vs)
+
+	if certInfo := s.validCertificate(ctx, secretRef, domains); certInfo != nil {
+		s.renewAt[key] = certInfo.NotAfter.Add(-s.opts.RenewBefore)
+		// complete issuances interrupted after the secret was written
+		if state, _ := gwutils.AcmeState(vs); state != gwutils.AcmeStateIssued || vs.SslConfig == nil {
+			return s.complete(ctx, vs, secretRef, domains, certInfo)
+		}
+		return nil
+	}
+
+	contextutils.LoggerFrom(ctx).Infof("issuing certificate for virtual service %v (domains %v)", key, domains)
+	issueCtx, cancel := context.WithTimeout(ctx, s.opts.IssueTimeout)
+	defer cancel()
+
+	cert, err := s.issuer.Issue(issueCtx, domains, func(challenges []issuer.Challenge) error {
+		updated := cloneVirtualService(vs)
+		setChallengeRoutes(updated, challenges)
+		setState(updated, gwutils.AcmeStatePending, fmt.Sprintf("validating domains %v", strings.Join(domains, ", ")))
+		written, err := s.writeVirtualService(ctx, updated)
+		if err != nil {
+			return err
+		}
+		vs = written
+		select {
+		case <-time.After(s.opts.PropagationDelay):
+			return nil
+		case <-issueCtx.Done():
+			return issueCtx.Err()
+		}
+	})
+	if err == nil {
+		err = s.writeSecret(ctx, secretRef, cert)
+	}
+	if err != nil {
+		return s.fail(ctx, vs, err)
+	}
+	delete(s.retryAt, key)
+
+	certInfo, err := utils.ParseCertificateChain(cert.CertChain)
+	if err != nil {
+		return s.fail(ctx, vs, err)
+	}
+	s.renewAt[key] = certInfo.NotAfter.Add(-s.opts.RenewBefore)
+	if certInfo.TimeUntilExpiry() < s.opts.RenewBefore {
+		// do not reissue in a loop certificates that are due for renewal as soon as they are issued
+		contextutils.LoggerFrom(ctx).Warnf("certificate for virtual service %v expires within the renewal window, "+
+			"it will not be renewed before the retry interval", key)
+		s.retryAt[key] = time.Now().Add(s.opts.RetryInterval)
+	}
+	return s.complete(ctx, vs, secretRef, domains, certInfo)
+}
+
+// returns the certificate stored in the secret if it covers the domains and does not need to be renewed yet
+func (s *acmeSyncer) validCertificate(ctx context.Context, ref core.ResourceRef, domains []string) *utils.CertificateInfo {
+	secret, err := s.secretClient.Read(ref.Namespace, ref.Name, clients.ReadOpts{Ctx: ctx})
+	if err != nil || secret.GetTls() == nil {
+		return nil
+	}
+	certInfo, err := utils.ParseCertificateChain(secret.GetTls().GetCertChain())
+	if err != nil || !certInfo.ValidChain || certInfo.TimeUntilExpiry() < s.opts.RenewBefore {
+		return nil
+	}
+	for _, domain := range domains {
+		if !containsString(certInfo.DNSNames, domain) {
+			return nil
+		}
+	}
+	return certInfo
+}
+
+// removes the challenge routes, configures the virtual service to serve the certificate and marks it as issued
+func (s *acmeSyncer) complete(ctx context.Context, vs *v1.VirtualService, secretRef core.ResourceRef, domains []string, certInfo *utils.CertificateInfo) error {
+	updated := cloneVirtualService(vs)
+	setChallengeRoutes(updated, nil)
+	if updated.SslConfig == nil {
+		updated.SslConfig = &gloov1.SslConfig{
+			SslSecrets: &gloov1.SslConfig_SecretRef{SecretRef: &secretRef},
+			SniDomains: domains,
+		}
+	}
+	if updated.HttpsRedirect == nil {
+		updated.HttpsRedirect = &v1.HttpsRedirect{}
+	}
+	setState(updated, gwutils.AcmeStateIssued, fmt.Sprintf("certificate expires at %v", certInfo.NotAfter.Format(time.RFC3339)))
+	_, err := s.writeVirtualService(ctx, updated)
+	return err
+}
+
+// removes the challenge routes and marks the issuance as failed, delaying the next attempt by the retry interval
+func (s *acmeSyncer) fail(ctx context.Context, vs *v1.VirtualService, err error) error {
+	s.retryAt[vs.Metadata.Ref().Key()] = time.Now().Add(s.opts.RetryInterval)
+	updated := cloneVirtualService(vs)
+	setChallengeRoutes(updated, nil)
+	setState(updated, gwutils.AcmeStateFailed, err.Error())
+	if _, writeErr := s.writeVirtualService(ctx, updated); writeErr != nil {
+		return multierr.Append(err, writeErr)
+	}
+	return err
+}
+
+func (s *acmeSyncer) writeVirtualService(ctx context.Context, vs *v1.VirtualService) (*v1.VirtualService, error) {
+	written, err := s.virtualServiceClient.Write(vs, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+	if err != nil {
+		return nil, eris.Wrapf(err, "writing virtual service %v", vs.Metadata.Ref().Key())
+	}
+	return written, nil
+}
+
+func (s *acmeSyncer) writeSecret(ctx context.Context, ref core.ResourceRef, cert *issuer.Certificate) error {
+	secret := &gloov1.Secret{
+		Metadata: core.Metadata{Name: ref.Name, Namespace: ref.Namespace},
+		Kind: &gloov1.Secret_Tls{
+			Tls: &gloov1.TlsSecret{
+				CertChain:  cert.CertChain,
+				PrivateKey: cert.PrivateKey,
+			},
+		},
+	}
+	if existing, err := s.secretClient.Read(ref.Namespace, ref.Name, clients.ReadOpts{Ctx: ctx}); err == nil {
+		secret.Metadata = existing.Metadata
+	}
+	if _, err := s.secretClient.Write(secret, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true}); err != nil {
+		return eris.Wrapf(err, "writing secret %v", ref.Key())
+	}
+	return nil
+}
+
+// the domains of the virtual service a certificate can be issued for with an HTTP-01 challenge
+func certificateDomains(vs *v1.VirtualService) []string {
+	var domains []string
+	for _, domain := range vs.GetVirtualHost().GetDomains() {
+		if domain == "" || strings.Contains(domain, "*") {
+			continue
+		}
+		// strip the port, as certificates are issued for host names
+		if i := strings.LastIndex(domain, ":"); i >= 0 {
+			domain = domain[:i]
+		}
+		if !containsString(domains, domain) {
+			domains = append(domains, domain)
+		}
+	}
+	return domains
+}
+
+func certificateSecretRef(vs *v1.VirtualService) core.ResourceRef {
+	if ref := vs.GetSslConfig().GetSecretRef(); ref != nil {
+		return *ref
+	}
+	return core.ResourceRef{
+		Name:      vs.Metadata.Name + SecretNameSuffix,
+		Namespace: vs.Metadata.Namespace,
+	}
+}
+
+// replaces the challenge routes of the virtual service with routes serving the given challenges.
+// the routes are served on plain HTTP: on the virtual service itself if it does not serve TLS,
+// on the exclusions of its https redirect otherwise.
+func setChallengeRoutes(vs *v1.VirtualService, challenges []issuer.Challenge) {
+	if vs.VirtualHost != nil {
+		vs.VirtualHost.Routes = withoutChallengeRoutes(vs.VirtualHost.Routes)
+	}
+	if vs.HttpsRedirect != nil {
+		vs.HttpsRedirect.Exclusions = withoutChallengeRoutes(vs.HttpsRedirect.Exclusions)
+	}
+	if len(challenges) == 0 {
+		return
+	}
+
+	var routes []*v1.Route
+	for _, challenge := range challenges {
+		routes = append(routes, &v1.Route{
+			Name: ChallengeRouteNamePrefix + challenge.Domain,
+			Matchers: []*matchers.Matcher{{
+				PathSpecifier: &matchers.Matcher_Exact{Exact: ChallengePathPrefix + challenge.Token},
+			}},
+			Action: &v1.Route_DirectResponseAction{
+				DirectResponseAction: &gloov1.DirectResponseAction{
+					Status: http.StatusOK,
+					Body:   challenge.KeyAuthorization,
+				},
+			},
+		})
+	}
+
+	if vs.SslConfig == nil {
+		vs.VirtualHost.Routes = append(routes, vs.VirtualHost.Routes...)
+		return
+	}
+	if vs.HttpsRedirect == nil {
+		vs.HttpsRedirect = &v1.HttpsRedirect{}
+	}
+	vs.HttpsRedirect.Exclusions = append(routes, vs.HttpsRedirect.Exclusions...)
+}
+
+func withoutChallengeRoutes(routes []*v1.Route) []*v1.Route {
+	var filtered []*v1.Route
+	for _, route := range routes {
+		if !strings.HasPrefix(route.GetName(), ChallengeRouteNamePrefix) {
+			filtered = append(filtered, route)
+		}
+	}
+	return filtered
+}
+
+func setState(vs *v1.VirtualService, state, message string) {
+	if vs.Metadata.Annotations == nil {
+		vs.Metadata.Annotations = map[string]string{}
+	}
+	vs.Metadata.Annotations[gwutils.AcmeStateAnnotation] = state
+	vs.Metadata.Annotations[gwutils.AcmeMessageAnnotation] = message
+}
+
+func cloneVirtualService(vs *v1.VirtualService) *v1.VirtualService {
+	return resources.Clone(vs).(*v1.VirtualService)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
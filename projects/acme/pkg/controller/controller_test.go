@@ -0,0 +1,332 @@
+package controller_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rotisserie/eris"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/acme/pkg/controller"
+	"github.com/solo-io/gloo/projects/acme/pkg/issuer"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gwutils "github.com/solo-io/gloo/projects/gateway/pkg/utils"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/test/helpers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+type fakeIssuer struct {
+	lock    sync.Mutex
+	domains []string
+	issued  int
+	// the virtual service as written when the challenges were presented
+	presented *v1.VirtualService
+	readVs    func() (*v1.VirtualService, error)
+	err       error
+}
+
+func (f *fakeIssuer) Issue(ctx context.Context, domains []string, present issuer.PresentFunc) (*issuer.Certificate, error) {
+	var challenges []issuer.Challenge
+	for _, domain := range domains {
+		challenges = append(challenges, issuer.Challenge{Domain: domain, Token: "token-" + domain, KeyAuthorization: "key-" + domain})
+	}
+	if err := present(challenges); err != nil {
+		return nil, err
+	}
+	presented, _ := f.readVs()
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.domains = domains
+	f.issued++
+	f.presented = presented
+	if f.err != nil {
+		return nil, f.err
+	}
+	validFor := 90 * 24 * time.Hour
+	cert, key := helpers.GetCerts(helpers.Params{
+		Hosts:      strings.Join(domains, ","),
+		ValidFor:   &validFor,
+		EcdsaCurve: "P256",
+	})
+	return &issuer.Certificate{CertChain: cert, PrivateKey: key}, nil
+}
+
+func (f *fakeIssuer) setErr(err error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.err = err
+}
+
+func (f *fakeIssuer) issuances() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.issued
+}
+
+func (f *fakeIssuer) issuedDomains() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.domains
+}
+
+func (f *fakeIssuer) presentedVs() *v1.VirtualService {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.presented
+}
+
+var _ = Describe("Controller", func() {
+
+	var (
+		ctx                  context.Context
+		cancel               context.CancelFunc
+		opts                 Opts
+		fake                 *fakeIssuer
+		virtualServiceClient v1.VirtualServiceClient
+		secretClient         gloov1.SecretClient
+		syncer               v1.ApiSyncer
+		vs                   *v1.VirtualService
+	)
+
+	readVsOrErr := func() (*v1.VirtualService, error) {
+		return virtualServiceClient.Read(vs.Metadata.Namespace, vs.Metadata.Name, clients.ReadOpts{})
+	}
+
+	readVs := func() *v1.VirtualService {
+		written, err := readVsOrErr()
+		Expect(err).NotTo(HaveOccurred())
+		return written
+	}
+
+	sync := func() {
+		Expect(syncer.Sync(ctx, &v1.ApiSnapshot{VirtualServices: v1.VirtualServiceList{readVs()}})).NotTo(HaveOccurred())
+	}
+
+	acmeState := func() string {
+		state, _ := gwutils.AcmeState(readVs())
+		return state
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		opts = Opts{PropagationDelay: time.Millisecond}
+		resourceClientFactory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		var err error
+		virtualServiceClient, err = v1.NewVirtualServiceClient(resourceClientFactory)
+		Expect(err).NotTo(HaveOccurred())
+		secretClient, err = gloov1.NewSecretClient(resourceClientFactory)
+		Expect(err).NotTo(HaveOccurred())
+
+		fake = &fakeIssuer{readVs: readVsOrErr}
+
+		vs = &v1.VirtualService{
+			Metadata: core.Metadata{
+				Name:        "vs",
+				Namespace:   "default",
+				Annotations: map[string]string{gwutils.AcmeEnabledAnnotation: "true"},
+			},
+			VirtualHost: &v1.VirtualHost{
+				Domains: []string{"example.com", "www.example.com:80", "*.example.com"},
+				Routes: []*v1.Route{{
+					Matchers: []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"}}},
+					Action: &v1.Route_DirectResponseAction{
+						DirectResponseAction: &gloov1.DirectResponseAction{Status: 200, Body: "hello"},
+					},
+				}},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		vs, err = virtualServiceClient.Write(vs, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		syncer = NewSyncer(ctx, fake, virtualServiceClient, secretClient, opts)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("issues a certificate for the domains of the virtual service", func() {
+		sync()
+		Eventually(acmeState).Should(Equal(gwutils.AcmeStateIssued))
+		Expect(fake.issuedDomains()).To(Equal([]string{"example.com", "www.example.com"}))
+
+		By("serving the challenges on the virtual service")
+		presented := fake.presentedVs()
+		routes := presented.VirtualHost.Routes
+		Expect(routes).To(HaveLen(3))
+		Expect(routes[0].GetMatchers()[0].GetExact()).To(Equal(ChallengePathPrefix + "token-example.com"))
+		Expect(routes[0].GetDirectResponseAction()).To(Equal(&gloov1.DirectResponseAction{Status: 200, Body: "key-example.com"}))
+		Expect(routes[1].GetDirectResponseAction().GetBody()).To(Equal("key-www.example.com"))
+		state, _ := gwutils.AcmeState(presented)
+		Expect(state).To(Equal(gwutils.AcmeStatePending))
+
+		By("writing the certificate and serving it on the virtual service")
+		secret, err := secretClient.Read("default", "vs"+SecretNameSuffix, clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secret.GetTls().GetCertChain()).NotTo(BeEmpty())
+		Expect(secret.GetTls().GetPrivateKey()).NotTo(BeEmpty())
+
+		issued := readVs()
+		Expect(issued.VirtualHost.Routes).To(HaveLen(1))
+		Expect(issued.SslConfig.GetSecretRef()).To(Equal(&core.ResourceRef{Name: "vs" + SecretNameSuffix, Namespace: "default"}))
+		Expect(issued.SslConfig.SniDomains).To(Equal([]string{"example.com", "www.example.com"}))
+		Expect(issued.HttpsRedirect).NotTo(BeNil())
+		state, message := gwutils.AcmeState(issued)
+		Expect(state).To(Equal(gwutils.AcmeStateIssued))
+		Expect(message).To(ContainSubstring("certificate expires at"))
+	})
+
+	It("does not renew valid certificates", func() {
+		sync()
+		Eventually(acmeState).Should(Equal(gwutils.AcmeStateIssued))
+		sync()
+		Consistently(fake.issuances, "100ms").Should(Equal(1))
+	})
+
+	Context("with a short renewal window", func() {
+		BeforeEach(func() {
+			// the issued certificates are valid for 90 days from a minute ago
+			opts.RenewBefore = 90*24*time.Hour - time.Minute - 2*time.Second
+		})
+
+		It("renews certificates once they enter the renewal window without a new snapshot", func() {
+			sync()
+			Eventually(fake.issuances).Should(Equal(1))
+			Eventually(fake.issuances, "5s").Should(Equal(2))
+		})
+	})
+
+	Context("with a renewal window longer than the certificate lifetime", func() {
+		BeforeEach(func() {
+			opts.RenewBefore = 100 * 24 * time.Hour
+		})
+
+		It("does not reissue the certificate before the retry interval", func() {
+			sync()
+			Eventually(acmeState).Should(Equal(gwutils.AcmeStateIssued))
+			Consistently(fake.issuances, "200ms").Should(Equal(1))
+		})
+	})
+
+	It("ignores virtual services that are not annotated", func() {
+		vs.Metadata.Annotations = nil
+		_, err := virtualServiceClient.Write(vs, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		sync()
+		Consistently(fake.issuances, "100ms").Should(BeZero())
+	})
+
+	Context("with an ssl config", func() {
+		var secretRef core.ResourceRef
+
+		BeforeEach(func() {
+			secretRef = core.ResourceRef{Name: "tls", Namespace: "default"}
+			vs.SslConfig = &gloov1.SslConfig{
+				SslSecrets: &gloov1.SslConfig_SecretRef{SecretRef: &secretRef},
+			}
+			vs.HttpsRedirect = &v1.HttpsRedirect{
+				Exclusions: []*v1.Route{{Name: "exclusion"}},
+			}
+		})
+
+		It("renews expiring certificates on the exclusions of the https redirect", func() {
+			validFor := 24 * time.Hour
+			cert, key := helpers.GetCerts(helpers.Params{Hosts: "example.com,www.example.com", ValidFor: &validFor, EcdsaCurve: "P256"})
+			_, err := secretClient.Write(&gloov1.Secret{
+				Metadata: core.Metadata{Name: secretRef.Name, Namespace: secretRef.Namespace},
+				Kind:     &gloov1.Secret_Tls{Tls: &gloov1.TlsSecret{CertChain: cert, PrivateKey: key}},
+			}, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+
+			sync()
+			Eventually(acmeState).Should(Equal(gwutils.AcmeStateIssued))
+			Expect(fake.issuances()).To(Equal(1))
+
+			presented := fake.presentedVs()
+			Expect(presented.VirtualHost.Routes).To(HaveLen(1))
+			exclusions := presented.HttpsRedirect.Exclusions
+			Expect(exclusions).To(HaveLen(3))
+			Expect(exclusions[0].GetName()).To(Equal(ChallengeRouteNamePrefix + "example.com"))
+			Expect(exclusions[2].GetName()).To(Equal("exclusion"))
+
+			secret, err := secretClient.Read(secretRef.Namespace, secretRef.Name, clients.ReadOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secret.GetTls().GetCertChain()).NotTo(Equal(cert))
+			Expect(readVs().HttpsRedirect.Exclusions).To(HaveLen(1))
+		})
+
+		It("fails when the ssl config does not reference a secret", func() {
+			vs.SslConfig.SslSecrets = &gloov1.SslConfig_SslFiles{SslFiles: &gloov1.SSLFiles{}}
+			_, err := virtualServiceClient.Write(vs, clients.WriteOpts{OverwriteExisting: true})
+			Expect(err).NotTo(HaveOccurred())
+
+			sync()
+			Eventually(acmeState).Should(Equal(gwutils.AcmeStateFailed))
+			_, message := gwutils.AcmeState(readVs())
+			Expect(message).To(Equal(SslConfigWithoutSecretError.Error()))
+		})
+	})
+
+	Context("when the issuance fails", func() {
+		BeforeEach(func() {
+			fake.err = eris.New("rate limited")
+		})
+
+		It("reports the failure and removes the challenge routes", func() {
+			sync()
+			Eventually(acmeState).Should(Equal(gwutils.AcmeStateFailed))
+
+			failed := readVs()
+			Expect(failed.VirtualHost.Routes).To(HaveLen(1))
+			Expect(failed.SslConfig).To(BeNil())
+			state, message := gwutils.AcmeState(failed)
+			Expect(state).To(Equal(gwutils.AcmeStateFailed))
+			Expect(message).To(Equal("rate limited"))
+		})
+
+		It("does not retry before the retry interval", func() {
+			sync()
+			Eventually(acmeState).Should(Equal(gwutils.AcmeStateFailed))
+			sync()
+			Consistently(fake.issuances, "100ms").Should(Equal(1))
+		})
+
+		Context("with a short retry interval", func() {
+			BeforeEach(func() {
+				opts.RetryInterval = 100 * time.Millisecond
+			})
+
+			It("retries after the retry interval without a new snapshot", func() {
+				sync()
+				Eventually(acmeState).Should(Equal(gwutils.AcmeStateFailed))
+				fake.setErr(nil)
+				Eventually(acmeState, "2s").Should(Equal(gwutils.AcmeStateIssued))
+			})
+		})
+	})
+
+	It("fails for virtual services with only wildcard domains", func() {
+		vs.VirtualHost.Domains = []string{"*"}
+		_, err := virtualServiceClient.Write(vs, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		sync()
+		Eventually(acmeState).Should(Equal(gwutils.AcmeStateFailed))
+		Expect(fake.issuances()).To(BeZero())
+	})
+})
@@ -0,0 +1,184 @@
+package issuer
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"net/http"
+	"sync"
+
+	"github.com/rotisserie/eris"
+	"golang.org/x/crypto/acme"
+)
+
+const Http01ChallengeType = "http-01"
+
+var (
+	NoHttp01ChallengeError = func(domain string) error {
+		return eris.Errorf("the ACME server did not offer an %v challenge for domain %v", Http01ChallengeType, domain)
+	}
+	NoDomainsError = eris.New("at least one domain is required to issue a certificate")
+)
+
+// Challenge is an HTTP-01 challenge that must be served on
+// `http://<Domain>/.well-known/acme-challenge/<Token>` with the KeyAuthorization as response body.
+type Challenge struct {
+	Domain           string
+	Token            string
+	KeyAuthorization string
+}
+
+// Certificate is a PEM encoded certificate chain, leaf first, and its private key
+type Certificate struct {
+	CertChain  string
+	PrivateKey string
+}
+
+// PresentFunc is called with the pending challenges of an order once they must be served.
+// Returning an error aborts the order.
+type PresentFunc func(challenges []Challenge) error
+
+type Issuer interface {
+	// Issue orders a certificate for the given domains, validating them with HTTP-01 challenges
+	Issue(ctx context.Context, domains []string, present PresentFunc) (*Certificate, error)
+}
+
+type acmeIssuer struct {
+	client *acme.Client
+	email  string
+
+	registerOnce sync.Once
+	registerErr  error
+}
+
+// NewIssuer creates an issuer for the ACME server with the given directory url.
+// The account of the key is registered with the server on first use.
+func NewIssuer(directoryUrl string, accountKey crypto.Signer, email string, httpClient *http.Client) Issuer {
+	return &acmeIssuer{
+		client: &acme.Client{
+			Key:          accountKey,
+			DirectoryURL: directoryUrl,
+			HTTPClient:   httpClient,
+		},
+		email: email,
+	}
+}
+
+func (i *acmeIssuer) register(ctx context.Context) error {
+	i.registerOnce.Do(func() {
+		account := &acme.Account{}
+		if i.email != "" {
+			account.Contact = []string{"mailto:" + i.email}
+		}
+		_, err := i.client.Register(ctx, account, acme.AcceptTOS)
+		if err != nil && err != acme.ErrAccountAlreadyExists {
+			i.registerErr = eris.Wrapf(err, "registering ACME account")
+		}
+	})
+	return i.registerErr
+}
+
+func (i *acmeIssuer) Issue(ctx context.Context, domains []string, present PresentFunc) (*Certificate, error) {
+	if len(domains) == 0 {
+		return nil, NoDomainsError
+	}
+	if err := i.register(ctx); err != nil {
+		return nil, err
+	}
+
+	order, err := i.client.AuthorizeOrder(ctx, acme.DomainIDs(domains...))
+	if err != nil {
+		return nil, eris.Wrapf(err, "creating ACME order")
+	}
+
+	var (
+		challenges []Challenge
+		pending    []*acme.Challenge
+	)
+	for _, authzUrl := range order.AuthzURLs {
+		authz, err := i.client.GetAuthorization(ctx, authzUrl)
+		if err != nil {
+			return nil, eris.Wrapf(err, "getting ACME authorization")
+		}
+		if authz.Status == acme.StatusValid {
+			continue
+		}
+		var challenge *acme.Challenge
+		for _, c := range authz.Challenges {
+			if c.Type == Http01ChallengeType {
+				challenge = c
+				break
+			}
+		}
+		if challenge == nil {
+			return nil, NoHttp01ChallengeError(authz.Identifier.Value)
+		}
+		keyAuth, err := i.client.HTTP01ChallengeResponse(challenge.Token)
+		if err != nil {
+			return nil, err
+		}
+		challenges = append(challenges, Challenge{
+			Domain:           authz.Identifier.Value,
+			Token:            challenge.Token,
+			KeyAuthorization: keyAuth,
+		})
+		pending = append(pending, challenge)
+	}
+
+	if len(challenges) > 0 {
+		if err := present(challenges); err != nil {
+			return nil, err
+		}
+	}
+	for _, challenge := range pending {
+		if _, err := i.client.Accept(ctx, challenge); err != nil {
+			return nil, eris.Wrapf(err, "accepting ACME challenge")
+		}
+	}
+	for _, authzUrl := range order.AuthzURLs {
+		if _, err := i.client.WaitAuthorization(ctx, authzUrl); err != nil {
+			return nil, eris.Wrapf(err, "validating ACME challenge")
+		}
+	}
+	if order, err = i.client.WaitOrder(ctx, order.URI); err != nil {
+		return nil, eris.Wrapf(err, "waiting for ACME order")
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: domains[0]},
+		DNSNames: domains,
+	}, key)
+	if err != nil {
+		return nil, err
+	}
+	der, _, err := i.client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, eris.Wrapf(err, "finalizing ACME order")
+	}
+
+	return encodeCertificate(der, key)
+}
+
+func encodeCertificate(der [][]byte, key *ecdsa.PrivateKey) (*Certificate, error) {
+	var certChain []byte
+	for _, cert := range der {
+		certChain = append(certChain, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert})...)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return &Certificate{
+		CertChain:  string(certChain),
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})),
+	}, nil
+}
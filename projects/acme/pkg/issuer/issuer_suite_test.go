@@ -0,0 +1,13 @@
+package issuer_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestIssuer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Issuer Suite")
+}
@@ -0,0 +1,63 @@
+package issuer_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"net/http"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/acme/pkg/issuer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+// Runs against a local Pebble server started with PEBBLE_VA_ALWAYS_VALID=1, e.g.
+//   docker run -p 14000:14000 -e PEBBLE_VA_ALWAYS_VALID=1 letsencrypt/pebble
+//   PEBBLE_DIRECTORY_URL=https://localhost:14000/dir go test ./projects/acme/pkg/issuer/...
+var _ = Describe("Issuer", func() {
+
+	var directoryUrl string
+
+	BeforeEach(func() {
+		directoryUrl = os.Getenv("PEBBLE_DIRECTORY_URL")
+		if directoryUrl == "" {
+			Skip("PEBBLE_DIRECTORY_URL is not set")
+		}
+	})
+
+	It("issues a certificate with HTTP-01 challenges", func() {
+		accountKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		// pebble serves its API with a certificate signed by a throwaway CA
+		httpClient := &http.Client{
+			Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+		}
+		issuer := NewIssuer(directoryUrl, accountKey, "admin@example.com", httpClient)
+
+		var presented []Challenge
+		cert, err := issuer.Issue(context.Background(), []string{"example.com", "www.example.com"}, func(challenges []Challenge) error {
+			presented = challenges
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(presented).To(HaveLen(2))
+		for _, challenge := range presented {
+			Expect(challenge.KeyAuthorization).To(HavePrefix(challenge.Token + "."))
+		}
+
+		info, err := utils.ParseCertificateChain(cert.CertChain)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.DNSNames).To(ConsistOf("example.com", "www.example.com"))
+		Expect(cert.PrivateKey).To(ContainSubstring("EC PRIVATE KEY"))
+	})
+
+	It("requires a domain", func() {
+		issuer := NewIssuer(directoryUrl, nil, "", nil)
+		_, err := issuer.Issue(context.Background(), nil, nil)
+		Expect(err).To(MatchError(NoDomainsError))
+	})
+})
@@ -0,0 +1,25 @@
+package setup
+
+import (
+	"crypto"
+	"net/http"
+
+	"github.com/solo-io/gloo/projects/acme/pkg/controller"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+)
+
+type Opts struct {
+	DirectoryUrl      string
+	Email             string
+	AccountKey        crypto.Signer
+	HttpClient        *http.Client
+	Controller        controller.Opts
+	WatchNamespaces   []string
+	VirtualServices   factory.ResourceClientFactory
+	RouteTables       factory.ResourceClientFactory
+	Gateways          factory.ResourceClientFactory
+	ReferencePolicies factory.ResourceClientFactory
+	Secrets           factory.ResourceClientFactory
+	WatchOpts         clients.WatchOpts
+}
@@ -0,0 +1,287 @@
+package setup
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/solo-io/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/acme/pkg/controller"
+	"github.com/solo-io/gloo/projects/acme/pkg/issuer"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
+	gloodefaults "github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/errutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"golang.org/x/crypto/acme"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// the directory url of the ACME server, defaults to Let's Encrypt
+	DirectoryUrlEnv = "ACME_DIRECTORY_URL"
+	// the contact email of the ACME account
+	EmailEnv = "ACME_EMAIL"
+	// a PEM encoded private key for the ACME account. a new account is created on every start if unset.
+	AccountKeyFileEnv = "ACME_ACCOUNT_KEY_FILE"
+	// a PEM encoded CA bundle to verify the ACME server with, e.g. the minica root of Pebble
+	CaBundleFileEnv = "ACME_CA_BUNDLE_FILE"
+	// durations configuring the controller, see controller.Opts
+	RenewBeforeEnv      = "ACME_RENEW_BEFORE"
+	PropagationDelayEnv = "ACME_PROPAGATION_DELAY"
+	RetryIntervalEnv    = "ACME_RETRY_INTERVAL"
+	ResyncIntervalEnv   = "ACME_RESYNC_INTERVAL"
+)
+
+func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory.InMemoryResourceCache, settings *gloov1.Settings) error {
+	var (
+		cfg           *rest.Config
+		clientset     kubernetes.Interface
+		kubeCoreCache cache.KubeCoreCache
+	)
+
+	consulClient, err := bootstrap.ConsulClientForSettings(ctx, settings)
+	if err != nil {
+		return err
+	}
+
+	params := bootstrap.NewConfigFactoryParams(
+		settings,
+		inMemoryCache,
+		kubeCache,
+		&cfg,
+		consulClient,
+	)
+
+	virtualServiceFactory, err := bootstrap.ConfigFactoryForSettings(params, v1.VirtualServiceCrd)
+	if err != nil {
+		return err
+	}
+
+	routeTableFactory, err := bootstrap.ConfigFactoryForSettings(params, v1.RouteTableCrd)
+	if err != nil {
+		return err
+	}
+
+	gatewayFactory, err := bootstrap.ConfigFactoryForSettings(params, v1.GatewayCrd)
+	if err != nil {
+		return err
+	}
+
+	referencePolicyFactory, err := bootstrap.ConfigFactoryForSettings(params, gloov1.ReferencePolicyCrd)
+	if err != nil {
+		return err
+	}
+
+	secretFactory, err := bootstrap.SecretFactoryForSettings(
+		ctx,
+		settings,
+		inMemoryCache,
+		&cfg,
+		&clientset,
+		&kubeCoreCache,
+		consulClient,
+		nil, // acme controller does not support vault config
+		gloov1.SecretCrd.Plural,
+	)
+	if err != nil {
+		return err
+	}
+
+	refreshRate, err := types.DurationFromProto(settings.RefreshRate)
+	if err != nil {
+		return err
+	}
+
+	writeNamespace := settings.DiscoveryNamespace
+	if writeNamespace == "" {
+		writeNamespace = gloodefaults.GlooSystem
+	}
+	watchNamespaces := utils.ProcessWatchNamespaces(settings.WatchNamespaces, writeNamespace)
+
+	directoryUrl := os.Getenv(DirectoryUrlEnv)
+	if directoryUrl == "" {
+		directoryUrl = acme.LetsEncryptURL
+	}
+
+	accountKey, err := loadAccountKey(os.Getenv(AccountKeyFileEnv))
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := httpClientForCaBundle(os.Getenv(CaBundleFileEnv))
+	if err != nil {
+		return err
+	}
+
+	var controllerOpts controller.Opts
+	for env, duration := range map[string]*time.Duration{
+		RenewBeforeEnv:      &controllerOpts.RenewBefore,
+		PropagationDelayEnv: &controllerOpts.PropagationDelay,
+		RetryIntervalEnv:    &controllerOpts.RetryInterval,
+		ResyncIntervalEnv:   &controllerOpts.ResyncInterval,
+	} {
+		if value := os.Getenv(env); value != "" {
+			if *duration, err = time.ParseDuration(value); err != nil {
+				return errors.Wrapf(err, "parsing %v", env)
+			}
+		}
+	}
+
+	opts := Opts{
+		DirectoryUrl:      directoryUrl,
+		Email:             os.Getenv(EmailEnv),
+		AccountKey:        accountKey,
+		HttpClient:        httpClient,
+		Controller:        controllerOpts,
+		WatchNamespaces:   watchNamespaces,
+		VirtualServices:   virtualServiceFactory,
+		RouteTables:       routeTableFactory,
+		Gateways:          gatewayFactory,
+		ReferencePolicies: referencePolicyFactory,
+		Secrets:           secretFactory,
+		WatchOpts: clients.WatchOpts{
+			Ctx:         ctx,
+			RefreshRate: refreshRate,
+		},
+	}
+
+	return RunAcme(opts)
+}
+
+func RunAcme(opts Opts) error {
+	opts.WatchOpts = opts.WatchOpts.WithDefaults()
+	opts.WatchOpts.Ctx = contextutils.WithLogger(opts.WatchOpts.Ctx, "acme")
+	ctx := opts.WatchOpts.Ctx
+
+	virtualServiceClient, err := v1.NewVirtualServiceClient(opts.VirtualServices)
+	if err != nil {
+		return err
+	}
+	if err := virtualServiceClient.Register(); err != nil {
+		return err
+	}
+
+	routeTableClient, err := v1.NewRouteTableClient(opts.RouteTables)
+	if err != nil {
+		return err
+	}
+	if err := routeTableClient.Register(); err != nil {
+		return err
+	}
+
+	gatewayClient, err := v1.NewGatewayClient(opts.Gateways)
+	if err != nil {
+		return err
+	}
+	if err := gatewayClient.Register(); err != nil {
+		return err
+	}
+
+	referencePolicyClient, err := gloov1.NewReferencePolicyClient(opts.ReferencePolicies)
+	if err != nil {
+		return err
+	}
+	if err := referencePolicyClient.Register(); err != nil {
+		return err
+	}
+
+	secretClient, err := gloov1.NewSecretClient(opts.Secrets)
+	if err != nil {
+		return err
+	}
+	if err := secretClient.Register(); err != nil {
+		return err
+	}
+
+	acmeIssuer := issuer.NewIssuer(opts.DirectoryUrl, opts.AccountKey, opts.Email, opts.HttpClient)
+	syncer := controller.NewSyncer(ctx, acmeIssuer, virtualServiceClient, secretClient, opts.Controller)
+
+	emitter := v1.NewApiEmitter(virtualServiceClient, routeTableClient, gatewayClient, referencePolicyClient)
+	eventLoop := v1.NewApiEventLoop(emitter, syncer)
+	eventLoopErrs, err := eventLoop.Run(opts.WatchNamespaces, opts.WatchOpts)
+	if err != nil {
+		return err
+	}
+
+	writeErrs := make(chan error)
+	go errutils.AggregateErrs(ctx, writeErrs, eventLoopErrs, "acme_event_loop")
+
+	logger := contextutils.LoggerFrom(ctx)
+	go func() {
+		for {
+			select {
+			case err := <-writeErrs:
+				logger.Errorf("error: %v", err)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func loadAccountKey(path string) (crypto.Signer, error) {
+	if path == "" {
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	}
+	keyPem, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading ACME account key")
+	}
+	block, _ := pem.Decode(keyPem)
+	if block == nil {
+		return nil, errors.Errorf("no PEM encoded ACME account key found in %v", path)
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing ACME account key")
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.Errorf("unsupported ACME account key type %T", key)
+	}
+	return signer, nil
+}
+
+func httpClientForCaBundle(path string) (*http.Client, error) {
+	if path == "" {
+		return http.DefaultClient, nil
+	}
+	caPem, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading ACME CA bundle")
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPem) {
+		return nil, errors.Errorf("no PEM encoded certificates found in %v", path)
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: pool},
+		},
+	}, nil
+}
@@ -8,22 +8,26 @@ import (
 	"hash/fnv"
 	"log"
 
+	gloo_solo_io "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/go-utils/hashutils"
 	"go.uber.org/zap"
 )
 
 type ApiSnapshot struct {
-	VirtualServices VirtualServiceList
-	RouteTables     RouteTableList
-	Gateways        GatewayList
+	VirtualServices   VirtualServiceList
+	RouteTables       RouteTableList
+	Gateways          GatewayList
+	ReferencePolicies gloo_solo_io.ReferencePolicyList
 }
 
 func (s ApiSnapshot) Clone() ApiSnapshot {
 	return ApiSnapshot{
-		VirtualServices: s.VirtualServices.Clone(),
-		RouteTables:     s.RouteTables.Clone(),
-		Gateways:        s.Gateways.Clone(),
+		VirtualServices:   s.VirtualServices.Clone(),
+		RouteTables:       s.RouteTables.Clone(),
+		Gateways:          s.Gateways.Clone(),
+		ReferencePolicies: s.ReferencePolicies.Clone(),
 	}
 }
 
@@ -40,6 +44,9 @@ func (s ApiSnapshot) Hash(hasher hash.Hash64) (uint64, error) {
 	if _, err := s.hashGateways(hasher); err != nil {
 		return 0, err
 	}
+	if _, err := s.hashReferencePolicies(hasher); err != nil {
+		return 0, err
+	}
 	return hasher.Sum64(), nil
 }
 
@@ -55,6 +62,10 @@ func (s ApiSnapshot) hashGateways(hasher hash.Hash64) (uint64, error) {
 	return hashutils.HashAllSafe(hasher, s.Gateways.AsInterfaces()...)
 }
 
+func (s ApiSnapshot) hashReferencePolicies(hasher hash.Hash64) (uint64, error) {
+	return hashutils.HashAllSafe(hasher, s.ReferencePolicies.AsInterfaces()...)
+}
+
 func (s ApiSnapshot) HashFields() []zap.Field {
 	var fields []zap.Field
 	hasher := fnv.New64()
@@ -73,6 +84,11 @@ func (s ApiSnapshot) HashFields() []zap.Field {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
 	}
 	fields = append(fields, zap.Uint64("gateways", GatewaysHash))
+	ReferencePoliciesHash, err := s.hashReferencePolicies(hasher)
+	if err != nil {
+		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
+	}
+	fields = append(fields, zap.Uint64("referencePolicies", ReferencePoliciesHash))
 	snapshotHash, err := s.Hash(hasher)
 	if err != nil {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
@@ -81,10 +97,11 @@ func (s ApiSnapshot) HashFields() []zap.Field {
 }
 
 type ApiSnapshotStringer struct {
-	Version         uint64
-	VirtualServices []string
-	RouteTables     []string
-	Gateways        []string
+	Version           uint64
+	VirtualServices   []string
+	RouteTables       []string
+	Gateways          []string
+	ReferencePolicies []string
 }
 
 func (ss ApiSnapshotStringer) String() string {
@@ -105,6 +122,11 @@ func (ss ApiSnapshotStringer) String() string {
 		s += fmt.Sprintf("    %v\n", name)
 	}
 
+	s += fmt.Sprintf("  ReferencePolicies %v\n", len(ss.ReferencePolicies))
+	for _, name := range ss.ReferencePolicies {
+		s += fmt.Sprintf("    %v\n", name)
+	}
+
 	return s
 }
 
@@ -114,9 +136,10 @@ func (s ApiSnapshot) Stringer() ApiSnapshotStringer {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
 	}
 	return ApiSnapshotStringer{
-		Version:         snapshotHash,
-		VirtualServices: s.VirtualServices.NamespacesDotNames(),
-		RouteTables:     s.RouteTables.NamespacesDotNames(),
-		Gateways:        s.Gateways.NamespacesDotNames(),
+		Version:           snapshotHash,
+		VirtualServices:   s.VirtualServices.NamespacesDotNames(),
+		RouteTables:       s.RouteTables.NamespacesDotNames(),
+		Gateways:          s.Gateways.NamespacesDotNames(),
+		ReferencePolicies: s.ReferencePolicies.NamespacesDotNames(),
 	}
 }
@@ -6,6 +6,8 @@ import (
 	"sync"
 	"time"
 
+	gloo_solo_io "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+
 	"go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
 	"go.opencensus.io/tag"
@@ -83,26 +85,29 @@ type ApiEmitter interface {
 	VirtualService() VirtualServiceClient
 	RouteTable() RouteTableClient
 	Gateway() GatewayClient
+	ReferencePolicy() gloo_solo_io.ReferencePolicyClient
 }
 
-func NewApiEmitter(virtualServiceClient VirtualServiceClient, routeTableClient RouteTableClient, gatewayClient GatewayClient) ApiEmitter {
-	return NewApiEmitterWithEmit(virtualServiceClient, routeTableClient, gatewayClient, make(chan struct{}))
+func NewApiEmitter(virtualServiceClient VirtualServiceClient, routeTableClient RouteTableClient, gatewayClient GatewayClient, referencePolicyClient gloo_solo_io.ReferencePolicyClient) ApiEmitter {
+	return NewApiEmitterWithEmit(virtualServiceClient, routeTableClient, gatewayClient, referencePolicyClient, make(chan struct{}))
 }
 
-func NewApiEmitterWithEmit(virtualServiceClient VirtualServiceClient, routeTableClient RouteTableClient, gatewayClient GatewayClient, emit <-chan struct{}) ApiEmitter {
+func NewApiEmitterWithEmit(virtualServiceClient VirtualServiceClient, routeTableClient RouteTableClient, gatewayClient GatewayClient, referencePolicyClient gloo_solo_io.ReferencePolicyClient, emit <-chan struct{}) ApiEmitter {
 	return &apiEmitter{
-		virtualService: virtualServiceClient,
-		routeTable:     routeTableClient,
-		gateway:        gatewayClient,
-		forceEmit:      emit,
+		virtualService:  virtualServiceClient,
+		routeTable:      routeTableClient,
+		gateway:         gatewayClient,
+		referencePolicy: referencePolicyClient,
+		forceEmit:       emit,
 	}
 }
 
 type apiEmitter struct {
-	forceEmit      <-chan struct{}
-	virtualService VirtualServiceClient
-	routeTable     RouteTableClient
-	gateway        GatewayClient
+	forceEmit       <-chan struct{}
+	virtualService  VirtualServiceClient
+	routeTable      RouteTableClient
+	gateway         GatewayClient
+	referencePolicy gloo_solo_io.ReferencePolicyClient
 }
 
 func (c *apiEmitter) Register() error {
@@ -115,6 +120,9 @@ func (c *apiEmitter) Register() error {
 	if err := c.gateway.Register(); err != nil {
 		return err
 	}
+	if err := c.referencePolicy.Register(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -130,6 +138,10 @@ func (c *apiEmitter) Gateway() GatewayClient {
 	return c.gateway
 }
 
+func (c *apiEmitter) ReferencePolicy() gloo_solo_io.ReferencePolicyClient {
+	return c.referencePolicy
+}
+
 func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts) (<-chan *ApiSnapshot, <-chan error, error) {
 
 	if len(watchNamespaces) == 0 {
@@ -170,6 +182,14 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 	gatewayChan := make(chan gatewayListWithNamespace)
 
 	var initialGatewayList GatewayList
+	/* Create channel for ReferencePolicy */
+	type referencePolicyListWithNamespace struct {
+		list      gloo_solo_io.ReferencePolicyList
+		namespace string
+	}
+	referencePolicyChan := make(chan referencePolicyListWithNamespace)
+
+	var initialReferencePolicyList gloo_solo_io.ReferencePolicyList
 
 	currentSnapshot := ApiSnapshot{}
 
@@ -228,6 +248,24 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 			defer done.Done()
 			errutils.AggregateErrs(ctx, errs, gatewayErrs, namespace+"-gateways")
 		}(namespace)
+		/* Setup namespaced watch for ReferencePolicy */
+		{
+			referencePolicies, err := c.referencePolicy.List(namespace, clients.ListOpts{Ctx: opts.Ctx, Selector: opts.Selector})
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "initial ReferencePolicy list")
+			}
+			initialReferencePolicyList = append(initialReferencePolicyList, referencePolicies...)
+		}
+		referencePolicyNamespacesChan, referencePolicyErrs, err := c.referencePolicy.Watch(namespace, opts)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "starting ReferencePolicy watch")
+		}
+
+		done.Add(1)
+		go func(namespace string) {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, referencePolicyErrs, namespace+"-referencePolicies")
+		}(namespace)
 
 		/* Watch for changes and update snapshot */
 		go func(namespace string) {
@@ -253,6 +291,12 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 						return
 					case gatewayChan <- gatewayListWithNamespace{list: gatewayList, namespace: namespace}:
 					}
+				case referencePolicyList := <-referencePolicyNamespacesChan:
+					select {
+					case <-ctx.Done():
+						return
+					case referencePolicyChan <- referencePolicyListWithNamespace{list: referencePolicyList, namespace: namespace}:
+					}
 				}
 			}
 		}(namespace)
@@ -263,6 +307,8 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 	currentSnapshot.RouteTables = initialRouteTableList.Sort()
 	/* Initialize snapshot for Gateways */
 	currentSnapshot.Gateways = initialGatewayList.Sort()
+	/* Initialize snapshot for ReferencePolicies */
+	currentSnapshot.ReferencePolicies = initialReferencePolicyList.Sort()
 
 	snapshots := make(chan *ApiSnapshot)
 	go func() {
@@ -297,6 +343,7 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 		virtualServicesByNamespace := make(map[string]VirtualServiceList)
 		routeTablesByNamespace := make(map[string]RouteTableList)
 		gatewaysByNamespace := make(map[string]GatewayList)
+		referencePoliciesByNamespace := make(map[string]gloo_solo_io.ReferencePolicyList)
 
 		for {
 			record := func() { stats.Record(ctx, mApiSnapshotIn.M(1)) }
@@ -369,6 +416,25 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 					gatewayList = append(gatewayList, gateways...)
 				}
 				currentSnapshot.Gateways = gatewayList.Sort()
+			case referencePolicyNamespacedList := <-referencePolicyChan:
+				record()
+
+				namespace := referencePolicyNamespacedList.namespace
+
+				skstats.IncrementResourceCount(
+					ctx,
+					namespace,
+					"reference_policy",
+					mApiResourcesIn,
+				)
+
+				// merge lists by namespace
+				referencePoliciesByNamespace[namespace] = referencePolicyNamespacedList.list
+				var referencePolicyList gloo_solo_io.ReferencePolicyList
+				for _, referencePolicies := range referencePoliciesByNamespace {
+					referencePolicyList = append(referencePolicyList, referencePolicies...)
+				}
+				currentSnapshot.ReferencePolicies = referencePolicyList.Sort()
 			}
 		}
 	}()
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"time"
 
+	gloo_solo_io "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+
 	"go.opencensus.io/stats"
 	"go.uber.org/zap"
 
@@ -94,6 +96,8 @@ func (c *apiSimpleEmitter) Snapshots(ctx context.Context) (<-chan *ApiSnapshot,
 						currentSnapshot.RouteTables = append(currentSnapshot.RouteTables, typed)
 					case *Gateway:
 						currentSnapshot.Gateways = append(currentSnapshot.Gateways, typed)
+					case *gloo_solo_io.ReferencePolicy:
+						currentSnapshot.ReferencePolicies = append(currentSnapshot.ReferencePolicies, typed)
 					default:
 						select {
 						case errs <- fmt.Errorf("ApiSnapshotEmitter "+
@@ -50,10 +50,12 @@ type Gateway struct {
 	// The type of gateway being created
 	// HttpGateway creates a listener with an http_connection_manager
 	// TcpGateway creates a listener with a tcp proxy filter
+	// HybridGateway creates a listener that serves both, selecting the filter chain by SNI
 	//
 	// Types that are valid to be assigned to GatewayType:
 	//	*Gateway_HttpGateway
 	//	*Gateway_TcpGateway
+	//	*Gateway_HybridGateway
 	GatewayType isGateway_GatewayType `protobuf_oneof:"GatewayType"`
 	//
 	// Names of the [`Proxy`](https://gloo.solo.io/api/github.com/solo-io/gloo/projects/gloo/api/v1/proxy.proto.sk/)
@@ -114,9 +116,13 @@ type Gateway_HttpGateway struct {
 type Gateway_TcpGateway struct {
 	TcpGateway *TcpGateway `protobuf:"bytes,10,opt,name=tcp_gateway,json=tcpGateway,proto3,oneof" json:"tcp_gateway,omitempty"`
 }
+type Gateway_HybridGateway struct {
+	HybridGateway *HybridGateway `protobuf:"bytes,11,opt,name=hybrid_gateway,json=hybridGateway,proto3,oneof" json:"hybrid_gateway,omitempty"`
+}
 
-func (*Gateway_HttpGateway) isGateway_GatewayType() {}
-func (*Gateway_TcpGateway) isGateway_GatewayType()  {}
+func (*Gateway_HttpGateway) isGateway_GatewayType()   {}
+func (*Gateway_TcpGateway) isGateway_GatewayType()    {}
+func (*Gateway_HybridGateway) isGateway_GatewayType() {}
 
 func (m *Gateway) GetGatewayType() isGateway_GatewayType {
 	if m != nil {
@@ -188,6 +194,13 @@ func (m *Gateway) GetTcpGateway() *TcpGateway {
 	return nil
 }
 
+func (m *Gateway) GetHybridGateway() *HybridGateway {
+	if x, ok := m.GetGatewayType().(*Gateway_HybridGateway); ok {
+		return x.HybridGateway
+	}
+	return nil
+}
+
 func (m *Gateway) GetProxyNames() []string {
 	if m != nil {
 		return m.ProxyNames
@@ -200,6 +213,7 @@ func (*Gateway) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Gateway_HttpGateway)(nil),
 		(*Gateway_TcpGateway)(nil),
+		(*Gateway_HybridGateway)(nil),
 	}
 }
 
@@ -218,10 +232,21 @@ type HttpGateway struct {
 	// Setting '*' will search all namespaces, equivalent to omitting this value.
 	VirtualServiceNamespaces []string `protobuf:"bytes,3,rep,name=virtual_service_namespaces,json=virtualServiceNamespaces,proto3" json:"virtual_service_namespaces,omitempty"`
 	// HTTP Gateway configuration
-	Options              *v1.HttpListenerOptions `protobuf:"bytes,8,opt,name=options,proto3" json:"options,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	Options *v1.HttpListenerOptions `protobuf:"bytes,8,opt,name=options,proto3" json:"options,omitempty"`
+	// Default virtual host options for all virtual services selected by this gateway.
+	// Options set on a virtual service take precedence over the defaults provided here.
+	VirtualHostOptions *v1.VirtualHostOptions `protobuf:"bytes,9,opt,name=virtual_host_options,json=virtualHostOptions,proto3" json:"virtual_host_options,omitempty"`
+	// Default route options for all routes of the virtual services selected by this gateway,
+	// including routes inherited from delegated route tables.
+	// Options set on a route take precedence over the defaults provided here.
+	RouteOptions *v1.RouteOptions `protobuf:"bytes,10,opt,name=route_options,json=routeOptions,proto3" json:"route_options,omitempty"`
+	// Only applies to SSL gateways. If provided, the gateway translator will generate HTTP to HTTPS redirects
+	// for all the virtual services selected by this gateway on the non-SSL gateways that would otherwise
+	// select them. The `httpsRedirect` configuration of a virtual service takes precedence over this one.
+	HttpsRedirect        *HttpsRedirect `protobuf:"bytes,11,opt,name=https_redirect,json=httpsRedirect,proto3" json:"https_redirect,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *HttpGateway) Reset()         { *m = HttpGateway{} }
@@ -276,6 +301,79 @@ func (m *HttpGateway) GetOptions() *v1.HttpListenerOptions {
 	return nil
 }
 
+func (m *HttpGateway) GetVirtualHostOptions() *v1.VirtualHostOptions {
+	if m != nil {
+		return m.VirtualHostOptions
+	}
+	return nil
+}
+
+func (m *HttpGateway) GetRouteOptions() *v1.RouteOptions {
+	if m != nil {
+		return m.RouteOptions
+	}
+	return nil
+}
+
+func (m *HttpGateway) GetHttpsRedirect() *HttpsRedirect {
+	if m != nil {
+		return m.HttpsRedirect
+	}
+	return nil
+}
+
+// A HybridGateway serves HTTP and TCP traffic on the same port.
+// TLS is terminated for the SNI domains of the virtual services selected by the HTTP gateway, which must have
+// `ssl` set to true on the Gateway, while the connections for the SNI domains of the TCP hosts are routed by the
+// TCP gateway. TCP hosts whose SSL Config does not specify any secret are passed through without terminating TLS.
+type HybridGateway struct {
+	// The HTTP gateway, which selects virtual services in the same way as a regular HTTP gateway
+	HttpGateway *HttpGateway `protobuf:"bytes,1,opt,name=http_gateway,json=httpGateway,proto3" json:"http_gateway,omitempty"`
+	// The TCP gateway
+	TcpGateway           *TcpGateway `protobuf:"bytes,2,opt,name=tcp_gateway,json=tcpGateway,proto3" json:"tcp_gateway,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *HybridGateway) Reset()         { *m = HybridGateway{} }
+func (m *HybridGateway) String() string { return proto.CompactTextString(m) }
+func (*HybridGateway) ProtoMessage()    {}
+func (*HybridGateway) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30f7529f6633771c, []int{2}
+}
+func (m *HybridGateway) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HybridGateway.Unmarshal(m, b)
+}
+func (m *HybridGateway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HybridGateway.Marshal(b, m, deterministic)
+}
+func (m *HybridGateway) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HybridGateway.Merge(m, src)
+}
+func (m *HybridGateway) XXX_Size() int {
+	return xxx_messageInfo_HybridGateway.Size(m)
+}
+func (m *HybridGateway) XXX_DiscardUnknown() {
+	xxx_messageInfo_HybridGateway.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HybridGateway proto.InternalMessageInfo
+
+func (m *HybridGateway) GetHttpGateway() *HttpGateway {
+	if m != nil {
+		return m.HttpGateway
+	}
+	return nil
+}
+
+func (m *HybridGateway) GetTcpGateway() *TcpGateway {
+	if m != nil {
+		return m.TcpGateway
+	}
+	return nil
+}
+
 type TcpGateway struct {
 	// TCP hosts that the gateway can route to
 	TcpHosts []*v1.TcpHost `protobuf:"bytes,1,rep,name=tcp_hosts,json=tcpHosts,proto3" json:"tcp_hosts,omitempty"`
@@ -290,7 +388,7 @@ func (m *TcpGateway) Reset()         { *m = TcpGateway{} }
 func (m *TcpGateway) String() string { return proto.CompactTextString(m) }
 func (*TcpGateway) ProtoMessage()    {}
 func (*TcpGateway) Descriptor() ([]byte, []int) {
-	return fileDescriptor_30f7529f6633771c, []int{2}
+	return fileDescriptor_30f7529f6633771c, []int{3}
 }
 func (m *TcpGateway) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpGateway.Unmarshal(m, b)
@@ -328,6 +426,7 @@ func init() {
 	proto.RegisterType((*Gateway)(nil), "gateway.solo.io.Gateway")
 	proto.RegisterType((*HttpGateway)(nil), "gateway.solo.io.HttpGateway")
 	proto.RegisterMapType((map[string]string)(nil), "gateway.solo.io.HttpGateway.VirtualServiceSelectorEntry")
+	proto.RegisterType((*HybridGateway)(nil), "gateway.solo.io.HybridGateway")
 	proto.RegisterType((*TcpGateway)(nil), "gateway.solo.io.TcpGateway")
 }
 
@@ -336,53 +435,61 @@ func init() {
 }
 
 var fileDescriptor_30f7529f6633771c = []byte{
-	// 727 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x4e, 0xdb, 0x40,
-	0x10, 0xc6, 0x49, 0x80, 0x64, 0x0d, 0x82, 0xae, 0x28, 0x32, 0xe1, 0x2f, 0x44, 0xaa, 0x9a, 0x4b,
-	0x6d, 0x15, 0x0e, 0x45, 0x69, 0xa9, 0x44, 0xa4, 0xaa, 0xf4, 0x8f, 0x22, 0x83, 0x38, 0xf4, 0x12,
-	0x39, 0xce, 0xc6, 0x71, 0x31, 0xd9, 0xd5, 0xee, 0x38, 0x10, 0xa9, 0x27, 0x5e, 0xa1, 0x2f, 0x51,
-	0xf5, 0x09, 0xfa, 0x08, 0x7d, 0x0a, 0x0e, 0x7d, 0x03, 0x2a, 0xf5, 0x5e, 0xed, 0x7a, 0x9d, 0x10,
-	0xd3, 0xa0, 0xde, 0x76, 0xe6, 0x9b, 0xef, 0xf3, 0xcc, 0xec, 0xb7, 0x46, 0x7b, 0x41, 0x08, 0xdd,
-	0xb8, 0x65, 0xfb, 0xf4, 0xdc, 0x11, 0x34, 0xa2, 0x4f, 0x42, 0xea, 0x04, 0x11, 0xa5, 0x0e, 0xe3,
-	0xf4, 0x33, 0xf1, 0x41, 0x38, 0x81, 0x07, 0xe4, 0xc2, 0x1b, 0x38, 0x1e, 0x0b, 0x9d, 0xfe, 0xd3,
-	0x34, 0xb4, 0x19, 0xa7, 0x40, 0xf1, 0x42, 0x1a, 0x4a, 0xae, 0x1d, 0xd2, 0xf2, 0x52, 0x40, 0x03,
-	0xaa, 0x30, 0x47, 0x9e, 0x92, 0xb2, 0x32, 0x26, 0x97, 0x90, 0x24, 0xc9, 0x25, 0xe8, 0xdc, 0x46,
-	0x40, 0x69, 0x10, 0x11, 0x47, 0x45, 0xad, 0xb8, 0xe3, 0x5c, 0x70, 0x8f, 0x31, 0xc2, 0x45, 0x8a,
-	0xab, 0x76, 0xce, 0x42, 0x48, 0xbf, 0x7c, 0x4e, 0xc0, 0x6b, 0x7b, 0xe0, 0x69, 0x7c, 0x2d, 0x8b,
-	0x0b, 0xf0, 0x20, 0x4e, 0xd9, 0x2b, 0x59, 0x94, 0x93, 0xce, 0x24, 0xe1, 0x34, 0xd6, 0xf8, 0xa3,
-	0xcc, 0xfc, 0x32, 0xd2, 0x95, 0x8c, 0xd3, 0x4b, 0x3d, 0x7a, 0xf9, 0xf1, 0xe4, 0x32, 0xca, 0x20,
-	0xa4, 0x3d, 0xdd, 0x4a, 0xf5, 0x7b, 0x01, 0xcd, 0xbe, 0x4e, 0xd6, 0x84, 0x17, 0x51, 0x5e, 0x88,
-	0xc8, 0x32, 0x2a, 0x46, 0xad, 0xe8, 0xca, 0x23, 0xde, 0x42, 0x73, 0xad, 0xb0, 0xd7, 0x6e, 0x7a,
-	0xed, 0x36, 0x27, 0x42, 0x58, 0xf9, 0x8a, 0x51, 0x2b, 0xb9, 0xa6, 0xcc, 0xed, 0x27, 0x29, 0xbc,
-	0x8a, 0x4a, 0xaa, 0x84, 0x51, 0x0e, 0x56, 0xa1, 0x62, 0xd4, 0xe6, 0xdd, 0xa2, 0x4c, 0x1c, 0x51,
-	0x0e, 0xf8, 0x19, 0x9a, 0xd5, 0x9f, 0xb3, 0xa6, 0x2b, 0x46, 0xcd, 0xdc, 0x5e, 0xb7, 0x65, 0x2b,
-	0xe9, 0x85, 0xd8, 0xef, 0x43, 0x01, 0xa4, 0x47, 0xf8, 0xc7, 0xa4, 0xc8, 0x4d, 0xab, 0xf1, 0x3b,
-	0x34, 0x93, 0x6c, 0xcc, 0x9a, 0x51, 0xbc, 0x25, 0xdb, 0xa7, 0x9c, 0x0c, 0x79, 0xc7, 0x0a, 0x6b,
-	0xac, 0xff, 0xf8, 0x53, 0x30, 0x7e, 0x5e, 0x6f, 0x4e, 0xfd, 0xbe, 0xde, 0x7c, 0x00, 0x44, 0x40,
-	0x3b, 0xec, 0x74, 0xea, 0xd5, 0x30, 0xe8, 0x51, 0x4e, 0xaa, 0xae, 0x96, 0xc0, 0xbb, 0xa8, 0x98,
-	0x5e, 0x8f, 0x35, 0xab, 0xe4, 0x96, 0xc7, 0xe5, 0x3e, 0x68, 0xb4, 0x51, 0x90, 0x62, 0xee, 0xb0,
-	0x1a, 0x37, 0xd0, 0x42, 0x2c, 0x48, 0x53, 0x6d, 0xb6, 0xa9, 0x16, 0x66, 0x15, 0x95, 0x40, 0xd9,
-	0x4e, 0x0c, 0x62, 0xa7, 0x06, 0xb1, 0x1b, 0x94, 0x46, 0xa7, 0x5e, 0x14, 0x13, 0x77, 0x3e, 0x16,
-	0xe4, 0x48, 0x32, 0x8e, 0x94, 0x0b, 0xf7, 0xd1, 0x5c, 0x17, 0x80, 0x35, 0xb5, 0x19, 0xad, 0x92,
-	0x12, 0x58, 0xb3, 0x33, 0xe6, 0xb4, 0x0f, 0x00, 0x98, 0xbe, 0x89, 0x83, 0x29, 0xd7, 0xec, 0x8e,
-	0x42, 0xfc, 0x12, 0x99, 0xe0, 0x8f, 0x14, 0x90, 0x52, 0x58, 0xbd, 0xa3, 0x70, 0xe2, 0xdf, 0x12,
-	0x40, 0x30, 0x8c, 0xf0, 0x26, 0x32, 0x93, 0x11, 0x7a, 0xde, 0x39, 0x11, 0xd6, 0x5c, 0x25, 0x5f,
-	0x2b, 0xb9, 0x48, 0xa5, 0x0e, 0x65, 0xa6, 0xbe, 0x7c, 0x75, 0x53, 0x28, 0xa0, 0x5c, 0x70, 0x71,
-	0x75, 0x53, 0x40, 0xb8, 0xa8, 0x85, 0x45, 0x63, 0x1e, 0x99, 0x5a, 0xe3, 0x64, 0xc0, 0x48, 0xf5,
-	0x6b, 0x1e, 0x99, 0xb7, 0xda, 0xc4, 0x6f, 0xd1, 0x62, 0x3f, 0xe4, 0x10, 0x7b, 0x51, 0x53, 0x10,
-	0xde, 0x0f, 0x7d, 0x22, 0x2c, 0xa3, 0x92, 0xaf, 0x99, 0xdb, 0x2b, 0xe3, 0x0b, 0x76, 0x89, 0xa0,
-	0x31, 0xf7, 0x89, 0x4b, 0x3a, 0x7a, 0xc7, 0x0b, 0x9a, 0x78, 0xac, 0x79, 0x98, 0x23, 0x2b, 0xa3,
-	0xd5, 0x14, 0x24, 0x22, 0x3e, 0x50, 0x6e, 0xe5, 0x94, 0xe6, 0xee, 0x7d, 0x2b, 0xb3, 0x4f, 0xc7,
-	0xf4, 0x8e, 0x35, 0xf5, 0x55, 0x0f, 0xf8, 0xc0, 0x5d, 0xee, 0xff, 0x13, 0xc4, 0x2f, 0x50, 0x39,
-	0xfb, 0x4d, 0xb5, 0x21, 0xe6, 0xc9, 0x49, 0xf2, 0x6a, 0x4d, 0xd6, 0x38, 0xf7, 0x70, 0x88, 0xe3,
-	0xe7, 0x23, 0x73, 0x27, 0xa6, 0xd8, 0x1a, 0x37, 0xb7, 0xec, 0x6e, 0x92, 0xc1, 0xcb, 0x6f, 0xd0,
-	0xea, 0x3d, 0x1d, 0xcb, 0xa7, 0x78, 0x46, 0x06, 0xea, 0x29, 0x96, 0x5c, 0x79, 0xc4, 0x4b, 0x68,
-	0xba, 0x2f, 0xed, 0x65, 0xe5, 0x54, 0x2e, 0x09, 0xea, 0xb9, 0x5d, 0xa3, 0xfa, 0x05, 0xa1, 0xd1,
-	0xcd, 0xe3, 0x6d, 0x54, 0x92, 0x5e, 0xe9, 0x52, 0x01, 0xe9, 0x65, 0x3c, 0x1c, 0xef, 0xeb, 0xc4,
-	0x67, 0x07, 0x54, 0x80, 0x5b, 0x84, 0xe4, 0x20, 0x70, 0x3d, 0x3b, 0x49, 0xe5, 0x0e, 0x63, 0xd2,
-	0x20, 0x8d, 0x3d, 0xf9, 0x06, 0xbf, 0xfd, 0xda, 0x30, 0x3e, 0xed, 0xfc, 0xf7, 0xdf, 0x9a, 0x9d,
-	0x05, 0xfa, 0x6f, 0xd4, 0x9a, 0x51, 0x0f, 0x68, 0xe7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x68,
-	0x17, 0x9a, 0x44, 0xeb, 0x05, 0x00, 0x00,
+	// 851 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4d, 0x6e, 0xe3, 0x36,
+	0x14, 0x1e, 0xd9, 0x9e, 0xc4, 0xa6, 0xec, 0x64, 0x4a, 0xb8, 0x03, 0x8d, 0x33, 0x93, 0x68, 0x0c,
+	0x14, 0xf5, 0xa6, 0x12, 0xea, 0x2c, 0x1a, 0xb8, 0x49, 0x83, 0x18, 0x08, 0x92, 0xfe, 0xa5, 0x01,
+	0x13, 0x64, 0xd1, 0x8d, 0x20, 0xcb, 0xb4, 0xac, 0x46, 0x31, 0x05, 0x92, 0x72, 0x62, 0xa0, 0xab,
+	0x1e, 0xa0, 0xe7, 0xe8, 0x11, 0x7a, 0x84, 0x9e, 0x22, 0x8b, 0x5e, 0xa0, 0x68, 0x81, 0xee, 0x0b,
+	0x52, 0xa4, 0x6d, 0xc9, 0x75, 0x32, 0x3b, 0xbe, 0x9f, 0xef, 0xd3, 0xe3, 0x7b, 0xdf, 0xa3, 0xc0,
+	0x51, 0x18, 0xf1, 0x71, 0x3a, 0x70, 0x02, 0x72, 0xe7, 0x32, 0x12, 0x93, 0xcf, 0x22, 0xe2, 0x86,
+	0x31, 0x21, 0x6e, 0x42, 0xc9, 0x4f, 0x38, 0xe0, 0xcc, 0x0d, 0x7d, 0x8e, 0xef, 0xfd, 0x99, 0xeb,
+	0x27, 0x91, 0x3b, 0xfd, 0x5c, 0x9b, 0x4e, 0x42, 0x09, 0x27, 0x70, 0x5b, 0x9b, 0x02, 0xeb, 0x44,
+	0xa4, 0xd5, 0x0c, 0x49, 0x48, 0x64, 0xcc, 0x15, 0xa7, 0x2c, 0xad, 0x05, 0xf1, 0x03, 0xcf, 0x9c,
+	0xf8, 0x81, 0x2b, 0xdf, 0x6e, 0x48, 0x48, 0x18, 0x63, 0x57, 0x5a, 0x83, 0x74, 0xe4, 0xde, 0x53,
+	0x3f, 0x49, 0x30, 0x65, 0x3a, 0x2e, 0xcb, 0xb9, 0x8d, 0xb8, 0xfe, 0xf2, 0x1d, 0xe6, 0xfe, 0xd0,
+	0xe7, 0xbe, 0x8a, 0xbf, 0x2d, 0xc6, 0x19, 0xf7, 0x79, 0xaa, 0xd1, 0x6f, 0x8a, 0x51, 0x8a, 0x47,
+	0xeb, 0x88, 0xb5, 0xad, 0xe2, 0x9f, 0x14, 0xee, 0x2f, 0x2c, 0x95, 0x99, 0x50, 0xf2, 0xa0, 0xae,
+	0xde, 0xfa, 0x74, 0x7d, 0x1a, 0x49, 0x78, 0x44, 0x26, 0xba, 0x94, 0xee, 0x93, 0xfd, 0x9c, 0x46,
+	0x94, 0xa7, 0x7e, 0xec, 0x31, 0x4c, 0xa7, 0x51, 0x80, 0x33, 0x4c, 0xfb, 0xaf, 0x0a, 0xd8, 0x3c,
+	0xcb, 0x12, 0xe1, 0x2b, 0x50, 0x66, 0x2c, 0xb6, 0x0c, 0xdb, 0xe8, 0x54, 0x91, 0x38, 0xc2, 0xf7,
+	0xa0, 0x3e, 0x88, 0x26, 0x43, 0xcf, 0x1f, 0x0e, 0x29, 0x66, 0xcc, 0x2a, 0xdb, 0x46, 0xa7, 0x86,
+	0x4c, 0xe1, 0x3b, 0xc9, 0x5c, 0x70, 0x07, 0xd4, 0x64, 0x4a, 0x42, 0x28, 0xb7, 0x2a, 0xb6, 0xd1,
+	0x69, 0xa0, 0xaa, 0x70, 0x5c, 0x12, 0xca, 0xe1, 0x17, 0x60, 0x53, 0x95, 0x68, 0xbd, 0xb4, 0x8d,
+	0x8e, 0xd9, 0x7d, 0xe7, 0x88, 0x1a, 0xf5, 0x10, 0x9d, 0xef, 0x22, 0xc6, 0xf1, 0x04, 0xd3, 0x1f,
+	0xb2, 0x24, 0xa4, 0xb3, 0xe1, 0xb7, 0x60, 0x23, 0xeb, 0xb2, 0xb5, 0x21, 0x71, 0x4d, 0x27, 0x20,
+	0x14, 0xcf, 0x71, 0x57, 0x32, 0xd6, 0x7f, 0xf7, 0xfb, 0xbf, 0x15, 0xe3, 0x8f, 0xc7, 0xbd, 0x17,
+	0xff, 0x3c, 0xee, 0x7d, 0xc4, 0x31, 0xe3, 0xc3, 0x68, 0x34, 0xea, 0xb5, 0xa3, 0x70, 0x42, 0x28,
+	0x6e, 0x23, 0x45, 0x01, 0x0f, 0x40, 0x55, 0x8f, 0xd4, 0xda, 0x94, 0x74, 0xaf, 0xf3, 0x74, 0xdf,
+	0xab, 0x68, 0xbf, 0x22, 0xc8, 0xd0, 0x3c, 0x1b, 0xf6, 0xc1, 0x76, 0xca, 0xb0, 0x27, 0xa7, 0xe1,
+	0xc9, 0x86, 0x59, 0x55, 0x49, 0xd0, 0x72, 0x32, 0x51, 0x39, 0x5a, 0x54, 0x4e, 0x9f, 0x90, 0xf8,
+	0xc6, 0x8f, 0x53, 0x8c, 0x1a, 0x29, 0xc3, 0x97, 0x02, 0x71, 0x29, 0x95, 0x7b, 0x02, 0xea, 0x63,
+	0xce, 0x13, 0x4f, 0x8d, 0xc3, 0xaa, 0x49, 0x82, 0xb7, 0x4e, 0x41, 0xd0, 0xce, 0x39, 0xe7, 0x89,
+	0x9a, 0xc4, 0xf9, 0x0b, 0x64, 0x8e, 0x17, 0x26, 0xfc, 0x0a, 0x98, 0x3c, 0x58, 0x30, 0x00, 0xc9,
+	0xb0, 0xb3, 0xc2, 0x70, 0x1d, 0x2c, 0x11, 0x00, 0x3e, 0xb7, 0xe0, 0x19, 0xd8, 0x1a, 0xcf, 0x06,
+	0x34, 0x1a, 0xce, 0x29, 0x4c, 0x49, 0xb1, 0xbb, 0x5a, 0x84, 0x4c, 0x5b, 0xb0, 0x34, 0xc6, 0xcb,
+	0x0e, 0xb8, 0x07, 0xcc, 0xac, 0x17, 0x13, 0xff, 0x0e, 0x33, 0xab, 0x6e, 0x97, 0x3b, 0x35, 0x04,
+	0xa4, 0xeb, 0x42, 0x78, 0x7a, 0xf0, 0x97, 0xbf, 0x2b, 0x5b, 0xa0, 0x14, 0xde, 0xc3, 0xaa, 0xa2,
+	0x66, 0xfd, 0x06, 0x30, 0x15, 0xfe, 0x7a, 0x96, 0x60, 0xa1, 0x38, 0x73, 0xe9, 0xae, 0xf0, 0x1b,
+	0xf0, 0xaa, 0x20, 0x4d, 0x66, 0x19, 0x76, 0xb9, 0x63, 0x76, 0xdf, 0xe4, 0xa7, 0x84, 0x30, 0x23,
+	0x29, 0x0d, 0x30, 0xc2, 0x23, 0x35, 0xa8, 0x6d, 0x05, 0xbc, 0x52, 0x38, 0x48, 0x81, 0x55, 0xe0,
+	0xf2, 0x18, 0x8e, 0x71, 0xc0, 0x09, 0xb5, 0x4a, 0x92, 0xf3, 0xe0, 0xa9, 0xbe, 0x3b, 0x37, 0x39,
+	0xbe, 0x2b, 0x05, 0x3d, 0x9d, 0x70, 0x3a, 0x43, 0xaf, 0xa7, 0xff, 0x1b, 0x84, 0x87, 0xa0, 0x55,
+	0xfc, 0xa6, 0xec, 0x4e, 0xe2, 0x8b, 0x9b, 0x94, 0x65, 0x8b, 0xac, 0x3c, 0xf6, 0x62, 0x1e, 0x87,
+	0x5f, 0x2e, 0x36, 0x24, 0x53, 0xd6, 0xfb, 0xfc, 0x86, 0x88, 0xea, 0xd6, 0x6e, 0x09, 0x02, 0x4d,
+	0xfd, 0xe9, 0x31, 0x61, 0xdc, 0xd3, 0x4c, 0x99, 0xc4, 0xec, 0x3c, 0x93, 0xba, 0xdb, 0x39, 0x61,
+	0x5c, 0x13, 0xc1, 0xe9, 0x8a, 0x0f, 0x1e, 0x83, 0x06, 0x25, 0x29, 0xc7, 0x73, 0x32, 0xa0, 0x05,
+	0xbf, 0x4c, 0x86, 0x44, 0x8a, 0xa6, 0xa9, 0xd3, 0x25, 0x0b, 0x9e, 0x82, 0x2d, 0xa1, 0x5d, 0xe6,
+	0x51, 0x3c, 0x8c, 0x28, 0x0e, 0xf8, 0x7a, 0xb1, 0x89, 0x34, 0xa4, 0xb2, 0x50, 0x63, 0xbc, 0x6c,
+	0xb6, 0xbe, 0x06, 0x3b, 0x4f, 0x4c, 0x43, 0xbc, 0x55, 0xb7, 0x78, 0x26, 0xdf, 0xaa, 0x1a, 0x12,
+	0x47, 0xd8, 0x04, 0x2f, 0xa7, 0x62, 0xff, 0xac, 0x92, 0xf4, 0x65, 0x46, 0xaf, 0x74, 0x60, 0xb4,
+	0x7f, 0x35, 0x40, 0x23, 0x27, 0x6c, 0x78, 0x5c, 0xd8, 0x49, 0xe3, 0xf9, 0x9d, 0xcc, 0x6f, 0xe4,
+	0x61, 0x7e, 0x23, 0x4b, 0xcf, 0x6e, 0xe4, 0xf2, 0x3e, 0xb6, 0x7f, 0x06, 0x60, 0x11, 0x81, 0x5d,
+	0x50, 0x13, 0x5c, 0x62, 0x82, 0x5a, 0xf9, 0x1f, 0xe7, 0xbb, 0x7d, 0x1d, 0x24, 0x62, 0x44, 0xa8,
+	0xca, 0xb3, 0x03, 0x83, 0xbd, 0xa2, 0x6c, 0xec, 0x15, 0xc4, 0x3a, 0xd5, 0xf4, 0x8f, 0xc4, 0xab,
+	0xf9, 0xdb, 0x9f, 0xbb, 0xc6, 0x8f, 0xfb, 0x1f, 0xfc, 0x4f, 0x4e, 0x6e, 0x43, 0xf5, 0x1f, 0x19,
+	0x6c, 0xc8, 0x27, 0x6f, 0xff, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x0d, 0x3a, 0xbb, 0xd1,
+	0x07, 0x00, 0x00,
 }
 
 func (this *Gateway) Equal(that interface{}) bool {
@@ -495,6 +602,30 @@ func (this *Gateway_TcpGateway) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Gateway_HybridGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Gateway_HybridGateway)
+	if !ok {
+		that2, ok := that.(Gateway_HybridGateway)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.HybridGateway.Equal(that1.HybridGateway) {
+		return false
+	}
+	return true
+}
 func (this *HttpGateway) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -541,6 +672,45 @@ func (this *HttpGateway) Equal(that interface{}) bool {
 	if !this.Options.Equal(that1.Options) {
 		return false
 	}
+	if !this.VirtualHostOptions.Equal(that1.VirtualHostOptions) {
+		return false
+	}
+	if !this.RouteOptions.Equal(that1.RouteOptions) {
+		return false
+	}
+	if !this.HttpsRedirect.Equal(that1.HttpsRedirect) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *HybridGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HybridGateway)
+	if !ok {
+		that2, ok := that.(HybridGateway)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.HttpGateway.Equal(that1.HttpGateway) {
+		return false
+	}
+	if !this.TcpGateway.Equal(that1.TcpGateway) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
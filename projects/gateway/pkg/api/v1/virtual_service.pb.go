@@ -133,6 +133,11 @@ type VirtualService struct {
 	// Unlike metadata.name, DisplayName can be any string
 	// and can be changed after creating the resource.
 	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// If provided and `sslConfig` is set, the gateway translator will generate a virtual host on the
+	// non-SSL gateways that would otherwise select this virtual service. The generated virtual host redirects
+	// plain HTTP requests for the domains of this virtual service to HTTPS.
+	// Takes precedence over the `httpsRedirect` configuration of the SSL gateway.
+	HttpsRedirect *HttpsRedirect `protobuf:"bytes,4,opt,name=https_redirect,json=httpsRedirect,proto3" json:"https_redirect,omitempty"`
 	// Status indicates the validation status of this resource.
 	// Status is read-only by clients, and set by gloo during validation
 	Status core.Status `protobuf:"bytes,6,opt,name=status,proto3" json:"status" testdiff:"ignore"`
@@ -188,6 +193,13 @@ func (m *VirtualService) GetDisplayName() string {
 	return ""
 }
 
+func (m *VirtualService) GetHttpsRedirect() *HttpsRedirect {
+	if m != nil {
+		return m.HttpsRedirect
+	}
+	return nil
+}
+
 func (m *VirtualService) GetStatus() core.Status {
 	if m != nil {
 		return m.Status
@@ -202,6 +214,57 @@ func (m *VirtualService) GetMetadata() core.Metadata {
 	return core.Metadata{}
 }
 
+// Configures the generation of an HTTP to HTTPS redirect for a virtual service that serves TLS traffic.
+type HttpsRedirect struct {
+	// The HTTP status code to use when redirecting requests. Defaults to `MOVED_PERMANENTLY` (301).
+	ResponseCode v1.RedirectAction_RedirectResponseCode `protobuf:"varint,1,opt,name=response_code,json=responseCode,proto3,enum=gloo.solo.io.RedirectAction_RedirectResponseCode" json:"response_code,omitempty"`
+	// Routes that are served over plain HTTP instead of being redirected, for example to serve
+	// ACME HTTP-01 challenges on the `/.well-known/acme-challenge/` path.
+	// These routes are evaluated before the redirect. Delegation to route tables is supported.
+	Exclusions           []*Route `protobuf:"bytes,2,rep,name=exclusions,proto3" json:"exclusions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HttpsRedirect) Reset()         { *m = HttpsRedirect{} }
+func (m *HttpsRedirect) String() string { return proto.CompactTextString(m) }
+func (*HttpsRedirect) ProtoMessage()    {}
+func (*HttpsRedirect) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93fa9472926a2049, []int{1}
+}
+func (m *HttpsRedirect) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HttpsRedirect.Unmarshal(m, b)
+}
+func (m *HttpsRedirect) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HttpsRedirect.Marshal(b, m, deterministic)
+}
+func (m *HttpsRedirect) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HttpsRedirect.Merge(m, src)
+}
+func (m *HttpsRedirect) XXX_Size() int {
+	return xxx_messageInfo_HttpsRedirect.Size(m)
+}
+func (m *HttpsRedirect) XXX_DiscardUnknown() {
+	xxx_messageInfo_HttpsRedirect.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HttpsRedirect proto.InternalMessageInfo
+
+func (m *HttpsRedirect) GetResponseCode() v1.RedirectAction_RedirectResponseCode {
+	if m != nil {
+		return m.ResponseCode
+	}
+	return v1.RedirectAction_MOVED_PERMANENTLY
+}
+
+func (m *HttpsRedirect) GetExclusions() []*Route {
+	if m != nil {
+		return m.Exclusions
+	}
+	return nil
+}
+
 //
 //Virtual Hosts serve an ordered list of routes for a set of domains.
 //
@@ -236,7 +299,7 @@ func (m *VirtualHost) Reset()         { *m = VirtualHost{} }
 func (m *VirtualHost) String() string { return proto.CompactTextString(m) }
 func (*VirtualHost) ProtoMessage()    {}
 func (*VirtualHost) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93fa9472926a2049, []int{1}
+	return fileDescriptor_93fa9472926a2049, []int{2}
 }
 func (m *VirtualHost) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VirtualHost.Unmarshal(m, b)
@@ -315,7 +378,7 @@ func (m *Route) Reset()         { *m = Route{} }
 func (m *Route) String() string { return proto.CompactTextString(m) }
 func (*Route) ProtoMessage()    {}
 func (*Route) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93fa9472926a2049, []int{2}
+	return fileDescriptor_93fa9472926a2049, []int{3}
 }
 func (m *Route) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Route.Unmarshal(m, b)
@@ -447,7 +510,7 @@ func (m *DelegateAction) Reset()         { *m = DelegateAction{} }
 func (m *DelegateAction) String() string { return proto.CompactTextString(m) }
 func (*DelegateAction) ProtoMessage()    {}
 func (*DelegateAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93fa9472926a2049, []int{3}
+	return fileDescriptor_93fa9472926a2049, []int{4}
 }
 func (m *DelegateAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DelegateAction.Unmarshal(m, b)
@@ -544,7 +607,7 @@ func (m *RouteTableSelector) Reset()         { *m = RouteTableSelector{} }
 func (m *RouteTableSelector) String() string { return proto.CompactTextString(m) }
 func (*RouteTableSelector) ProtoMessage()    {}
 func (*RouteTableSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93fa9472926a2049, []int{4}
+	return fileDescriptor_93fa9472926a2049, []int{5}
 }
 func (m *RouteTableSelector) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteTableSelector.Unmarshal(m, b)
@@ -580,6 +643,7 @@ func (m *RouteTableSelector) GetLabels() map[string]string {
 
 func init() {
 	proto.RegisterType((*VirtualService)(nil), "gateway.solo.io.VirtualService")
+	proto.RegisterType((*HttpsRedirect)(nil), "gateway.solo.io.HttpsRedirect")
 	proto.RegisterType((*VirtualHost)(nil), "gateway.solo.io.VirtualHost")
 	proto.RegisterType((*Route)(nil), "gateway.solo.io.Route")
 	proto.RegisterType((*DelegateAction)(nil), "gateway.solo.io.DelegateAction")
@@ -592,59 +656,64 @@ func init() {
 }
 
 var fileDescriptor_93fa9472926a2049 = []byte{
-	// 829 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4f, 0x6f, 0xdb, 0x36,
-	0x14, 0x8f, 0x2c, 0xc5, 0xb1, 0x9f, 0x83, 0xa4, 0x25, 0x8c, 0x4c, 0x31, 0x3a, 0xc7, 0x50, 0x30,
-	0x34, 0x97, 0x4a, 0x58, 0x3b, 0x14, 0x9d, 0x81, 0xad, 0xa8, 0xd7, 0xa2, 0xc6, 0xb6, 0x6e, 0x00,
-	0x33, 0xec, 0xd0, 0x8b, 0x41, 0x4b, 0xb4, 0xa2, 0x45, 0x16, 0x05, 0x92, 0xf6, 0xe2, 0x6b, 0xbf,
-	0xc2, 0xbe, 0xc4, 0x3e, 0x42, 0x3f, 0xc2, 0x2e, 0xc3, 0xce, 0xbb, 0xf4, 0xb0, 0x6f, 0xd0, 0x01,
-	0xbb, 0x0f, 0x22, 0x29, 0xd9, 0x72, 0x63, 0xa0, 0x27, 0x93, 0xef, 0xf7, 0x87, 0x4f, 0xef, 0x3d,
-	0xd2, 0xf0, 0x22, 0x4e, 0xe4, 0xd5, 0x62, 0xea, 0x87, 0x6c, 0x1e, 0x08, 0x96, 0xb2, 0x07, 0x09,
-	0x0b, 0xe2, 0x94, 0xb1, 0x20, 0xe7, 0xec, 0x17, 0x1a, 0x4a, 0x11, 0xc4, 0x44, 0xd2, 0x5f, 0xc9,
-	0x2a, 0x20, 0x79, 0x12, 0x2c, 0x3f, 0x0f, 0x96, 0x09, 0x97, 0x0b, 0x92, 0x4e, 0x04, 0xe5, 0xcb,
-	0x24, 0xa4, 0x7e, 0xce, 0x99, 0x64, 0xe8, 0xd8, 0xb0, 0xfc, 0xc2, 0xc3, 0x4f, 0x58, 0xaf, 0x1b,
-	0xb3, 0x98, 0x29, 0x2c, 0x28, 0x56, 0x9a, 0xd6, 0x43, 0xf4, 0x46, 0xea, 0x20, 0xbd, 0x91, 0x26,
-	0xd6, 0x57, 0xc7, 0x5e, 0x27, 0xb2, 0x3c, 0x61, 0x4e, 0x25, 0x89, 0x88, 0x24, 0x06, 0xbf, 0xb7,
-	0x8d, 0x0b, 0x49, 0xe4, 0x42, 0x18, 0xf4, 0x74, 0x1b, 0xe5, 0x74, 0xb6, 0xcb, 0xb8, 0xdc, 0x1b,
-	0xfc, 0x7c, 0xeb, 0x3b, 0x8b, 0x5d, 0xc9, 0x14, 0xa9, 0x21, 0x7d, 0xb6, 0x9b, 0x94, 0x73, 0x76,
-	0xb3, 0x32, 0xb4, 0xfb, 0xbb, 0x69, 0x2c, 0x97, 0x09, 0xcb, 0xca, 0x7c, 0x1f, 0xef, 0x26, 0x86,
-	0x8c, 0xd3, 0x60, 0x4e, 0x64, 0x78, 0x45, 0xb9, 0xa8, 0x16, 0x5a, 0xe7, 0xfd, 0xdd, 0x80, 0xa3,
-	0x9f, 0x75, 0xe9, 0x2f, 0x75, 0xe5, 0xd1, 0x53, 0x38, 0x2c, 0x9b, 0x71, 0xc5, 0x84, 0x74, 0xad,
-	0x81, 0x75, 0xd1, 0x79, 0x78, 0xcf, 0xdf, 0x6a, 0x85, 0x6f, 0x64, 0x63, 0x26, 0x24, 0xee, 0x2c,
-	0xd7, 0x1b, 0xf4, 0x18, 0x40, 0x88, 0x74, 0x12, 0xb2, 0x6c, 0x96, 0xc4, 0x6e, 0x43, 0xc9, 0x3f,
-	0xf1, 0x8b, 0x94, 0x2a, 0xed, 0xa5, 0x48, 0xbf, 0x51, 0x30, 0x6e, 0x8b, 0x72, 0x89, 0xee, 0xc3,
-	0x61, 0x94, 0x88, 0x3c, 0x25, 0xab, 0x49, 0x46, 0xe6, 0xd4, 0xb5, 0x07, 0xd6, 0x45, 0x7b, 0xe4,
-	0xbc, 0xfd, 0xcf, 0xb1, 0x70, 0xc7, 0x20, 0x3f, 0x90, 0x39, 0x45, 0xdf, 0x41, 0x53, 0x37, 0xcb,
-	0x6d, 0x2a, 0xf3, 0xae, 0x5f, 0x7c, 0xe3, 0xda, 0x5c, 0x61, 0xa3, 0x4f, 0x0b, 0xe1, 0x1f, 0xef,
-	0xce, 0xf6, 0xfe, 0x7d, 0x77, 0x76, 0x57, 0x52, 0x21, 0xa3, 0x64, 0x36, 0x1b, 0x7a, 0x49, 0x9c,
-	0x31, 0x4e, 0x3d, 0x6c, 0x2c, 0xd0, 0x13, 0x68, 0x95, 0x93, 0xe1, 0x1e, 0x28, 0xbb, 0x93, 0xba,
-	0xdd, 0x2b, 0x83, 0x8e, 0x9c, 0xc2, 0x0c, 0x57, 0xec, 0x61, 0xff, 0xcd, 0x7b, 0xc7, 0x81, 0xc6,
-	0x52, 0xbc, 0x79, 0xef, 0x20, 0x74, 0x67, 0x6b, 0x82, 0x85, 0xf7, 0x9b, 0x05, 0x9d, 0x8d, 0x22,
-	0x21, 0x17, 0x0e, 0x22, 0x36, 0x27, 0x49, 0x26, 0xdc, 0xc6, 0xc0, 0xbe, 0x68, 0xe3, 0x72, 0x8b,
-	0x7c, 0x68, 0x72, 0xb6, 0x90, 0x54, 0xb8, 0xf6, 0xc0, 0x56, 0x19, 0x6c, 0x17, 0x1b, 0x17, 0x30,
-	0x36, 0x2c, 0x34, 0x84, 0x03, 0xd3, 0x7e, 0xd7, 0x51, 0x29, 0x0f, 0xea, 0xe5, 0xdd, 0x38, 0xf5,
-	0x47, 0xcd, 0xc3, 0xa5, 0xc0, 0xfb, 0xd3, 0x86, 0x7d, 0xe5, 0x86, 0x9e, 0x42, 0xab, 0x9c, 0x06,
-	0xd7, 0x52, 0xe7, 0x9e, 0xfb, 0xd5, 0x78, 0xa8, 0x12, 0xd4, 0x4c, 0x5f, 0x69, 0x08, 0x57, 0x22,
-	0xf4, 0x35, 0x1c, 0xaa, 0x84, 0x26, 0x24, 0x2c, 0xbc, 0x4d, 0xab, 0x4f, 0xeb, 0x32, 0x75, 0xd6,
-	0x33, 0x45, 0x18, 0xef, 0xe1, 0x0e, 0x5f, 0x6f, 0xd1, 0x4b, 0x38, 0xe6, 0x34, 0x4a, 0x38, 0x0d,
-	0x65, 0x69, 0x61, 0x97, 0xc3, 0x56, 0xb3, 0x30, 0xa4, 0xca, 0xe5, 0x88, 0xd7, 0x22, 0xe8, 0x35,
-	0x9c, 0x18, 0x1b, 0x4e, 0x45, 0xce, 0x32, 0x51, 0xa5, 0xa4, 0xcb, 0xe3, 0xd5, 0xfd, 0x9e, 0x2b,
-	0x2e, 0x36, 0xd4, 0xca, 0xb5, 0x1b, 0xdd, 0x12, 0x47, 0xdf, 0xc2, 0x71, 0x44, 0x53, 0x5a, 0x34,
-	0xa4, 0x34, 0xdd, 0x57, 0xa6, 0x67, 0x1f, 0x34, 0xe9, 0xb9, 0xe1, 0xad, 0xf3, 0x8c, 0x6a, 0x11,
-	0xf4, 0xc5, 0xba, 0x6f, 0x7a, 0x72, 0x7b, 0xb7, 0xd4, 0x6a, 0xbb, 0x63, 0x08, 0x81, 0xa3, 0xee,
-	0x43, 0x31, 0x9d, 0x6d, 0xac, 0xd6, 0xa3, 0x16, 0x34, 0x75, 0x32, 0xde, 0x5f, 0x16, 0x1c, 0xd5,
-	0x0f, 0x46, 0x27, 0x46, 0x60, 0xa9, 0x0b, 0xd4, 0x70, 0x2d, 0x2d, 0x42, 0x03, 0x68, 0x17, 0xbf,
-	0x22, 0x27, 0x21, 0x55, 0xcd, 0xd2, 0xe0, 0x3a, 0x88, 0x1e, 0x80, 0xcd, 0xe9, 0xcc, 0x74, 0xe1,
-	0xb4, 0x7e, 0x0f, 0x30, 0x15, 0x6c, 0xc1, 0x43, 0x8a, 0xe9, 0x6c, 0xbc, 0x87, 0x0b, 0x1e, 0x7a,
-	0x06, 0x2d, 0x41, 0x53, 0x1a, 0x4a, 0xc6, 0x4d, 0xa5, 0xcf, 0x6f, 0x9f, 0xdc, 0x9f, 0xc8, 0x34,
-	0xa5, 0x97, 0x86, 0x3a, 0xde, 0xc3, 0x95, 0x6c, 0x74, 0xb7, 0x2a, 0x6f, 0xc2, 0xb2, 0x89, 0x5c,
-	0xe5, 0xd4, 0x7b, 0x6b, 0x01, 0xfa, 0x50, 0x85, 0xfa, 0x00, 0x55, 0xa2, 0x7a, 0x60, 0xdb, 0x78,
-	0x23, 0x82, 0x5e, 0x42, 0x33, 0x25, 0x53, 0x9a, 0xea, 0xdb, 0xd5, 0x79, 0x18, 0x7c, 0x44, 0x2a,
-	0xfe, 0xf7, 0x4a, 0xf1, 0x22, 0x93, 0x7c, 0x85, 0x8d, 0xbc, 0xf7, 0x25, 0x74, 0x36, 0xc2, 0xe8,
-	0x0e, 0xd8, 0xd7, 0x74, 0xa5, 0x8b, 0x89, 0x8b, 0x25, 0xea, 0xc2, 0xfe, 0x92, 0xa4, 0x0b, 0x53,
-	0x43, 0xac, 0x37, 0xc3, 0xc6, 0x13, 0x6b, 0xf4, 0x55, 0xf1, 0xe6, 0xfc, 0xfe, 0x4f, 0xdf, 0x7a,
-	0xfd, 0xe8, 0xa3, 0xff, 0x00, 0xf3, 0xeb, 0xd8, 0x3c, 0xd5, 0xd3, 0xa6, 0x7a, 0x94, 0x1f, 0xfd,
-	0x1f, 0x00, 0x00, 0xff, 0xff, 0x21, 0xb5, 0xee, 0xc2, 0x3e, 0x07, 0x00, 0x00,
+	// 901 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xc1, 0x6e, 0xdb, 0x46,
+	0x13, 0x16, 0x25, 0x59, 0xb6, 0x46, 0xb6, 0x9c, 0x2c, 0x0c, 0xff, 0xb4, 0x90, 0xdf, 0x16, 0x68,
+	0x14, 0xc9, 0x25, 0x24, 0x92, 0x14, 0x41, 0x6a, 0xa0, 0x0d, 0xa2, 0xc4, 0x88, 0xd0, 0x36, 0x2d,
+	0xb0, 0x2e, 0x72, 0xc8, 0x45, 0x58, 0x93, 0x23, 0x89, 0x35, 0xa5, 0x25, 0x76, 0x57, 0xaa, 0x75,
+	0xed, 0x2b, 0xf4, 0x5a, 0xa0, 0xd7, 0x3e, 0x42, 0x1e, 0xa1, 0x97, 0xa2, 0x6f, 0x90, 0x43, 0xdf,
+	0xa0, 0x05, 0x7a, 0x2f, 0x76, 0xb9, 0xa4, 0x44, 0xd9, 0x2a, 0x72, 0xd2, 0xce, 0xcc, 0x37, 0xdf,
+	0xce, 0xce, 0x37, 0x23, 0xc2, 0xf9, 0x28, 0x56, 0xe3, 0xd9, 0xa5, 0x1f, 0xf2, 0x49, 0x20, 0x79,
+	0xc2, 0x1f, 0xc6, 0x3c, 0x18, 0x25, 0x9c, 0x07, 0xa9, 0xe0, 0xdf, 0x63, 0xa8, 0x64, 0x30, 0x62,
+	0x0a, 0x7f, 0x60, 0x8b, 0x80, 0xa5, 0x71, 0x30, 0x7f, 0x14, 0xcc, 0x63, 0xa1, 0x66, 0x2c, 0x19,
+	0x48, 0x14, 0xf3, 0x38, 0x44, 0x3f, 0x15, 0x5c, 0x71, 0xb2, 0x6f, 0x51, 0xbe, 0xe6, 0xf0, 0x63,
+	0xde, 0x39, 0x18, 0xf1, 0x11, 0x37, 0xb1, 0x40, 0x9f, 0x32, 0x58, 0x87, 0xe0, 0xb5, 0xca, 0x9c,
+	0x78, 0xad, 0xac, 0xef, 0xd8, 0x5c, 0x7b, 0x15, 0xab, 0xfc, 0x86, 0x09, 0x2a, 0x16, 0x31, 0xc5,
+	0x6c, 0xfc, 0xde, 0x7a, 0x5c, 0x2a, 0xa6, 0x66, 0xd2, 0x46, 0x8f, 0xd6, 0xa3, 0x02, 0x87, 0x9b,
+	0x88, 0x73, 0xdb, 0xc6, 0x4f, 0xd7, 0xde, 0xa9, 0xad, 0x1c, 0x29, 0x13, 0x0b, 0xfa, 0x64, 0x33,
+	0x28, 0x15, 0xfc, 0x7a, 0x61, 0x61, 0xf7, 0x37, 0xc3, 0x78, 0xaa, 0x62, 0x3e, 0xcd, 0xeb, 0x7d,
+	0xba, 0x19, 0x18, 0x72, 0x81, 0xc1, 0x84, 0xa9, 0x70, 0x8c, 0x42, 0x16, 0x87, 0x2c, 0xcf, 0xfb,
+	0xb9, 0x06, 0xed, 0xb7, 0x59, 0xeb, 0x2f, 0xb2, 0xce, 0x93, 0xe7, 0xb0, 0x9b, 0x8b, 0x31, 0xe6,
+	0x52, 0xb9, 0x4e, 0xd7, 0x79, 0xd0, 0x7a, 0x7c, 0xcf, 0x5f, 0x93, 0xc2, 0xb7, 0x69, 0x7d, 0x2e,
+	0x15, 0x6d, 0xcd, 0x97, 0x06, 0x79, 0x0a, 0x20, 0x65, 0x32, 0x08, 0xf9, 0x74, 0x18, 0x8f, 0xdc,
+	0xaa, 0x49, 0xff, 0x9f, 0xaf, 0x4b, 0x2a, 0x72, 0x2f, 0x64, 0xf2, 0xd2, 0x84, 0x69, 0x53, 0xe6,
+	0x47, 0x72, 0x1f, 0x76, 0xa3, 0x58, 0xa6, 0x09, 0x5b, 0x0c, 0xa6, 0x6c, 0x82, 0x6e, 0xad, 0xeb,
+	0x3c, 0x68, 0xf6, 0xea, 0xef, 0xff, 0xa9, 0x3b, 0xb4, 0x65, 0x23, 0xdf, 0xb0, 0x09, 0x92, 0x73,
+	0x68, 0x8f, 0x95, 0x4a, 0xe5, 0x40, 0x60, 0x14, 0x0b, 0x0c, 0x95, 0x5b, 0x37, 0x97, 0x1c, 0xdf,
+	0xa8, 0xb1, 0xaf, 0x61, 0xd4, 0xa2, 0xe8, 0xde, 0x78, 0xd5, 0x24, 0x5f, 0x41, 0x23, 0xd3, 0xdc,
+	0x6d, 0x98, 0xf4, 0x03, 0x5f, 0xb7, 0x6a, 0x59, 0xa3, 0x89, 0xf5, 0xfe, 0xaf, 0xef, 0xff, 0xed,
+	0xc3, 0x49, 0xe5, 0xef, 0x0f, 0x27, 0x77, 0x15, 0x4a, 0x15, 0xc5, 0xc3, 0xe1, 0x99, 0x17, 0x8f,
+	0xa6, 0x5c, 0xa0, 0x47, 0x2d, 0x05, 0x79, 0x06, 0x3b, 0xf9, 0x80, 0xb9, 0xdb, 0x86, 0xee, 0xb0,
+	0x4c, 0xf7, 0xc6, 0x46, 0x7b, 0x75, 0x4d, 0x46, 0x0b, 0xf4, 0x59, 0xe7, 0xc7, 0xbf, 0xea, 0x87,
+	0x50, 0x9d, 0x4b, 0x72, 0x67, 0x6d, 0x09, 0xa4, 0xf7, 0x8b, 0x03, 0x7b, 0xa5, 0x37, 0x90, 0xb7,
+	0xb0, 0x27, 0x50, 0xa6, 0x7c, 0x2a, 0x71, 0x10, 0xf2, 0x08, 0x8d, 0x3c, 0xed, 0xc7, 0x8f, 0xca,
+	0xfd, 0xcd, 0xe1, 0x2f, 0x42, 0x3d, 0x24, 0x85, 0x49, 0x6d, 0xe6, 0x4b, 0x1e, 0x21, 0xdd, 0x15,
+	0x2b, 0x96, 0x16, 0x0d, 0xaf, 0xc3, 0x64, 0x26, 0xf5, 0x50, 0xb9, 0xd5, 0x6e, 0xcd, 0xbc, 0x60,
+	0xbd, 0x9f, 0x94, 0xcf, 0x14, 0xd2, 0x15, 0xa4, 0xf7, 0x93, 0x03, 0xad, 0x95, 0x49, 0x20, 0x2e,
+	0x6c, 0x47, 0x7c, 0xc2, 0x62, 0x4b, 0xd2, 0xa4, 0xb9, 0x49, 0x7c, 0x68, 0x08, 0x9d, 0x2e, 0xdd,
+	0xda, 0x7f, 0xb2, 0x5b, 0x14, 0x39, 0x83, 0x6d, 0x3b, 0xe3, 0x56, 0xde, 0x6e, 0xf9, 0x8d, 0x2b,
+	0xb7, 0x7e, 0x9b, 0xe1, 0x68, 0x9e, 0xe0, 0xfd, 0x5e, 0x83, 0x2d, 0xc3, 0x46, 0x9e, 0xc3, 0x4e,
+	0x3e, 0xf2, 0xae, 0x63, 0xee, 0x3d, 0xf5, 0x8b, 0x1d, 0x30, 0x02, 0x95, 0x48, 0xdf, 0x64, 0x21,
+	0x5a, 0x24, 0x91, 0x2f, 0x60, 0xd7, 0x14, 0x34, 0x60, 0xa6, 0x97, 0x76, 0x9e, 0x8f, 0xd6, 0xfa,
+	0xad, 0x11, 0x59, 0xb3, 0xfb, 0x15, 0xda, 0x12, 0x4b, 0x93, 0xbc, 0x86, 0xfd, 0x7c, 0x4c, 0x73,
+	0x8a, 0x5a, 0xbe, 0x51, 0x9b, 0x25, 0xeb, 0x57, 0x68, 0x5b, 0x94, 0x3c, 0xe4, 0x1d, 0x1c, 0x5a,
+	0x9a, 0x62, 0x00, 0x2c, 0x5f, 0xd6, 0x1e, 0xaf, 0xcc, 0xf7, 0xaa, 0xa4, 0x78, 0xc1, 0x7a, 0x10,
+	0xdd, 0xe2, 0x27, 0x5f, 0xc2, 0x7e, 0x84, 0x09, 0x6a, 0x41, 0x72, 0xd2, 0x2d, 0x43, 0x7a, 0x72,
+	0x43, 0xa4, 0x57, 0x16, 0xb7, 0xac, 0x33, 0x2a, 0x79, 0xc8, 0xa7, 0x4b, 0xdd, 0xb2, 0xbd, 0xea,
+	0xdc, 0xd2, 0xab, 0x75, 0xc5, 0x08, 0x81, 0xba, 0x59, 0x7a, 0xbd, 0x3b, 0x4d, 0x6a, 0xce, 0xbd,
+	0x1d, 0x68, 0x64, 0xc5, 0x78, 0x7f, 0x38, 0xd0, 0x2e, 0x5f, 0x4c, 0x0e, 0x6d, 0x82, 0x63, 0xfe,
+	0x25, 0xaa, 0xae, 0x93, 0x25, 0x91, 0x2e, 0x34, 0xf5, 0xaf, 0x4c, 0x59, 0x88, 0x46, 0xac, 0x2c,
+	0xb8, 0x74, 0x92, 0x87, 0x50, 0x13, 0x38, 0xb4, 0x2a, 0x1c, 0x95, 0xb7, 0x94, 0xa2, 0xe4, 0x33,
+	0x11, 0x22, 0xc5, 0x61, 0xbf, 0x42, 0x35, 0x8e, 0xbc, 0x80, 0x1d, 0x89, 0x09, 0x86, 0x8a, 0x0b,
+	0xdb, 0xe9, 0xd3, 0xdb, 0x27, 0xf7, 0x3b, 0x76, 0x99, 0xe0, 0x85, 0x85, 0xf6, 0x2b, 0xb4, 0x48,
+	0xeb, 0xdd, 0x2d, 0xda, 0x1b, 0xf3, 0xe9, 0x40, 0x2d, 0x52, 0xf4, 0xde, 0x3b, 0x40, 0x6e, 0x66,
+	0x91, 0x63, 0x80, 0xa2, 0xd0, 0x6c, 0x60, 0x9b, 0x74, 0xc5, 0x43, 0x5e, 0x43, 0x23, 0x61, 0x97,
+	0x98, 0xe4, 0x2b, 0x1a, 0x7c, 0x44, 0x29, 0xfe, 0xd7, 0x26, 0xe3, 0x7c, 0xaa, 0xc4, 0x82, 0xda,
+	0xf4, 0xce, 0x67, 0xd0, 0x5a, 0x71, 0x93, 0x3b, 0x50, 0xbb, 0xc2, 0x45, 0xd6, 0x4c, 0xaa, 0x8f,
+	0xe4, 0x00, 0xb6, 0xe6, 0x2c, 0x99, 0xd9, 0x1e, 0xd2, 0xcc, 0x38, 0xab, 0x3e, 0x73, 0x7a, 0x9f,
+	0xeb, 0x7f, 0xc4, 0x5f, 0xff, 0x3c, 0x76, 0xde, 0x3d, 0xf9, 0xe8, 0xaf, 0x7c, 0x7a, 0x35, 0xb2,
+	0xdf, 0xa3, 0xcb, 0x86, 0xf9, 0xf2, 0x3c, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0x82, 0x26, 0xb9,
+	0xbc, 0x23, 0x08, 0x00, 0x00,
 }
 
 func (this *VirtualService) Equal(that interface{}) bool {
@@ -675,6 +744,9 @@ func (this *VirtualService) Equal(that interface{}) bool {
 	if this.DisplayName != that1.DisplayName {
 		return false
 	}
+	if !this.HttpsRedirect.Equal(that1.HttpsRedirect) {
+		return false
+	}
 	if !this.Status.Equal(&that1.Status) {
 		return false
 	}
@@ -686,6 +758,41 @@ func (this *VirtualService) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *HttpsRedirect) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HttpsRedirect)
+	if !ok {
+		that2, ok := that.(HttpsRedirect)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ResponseCode != that1.ResponseCode {
+		return false
+	}
+	if len(this.Exclusions) != len(that1.Exclusions) {
+		return false
+	}
+	for i := range this.Exclusions {
+		if !this.Exclusions[i].Equal(that1.Exclusions[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *VirtualHost) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -14,6 +14,7 @@ import (
 
 	"github.com/solo-io/gloo/projects/gateway/pkg/utils"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 	"github.com/solo-io/solo-kit/pkg/errors"
@@ -78,9 +79,9 @@ func forEachListener(proxy *gloov1.Proxy, reports reporter.ResourceReports, fn f
 }
 
 func forEachVhost(lis *gloov1.Listener, reports reporter.ResourceReports, fn func(*gloov1.VirtualHost, bool)) error {
-	if httpListenerType, ok := lis.ListenerType.(*gloov1.Listener_HttpListener); ok {
+	if httpListener := glooutils.GetHttpListener(lis); httpListener != nil {
 
-		for _, vhost := range httpListenerType.HttpListener.GetVirtualHosts() {
+		for _, vhost := range httpListener.GetVirtualHosts() {
 			accepted, err := reporting.AllSourcesAccepted(reports, vhost)
 			if err != nil {
 				return err
@@ -153,7 +154,7 @@ func stripInvalidListenersAndVirtualHosts(ctx context.Context, proxiesToWrite Ge
 
 		for _, lis := range proxy.Listeners {
 
-			if httpListenerType, ok := lis.ListenerType.(*gloov1.Listener_HttpListener); ok {
+			if httpListener := glooutils.GetHttpListener(lis); httpListener != nil {
 				var validVhosts []*gloov1.VirtualHost
 
 				if err := forEachVhost(lis, reports, func(vhost *gloov1.VirtualHost, accepted bool) {
@@ -170,7 +171,7 @@ func stripInvalidListenersAndVirtualHosts(ctx context.Context, proxiesToWrite Ge
 					return validVhosts[i].Name < validVhosts[j].Name
 				})
 
-				httpListenerType.HttpListener.VirtualHosts = validVhosts
+				httpListener.VirtualHosts = validVhosts
 			}
 		}
 
@@ -209,7 +210,7 @@ func transitionFunc(proxiesToWrite GeneratedProxies) gloov1.TransitionProxyFunc
 		// preserve previous vhosts if new vservice was errored
 		for _, desiredListener := range desired.Listeners {
 
-			desiredHttpListener := desiredListener.GetHttpListener()
+			desiredHttpListener := glooutils.GetHttpListener(desiredListener)
 			if desiredHttpListener == nil {
 				continue
 			}
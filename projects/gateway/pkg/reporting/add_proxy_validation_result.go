@@ -1,9 +1,11 @@
 package reporting
 
 import (
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	validationutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
@@ -35,9 +37,9 @@ func AddProxyValidationResult(resourceReports reporter.ResourceReports, proxy *g
 			return err
 		}
 
-		if httpListenerReport := listenerReport.GetHttpListenerReport(); httpListenerReport != nil {
+		if httpListenerReport := validationutils.GetHttpListenerReport(listenerReport); httpListenerReport != nil {
 			vhReports := httpListenerReport.GetVirtualHostReports()
-			virtualHosts := listener.GetHttpListener().GetVirtualHosts()
+			virtualHosts := glooutils.GetHttpListener(listener).GetVirtualHosts()
 
 			if len(vhReports) != len(virtualHosts) {
 				return invalidReportsVirtualHostsErr
@@ -50,6 +52,10 @@ func AddProxyValidationResult(resourceReports reporter.ResourceReports, proxy *g
 					return err
 				}
 			}
+
+			if err := addHttpListenerWarnings(resourceReports, virtualHosts, httpListenerReport); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -83,17 +89,42 @@ func addVirtualHostResult(resourceReports reporter.ResourceReports, virtualHost
 	})
 }
 
+// warnings about a tls secret are added to the virtual services referencing it
+func addHttpListenerWarnings(resourceReports reporter.ResourceReports, virtualHosts []*gloov1.VirtualHost, httpListenerReport *validation.HttpListenerReport) error {
+	for _, warning := range httpListenerReport.GetWarnings() {
+		secretRef := warning.GetSecretRef()
+		if secretRef == nil {
+			continue
+		}
+		for _, virtualHost := range virtualHosts {
+			if err := translator.ForEachSource(virtualHost, func(src translator.SourceRef) error {
+				srcResource, _ := resourceReports.Find(src.ResourceKind, core.ResourceRef{Name: src.Name, Namespace: src.Namespace})
+				if srcResource == nil {
+					return missingReportForSourceErr
+				}
+				virtualService, ok := srcResource.(*v1.VirtualService)
+				if !ok || !virtualService.GetSslConfig().GetSecretRef().Equal(secretRef) {
+					return nil
+				}
+				resourceReports.AddWarnings(srcResource, validationutils.GetHttpListenerWarning(warning))
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // get errors that can be caused by gateways
 func getListenerLevelErrors(listenerReport *validation.ListenerReport) []error {
 	listenerErrs := validationutils.GetListenerErr(listenerReport)
 
-	switch listenerType := listenerReport.ListenerTypeReport.(type) {
-	case *validation.ListenerReport_HttpListenerReport:
-		httpListener := listenerType.HttpListenerReport
+	if httpListener := validationutils.GetHttpListenerReport(listenerReport); httpListener != nil {
 		listenerErrs = append(listenerErrs, validationutils.GetHttpListenerErr(httpListener)...)
+	}
 
-	case *validation.ListenerReport_TcpListenerReport:
-		tcpListener := listenerType.TcpListenerReport
+	if tcpListener := validationutils.GetTcpListenerReport(listenerReport); tcpListener != nil {
 		listenerErrs = append(listenerErrs, validationutils.GetTcpListenerErr(tcpListener)...)
 
 		for _, hostReport := range tcpListener.GetTcpHostReports() {
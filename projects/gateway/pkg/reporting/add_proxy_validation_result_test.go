@@ -66,4 +66,34 @@ var _ = Describe("AddProxyValidationResult", func() {
 	* Route Error: InvalidMatcherError. Reason: bad route`))
 		}
 	})
+
+	It("adds tls secret warnings to the virtual services referencing the secret", func() {
+		secretRef := &core.ResourceRef{Name: "cert", Namespace: "ns"}
+		snap.VirtualServices[0].SslConfig = &gloov1.SslConfig{
+			SslSecrets: &gloov1.SslConfig_SecretRef{SecretRef: secretRef},
+		}
+		tx := translator.NewTranslator([]translator.ListenerFactory{&translator.HttpTranslator{}, &translator.TcpTranslator{}}, translator.Opts{})
+		proxy, reports = tx.Translate(context.TODO(), ignored, ignored, snap, snap.Gateways)
+		proxyReport := validation.MakeReport(proxy)
+
+		for _, lis := range proxyReport.ListenerReports {
+			if httpListenerReport := lis.GetHttpListenerReport(); httpListenerReport != nil {
+				validation.AppendHTTPListenerWarning(httpListenerReport,
+					validationapi.HttpListenerReport_Warning_SslCertificateExpiringWarning,
+					"expiring", secretRef)
+			}
+		}
+
+		err := AddProxyValidationResult(reports, proxy, proxyReport)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(reports[snap.VirtualServices[0]].Warnings).To(ConsistOf(
+			"HttpListener Warning: SslCertificateExpiringWarning. Reason: expiring"))
+		for _, vs := range snap.VirtualServices[1:] {
+			Expect(reports[vs].Warnings).To(BeEmpty())
+		}
+		for _, gw := range snap.Gateways {
+			Expect(reports[gw].Warnings).To(BeEmpty())
+		}
+	})
 })
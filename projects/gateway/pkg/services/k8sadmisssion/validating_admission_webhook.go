@@ -19,8 +19,6 @@ import (
 
 	validationutil "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 
-	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
-
 	errors "github.com/rotisserie/eris"
 	gwv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gateway/pkg/validation"
@@ -296,14 +294,13 @@ func (wh *gatewayValidationWebhook) getFailureCauses(proxyReports validation.Pro
 					Message: fmt.Sprintf("Listener Error %v: %v", err.Type.String(), err.Reason),
 				})
 			}
-			switch listener := listenerReport.ListenerTypeReport.(type) {
-			case *validationapi.ListenerReport_HttpListenerReport:
-				for _, err := range listener.HttpListenerReport.Errors {
+			if httpListenerReport := validationutil.GetHttpListenerReport(listenerReport); httpListenerReport != nil {
+				for _, err := range httpListenerReport.Errors {
 					causes = append(causes, metav1.StatusCause{
 						Message: fmt.Sprintf("HTTPListener Error %v: %v", err.Type.String(), err.Reason),
 					})
 				}
-				for _, vh := range listener.HttpListenerReport.VirtualHostReports {
+				for _, vh := range httpListenerReport.VirtualHostReports {
 					for _, err := range vh.Errors {
 						causes = append(causes, metav1.StatusCause{
 							Message: fmt.Sprintf("VirtualHost Error %v: %v", err.Type.String(), err.Reason),
@@ -317,13 +314,14 @@ func (wh *gatewayValidationWebhook) getFailureCauses(proxyReports validation.Pro
 						}
 					}
 				}
-			case *validationapi.ListenerReport_TcpListenerReport:
-				for _, err := range listener.TcpListenerReport.Errors {
+			}
+			if tcpListenerReport := validationutil.GetTcpListenerReport(listenerReport); tcpListenerReport != nil {
+				for _, err := range tcpListenerReport.Errors {
 					causes = append(causes, metav1.StatusCause{
 						Message: fmt.Sprintf("TCPListener Error %v: %v", err.Type.String(), err.Reason),
 					})
 				}
-				for _, host := range listener.TcpListenerReport.TcpHostReports {
+				for _, host := range tcpListenerReport.TcpHostReports {
 					for _, err := range host.Errors {
 						causes = append(causes, metav1.StatusCause{
 							Message: fmt.Sprintf("TcpHost Error %v: %v", err.Type.String(), err.Reason),
@@ -78,6 +78,11 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 		return err
 	}
 
+	referencePolicyFactory, err := bootstrap.ConfigFactoryForSettings(params, gloov1.ReferencePolicyCrd)
+	if err != nil {
+		return err
+	}
+
 	refreshRate, err := types.DurationFromProto(settings.RefreshRate)
 	if err != nil {
 		return err
@@ -129,12 +134,13 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 	}
 
 	opts := translator.Opts{
-		WriteNamespace:  writeNamespace,
-		WatchNamespaces: watchNamespaces,
-		Gateways:        gatewayFactory,
-		VirtualServices: virtualServiceFactory,
-		RouteTables:     routeTableFactory,
-		Proxies:         proxyFactory,
+		WriteNamespace:    writeNamespace,
+		WatchNamespaces:   watchNamespaces,
+		Gateways:          gatewayFactory,
+		VirtualServices:   virtualServiceFactory,
+		RouteTables:       routeTableFactory,
+		ReferencePolicies: referencePolicyFactory,
+		Proxies:           proxyFactory,
 		WatchOpts: clients.WatchOpts{
 			Ctx:         ctx,
 			RefreshRate: refreshRate,
@@ -176,6 +182,14 @@ func RunGateway(opts translator.Opts) error {
 		return err
 	}
 
+	referencePolicyClient, err := gloov1.NewReferencePolicyClient(opts.ReferencePolicies)
+	if err != nil {
+		return err
+	}
+	if err := referencePolicyClient.Register(); err != nil {
+		return err
+	}
+
 	proxyClient, err := gloov1.NewProxyClient(opts.Proxies)
 	if err != nil {
 		return err
@@ -220,7 +234,7 @@ func RunGateway(opts translator.Opts) error {
 		allowMissingLinks = opts.Validation.AllowMissingLinks
 	}
 
-	emitter := v1.NewApiEmitterWithEmit(virtualServiceClient, routeTableClient, gatewayClient, notifications)
+	emitter := v1.NewApiEmitterWithEmit(virtualServiceClient, routeTableClient, gatewayClient, referencePolicyClient, notifications)
 
 	validationSyncer := gatewayvalidation.NewValidator(gatewayvalidation.NewValidatorConfig(
 		txlator,
@@ -9,6 +9,7 @@ import (
 	"github.com/solo-io/gloo/pkg/utils/syncutil"
 	"github.com/solo-io/gloo/projects/gateway/pkg/reconciler"
 	"github.com/solo-io/go-utils/hashutils"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
@@ -126,7 +127,7 @@ func (s *translatorSyncer) propagateProxyStatus(ctx context.Context, proxy *gloo
 					zap.Any("reports", reports),
 					zap.Any("subresourceStatuses", subresourceStatuses))
 
-				err := s.reporter.WriteReports(ctx, reports, subresourceStatuses)
+				err := s.writeReports(ctx, reports, subresourceStatuses)
 				if err != nil {
 					contextutils.LoggerFrom(ctx).Errorf("err: updating dependent statuses: %v", err)
 				}
@@ -136,6 +137,31 @@ func (s *translatorSyncer) propagateProxyStatus(ctx context.Context, proxy *gloo
 	return nil
 }
 
+// writes the reports, adding the state of their ACME certificate issuance to the statuses of virtual services
+func (s *translatorSyncer) writeReports(ctx context.Context, reports reporter.ResourceReports, subresourceStatuses map[string]*core.Status) error {
+	var errs error
+	remaining := make(reporter.ResourceReports)
+	for resource, report := range reports {
+		vs, ok := resource.(*v1.VirtualService)
+		if !ok {
+			remaining[resource] = report
+			continue
+		}
+		acmeStatus := utils.AcmeStatus(vs)
+		if acmeStatus == nil {
+			remaining[resource] = report
+			continue
+		}
+		vsSubresourceStatuses := map[string]*core.Status{utils.AcmeSubresourceStatusKey: acmeStatus}
+		for key, status := range subresourceStatuses {
+			vsSubresourceStatuses[key] = status
+		}
+		err := s.reporter.WriteReports(ctx, reporter.ResourceReports{vs: report}, vsSubresourceStatuses)
+		errs = multierr.Append(errs, err)
+	}
+	return multierr.Append(errs, s.reporter.WriteReports(ctx, remaining, subresourceStatuses))
+}
+
 func watchProxyStatus(ctx context.Context, proxyClient gloov1.ProxyClient, proxy *gloov1.Proxy) (<-chan core.Status, error) {
 	ctx = contextutils.WithLogger(ctx, "proxy-err-propagator")
 	proxies, errs, err := proxyClient.Watch(proxy.Metadata.Namespace, clients.WatchOpts{
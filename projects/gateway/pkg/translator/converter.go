@@ -13,6 +13,7 @@ import (
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	matchersv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	glooutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/referencepolicy"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
@@ -42,13 +43,16 @@ var (
 type RouteConverter interface {
 	// Converts a VirtualService to a set of Gloo API routes (i.e. routes on a Proxy resource).
 	ConvertVirtualService(virtualService *gatewayv1.VirtualService) ([]*gloov1.Route, error)
+	// Converts the routes that are excluded from the HTTP to HTTPS redirect of a VirtualService to a set of Gloo API routes.
+	ConvertHttpsRedirectExclusions(virtualService *gatewayv1.VirtualService, httpsRedirect *gatewayv1.HttpsRedirect) ([]*gloov1.Route, error)
 }
 
-func NewRouteConverter(selector RouteTableSelector, indexer RouteTableIndexer, reports reporter.ResourceReports) RouteConverter {
+func NewRouteConverter(selector RouteTableSelector, indexer RouteTableIndexer, policies gloov1.ReferencePolicyList, reports reporter.ResourceReports) RouteConverter {
 	return &routeVisitor{
 		reports:            reports,
 		routeTableSelector: selector,
 		routeTableIndexer:  indexer,
+		referencePolicies:  policies,
 	}
 }
 
@@ -70,6 +74,20 @@ func (v *visitableVirtualService) InputResource() resources.InputResource {
 	return v.VirtualService
 }
 
+// The exclusions of an https redirect are reported on the virtual service the redirect is generated for.
+type visitableHttpsRedirectExclusions struct {
+	*gatewayv1.VirtualService
+	exclusions []*gatewayv1.Route
+}
+
+func (v *visitableHttpsRedirectExclusions) GetRoutes() []*gatewayv1.Route {
+	return v.exclusions
+}
+
+func (v *visitableHttpsRedirectExclusions) InputResource() resources.InputResource {
+	return v.VirtualService
+}
+
 type visitableRouteTable struct {
 	*gatewayv1.RouteTable
 }
@@ -86,6 +104,8 @@ type routeVisitor struct {
 	routeTableSelector RouteTableSelector
 	// Used to sort route tables when multiple ones are matched by a selector.
 	routeTableIndexer RouteTableIndexer
+	// Used to validate references to route tables, upstreams and upstream groups in other namespaces.
+	referencePolicies gloov1.ReferencePolicyList
 }
 
 // Helper object used to store information about previously visited routes.
@@ -105,6 +125,11 @@ func (rv *routeVisitor) ConvertVirtualService(virtualService *gatewayv1.VirtualS
 	return rv.visit(wrapper, nil, nil)
 }
 
+func (rv *routeVisitor) ConvertHttpsRedirectExclusions(virtualService *gatewayv1.VirtualService, httpsRedirect *gatewayv1.HttpsRedirect) ([]*gloov1.Route, error) {
+	wrapper := &visitableHttpsRedirectExclusions{VirtualService: virtualService, exclusions: httpsRedirect.GetExclusions()}
+	return rv.visit(wrapper, nil, nil)
+}
+
 // Performs a depth-first, in-order traversal of a route tree rooted at the given resource.
 // The additional arguments are used to store the state of the traversal of the current branch of the route tree.
 func (rv *routeVisitor) visit(resource resourceWithRoutes, parentRoute *routeInfo, visitedRouteTables gatewayv1.RouteTableList) ([]*gloov1.Route, error) {
@@ -145,6 +170,12 @@ func (rv *routeVisitor) visit(resource resourceWithRoutes, parentRoute *routeInf
 				continue
 			}
 
+			// Drop the route tables that this resource is not allowed to reference
+			routeTables = rv.filterAllowedRouteTables(resource.InputResource(), action.DelegateAction, routeTables)
+			if len(routeTables) == 0 {
+				continue
+			}
+
 			// Default missing weights to 0
 			for _, routeTable := range routeTables {
 				if routeTable.GetWeight() == nil {
@@ -207,6 +238,14 @@ func (rv *routeVisitor) visit(resource resourceWithRoutes, parentRoute *routeInf
 				routeClone.Name = ""
 			}
 
+			if routeAction := routeClone.GetRouteAction(); routeAction != nil {
+				namespace := resource.InputResource().GetMetadata().Namespace
+				if err := referencepolicy.ValidateRouteAction(rv.referencePolicies, namespace, routeAction); err != nil {
+					rv.reports.AddError(resource.InputResource(), err)
+					continue
+				}
+			}
+
 			glooRoute, err := convertSimpleAction(routeClone)
 			if err != nil {
 				return nil, err
@@ -226,6 +265,27 @@ func (rv *routeVisitor) visit(resource resourceWithRoutes, parentRoute *routeInf
 	return routes, nil
 }
 
+// Returns the route tables that the given resource is allowed to reference according to the reference policies.
+// Explicit references to a route table that is not allowed are reported as errors, while route tables
+// that are matched by a selector but are not allowed are reported as warnings.
+func (rv *routeVisitor) filterAllowedRouteTables(resource resources.InputResource, action *gatewayv1.DelegateAction, routeTables gatewayv1.RouteTableList) gatewayv1.RouteTableList {
+	var allowed gatewayv1.RouteTableList
+	for _, routeTable := range routeTables {
+		err := referencepolicy.ValidateReference(rv.referencePolicies, resource.GetMetadata().Namespace,
+			gloov1.ReferencePolicyRule_ROUTE_TABLE, routeTable.Metadata.Ref())
+		if err == nil {
+			allowed = append(allowed, routeTable)
+			continue
+		}
+		if getRouteTableRef(action) != nil {
+			rv.reports.AddError(resource, err)
+		} else {
+			rv.reports.AddWarning(resource, err.Error())
+		}
+	}
+	return allowed
+}
+
 // Returns the name of the route and a flag that is true if either the route or the parent route are explicitly named.
 // Route names have the following format: "vs:myvirtualservice_route:myfirstroute_rt:myroutetable_route:<unnamed>"
 func routeName(resource resources.InputResource, route *gatewayv1.Route, parentRouteInfo *routeInfo) (string, bool) {
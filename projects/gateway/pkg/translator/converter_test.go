@@ -19,7 +19,7 @@ var _ = Describe("Route converter", func() {
 
 	DescribeTable("should reject bad config on a delegate route",
 		func(route *v1.Route, expectedErr error) {
-			rv := translator.NewRouteConverter(nil, nil, reporter.ResourceReports{})
+			rv := translator.NewRouteConverter(nil, nil, nil, reporter.ResourceReports{})
 			_, err := rv.ConvertVirtualService(
 				&v1.VirtualService{
 					VirtualHost: &v1.VirtualHost{
@@ -196,6 +196,7 @@ var _ = Describe("Route converter", func() {
 			rv := translator.NewRouteConverter(
 				translator.NewRouteTableSelector(v1.RouteTableList{&rt}),
 				translator.NewRouteTableIndexer(),
+				nil,
 				rpt,
 			)
 			converted, err := rv.ConvertVirtualService(vs)
@@ -248,6 +249,7 @@ var _ = Describe("Route converter", func() {
 			rv := translator.NewRouteConverter(
 				translator.NewRouteTableSelector(v1.RouteTableList{&rt}),
 				translator.NewRouteTableIndexer(),
+				nil,
 				rpt,
 			)
 			converted, err := rv.ConvertVirtualService(vs)
@@ -303,6 +305,7 @@ var _ = Describe("Route converter", func() {
 			rv := translator.NewRouteConverter(
 				translator.NewRouteTableSelector(v1.RouteTableList{&rt}),
 				translator.NewRouteTableIndexer(),
+				nil,
 				rpt,
 			)
 			converted, err := rv.ConvertVirtualService(vs)
@@ -364,6 +367,7 @@ var _ = Describe("Route converter", func() {
 			rv := translator.NewRouteConverter(
 				translator.NewRouteTableSelector(v1.RouteTableList{&rt}),
 				translator.NewRouteTableIndexer(),
+				nil,
 				rpt,
 			)
 			converted, err := rv.ConvertVirtualService(vs)
@@ -421,6 +425,7 @@ var _ = Describe("Route converter", func() {
 			visitor = translator.NewRouteConverter(
 				translator.NewRouteTableSelector(allRouteTables),
 				translator.NewRouteTableIndexer(),
+				nil,
 				reports,
 			)
 		})
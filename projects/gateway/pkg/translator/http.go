@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/solo-io/go-utils/hashutils"
 
 	errors "github.com/rotisserie/eris"
@@ -11,7 +12,10 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	gwutils "github.com/solo-io/gloo/projects/gateway/pkg/utils"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 )
@@ -39,6 +43,16 @@ var (
 		return errors.Errorf("domain conflict: the following domains are present in more than one of the "+
 			"virtual services associated with this gateway: %v", loggedDomains)
 	}
+	HttpsRedirectDomainConflictErr = func(gateway *v1.Gateway, domain string) error {
+		if domain == "" {
+			domain = "EMPTY_DOMAIN"
+		}
+		return errors.Errorf("skipping https redirect on gateway [%s]: the [%s] domain is already served by "+
+			"another virtual service on this gateway", gateway.Metadata.Ref().Key(), domain)
+	}
+	AcmeIssuanceFailedErr = func(message string) error {
+		return errors.Errorf("ACME certificate issuance failed: %s", message)
+	}
 )
 
 type HttpTranslator struct{}
@@ -57,7 +71,11 @@ func (t *HttpTranslator) GenerateListeners(ctx context.Context, snap *v1.ApiSnap
 
 		virtualServices := getVirtualServicesForGateway(gateway, snap.VirtualServices)
 		validateVirtualServiceDomains(gateway, virtualServices, reports)
-		listener := desiredListenerForHttp(gateway, virtualServices, snap.RouteTables, reports)
+		listener := desiredListenerForHttp(gateway, virtualServices, snap.RouteTables, snap.ReferencePolicies, reports)
+		if !gateway.Ssl {
+			redirects := getHttpsRedirectsForGateway(gateway, filteredGateways, snap.VirtualServices)
+			appendHttpsRedirectVirtualHosts(listener, gateway, virtualServices, redirects, snap.RouteTables, snap.ReferencePolicies, reports)
+		}
 		result = append(result, listener)
 	}
 	return result
@@ -121,12 +139,17 @@ func getVirtualServicesForGateway(gateway *v1.Gateway, virtualServices v1.Virtua
 }
 
 func GatewayContainsVirtualService(gateway *v1.Gateway, virtualService *v1.VirtualService) bool {
-	httpGateway := gateway.GetHttpGateway()
-	if httpGateway == nil {
+	if gateway.Ssl != hasSsl(virtualService) {
 		return false
 	}
 
-	if gateway.Ssl != hasSsl(virtualService) {
+	return gatewaySelectsVirtualService(gateway, virtualService)
+}
+
+// Returns true if the gateway selects the virtual service, regardless of whether they serve TLS traffic.
+func gatewaySelectsVirtualService(gateway *v1.Gateway, virtualService *v1.VirtualService) bool {
+	httpGateway := gwutils.GetHttpGateway(gateway)
+	if httpGateway == nil {
 		return false
 	}
 
@@ -157,7 +180,7 @@ func GatewayContainsVirtualService(gateway *v1.Gateway, virtualService *v1.Virtu
 }
 
 func virtualServiceNamespaceValidForGateway(gateway *v1.Gateway, virtualService *v1.VirtualService) bool {
-	httpGateway := gateway.GetHttpGateway()
+	httpGateway := gwutils.GetHttpGateway(gateway)
 	if httpGateway == nil {
 		return false
 	}
@@ -179,7 +202,7 @@ func hasSsl(vs *v1.VirtualService) bool {
 	return vs.SslConfig != nil
 }
 
-func desiredListenerForHttp(gateway *v1.Gateway, virtualServicesForGateway v1.VirtualServiceList, tables v1.RouteTableList, reports reporter.ResourceReports) *gloov1.Listener {
+func desiredListenerForHttp(gateway *v1.Gateway, virtualServicesForGateway v1.VirtualServiceList, tables v1.RouteTableList, policies gloov1.ReferencePolicyList, reports reporter.ResourceReports) *gloov1.Listener {
 	var (
 		virtualHosts []*gloov1.VirtualHost
 		sslConfigs   []*gloov1.SslConfig
@@ -189,11 +212,15 @@ func desiredListenerForHttp(gateway *v1.Gateway, virtualServicesForGateway v1.Vi
 		if virtualService.VirtualHost == nil {
 			virtualService.VirtualHost = &v1.VirtualHost{}
 		}
-		vh, err := virtualServiceToVirtualHost(virtualService, tables, reports)
+		vh, err := virtualServiceToVirtualHost(virtualService, tables, policies, reports)
 		if err != nil {
 			reports.AddError(virtualService, err)
 			continue
 		}
+		if err := applyGatewayDefaultOptions(gwutils.GetHttpGateway(gateway), vh); err != nil {
+			reports.AddError(virtualService, err)
+			continue
+		}
 		virtualHosts = append(virtualHosts, vh)
 		if virtualService.SslConfig != nil {
 			sslConfigs = append(sslConfigs, virtualService.SslConfig)
@@ -201,7 +228,7 @@ func desiredListenerForHttp(gateway *v1.Gateway, virtualServicesForGateway v1.Vi
 	}
 
 	var httpPlugins *gloov1.HttpListenerOptions
-	if httpGateway := gateway.GetHttpGateway(); httpGateway != nil {
+	if httpGateway := gwutils.GetHttpGateway(gateway); httpGateway != nil {
 		httpPlugins = httpGateway.Options
 	}
 	listener := makeListener(gateway)
@@ -221,8 +248,8 @@ func desiredListenerForHttp(gateway *v1.Gateway, virtualServicesForGateway v1.Vi
 	return listener
 }
 
-func virtualServiceToVirtualHost(vs *v1.VirtualService, tables v1.RouteTableList, reports reporter.ResourceReports) (*gloov1.VirtualHost, error) {
-	converter := NewRouteConverter(NewRouteTableSelector(tables), NewRouteTableIndexer(), reports)
+func virtualServiceToVirtualHost(vs *v1.VirtualService, tables v1.RouteTableList, policies gloov1.ReferencePolicyList, reports reporter.ResourceReports) (*gloov1.VirtualHost, error) {
+	converter := NewRouteConverter(NewRouteTableSelector(tables), NewRouteTableIndexer(), policies, reports)
 	routes, err := converter.ConvertVirtualService(vs)
 	if err != nil {
 		return nil, err
@@ -243,6 +270,180 @@ func virtualServiceToVirtualHost(vs *v1.VirtualService, tables v1.RouteTableList
 	return vh, nil
 }
 
+// applies the default virtual host and route options of the gateway to the given virtual host.
+// options that are already set on the virtual host or its routes are not overwritten.
+func applyGatewayDefaultOptions(httpGateway *v1.HttpGateway, vh *gloov1.VirtualHost) error {
+	if defaultOptions := httpGateway.GetVirtualHostOptions(); defaultOptions != nil {
+		// the virtual host options may be shared with the virtual service, so never merge into them directly
+		var vhOptions *gloov1.VirtualHostOptions
+		if vh.Options != nil {
+			vhOptions = proto.Clone(vh.Options).(*gloov1.VirtualHostOptions)
+		}
+		merged, err := mergeVirtualHostOptions(vhOptions, defaultOptions)
+		if err != nil {
+			return err
+		}
+		vh.Options = merged
+	}
+
+	if defaultOptions := httpGateway.GetRouteOptions(); defaultOptions != nil {
+		for _, route := range vh.Routes {
+			var routeOptions *gloov1.RouteOptions
+			if route.Options != nil {
+				routeOptions = proto.Clone(route.Options).(*gloov1.RouteOptions)
+			}
+			merged, err := mergeRoutePlugins(routeOptions, defaultOptions)
+			if err != nil {
+				return err
+			}
+			route.Options = merged
+		}
+	}
+
+	return nil
+}
+
 func VirtualHostName(vs *v1.VirtualService) string {
 	return fmt.Sprintf("%v.%v", vs.Metadata.Namespace, vs.Metadata.Name)
 }
+
+func HttpsRedirectVirtualHostName(vs *v1.VirtualService) string {
+	return fmt.Sprintf("%v-https-redirect", VirtualHostName(vs))
+}
+
+// An SSL virtual service that requires an HTTP to HTTPS redirect.
+type httpsRedirect struct {
+	virtualService *v1.VirtualService
+	config         *v1.HttpsRedirect
+}
+
+// Returns the SSL virtual services that the given non-SSL gateway should redirect to HTTPS.
+// A virtual service is redirected if it is served by one of the SSL gateways of the proxy, the non-SSL gateway
+// would select it if it did not serve TLS traffic, and either the virtual service or the SSL gateway
+// configure an https redirect.
+func getHttpsRedirectsForGateway(gateway *v1.Gateway, proxyGateways []*v1.Gateway, virtualServices v1.VirtualServiceList) []httpsRedirect {
+	var candidates v1.VirtualServiceList
+	for _, vs := range virtualServices {
+		if hasSsl(vs) && vs.VirtualHost != nil && gatewaySelectsVirtualService(gateway, vs) {
+			candidates = append(candidates, vs)
+		}
+	}
+
+	var redirects []httpsRedirect
+	for _, vs := range candidates.Sort() {
+
+		config := vs.HttpsRedirect
+		servedBySslGateway := false
+		for _, sslGateway := range proxyGateways {
+			if !sslGateway.Ssl || !GatewayContainsVirtualService(sslGateway, vs) {
+				continue
+			}
+			servedBySslGateway = true
+			if config == nil {
+				config = gwutils.GetHttpGateway(sslGateway).GetHttpsRedirect()
+			}
+		}
+
+		if servedBySslGateway && config != nil {
+			redirects = append(redirects, httpsRedirect{virtualService: vs, config: config})
+		}
+	}
+	return redirects
+}
+
+// Appends a virtual host that redirects plain HTTP requests to HTTPS to the listener for each of the given redirects.
+// Redirects for domains that are already served by the non-SSL virtual services of the gateway are skipped with a
+// warning on the SSL virtual service.
+func appendHttpsRedirectVirtualHosts(listener *gloov1.Listener, gateway *v1.Gateway, virtualServicesForGateway v1.VirtualServiceList,
+	redirects []httpsRedirect, tables v1.RouteTableList, policies gloov1.ReferencePolicyList, reports reporter.ResourceReports) {
+	if len(redirects) == 0 {
+		return
+	}
+
+	usedDomains := map[string]bool{}
+	for _, vs := range virtualServicesForGateway {
+		for _, domain := range virtualServiceDomains(vs) {
+			usedDomains[domain] = true
+		}
+	}
+
+	httpListener := listener.GetHttpListener()
+	for _, redirect := range redirects {
+		vs := redirect.virtualService
+
+		domains := virtualServiceDomains(vs)
+		conflict := false
+		for _, domain := range domains {
+			if usedDomains[domain] {
+				reports.AddWarning(vs, HttpsRedirectDomainConflictErr(gateway, domain).Error())
+				conflict = true
+				break
+			}
+		}
+		if conflict {
+			continue
+		}
+
+		vh, err := httpsRedirectToVirtualHost(redirect, tables, policies, reports)
+		if err != nil {
+			reports.AddError(vs, err)
+			continue
+		}
+		if err := applyGatewayDefaultOptions(gateway.GetHttpGateway(), vh); err != nil {
+			reports.AddError(vs, err)
+			continue
+		}
+
+		for _, domain := range domains {
+			usedDomains[domain] = true
+		}
+		httpListener.VirtualHosts = append(httpListener.VirtualHosts, vh)
+	}
+}
+
+func httpsRedirectToVirtualHost(redirect httpsRedirect, tables v1.RouteTableList, policies gloov1.ReferencePolicyList, reports reporter.ResourceReports) (*gloov1.VirtualHost, error) {
+	converter := NewRouteConverter(NewRouteTableSelector(tables), NewRouteTableIndexer(), policies, reports)
+	routes, err := converter.ConvertHttpsRedirectExclusions(redirect.virtualService, redirect.config)
+	if err != nil {
+		return nil, err
+	}
+
+	redirectRoute := &gloov1.Route{
+		Matchers: []*matchers.Matcher{defaults.DefaultMatcher()},
+		Action: &gloov1.Route_RedirectAction{
+			RedirectAction: &gloov1.RedirectAction{
+				HttpsRedirect: true,
+				ResponseCode:  redirect.config.GetResponseCode(),
+			},
+		},
+	}
+	if err := appendSource(redirectRoute, redirect.virtualService); err != nil {
+		// should never happen
+		return nil, err
+	}
+
+	vh := &gloov1.VirtualHost{
+		Name:    HttpsRedirectVirtualHostName(redirect.virtualService),
+		Domains: redirect.virtualService.VirtualHost.Domains,
+		Routes:  append(routes, redirectRoute),
+	}
+
+	if err := appendSource(vh, redirect.virtualService); err != nil {
+		// should never happen
+		return nil, err
+	}
+
+	return vh, nil
+}
+
+// Returns the domains of the virtual service, using the empty string as a placeholder for virtual services that
+// don't specify any domain (and thus default to '*').
+func virtualServiceDomains(vs *v1.VirtualService) []string {
+	if vs.VirtualHost == nil {
+		return nil
+	}
+	if len(vs.VirtualHost.Domains) == 0 {
+		return []string{""}
+	}
+	return vs.VirtualHost.Domains
+}
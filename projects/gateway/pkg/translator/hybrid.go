@@ -0,0 +1,44 @@
+package translator
+
+import (
+	"context"
+
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+)
+
+// HybridTranslator generates a single listener for each hybrid gateway that serves the gateway's virtual services
+// and tcp hosts side by side; gloo matches each connection to one of them by its SNI.
+type HybridTranslator struct{}
+
+func (t *HybridTranslator) GenerateListeners(ctx context.Context, snap *v1.ApiSnapshot, filteredGateways []*v1.Gateway, reports reporter.ResourceReports) []*gloov1.Listener {
+	var result []*gloov1.Listener
+	for _, gateway := range filteredGateways {
+		hybridGateway := gateway.GetHybridGateway()
+		if hybridGateway == nil {
+			continue
+		}
+
+		virtualServices := getVirtualServicesForGateway(gateway, snap.VirtualServices)
+		validateVirtualServiceDomains(gateway, virtualServices, reports)
+		httpListener := desiredListenerForHttp(gateway, virtualServices, snap.RouteTables, snap.ReferencePolicies, reports)
+
+		listener := makeListener(gateway)
+		listener.SslConfigurations = httpListener.SslConfigurations
+		listener.ListenerType = &gloov1.Listener_HybridListener{
+			HybridListener: &gloov1.HybridListener{
+				HttpListener: httpListener.GetHttpListener(),
+				TcpListener:  desiredTcpListener(gateway, hybridGateway.GetTcpGateway(), snap.ReferencePolicies, reports),
+			},
+		}
+
+		if err := appendSource(listener, gateway); err != nil {
+			// should never happen
+			reports.AddError(gateway, err)
+		}
+
+		result = append(result, listener)
+	}
+	return result
+}
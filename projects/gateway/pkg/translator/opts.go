@@ -11,6 +11,7 @@ type Opts struct {
 	Gateways                      factory.ResourceClientFactory
 	VirtualServices               factory.ResourceClientFactory
 	RouteTables                   factory.ResourceClientFactory
+	ReferencePolicies             factory.ResourceClientFactory
 	Proxies                       factory.ResourceClientFactory
 	WatchOpts                     clients.WatchOpts
 	ValidationServerAddress       string
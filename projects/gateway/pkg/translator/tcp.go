@@ -5,6 +5,7 @@ import (
 
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/referencepolicy"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 )
 
@@ -25,12 +26,26 @@ func (t *TcpTranslator) GenerateListeners(ctx context.Context, snap *v1.ApiSnaps
 		}
 
 		listener.ListenerType = &gloov1.Listener_TcpListener{
-			TcpListener: &gloov1.TcpListener{
-				Options:  tcpGateway.Options,
-				TcpHosts: tcpGateway.TcpHosts,
-			},
+			TcpListener: desiredTcpListener(gateway, tcpGateway, snap.ReferencePolicies, reports),
 		}
 		result = append(result, listener)
 	}
 	return result
 }
+
+// tcp hosts whose destinations are not allowed by a reference policy are dropped with an error on the gateway
+func desiredTcpListener(gateway *v1.Gateway, tcpGateway *v1.TcpGateway, policies gloov1.ReferencePolicyList, reports reporter.ResourceReports) *gloov1.TcpListener {
+	var tcpHosts []*gloov1.TcpHost
+	for _, tcpHost := range tcpGateway.GetTcpHosts() {
+		if err := referencepolicy.ValidateRouteAction(policies, gateway.Metadata.Namespace, tcpHost.GetDestination()); err != nil {
+			reports.AddError(gateway, err)
+			continue
+		}
+		tcpHosts = append(tcpHosts, tcpHost)
+	}
+
+	return &gloov1.TcpListener{
+		Options:  tcpGateway.GetOptions(),
+		TcpHosts: tcpHosts,
+	}
+}
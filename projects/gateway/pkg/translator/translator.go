@@ -9,6 +9,7 @@ import (
 	"github.com/solo-io/go-utils/hashutils"
 
 	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	gwutils "github.com/solo-io/gloo/projects/gateway/pkg/utils"
 
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/go-utils/contextutils"
@@ -40,7 +41,7 @@ func NewTranslator(factories []ListenerFactory, opts Opts) *translator {
 }
 
 func NewDefaultTranslator(opts Opts) *translator {
-	return NewTranslator([]ListenerFactory{&HttpTranslator{}, &TcpTranslator{}}, opts)
+	return NewTranslator([]ListenerFactory{&HttpTranslator{}, &TcpTranslator{}, &HybridTranslator{}}, opts)
 }
 
 func (t *translator) Translate(ctx context.Context, proxyName, namespace string, snap *v1.ApiSnapshot, gatewaysByProxy v1.GatewayList) (*gloov1.Proxy, reporter.ResourceReports) {
@@ -58,6 +59,7 @@ func (t *translator) Translate(ctx context.Context, proxyName, namespace string,
 		return nil, reports
 	}
 	validateGateways(filteredGateways, snap.VirtualServices, reports)
+	reportAcmeState(filteredGateways, snap.VirtualServices, reports)
 	listeners := make([]*gloov1.Listener, 0, len(filteredGateways))
 	for _, listenerFactory := range t.listenerTypes {
 		listeners = append(listeners, listenerFactory.GenerateListeners(ctx, snap, filteredGateways, reports)...)
@@ -96,7 +98,7 @@ func validateGateways(gateways v1.GatewayList, virtualServices v1.VirtualService
 		bindAddress := fmt.Sprintf("%s:%d", gw.BindAddress, gw.BindPort)
 		bindAddresses[bindAddress] = append(bindAddresses[bindAddress], gw)
 
-		if httpGw := gw.GetHttpGateway(); httpGw != nil {
+		if httpGw := gwutils.GetHttpGateway(gw); httpGw != nil {
 			for _, vs := range httpGw.VirtualServices {
 				if _, err := virtualServices.Find(vs.Strings()); err != nil {
 					reports.AddError(gw, fmt.Errorf("invalid virtual service ref %v", vs))
@@ -114,6 +116,25 @@ func validateGateways(gateways v1.GatewayList, virtualServices v1.VirtualService
 	}
 }
 
+// surfaces failed certificate issuances of the ACME controller as warnings on the virtual services of the gateways
+func reportAcmeState(gateways v1.GatewayList, virtualServices v1.VirtualServiceList, reports reporter.ResourceReports) {
+	for _, vs := range virtualServices {
+		if !gwutils.AcmeEnabled(vs) {
+			continue
+		}
+		state, message := gwutils.AcmeState(vs)
+		if state != gwutils.AcmeStateFailed {
+			continue
+		}
+		for _, gw := range gateways {
+			if gatewaySelectsVirtualService(gw, vs) {
+				reports.AddWarning(vs, AcmeIssuanceFailedErr(message).Error())
+				break
+			}
+		}
+	}
+}
+
 func gatewaysRefsToString(gateways v1.GatewayList) []string {
 	var ret []string
 	for _, gw := range gateways {
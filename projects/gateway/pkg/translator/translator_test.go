@@ -10,6 +10,8 @@ import (
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/waf"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/als"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/cors"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
 
 	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
@@ -22,6 +24,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tcp"
 
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gwutils "github.com/solo-io/gloo/projects/gateway/pkg/utils"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
@@ -433,6 +436,70 @@ var _ = Describe("Translator", func() {
 				Expect(proxy.Listeners[0].SslConfigurations).To(BeEmpty())
 			})
 
+			Context("with default options on the gateway", func() {
+
+				var (
+					defaultTimeout = time.Minute
+					routeTimeout   = time.Second
+				)
+
+				BeforeEach(func() {
+					httpGateway := snap.Gateways[0].GetHttpGateway()
+					httpGateway.VirtualHostOptions = &gloov1.VirtualHostOptions{
+						HeaderManipulation: &headers.HeaderManipulation{
+							ResponseHeadersToAdd: []*headers.HeaderValueOption{{
+								Header: &headers.HeaderValue{Key: "Strict-Transport-Security", Value: "max-age=31536000"},
+							}},
+						},
+						Cors: &cors.CorsPolicy{AllowOrigin: []string{"default.com"}},
+					}
+					httpGateway.RouteOptions = &gloov1.RouteOptions{
+						Timeout:       &defaultTimeout,
+						PrefixRewrite: &types.StringValue{Value: "/default"},
+					}
+				})
+
+				It("applies the defaults to all virtual hosts and routes", func() {
+					proxy, _ := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+
+					Expect(proxy.Listeners).To(HaveLen(1))
+					listener := proxy.Listeners[0].ListenerType.(*gloov1.Listener_HttpListener).HttpListener
+					Expect(listener.VirtualHosts).To(HaveLen(len(snap.VirtualServices)))
+					for _, vh := range listener.VirtualHosts {
+						Expect(vh.Options.HeaderManipulation.ResponseHeadersToAdd).To(HaveLen(1))
+						Expect(vh.Options.Cors.AllowOrigin).To(Equal([]string{"default.com"}))
+						for _, route := range vh.Routes {
+							Expect(route.Options.Timeout).To(Equal(&defaultTimeout))
+							Expect(route.Options.PrefixRewrite.Value).To(Equal("/default"))
+						}
+					}
+				})
+
+				It("does not override options set on the virtual service", func() {
+					vs := snap.VirtualServices[0]
+					vs.VirtualHost.Options = &gloov1.VirtualHostOptions{
+						Cors: &cors.CorsPolicy{AllowOrigin: []string{"tenant.com"}},
+					}
+					vs.VirtualHost.Routes[0].Options = &gloov1.RouteOptions{
+						Timeout: &routeTimeout,
+					}
+
+					proxy, _ := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+
+					listener := proxy.Listeners[0].ListenerType.(*gloov1.Listener_HttpListener).HttpListener
+					vh := listener.VirtualHosts[0]
+					Expect(vh.Name).To(Equal(VirtualHostName(vs)))
+					Expect(vh.Options.Cors.AllowOrigin).To(Equal([]string{"tenant.com"}))
+					Expect(vh.Options.HeaderManipulation.ResponseHeadersToAdd).To(HaveLen(1))
+					Expect(vh.Routes[0].Options.Timeout).To(Equal(&routeTimeout))
+					Expect(vh.Routes[0].Options.PrefixRewrite.Value).To(Equal("/default"))
+
+					// the virtual service itself must not be modified
+					Expect(vs.VirtualHost.Options.HeaderManipulation).To(BeNil())
+					Expect(vs.VirtualHost.Routes[0].Options.PrefixRewrite).To(BeNil())
+				})
+			})
+
 			Context("with VirtualServices (refs)", func() {
 				It("should translate a gateway to only have its virtual services", func() {
 					snap.Gateways[0].GatewayType = &v1.Gateway_HttpGateway{
@@ -539,6 +606,119 @@ var _ = Describe("Translator", func() {
 				Expect(listener.VirtualHosts[0].Name).To(ContainSubstring("name1"))
 			})
 
+			Context("with https redirects", func() {
+
+				var (
+					sslVs *v1.VirtualService
+				)
+
+				BeforeEach(func() {
+					snap.Gateways = append(snap.Gateways, &v1.Gateway{
+						Metadata: core.Metadata{Namespace: ns, Name: "ssl"},
+						GatewayType: &v1.Gateway_HttpGateway{
+							HttpGateway: &v1.HttpGateway{},
+						},
+						BindPort: 3,
+						Ssl:      true,
+					})
+					sslVs = snap.VirtualServices[0]
+					sslVs.SslConfig = new(gloov1.SslConfig)
+					sslVs.HttpsRedirect = &v1.HttpsRedirect{}
+				})
+
+				getHttpListener := func(proxy *gloov1.Proxy, port uint32) *gloov1.HttpListener {
+					for _, listener := range proxy.Listeners {
+						if listener.BindPort == port {
+							return listener.GetHttpListener()
+						}
+					}
+					Fail("listener not found")
+					return nil
+				}
+
+				getRedirectVirtualHost := func(listener *gloov1.HttpListener) *gloov1.VirtualHost {
+					for _, vh := range listener.VirtualHosts {
+						if vh.Name == HttpsRedirectVirtualHostName(sslVs) {
+							return vh
+						}
+					}
+					return nil
+				}
+
+				It("generates a redirect virtual host on the non-ssl gateway", func() {
+					proxy, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(errs.ValidateStrict()).NotTo(HaveOccurred())
+
+					Expect(proxy.Listeners).To(HaveLen(2))
+					Expect(getRedirectVirtualHost(getHttpListener(proxy, 3))).To(BeNil())
+
+					vh := getRedirectVirtualHost(getHttpListener(proxy, 2))
+					Expect(vh).NotTo(BeNil())
+					Expect(vh.Domains).To(Equal(sslVs.VirtualHost.Domains))
+					Expect(vh.Routes).To(HaveLen(1))
+					Expect(vh.Routes[0].Matchers).To(Equal([]*matchers.Matcher{defaults.DefaultMatcher()}))
+					Expect(vh.Routes[0].GetRedirectAction()).To(Equal(&gloov1.RedirectAction{HttpsRedirect: true}))
+				})
+
+				It("uses the https redirect of the ssl gateway when the virtual service does not configure one", func() {
+					sslVs.HttpsRedirect = nil
+					snap.Gateways[2].GetHttpGateway().HttpsRedirect = &v1.HttpsRedirect{
+						ResponseCode: gloov1.RedirectAction_PERMANENT_REDIRECT,
+					}
+
+					proxy, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(errs.ValidateStrict()).NotTo(HaveOccurred())
+
+					vh := getRedirectVirtualHost(getHttpListener(proxy, 2))
+					Expect(vh).NotTo(BeNil())
+					Expect(vh.Routes[0].GetRedirectAction().ResponseCode).To(Equal(gloov1.RedirectAction_PERMANENT_REDIRECT))
+				})
+
+				It("does not generate a redirect if it is not configured", func() {
+					sslVs.HttpsRedirect = nil
+
+					proxy, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(errs.ValidateStrict()).NotTo(HaveOccurred())
+
+					Expect(getRedirectVirtualHost(getHttpListener(proxy, 2))).To(BeNil())
+				})
+
+				It("serves the excluded routes before the redirect", func() {
+					sslVs.HttpsRedirect.Exclusions = []*v1.Route{{
+						Matchers: []*matchers.Matcher{{
+							PathSpecifier: &matchers.Matcher_Prefix{
+								Prefix: "/.well-known/acme-challenge/",
+							},
+						}},
+						Action: &v1.Route_DirectResponseAction{
+							DirectResponseAction: &gloov1.DirectResponseAction{
+								Body: "challenge",
+							},
+						},
+					}}
+
+					proxy, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(errs.ValidateStrict()).NotTo(HaveOccurred())
+
+					vh := getRedirectVirtualHost(getHttpListener(proxy, 2))
+					Expect(vh).NotTo(BeNil())
+					Expect(vh.Routes).To(HaveLen(2))
+					Expect(vh.Routes[0].GetDirectResponseAction().Body).To(Equal("challenge"))
+					Expect(vh.Routes[1].GetRedirectAction()).NotTo(BeNil())
+				})
+
+				It("warns and skips the redirect when a non-ssl virtual service serves the same domain", func() {
+					snap.VirtualServices[1].VirtualHost.Domains = sslVs.VirtualHost.Domains
+
+					proxy, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(errs[sslVs].Errors).NotTo(HaveOccurred())
+					Expect(errs[sslVs].Warnings).To(HaveLen(1))
+					Expect(errs[sslVs].Warnings[0]).To(ContainSubstring("skipping https redirect"))
+
+					Expect(getRedirectVirtualHost(getHttpListener(proxy, 2))).To(BeNil())
+				})
+			})
+
 			Context("validate domains", func() {
 				BeforeEach(func() {
 					snap.VirtualServices[1].VirtualHost.Domains = snap.VirtualServices[0].VirtualHost.Domains
@@ -593,6 +773,31 @@ var _ = Describe("Translator", func() {
 					Expect(errs.Error()).To(ContainSubstring(NoVirtualHostErr(snap.VirtualServices[0]).Error()))
 				})
 			})
+
+			Context("acme certificates", func() {
+				setAcmeState := func(state, message string) {
+					snap.VirtualServices[0].Metadata.Annotations = map[string]string{
+						gwutils.AcmeEnabledAnnotation: "true",
+						gwutils.AcmeStateAnnotation:   state,
+						gwutils.AcmeMessageAnnotation: message,
+					}
+				}
+
+				It("should warn when the certificate issuance failed", func() {
+					setAcmeState(gwutils.AcmeStateFailed, "rate limited")
+
+					_, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(reports.Validate()).NotTo(HaveOccurred())
+					Expect(reports[snap.VirtualServices[0]].Warnings).To(ConsistOf(AcmeIssuanceFailedErr("rate limited").Error()))
+				})
+
+				It("should not warn while the certificate is pending", func() {
+					setAcmeState(gwutils.AcmeStatePending, "")
+
+					_, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+				})
+			})
 		})
 
 		Context("using RouteTables and delegation", func() {
@@ -1057,6 +1262,130 @@ var _ = Describe("Translator", func() {
 				})
 			})
 
+			Context("with reference policies", func() {
+
+				var upstreamRoute = func(namespace string) *v1.Route {
+					return &v1.Route{
+						Action: &v1.Route_RouteAction{
+							RouteAction: &gloov1.RouteAction{
+								Destination: &gloov1.RouteAction_Single{
+									Single: &gloov1.Destination{
+										DestinationType: &gloov1.Destination_Upstream{
+											Upstream: &core.ResourceRef{Namespace: namespace, Name: "us"},
+										},
+									},
+								},
+							},
+						},
+					}
+				}
+
+				var delegateRoute = func(action *v1.DelegateAction) *v1.Route {
+					return &v1.Route{
+						Action: &v1.Route_DelegateAction{
+							DelegateAction: action,
+						},
+					}
+				}
+
+				BeforeEach(func() {
+					translator = NewTranslator([]ListenerFactory{&HttpTranslator{}}, Opts{})
+					snap = &v1.ApiSnapshot{
+						Gateways: v1.GatewayList{
+							{
+								Metadata: core.Metadata{Namespace: ns, Name: "name"},
+								GatewayType: &v1.Gateway_HttpGateway{
+									HttpGateway: &v1.HttpGateway{},
+								},
+								BindPort: 2,
+							},
+						},
+						VirtualServices: v1.VirtualServiceList{
+							{
+								Metadata: core.Metadata{Namespace: ns, Name: "vs"},
+								VirtualHost: &v1.VirtualHost{
+									Domains: []string{"d1.com"},
+								},
+							},
+						},
+						RouteTables: v1.RouteTableList{
+							{
+								Metadata: core.Metadata{Namespace: ns2, Name: "rt", Labels: labelSet},
+								Routes:   []*v1.Route{upstreamRoute(ns2)},
+							},
+						},
+						ReferencePolicies: gloov1.ReferencePolicyList{
+							{
+								Metadata: core.Metadata{Namespace: ns2, Name: "policy"},
+								Rules: []*gloov1.ReferencePolicyRule{{
+									Kinds:      []gloov1.ReferencePolicyRule_Kind{gloov1.ReferencePolicyRule_UPSTREAM},
+									Namespaces: []string{"other-namespace"},
+								}},
+							},
+						},
+					}
+				})
+
+				getRoutes := func(proxy *gloov1.Proxy) []*gloov1.Route {
+					Expect(proxy.Listeners).To(HaveLen(1))
+					vhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
+					Expect(vhosts).To(HaveLen(1))
+					return vhosts[0].Routes
+				}
+
+				It("reports an error on routes to upstreams that are not allowed", func() {
+					vs := snap.VirtualServices[0]
+					vs.VirtualHost.Routes = []*v1.Route{upstreamRoute(ns2), upstreamRoute(ns)}
+
+					proxy, errs := translator.Translate(context.TODO(), "", ns, snap, snap.Gateways)
+					Expect(getRoutes(proxy)).To(HaveLen(1))
+
+					err := errs.ValidateStrict()
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("reference policy violation"))
+					Expect(errs[vs].Errors).To(HaveOccurred())
+				})
+
+				It("allows references from the namespaces listed in the policy", func() {
+					snap.ReferencePolicies[0].Rules[0].Namespaces = []string{ns}
+					snap.VirtualServices[0].VirtualHost.Routes = []*v1.Route{upstreamRoute(ns2)}
+
+					proxy, errs := translator.Translate(context.TODO(), "", ns, snap, snap.Gateways)
+					Expect(errs.ValidateStrict()).NotTo(HaveOccurred())
+					Expect(getRoutes(proxy)).To(HaveLen(1))
+				})
+
+				It("reports an error when delegating to a route table that is not allowed", func() {
+					vs := snap.VirtualServices[0]
+					vs.VirtualHost.Routes = []*v1.Route{delegateRoute(&v1.DelegateAction{
+						DelegationType: &v1.DelegateAction_Ref{
+							Ref: &core.ResourceRef{Namespace: ns2, Name: "rt"},
+						},
+					})}
+
+					_, errs := translator.Translate(context.TODO(), "", ns, snap, snap.Gateways)
+					Expect(errs[vs].Errors).To(HaveOccurred())
+					Expect(errs[vs].Errors.Error()).To(ContainSubstring("route table"))
+				})
+
+				It("reports a warning when a selector matches a route table that is not allowed", func() {
+					vs := snap.VirtualServices[0]
+					vs.VirtualHost.Routes = []*v1.Route{delegateRoute(&v1.DelegateAction{
+						DelegationType: &v1.DelegateAction_Selector{
+							Selector: &v1.RouteTableSelector{
+								Labels:     labelSet,
+								Namespaces: []string{"*"},
+							},
+						},
+					})}
+
+					_, errs := translator.Translate(context.TODO(), "", ns, snap, snap.Gateways)
+					Expect(errs[vs].Errors).NotTo(HaveOccurred())
+					Expect(errs[vs].Warnings).To(HaveLen(1))
+					Expect(errs[vs].Warnings[0]).To(ContainSubstring("route table"))
+				})
+			})
+
 		})
 	})
 
@@ -1116,6 +1445,118 @@ var _ = Describe("Translator", func() {
 			Expect(listener.TcpHosts[0]).To(Equal(tcpHost))
 		})
 
+		It("drops tcp hosts with destinations that are not allowed by reference policies", func() {
+			tcpHost.Destination.Destination = &gloov1.RouteAction_UpstreamGroup{
+				UpstreamGroup: &core.ResourceRef{Namespace: ns2, Name: "ug-name"},
+			}
+			snap.ReferencePolicies = gloov1.ReferencePolicyList{
+				{
+					Metadata: core.Metadata{Namespace: ns2, Name: "policy"},
+					Rules: []*gloov1.ReferencePolicyRule{{
+						Kinds:      []gloov1.ReferencePolicyRule_Kind{gloov1.ReferencePolicyRule_UPSTREAM_GROUP},
+						Namespaces: []string{"other-namespace"},
+					}},
+				},
+			}
+
+			proxy, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+
+			Expect(errs[snap.Gateways[0]].Errors).To(HaveOccurred())
+			Expect(proxy.Listeners).To(HaveLen(1))
+			listener := proxy.Listeners[0].ListenerType.(*gloov1.Listener_TcpListener).TcpListener
+			Expect(listener.TcpHosts).To(BeEmpty())
+		})
+
+	})
+
+	Context("hybrid", func() {
+		var (
+			tcpHost *gloov1.TcpHost
+		)
+		BeforeEach(func() {
+			translator = NewTranslator([]ListenerFactory{&HttpTranslator{}, &TcpTranslator{}, &HybridTranslator{}}, Opts{})
+
+			tcpHost = &gloov1.TcpHost{
+				Name: "passthrough",
+				Destination: &gloov1.RouteAction{
+					Destination: &gloov1.RouteAction_Single{
+						Single: &gloov1.Destination{
+							DestinationType: &gloov1.Destination_Upstream{
+								Upstream: &core.ResourceRef{Namespace: ns, Name: "tcp-upstream"},
+							},
+						},
+					},
+				},
+				SslConfig: &gloov1.SslConfig{
+					SniDomains: []string{"passthrough.example.com"},
+				},
+			}
+
+			snap = &v1.ApiSnapshot{
+				Gateways: v1.GatewayList{
+					{
+						Metadata: core.Metadata{Namespace: ns, Name: "hybrid"},
+						Ssl:      true,
+						GatewayType: &v1.Gateway_HybridGateway{
+							HybridGateway: &v1.HybridGateway{
+								HttpGateway: &v1.HttpGateway{},
+								TcpGateway: &v1.TcpGateway{
+									TcpHosts: []*gloov1.TcpHost{tcpHost},
+								},
+							},
+						},
+						BindPort: 8443,
+					},
+				},
+				VirtualServices: v1.VirtualServiceList{
+					{
+						Metadata: core.Metadata{Namespace: ns, Name: "ssl-vs"},
+						VirtualHost: &v1.VirtualHost{
+							Domains: []string{"terminated.example.com"},
+						},
+						SslConfig: &gloov1.SslConfig{
+							SniDomains: []string{"terminated.example.com"},
+							SslSecrets: &gloov1.SslConfig_SecretRef{
+								SecretRef: &core.ResourceRef{Namespace: ns, Name: "tls"},
+							},
+						},
+					},
+					{
+						Metadata: core.Metadata{Namespace: ns, Name: "plain-vs"},
+						VirtualHost: &v1.VirtualHost{
+							Domains: []string{"plain.example.com"},
+						},
+					},
+				},
+			}
+		})
+
+		It("serves the ssl virtual services and tcp hosts of a hybrid gateway from one listener", func() {
+			proxy, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+			Expect(errs.Validate()).NotTo(HaveOccurred())
+
+			Expect(proxy.Listeners).To(HaveLen(1))
+			listener := proxy.Listeners[0]
+			Expect(listener.BindPort).To(Equal(uint32(8443)))
+			Expect(listener.SslConfigurations).To(Equal([]*gloov1.SslConfig{snap.VirtualServices[0].SslConfig}))
+
+			hybridListener := listener.GetHybridListener()
+			Expect(hybridListener).NotTo(BeNil())
+			Expect(hybridListener.GetHttpListener().GetVirtualHosts()).To(HaveLen(1))
+			Expect(hybridListener.GetHttpListener().GetVirtualHosts()[0].Name).To(Equal(VirtualHostName(snap.VirtualServices[0])))
+			Expect(hybridListener.GetTcpListener().GetTcpHosts()).To(Equal([]*gloov1.TcpHost{tcpHost}))
+		})
+
+		It("applies the http gateway's virtual service selection to the hybrid gateway", func() {
+			snap.Gateways[0].GetHybridGateway().HttpGateway.VirtualServiceNamespaces = []string{"other-namespace"}
+
+			proxy, _ := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+
+			Expect(proxy.Listeners).To(HaveLen(1))
+			Expect(proxy.Listeners[0].GetHybridListener().GetHttpListener().GetVirtualHosts()).To(BeEmpty())
+			Expect(proxy.Listeners[0].GetHybridListener().GetTcpListener().GetTcpHosts()).To(HaveLen(1))
+		})
+
 	})
 
 })
@@ -0,0 +1,60 @@
+package utils
+
+import (
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// Annotations used by the ACME controller to manage the certificates of virtual services.
+const (
+	// set to "true" on a virtual service to have the ACME controller issue a certificate for its domains
+	AcmeEnabledAnnotation = "acme.gloo.solo.io/enabled"
+	// the state of the certificate issuance, written by the ACME controller
+	AcmeStateAnnotation = "acme.gloo.solo.io/state"
+	// a human readable description of the state, written by the ACME controller
+	AcmeMessageAnnotation = "acme.gloo.solo.io/message"
+)
+
+const (
+	AcmeStatePending = "Pending"
+	AcmeStateIssued  = "Issued"
+	AcmeStateFailed  = "Failed"
+)
+
+// the key of the ACME certificate issuance in the subresource statuses of virtual services
+const AcmeSubresourceStatusKey = "acme.gloo.solo.io"
+
+// AcmeEnabled returns true if the ACME controller manages the certificate of the virtual service
+func AcmeEnabled(vs *v1.VirtualService) bool {
+	return vs.GetMetadata().Annotations[AcmeEnabledAnnotation] == "true"
+}
+
+// AcmeState returns the state and message of the ACME certificate issuance of the virtual service
+func AcmeState(vs *v1.VirtualService) (string, string) {
+	annotations := vs.GetMetadata().Annotations
+	return annotations[AcmeStateAnnotation], annotations[AcmeMessageAnnotation]
+}
+
+// AcmeStatus returns the state of the ACME certificate issuance of the virtual service as a status,
+// or nil if the ACME controller has not processed the virtual service yet
+func AcmeStatus(vs *v1.VirtualService) *core.Status {
+	if !AcmeEnabled(vs) {
+		return nil
+	}
+	state, message := AcmeState(vs)
+	status := &core.Status{
+		Reason:     message,
+		ReportedBy: "acme",
+	}
+	switch state {
+	case AcmeStatePending:
+		status.State = core.Status_Pending
+	case AcmeStateIssued:
+		status.State = core.Status_Accepted
+	case AcmeStateFailed:
+		status.State = core.Status_Rejected
+	default:
+		return nil
+	}
+	return status
+}
@@ -0,0 +1,21 @@
+package utils
+
+import (
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+)
+
+// GetHttpGateway returns the http gateway of an http or hybrid gateway
+func GetHttpGateway(gateway *v1.Gateway) *v1.HttpGateway {
+	if hybridGateway := gateway.GetHybridGateway(); hybridGateway != nil {
+		return hybridGateway.GetHttpGateway()
+	}
+	return gateway.GetHttpGateway()
+}
+
+// GetTcpGateway returns the tcp gateway of a tcp or hybrid gateway
+func GetTcpGateway(gateway *v1.Gateway) *v1.TcpGateway {
+	if hybridGateway := gateway.GetHybridGateway(); hybridGateway != nil {
+		return hybridGateway.GetTcpGateway()
+	}
+	return gateway.GetTcpGateway()
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	sslutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/go-utils/cliutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
@@ -87,7 +88,7 @@ func CheckResources(opts *options.Options) (bool, error) {
 		return ok, err
 	}
 
-	ok, err = checkSecrets(namespaces)
+	ok, err = checkSecrets(settings, namespaces)
 	if !ok || err != nil {
 		return ok, err
 	}
@@ -401,17 +402,42 @@ func checkProxies(ctx context.Context, namespaces []string, glooNamespace string
 
 }
 
-func checkSecrets(namespaces []string) (bool, error) {
+func checkSecrets(settings *v1.Settings, namespaces []string) (bool, error) {
 	fmt.Printf("Checking secrets... ")
 	client := helpers.MustSecretClient()
+	window := sslutils.CertificateExpiryWarningWindow(settings)
+	var expiringSecrets []string
 	for _, ns := range namespaces {
-		_, err := client.List(ns, clients.ListOpts{})
+		secrets, err := client.List(ns, clients.ListOpts{})
 		if err != nil {
 			return false, err
 		}
-		// currently this would only find syntax errors
+		for _, secret := range secrets {
+			certChain := secret.GetTls().GetCertChain()
+			if certChain == "" {
+				continue
+			}
+			info, err := sslutils.ParseCertificateChain(certChain)
+			if err != nil {
+				fmt.Printf("Found secret with invalid certificate chain: %s\n", renderMetadata(secret.GetMetadata()))
+				fmt.Printf("Reason: %s", err)
+				return false, nil
+			}
+			if info.TimeUntilExpiry() <= 0 {
+				fmt.Printf("Found secret with expired certificate: %s\n", renderMetadata(secret.GetMetadata()))
+				fmt.Printf("Expired on: %s", info.NotAfter.UTC().Format(time.RFC3339))
+				return false, nil
+			}
+			if window > 0 && info.TimeUntilExpiry() < window {
+				expiringSecrets = append(expiringSecrets, fmt.Sprintf("%s (expires on %s)",
+					renderMetadata(secret.GetMetadata()), info.NotAfter.UTC().Format(time.RFC3339)))
+			}
+		}
 	}
 	fmt.Printf("OK\n")
+	for _, expiringSecret := range expiringSecrets {
+		fmt.Printf("Warning: found secret with certificate about to expire: %s\n", expiringSecret)
+	}
 	return true, nil
 }
 
@@ -90,6 +90,11 @@ func createAwsSecret(ctx context.Context, meta core.Metadata, input options.AwsS
 		},
 	}
 
+	secret, err := helpers.EncryptSecret(secret)
+	if err != nil {
+		return err
+	}
+
 	if !dryRun {
 		var err error
 		secretClient := helpers.MustSecretClient()
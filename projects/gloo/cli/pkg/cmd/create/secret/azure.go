@@ -71,6 +71,11 @@ func createAzureSecret(ctx context.Context, meta core.Metadata, input options.Az
 		},
 	}
 
+	secret, err := helpers.EncryptSecret(secret)
+	if err != nil {
+		return err
+	}
+
 	if !dryRun {
 		var err error
 		secretClient := helpers.MustSecretClient()
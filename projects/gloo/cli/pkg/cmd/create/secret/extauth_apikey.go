@@ -129,6 +129,11 @@ func createApiKeySecret(ctx context.Context, meta core.Metadata, input extauth.A
 		},
 	}
 
+	secret, err := helpers.EncryptSecret(secret)
+	if err != nil {
+		return err
+	}
+
 	if !dryRun {
 		secretClient := helpers.MustSecretClient()
 		if _, err := secretClient.Write(secret, clients.WriteOpts{Ctx: ctx}); err != nil {
@@ -80,6 +80,11 @@ func createOauthSecret(ctx context.Context, meta core.Metadata, input extauth.Oa
 		},
 	}
 
+	secret, err := helpers.EncryptSecret(secret)
+	if err != nil {
+		return err
+	}
+
 	if !dryRun {
 		secretClient := helpers.MustSecretClient()
 		var err error
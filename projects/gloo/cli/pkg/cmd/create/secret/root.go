@@ -25,6 +25,9 @@ func CreateCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobr
 			if err := prerun.EnableVaultClients(opts.Create.Vault); err != nil {
 				return err
 			}
+			if err := prerun.EnableSecretEncryption(opts.Create.SecretEncryption); err != nil {
+				return err
+			}
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -37,6 +40,7 @@ func CreateCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobr
 	cmd.AddCommand(ExtAuthApiKeyCmd(opts))
 	cmd.AddCommand(ExtAuthOathCmd(opts))
 	flagutils.AddVaultSecretFlags(cmd.PersistentFlags(), &opts.Create.Vault)
+	flagutils.AddSecretEncryptionFlags(cmd.PersistentFlags(), &opts.Create.SecretEncryption)
 	cliutils.ApplyOptions(cmd, optionsFunc)
 	return cmd
 }
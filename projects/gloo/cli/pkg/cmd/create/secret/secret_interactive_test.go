@@ -116,6 +116,7 @@ var _ = Describe("Secret Interactive Mode", func() {
 				rootCa            = "foo"
 				privateKey        = "bar"
 				certChainFilename = "baz"
+				ocspStaple        = "qux"
 			)
 			testutil.ExpectInteractive(func(c *testutil.Console) {
 				c.ExpectString(surveyutils.PromptInteractiveNamespace)
@@ -128,6 +129,8 @@ var _ = Describe("Secret Interactive Mode", func() {
 				c.SendLine(privateKey)
 				c.ExpectString(tlsPromptCertChain)
 				c.SendLine(certChainFilename)
+				c.ExpectString(tlsPromptOcspStaple)
+				c.SendLine(ocspStaple)
 				c.ExpectEOF()
 			}, func() {
 				tlsSecretOpts := options.Secret{
@@ -144,6 +147,7 @@ var _ = Describe("Secret Interactive Mode", func() {
 				Expect(opts.Create.InputSecret.TlsSecret.RootCaFilename).To(Equal(rootCa))
 				Expect(opts.Create.InputSecret.TlsSecret.PrivateKeyFilename).To(Equal(privateKey))
 				Expect(opts.Create.InputSecret.TlsSecret.CertChainFilename).To(Equal(certChainFilename))
+				Expect(opts.Create.InputSecret.TlsSecret.OcspStapleFilename).To(Equal(ocspStaple))
 			})
 		})
 	})
@@ -15,6 +15,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/testutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/secretencryption"
 	"github.com/solo-io/go-utils/log"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 )
@@ -85,6 +86,43 @@ metadata:
 		})
 	})
 
+	Context("Encryption", func() {
+		var keyFile string
+
+		BeforeEach(func() {
+			f, err := ioutil.TempFile("", "")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = f.WriteString("MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=")
+			Expect(err).NotTo(HaveOccurred())
+			keyFile = f.Name()
+		})
+
+		AfterEach(func() {
+			_ = os.Remove(keyFile)
+		})
+
+		It("should encrypt the secret", func() {
+			err := testutils.Glooctl("create secret aws --name test --access-key foo --secret-key bar --encrypt --encryption-key-file " + keyFile)
+			Expect(err).NotTo(HaveOccurred())
+
+			stored, err := helpers.MustSecretClient().Read("gloo-system", "test", clients.ReadOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stored.GetAws()).To(BeNil())
+			Expect(secretencryption.IsEncrypted(stored)).To(BeTrue())
+
+			key, err := secretencryption.LoadKey(keyFile)
+			Expect(err).NotTo(HaveOccurred())
+			decrypted, err := secretencryption.Decrypt(stored, key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decrypted.GetAws()).To(Equal(&v1.AwsSecret{AccessKey: "foo", SecretKey: "bar"}))
+		})
+
+		It("should require a key file", func() {
+			err := testutils.Glooctl("create secret aws --name test --access-key foo --secret-key bar --encrypt")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	Context("Azure", func() {
 		It("should error if no name provided", func() {
 			err := testutils.Glooctl("create secret azure")
@@ -44,6 +44,7 @@ func tlsCmd(opts *options.Options) *cobra.Command {
 	flags.StringVar(&input.RootCaFilename, "rootca", "", "filename of rootca for secret")
 	flags.StringVar(&input.PrivateKeyFilename, "privatekey", "", "filename of privatekey for secret")
 	flags.StringVar(&input.CertChainFilename, "certchain", "", "filename of certchain for secret")
+	flags.StringVar(&input.OcspStapleFilename, "ocspstaple", "", "filename of a DER encoded OCSP response to staple (optional)")
 
 	return cmd
 }
@@ -52,6 +53,7 @@ const (
 	tlsPromptRootCa     = "filename of rootca for secret (optional)"
 	tlsPromptPrivateKey = "filename of privatekey for secret"
 	tlsPromptCertChain  = "filename of certchain for secret"
+	tlsPromptOcspStaple = "filename of ocsp staple for secret (optional)"
 )
 
 func TlsSecretArgsInteractive(input *options.TlsSecret) error {
@@ -64,6 +66,9 @@ func TlsSecretArgsInteractive(input *options.TlsSecret) error {
 	if err := cliutil.GetStringInput("filename of certchain for secret", &input.CertChainFilename); err != nil {
 		return err
 	}
+	if err := cliutil.GetStringInput(tlsPromptOcspStaple, &input.OcspStapleFilename); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -76,6 +81,10 @@ func createTlsSecret(ctx context.Context, meta core.Metadata, input options.TlsS
 	if err != nil {
 		return err
 	}
+	ocspStaple, err := input.ReadOcspStaple()
+	if err != nil {
+		return err
+	}
 
 	secret := &gloov1.Secret{
 		Metadata: meta,
@@ -84,10 +93,16 @@ func createTlsSecret(ctx context.Context, meta core.Metadata, input options.TlsS
 				CertChain:  string(certChain),
 				PrivateKey: string(privateKey),
 				RootCa:     string(rootCa),
+				OcspStaple: ocspStaple,
 			},
 		},
 	}
 
+	secret, err = helpers.EncryptSecret(secret)
+	if err != nil {
+		return err
+	}
+
 	if !dryRun {
 		var err error
 		secretClient := helpers.MustSecretClient()
@@ -44,6 +44,7 @@ func init() {
 		"settings.gloo.solo.io",
 		"upstreams.gloo.solo.io",
 		"upstreamgroups.gloo.solo.io",
+		"referencepolicies.gloo.solo.io",
 		"virtualservices.gateway.solo.io",
 		"routetables.gateway.solo.io",
 		"authconfigs.enterprise.gloo.solo.io",
@@ -109,6 +109,11 @@ type Consul struct {
 	Client    func() (*api.Client, error)
 }
 
+type SecretEncryption struct {
+	Encrypt bool   // encrypt secrets before writing or printing them
+	KeyFile string // the key encryption key, see settings.secretEncryption
+}
+
 type Vault struct {
 	UseVault bool // enable vault secret clients
 	RootKey  string
@@ -123,6 +128,7 @@ type Create struct {
 	AuthConfig         InputAuthConfig
 	DryRun             bool  // print resource as a kubernetes style yaml and exit without writing to storage
 	Vault              Vault // use vault as secrets backend
+	SecretEncryption   SecretEncryption
 }
 
 type RouteMatchers struct {
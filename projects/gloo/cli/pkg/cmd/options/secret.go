@@ -26,6 +26,7 @@ type TlsSecret struct {
 	RootCaFilename     string
 	PrivateKeyFilename string
 	CertChainFilename  string
+	OcspStapleFilename string
 	// non-user facing value for test purposes
 	// if set, Read() will just return the filenames
 	Mock bool
@@ -64,6 +65,22 @@ func (t *TlsSecret) ReadFiles() (string, string, string, error) {
 	return string(rootCa), string(privateKey), string(certChain), nil
 }
 
+// ReadOcspStaple reads the optional OCSP staple, which is binary and so kept apart from ReadFiles
+func (t *TlsSecret) ReadOcspStaple() ([]byte, error) {
+	if t.OcspStapleFilename == "" {
+		return nil, nil
+	}
+	// short circuit if testing
+	if t.Mock {
+		return []byte(t.OcspStapleFilename), nil
+	}
+	ocspStaple, err := ioutil.ReadFile(t.OcspStapleFilename)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading ocsp staple file: %v", t.OcspStapleFilename)
+	}
+	return ocspStaple, nil
+}
+
 func (t *TlsSecret) validateKeyPair() error {
 	if _, err := tls.LoadX509KeyPair(t.CertChainFilename, t.PrivateKeyFilename); err != nil {
 		return err
@@ -108,3 +108,10 @@ func AddVaultSecretFlags(set *pflag.FlagSet, vault *options.Vault) {
 		return vaultClient, nil
 	}
 }
+
+func AddSecretEncryptionFlags(set *pflag.FlagSet, encryption *options.SecretEncryption) {
+	set.BoolVar(&encryption.Encrypt, "encrypt", false, "encrypt the secret for the directory and Consul Key-Value "+
+		"secret sources, which Gloo decrypts with the key configured in settings.secretEncryption")
+	set.StringVar(&encryption.KeyFile, "encryption-key-file", "", "path to a file containing the base64 encoded 32 byte "+
+		"key to encrypt the secret with. Use with --encrypt")
+}
@@ -20,6 +20,7 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	extauth "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/secretencryption"
 	"github.com/solo-io/go-utils/kubeutils"
 	"github.com/solo-io/go-utils/log"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
@@ -36,6 +37,8 @@ var (
 	memResourceClient *factory.MemoryResourceClientFactory
 	consulClient      *factory.ConsulResourceClientFactory
 	vaultClient       *factory.VaultSecretClientFactory
+	// when set, secrets created with glooctl are encrypted with this key
+	secretEncryptionKey []byte
 
 	lock sync.Mutex
 )
@@ -78,6 +81,7 @@ func UseDefaultClients() {
 	memResourceClient = nil
 	consulClient = nil
 	vaultClient = nil
+	secretEncryptionKey = nil
 }
 
 func UseMemoryClients() {
@@ -109,6 +113,24 @@ func UseVaultClients(client *vaultapi.Client, rootKey string) {
 	}
 }
 
+// only applies to secrets created with glooctl
+func UseSecretEncryption(key []byte) {
+	lock.Lock()
+	defer lock.Unlock()
+	secretEncryptionKey = key
+}
+
+// EncryptSecret encrypts the secret if UseSecretEncryption was called, and returns it unchanged otherwise
+func EncryptSecret(secret *v1.Secret) (*v1.Secret, error) {
+	lock.Lock()
+	key := secretEncryptionKey
+	lock.Unlock()
+	if key == nil {
+		return secret, nil
+	}
+	return secretencryption.Encrypt(secret, key)
+}
+
 func MustKubeClient() kubernetes.Interface {
 	client, err := KubeClient()
 	if err != nil {
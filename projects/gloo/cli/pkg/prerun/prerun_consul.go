@@ -4,6 +4,7 @@ import (
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/secretencryption"
 )
 
 func EnableConsulClients(opts *options.Options) error {
@@ -28,3 +29,17 @@ func EnableVaultClients(vault options.Vault) error {
 	}
 	return nil
 }
+
+func EnableSecretEncryption(encryption options.SecretEncryption) error {
+	if encryption.Encrypt {
+		if encryption.KeyFile == "" {
+			return eris.Errorf("--encryption-key-file is required with --encrypt")
+		}
+		key, err := secretencryption.LoadKey(encryption.KeyFile)
+		if err != nil {
+			return err
+		}
+		helpers.UseSecretEncryption(key)
+	}
+	return nil
+}
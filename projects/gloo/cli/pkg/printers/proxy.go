@@ -8,6 +8,7 @@ import (
 
 	"github.com/olekukonko/tablewriter"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/go-utils/cliutils"
 )
 
@@ -34,11 +35,7 @@ func ProxyTable(list v1.ProxyList, w io.Writer) {
 		)
 		for _, listener := range proxy.Listeners {
 			listeners = append(listeners, fmt.Sprintf("%v:%v", listener.BindAddress, listener.BindPort))
-			http, ok := listener.ListenerType.(*v1.Listener_HttpListener)
-			if !ok {
-				continue
-			}
-			vhCount += len(http.HttpListener.VirtualHosts)
+			vhCount += len(utils.GetHttpListener(listener).GetVirtualHosts())
 		}
 		name := proxy.GetMetadata().Name
 
@@ -69,6 +69,10 @@ func upstreamType(up *v1.Upstream) string {
 		return "Consul"
 	case *v1.Upstream_AwsEc2:
 		return "AWS EC2"
+	case *v1.Upstream_AwsEcs:
+		return "AWS ECS"
+	case *v1.Upstream_DnsSrv:
+		return "DNS SRV"
 	case *v1.Upstream_Kube:
 		return "Kubernetes"
 	case *v1.Upstream_Static:
@@ -117,6 +121,21 @@ func upstreamDetails(up *v1.Upstream, xdsDump *xdsinspection.XdsDump) []string {
 		add(
 			instances...,
 		)
+	case *v1.Upstream_AwsEcs:
+		add(
+			fmt.Sprintf("region:    %v", usType.AwsEcs.Region),
+			fmt.Sprintf("role:      %v", usType.AwsEcs.RoleArn),
+			fmt.Sprintf("cluster:   %v", usType.AwsEcs.Cluster),
+			fmt.Sprintf("service:   %v", usType.AwsEcs.ServiceName),
+			fmt.Sprintf("container: %v", usType.AwsEcs.ContainerName),
+			fmt.Sprintf("port:      %v", usType.AwsEcs.Port),
+		)
+	case *v1.Upstream_DnsSrv:
+		add(
+			fmt.Sprintf("name:        %v", usType.DnsSrv.Name),
+			fmt.Sprintf("dns server:  %v", usType.DnsSrv.DnsServer),
+			fmt.Sprintf("respect ttl: %v", usType.DnsSrv.RespectTtl),
+		)
 	case *v1.Upstream_Azure:
 		var functions []string
 		for _, fn := range usType.Azure.Functions {
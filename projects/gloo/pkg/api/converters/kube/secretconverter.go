@@ -16,6 +16,9 @@ import (
 const (
 	annotationKey   = "solo.io/secret-converter"
 	annotationValue = "kube-tls"
+
+	// OcspStapleKey is the key for an optional DER encoded OCSP response in kubernetes tls secrets
+	OcspStapleKey = "tls.ocsp-staple"
 )
 
 type SecretConverterChain struct {
@@ -67,6 +70,7 @@ func (t *TLSSecretConverter) FromKubeSecret(ctx context.Context, rc *kubesecret.
 				Tls: &v1.TlsSecret{
 					PrivateKey: string(secret.Data[kubev1.TLSPrivateKeyKey]),
 					CertChain:  string(secret.Data[kubev1.TLSCertKey]),
+					OcspStaple: secret.Data[OcspStapleKey],
 				},
 			},
 			Metadata: kubeutils.FromKubeMeta(secret.ObjectMeta),
@@ -99,6 +103,9 @@ func (t *TLSSecretConverter) ToKubeSecret(_ context.Context, _ *kubesecret.Resou
 							kubev1.TLSCertKey:       []byte(tlsGlooSecret.Tls.CertChain),
 						},
 					}
+					if len(tlsGlooSecret.Tls.OcspStaple) != 0 {
+						kubeSecret.Data[OcspStapleKey] = tlsGlooSecret.Tls.OcspStaple
+					}
 					return kubeSecret, nil
 				}
 			}
@@ -86,6 +86,31 @@ var _ = Describe("SecretConverter", func() {
 
 	})
 
+	It("should round trip the ocsp staple of a kube ssl secret", func() {
+		secret := &kubev1.Secret{
+			Type: kubev1.SecretTypeTLS,
+			Data: map[string][]byte{
+				kubev1.TLSCertKey:       []byte("cert"),
+				kubev1.TLSPrivateKeyKey: []byte("key"),
+				OcspStapleKey:           []byte("staple"),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "s1",
+				Namespace:       "ns",
+				Labels:          map[string]string{},
+				OwnerReferences: []metav1.OwnerReference{},
+			},
+		}
+		var t TLSSecretConverter
+		resource, err := t.FromKubeSecret(context.Background(), nil, secret)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resource.(*v1.Secret).Kind.(*v1.Secret_Tls).Tls.OcspStaple).To(Equal([]byte("staple")))
+		kubeSecret, err := t.ToKubeSecret(context.Background(), nil, resource)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(secret).To(Equal(kubeSecret))
+	})
+
 	It("should round trip kube aws secret to gloo aws secret and back to kube aws secret", func() {
 		awsSecret := &v1.AwsSecret{
 			AccessKey: "access",
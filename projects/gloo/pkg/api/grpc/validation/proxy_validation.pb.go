@@ -10,6 +10,7 @@ import (
 
 	proto "github.com/gogo/protobuf/proto"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -76,7 +77,29 @@ func (x HttpListenerReport_Error_Type) String() string {
 }
 
 func (HttpListenerReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{6, 0, 0}
+	return fileDescriptor_aacaf097b496f502, []int{7, 0, 0}
+}
+
+type HttpListenerReport_Warning_Type int32
+
+const (
+	HttpListenerReport_Warning_SslCertificateExpiringWarning HttpListenerReport_Warning_Type = 0
+)
+
+var HttpListenerReport_Warning_Type_name = map[int32]string{
+	0: "SslCertificateExpiringWarning",
+}
+
+var HttpListenerReport_Warning_Type_value = map[string]int32{
+	"SslCertificateExpiringWarning": 0,
+}
+
+func (x HttpListenerReport_Warning_Type) String() string {
+	return proto.EnumName(HttpListenerReport_Warning_Type_name, int32(x))
+}
+
+func (HttpListenerReport_Warning_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aacaf097b496f502, []int{7, 1, 0}
 }
 
 type VirtualHostReport_Error_Type int32
@@ -107,7 +130,7 @@ func (x VirtualHostReport_Error_Type) String() string {
 }
 
 func (VirtualHostReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{7, 0, 0}
+	return fileDescriptor_aacaf097b496f502, []int{8, 0, 0}
 }
 
 type RouteReport_Error_Type int32
@@ -132,7 +155,7 @@ func (x RouteReport_Error_Type) String() string {
 }
 
 func (RouteReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8, 0, 0}
+	return fileDescriptor_aacaf097b496f502, []int{9, 0, 0}
 }
 
 type RouteReport_Warning_Type int32
@@ -154,7 +177,7 @@ func (x RouteReport_Warning_Type) String() string {
 }
 
 func (RouteReport_Warning_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8, 1, 0}
+	return fileDescriptor_aacaf097b496f502, []int{9, 1, 0}
 }
 
 type TcpListenerReport_Error_Type int32
@@ -185,7 +208,7 @@ func (x TcpListenerReport_Error_Type) String() string {
 }
 
 func (TcpListenerReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{9, 0, 0}
+	return fileDescriptor_aacaf097b496f502, []int{10, 0, 0}
 }
 
 type TcpHostReport_Error_Type int32
@@ -213,7 +236,7 @@ func (x TcpHostReport_Error_Type) String() string {
 }
 
 func (TcpHostReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{10, 0, 0}
+	return fileDescriptor_aacaf097b496f502, []int{11, 0, 0}
 }
 
 type ProxyValidationServiceRequest struct {
@@ -402,6 +425,7 @@ type ListenerReport struct {
 	// Types that are valid to be assigned to ListenerTypeReport:
 	//	*ListenerReport_HttpListenerReport
 	//	*ListenerReport_TcpListenerReport
+	//	*ListenerReport_HybridListenerReport
 	ListenerTypeReport   isListenerReport_ListenerTypeReport `protobuf_oneof:"listener_type_report"`
 	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
 	XXX_unrecognized     []byte                              `json:"-"`
@@ -442,9 +466,13 @@ type ListenerReport_HttpListenerReport struct {
 type ListenerReport_TcpListenerReport struct {
 	TcpListenerReport *TcpListenerReport `protobuf:"bytes,4,opt,name=tcp_listener_report,json=tcpListenerReport,proto3,oneof" json:"tcp_listener_report,omitempty"`
 }
+type ListenerReport_HybridListenerReport struct {
+	HybridListenerReport *HybridListenerReport `protobuf:"bytes,5,opt,name=hybrid_listener_report,json=hybridListenerReport,proto3,oneof" json:"hybrid_listener_report,omitempty"`
+}
 
-func (*ListenerReport_HttpListenerReport) isListenerReport_ListenerTypeReport() {}
-func (*ListenerReport_TcpListenerReport) isListenerReport_ListenerTypeReport()  {}
+func (*ListenerReport_HttpListenerReport) isListenerReport_ListenerTypeReport()   {}
+func (*ListenerReport_TcpListenerReport) isListenerReport_ListenerTypeReport()    {}
+func (*ListenerReport_HybridListenerReport) isListenerReport_ListenerTypeReport() {}
 
 func (m *ListenerReport) GetListenerTypeReport() isListenerReport_ListenerTypeReport {
 	if m != nil {
@@ -474,11 +502,19 @@ func (m *ListenerReport) GetTcpListenerReport() *TcpListenerReport {
 	return nil
 }
 
+func (m *ListenerReport) GetHybridListenerReport() *HybridListenerReport {
+	if x, ok := m.GetListenerTypeReport().(*ListenerReport_HybridListenerReport); ok {
+		return x.HybridListenerReport
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ListenerReport) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ListenerReport_HttpListenerReport)(nil),
 		(*ListenerReport_TcpListenerReport)(nil),
+		(*ListenerReport_HybridListenerReport)(nil),
 	}
 }
 
@@ -531,20 +567,70 @@ func (m *ListenerReport_Error) GetReason() string {
 	return ""
 }
 
+type HybridListenerReport struct {
+	// report for the http listener of the hybrid listener
+	HttpListenerReport *HttpListenerReport `protobuf:"bytes,1,opt,name=http_listener_report,json=httpListenerReport,proto3" json:"http_listener_report,omitempty"`
+	// report for the tcp listener of the hybrid listener
+	TcpListenerReport    *TcpListenerReport `protobuf:"bytes,2,opt,name=tcp_listener_report,json=tcpListenerReport,proto3" json:"tcp_listener_report,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *HybridListenerReport) Reset()         { *m = HybridListenerReport{} }
+func (m *HybridListenerReport) String() string { return proto.CompactTextString(m) }
+func (*HybridListenerReport) ProtoMessage()    {}
+func (*HybridListenerReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aacaf097b496f502, []int{6}
+}
+func (m *HybridListenerReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HybridListenerReport.Unmarshal(m, b)
+}
+func (m *HybridListenerReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HybridListenerReport.Marshal(b, m, deterministic)
+}
+func (m *HybridListenerReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HybridListenerReport.Merge(m, src)
+}
+func (m *HybridListenerReport) XXX_Size() int {
+	return xxx_messageInfo_HybridListenerReport.Size(m)
+}
+func (m *HybridListenerReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_HybridListenerReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HybridListenerReport proto.InternalMessageInfo
+
+func (m *HybridListenerReport) GetHttpListenerReport() *HttpListenerReport {
+	if m != nil {
+		return m.HttpListenerReport
+	}
+	return nil
+}
+
+func (m *HybridListenerReport) GetTcpListenerReport() *TcpListenerReport {
+	if m != nil {
+		return m.TcpListenerReport
+	}
+	return nil
+}
+
 type HttpListenerReport struct {
 	Errors []*HttpListenerReport_Error `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
 	// report for nested virtual hosts
-	VirtualHostReports   []*VirtualHostReport `protobuf:"bytes,2,rep,name=virtual_host_reports,json=virtualHostReports,proto3" json:"virtual_host_reports,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	VirtualHostReports []*VirtualHostReport `protobuf:"bytes,2,rep,name=virtual_host_reports,json=virtualHostReports,proto3" json:"virtual_host_reports,omitempty"`
+	// warnings on the config of the http listener
+	Warnings             []*HttpListenerReport_Warning `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
 func (m *HttpListenerReport) Reset()         { *m = HttpListenerReport{} }
 func (m *HttpListenerReport) String() string { return proto.CompactTextString(m) }
 func (*HttpListenerReport) ProtoMessage()    {}
 func (*HttpListenerReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{6}
+	return fileDescriptor_aacaf097b496f502, []int{7}
 }
 func (m *HttpListenerReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HttpListenerReport.Unmarshal(m, b)
@@ -578,6 +664,13 @@ func (m *HttpListenerReport) GetVirtualHostReports() []*VirtualHostReport {
 	return nil
 }
 
+func (m *HttpListenerReport) GetWarnings() []*HttpListenerReport_Warning {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
 // error types for top-level http listener config
 type HttpListenerReport_Error struct {
 	// the type of the error
@@ -593,7 +686,7 @@ func (m *HttpListenerReport_Error) Reset()         { *m = HttpListenerReport_Err
 func (m *HttpListenerReport_Error) String() string { return proto.CompactTextString(m) }
 func (*HttpListenerReport_Error) ProtoMessage()    {}
 func (*HttpListenerReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{6, 0}
+	return fileDescriptor_aacaf097b496f502, []int{7, 0}
 }
 func (m *HttpListenerReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HttpListenerReport_Error.Unmarshal(m, b)
@@ -627,6 +720,64 @@ func (m *HttpListenerReport_Error) GetReason() string {
 	return ""
 }
 
+// warning types for top-level http listener config
+type HttpListenerReport_Warning struct {
+	// the type of the warning
+	Type HttpListenerReport_Warning_Type `protobuf:"varint,1,opt,name=type,proto3,enum=gloo.solo.io.HttpListenerReport_Warning_Type" json:"type,omitempty"`
+	// any extra info as a string
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// the tls secret the warning refers to, if any
+	SecretRef            *core.ResourceRef `protobuf:"bytes,3,opt,name=secret_ref,json=secretRef,proto3" json:"secret_ref,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *HttpListenerReport_Warning) Reset()         { *m = HttpListenerReport_Warning{} }
+func (m *HttpListenerReport_Warning) String() string { return proto.CompactTextString(m) }
+func (*HttpListenerReport_Warning) ProtoMessage()    {}
+func (*HttpListenerReport_Warning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aacaf097b496f502, []int{7, 1}
+}
+func (m *HttpListenerReport_Warning) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HttpListenerReport_Warning.Unmarshal(m, b)
+}
+func (m *HttpListenerReport_Warning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HttpListenerReport_Warning.Marshal(b, m, deterministic)
+}
+func (m *HttpListenerReport_Warning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HttpListenerReport_Warning.Merge(m, src)
+}
+func (m *HttpListenerReport_Warning) XXX_Size() int {
+	return xxx_messageInfo_HttpListenerReport_Warning.Size(m)
+}
+func (m *HttpListenerReport_Warning) XXX_DiscardUnknown() {
+	xxx_messageInfo_HttpListenerReport_Warning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HttpListenerReport_Warning proto.InternalMessageInfo
+
+func (m *HttpListenerReport_Warning) GetType() HttpListenerReport_Warning_Type {
+	if m != nil {
+		return m.Type
+	}
+	return HttpListenerReport_Warning_SslCertificateExpiringWarning
+}
+
+func (m *HttpListenerReport_Warning) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *HttpListenerReport_Warning) GetSecretRef() *core.ResourceRef {
+	if m != nil {
+		return m.SecretRef
+	}
+	return nil
+}
+
 type VirtualHostReport struct {
 	// errors on top-level config of the virtual host
 	Errors               []*VirtualHostReport_Error `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
@@ -640,7 +791,7 @@ func (m *VirtualHostReport) Reset()         { *m = VirtualHostReport{} }
 func (m *VirtualHostReport) String() string { return proto.CompactTextString(m) }
 func (*VirtualHostReport) ProtoMessage()    {}
 func (*VirtualHostReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{7}
+	return fileDescriptor_aacaf097b496f502, []int{8}
 }
 func (m *VirtualHostReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VirtualHostReport.Unmarshal(m, b)
@@ -689,7 +840,7 @@ func (m *VirtualHostReport_Error) Reset()         { *m = VirtualHostReport_Error
 func (m *VirtualHostReport_Error) String() string { return proto.CompactTextString(m) }
 func (*VirtualHostReport_Error) ProtoMessage()    {}
 func (*VirtualHostReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{7, 0}
+	return fileDescriptor_aacaf097b496f502, []int{8, 0}
 }
 func (m *VirtualHostReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VirtualHostReport_Error.Unmarshal(m, b)
@@ -737,7 +888,7 @@ func (m *RouteReport) Reset()         { *m = RouteReport{} }
 func (m *RouteReport) String() string { return proto.CompactTextString(m) }
 func (*RouteReport) ProtoMessage()    {}
 func (*RouteReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8}
+	return fileDescriptor_aacaf097b496f502, []int{9}
 }
 func (m *RouteReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteReport.Unmarshal(m, b)
@@ -786,7 +937,7 @@ func (m *RouteReport_Error) Reset()         { *m = RouteReport_Error{} }
 func (m *RouteReport_Error) String() string { return proto.CompactTextString(m) }
 func (*RouteReport_Error) ProtoMessage()    {}
 func (*RouteReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8, 0}
+	return fileDescriptor_aacaf097b496f502, []int{9, 0}
 }
 func (m *RouteReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteReport_Error.Unmarshal(m, b)
@@ -835,7 +986,7 @@ func (m *RouteReport_Warning) Reset()         { *m = RouteReport_Warning{} }
 func (m *RouteReport_Warning) String() string { return proto.CompactTextString(m) }
 func (*RouteReport_Warning) ProtoMessage()    {}
 func (*RouteReport_Warning) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8, 1}
+	return fileDescriptor_aacaf097b496f502, []int{9, 1}
 }
 func (m *RouteReport_Warning) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteReport_Warning.Unmarshal(m, b)
@@ -882,7 +1033,7 @@ func (m *TcpListenerReport) Reset()         { *m = TcpListenerReport{} }
 func (m *TcpListenerReport) String() string { return proto.CompactTextString(m) }
 func (*TcpListenerReport) ProtoMessage()    {}
 func (*TcpListenerReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{9}
+	return fileDescriptor_aacaf097b496f502, []int{10}
 }
 func (m *TcpListenerReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpListenerReport.Unmarshal(m, b)
@@ -931,7 +1082,7 @@ func (m *TcpListenerReport_Error) Reset()         { *m = TcpListenerReport_Error
 func (m *TcpListenerReport_Error) String() string { return proto.CompactTextString(m) }
 func (*TcpListenerReport_Error) ProtoMessage()    {}
 func (*TcpListenerReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{9, 0}
+	return fileDescriptor_aacaf097b496f502, []int{10, 0}
 }
 func (m *TcpListenerReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpListenerReport_Error.Unmarshal(m, b)
@@ -977,7 +1128,7 @@ func (m *TcpHostReport) Reset()         { *m = TcpHostReport{} }
 func (m *TcpHostReport) String() string { return proto.CompactTextString(m) }
 func (*TcpHostReport) ProtoMessage()    {}
 func (*TcpHostReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{10}
+	return fileDescriptor_aacaf097b496f502, []int{11}
 }
 func (m *TcpHostReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpHostReport.Unmarshal(m, b)
@@ -1019,7 +1170,7 @@ func (m *TcpHostReport_Error) Reset()         { *m = TcpHostReport_Error{} }
 func (m *TcpHostReport_Error) String() string { return proto.CompactTextString(m) }
 func (*TcpHostReport_Error) ProtoMessage()    {}
 func (*TcpHostReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{10, 0}
+	return fileDescriptor_aacaf097b496f502, []int{11, 0}
 }
 func (m *TcpHostReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpHostReport_Error.Unmarshal(m, b)
@@ -1056,6 +1207,7 @@ func (m *TcpHostReport_Error) GetReason() string {
 func init() {
 	proto.RegisterEnum("gloo.solo.io.ListenerReport_Error_Type", ListenerReport_Error_Type_name, ListenerReport_Error_Type_value)
 	proto.RegisterEnum("gloo.solo.io.HttpListenerReport_Error_Type", HttpListenerReport_Error_Type_name, HttpListenerReport_Error_Type_value)
+	proto.RegisterEnum("gloo.solo.io.HttpListenerReport_Warning_Type", HttpListenerReport_Warning_Type_name, HttpListenerReport_Warning_Type_value)
 	proto.RegisterEnum("gloo.solo.io.VirtualHostReport_Error_Type", VirtualHostReport_Error_Type_name, VirtualHostReport_Error_Type_value)
 	proto.RegisterEnum("gloo.solo.io.RouteReport_Error_Type", RouteReport_Error_Type_name, RouteReport_Error_Type_value)
 	proto.RegisterEnum("gloo.solo.io.RouteReport_Warning_Type", RouteReport_Warning_Type_name, RouteReport_Warning_Type_value)
@@ -1068,8 +1220,10 @@ func init() {
 	proto.RegisterType((*ProxyReport)(nil), "gloo.solo.io.ProxyReport")
 	proto.RegisterType((*ListenerReport)(nil), "gloo.solo.io.ListenerReport")
 	proto.RegisterType((*ListenerReport_Error)(nil), "gloo.solo.io.ListenerReport.Error")
+	proto.RegisterType((*HybridListenerReport)(nil), "gloo.solo.io.HybridListenerReport")
 	proto.RegisterType((*HttpListenerReport)(nil), "gloo.solo.io.HttpListenerReport")
 	proto.RegisterType((*HttpListenerReport_Error)(nil), "gloo.solo.io.HttpListenerReport.Error")
+	proto.RegisterType((*HttpListenerReport_Warning)(nil), "gloo.solo.io.HttpListenerReport.Warning")
 	proto.RegisterType((*VirtualHostReport)(nil), "gloo.solo.io.VirtualHostReport")
 	proto.RegisterType((*VirtualHostReport_Error)(nil), "gloo.solo.io.VirtualHostReport.Error")
 	proto.RegisterType((*RouteReport)(nil), "gloo.solo.io.RouteReport")
@@ -1086,62 +1240,72 @@ func init() {
 }
 
 var fileDescriptor_aacaf097b496f502 = []byte{
-	// 878 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x52, 0x2b, 0x45,
-	0x14, 0xc6, 0x33, 0x09, 0x17, 0xf5, 0x04, 0x72, 0x43, 0x27, 0x37, 0x84, 0xc1, 0xeb, 0x0d, 0x23,
-	0x20, 0x8a, 0x4e, 0x34, 0x5a, 0x25, 0xa2, 0x40, 0x15, 0x42, 0x89, 0x16, 0x62, 0x18, 0x22, 0x56,
-	0xb9, 0x30, 0x35, 0x0c, 0x4d, 0xd2, 0x9a, 0x4c, 0x0f, 0xdd, 0x9d, 0x68, 0x16, 0xee, 0xdc, 0xfb,
-	0x06, 0x2e, 0xdd, 0xf9, 0x10, 0x56, 0xe9, 0x03, 0xb8, 0x75, 0xe5, 0xce, 0x77, 0x70, 0x65, 0x65,
-	0xa6, 0x33, 0x64, 0xfe, 0x24, 0x33, 0xcb, 0xbb, 0xec, 0xce, 0x39, 0x5f, 0x7f, 0xfd, 0x3b, 0x27,
-	0x3d, 0x07, 0xf6, 0x1c, 0x46, 0xbf, 0xc5, 0x96, 0xe0, 0xf5, 0x4e, 0x8f, 0xd2, 0xba, 0xe9, 0x90,
-	0x7a, 0x87, 0x39, 0x56, 0x7d, 0x68, 0xf6, 0xc8, 0xad, 0x29, 0x08, 0xb5, 0xeb, 0x0e, 0xa3, 0x3f,
-	0x8c, 0xda, 0x0f, 0x1b, 0xba, 0xc3, 0xa8, 0xa0, 0x68, 0x69, 0x9c, 0xa0, 0x73, 0xda, 0xa3, 0x3a,
-	0xa1, 0xea, 0x96, 0x9b, 0x1e, 0x15, 0x1b, 0xbe, 0xe3, 0xe5, 0x7b, 0x49, 0xda, 0x67, 0xf0, 0xb4,
-	0x39, 0x5e, 0x5e, 0xfb, 0x6a, 0x57, 0x98, 0x0d, 0x89, 0x85, 0x0d, 0x7c, 0x3f, 0xc0, 0x5c, 0xa0,
-	0xd7, 0xe1, 0x91, 0x1b, 0x5f, 0x55, 0x6a, 0xca, 0x4e, 0xbe, 0x51, 0xd2, 0xa7, 0x4f, 0xd1, 0xdd,
-	0x5c, 0xc3, 0x8b, 0xd0, 0xbe, 0x81, 0x57, 0x66, 0x69, 0x71, 0x87, 0xda, 0x1c, 0xa3, 0x8f, 0x60,
-	0xc9, 0x33, 0xcf, 0xb0, 0x43, 0x99, 0x90, 0x9a, 0x6b, 0x71, 0x9a, 0x6e, 0x80, 0x91, 0x77, 0x1e,
-	0x16, 0xda, 0x2a, 0x3c, 0xb9, 0xa0, 0x82, 0xdc, 0x8d, 0xbe, 0xb0, 0x0d, 0xcc, 0x47, 0xb6, 0x25,
-	0x3d, 0x6a, 0x55, 0xa8, 0x84, 0x7f, 0xf0, 0x0e, 0xd4, 0xae, 0x21, 0x3f, 0x25, 0x87, 0x3e, 0x81,
-	0x62, 0x8f, 0x70, 0x81, 0x6d, 0xcc, 0xa4, 0x05, 0x5e, 0x55, 0x6a, 0xb9, 0x9d, 0x7c, 0xe3, 0xe5,
-	0xa0, 0x87, 0x73, 0x19, 0x25, 0x6d, 0x3c, 0xee, 0x05, 0xd6, 0x5c, 0xfb, 0x2b, 0x07, 0x85, 0x60,
-	0x0c, 0xda, 0x87, 0x45, 0xcc, 0x18, 0x65, 0xbc, 0x9a, 0x75, 0x15, 0xb5, 0x79, 0x8a, 0xfa, 0xe9,
-	0x38, 0xd4, 0x90, 0x19, 0xa8, 0x05, 0xe5, 0xae, 0x10, 0x4e, 0x3b, 0x64, 0xae, 0x9a, 0x73, 0xf9,
-	0xd4, 0x82, 0x4a, 0x67, 0x42, 0x38, 0x41, 0xb5, 0xb3, 0x8c, 0x81, 0xba, 0x91, 0x5d, 0x74, 0x09,
-	0x25, 0x61, 0x45, 0x45, 0x17, 0x5c, 0xd1, 0x67, 0x41, 0xd1, 0x96, 0x15, 0xd5, 0x5c, 0x11, 0xe1,
-	0x4d, 0xf5, 0x77, 0x05, 0x1e, 0xb9, 0xd6, 0xd1, 0x87, 0xb0, 0x20, 0x46, 0x0e, 0x76, 0x4b, 0x58,
-	0x68, 0xbc, 0x96, 0x7c, 0x59, 0xbd, 0x35, 0x72, 0xb0, 0xe1, 0x26, 0xa1, 0x0a, 0x2c, 0x32, 0x6c,
-	0x72, 0x6a, 0x57, 0xb3, 0x35, 0x65, 0xe7, 0x25, 0x43, 0xae, 0x34, 0x0b, 0x16, 0x5a, 0xde, 0xef,
-	0xe8, 0xc2, 0xec, 0xe3, 0x0b, 0x2a, 0xbe, 0xb4, 0xc9, 0xfd, 0x00, 0xbb, 0x02, 0xc5, 0x0c, 0x52,
-	0xa1, 0x72, 0x4c, 0xec, 0xdb, 0x26, 0x65, 0x22, 0xf4, 0x9b, 0x82, 0x10, 0x14, 0xae, 0xae, 0xce,
-	0x3f, 0xa6, 0xf6, 0x1d, 0xe9, 0x78, 0x7b, 0x59, 0x54, 0x82, 0xc7, 0x4d, 0x46, 0x2d, 0xcc, 0x39,
-	0xb1, 0xe5, 0x66, 0xee, 0xb8, 0x02, 0x65, 0x1f, 0xc9, 0xd8, 0x8d, 0xe4, 0xa2, 0xfd, 0x9a, 0x05,
-	0x14, 0x65, 0x8b, 0x0e, 0xfd, 0xba, 0x7a, 0x9d, 0xb2, 0x9d, 0x54, 0x8d, 0x50, 0x6d, 0x2f, 0xa1,
-	0x3c, 0x24, 0x4c, 0x0c, 0xcc, 0x5e, 0xbb, 0x4b, 0xb9, 0xf0, 0xfb, 0xce, 0xeb, 0x92, 0x50, 0x19,
-	0xae, 0xbd, 0xc8, 0x33, 0xca, 0x85, 0x6c, 0x3d, 0x34, 0x0c, 0x6f, 0x71, 0xf5, 0xc7, 0x49, 0x11,
-	0x8e, 0x02, 0x45, 0xd8, 0x4d, 0xe7, 0x2c, 0x4d, 0x21, 0xd6, 0x65, 0x21, 0x62, 0x00, 0x66, 0xb4,
-	0xbf, 0xb3, 0xb0, 0x12, 0x31, 0x8a, 0x0e, 0x42, 0x9c, 0xb6, 0x12, 0x6e, 0x16, 0xc2, 0x74, 0x08,
-	0xcb, 0x8c, 0x0e, 0x04, 0x0e, 0xf1, 0x09, 0xbd, 0x0d, 0xc6, 0x38, 0x44, 0x92, 0x59, 0x62, 0x0f,
-	0x0b, 0xae, 0xfe, 0xe9, 0x77, 0xe6, 0x61, 0x00, 0xca, 0x1b, 0xa9, 0x6c, 0xa4, 0x61, 0x72, 0x9b,
-	0xd0, 0x9c, 0x6b, 0xf0, 0xe4, 0x84, 0xf6, 0x4d, 0x62, 0xf3, 0x48, 0x6f, 0xc6, 0x60, 0xcc, 0xa2,
-	0x32, 0x14, 0x4f, 0xfb, 0x8e, 0x18, 0x79, 0x49, 0xb2, 0x3b, 0xb5, 0x5f, 0x72, 0x90, 0x9f, 0xba,
-	0x25, 0x7a, 0x3f, 0x84, 0xf5, 0xd9, 0x4c, 0x20, 0x21, 0xa0, 0x07, 0xf0, 0xe2, 0xf7, 0x26, 0xb3,
-	0x89, 0xdd, 0x99, 0xb0, 0xdc, 0x98, 0x9d, 0xfa, 0x95, 0x17, 0x69, 0xf8, 0x29, 0xea, 0xcf, 0x3e,
-	0xcf, 0xbd, 0x00, 0xcf, 0xcd, 0x84, 0xf3, 0xd3, 0x90, 0x7c, 0x4f, 0x92, 0x5c, 0x85, 0xd2, 0xa7,
-	0xb6, 0xfb, 0x1d, 0xfb, 0xdc, 0x14, 0x56, 0x17, 0xb3, 0x09, 0xca, 0x18, 0x5e, 0x8a, 0xfa, 0x93,
-	0x02, 0x2f, 0x48, 0x9f, 0x68, 0x3f, 0xe0, 0x69, 0x3b, 0xf1, 0x62, 0x69, 0x5c, 0x6d, 0x49, 0x57,
-	0x4f, 0x61, 0x4d, 0xba, 0x3a, 0xc1, 0x5c, 0x10, 0xdb, 0xfd, 0x92, 0x49, 0x9d, 0x62, 0x46, 0xfb,
-	0x27, 0x0b, 0x2b, 0x91, 0xd7, 0x32, 0xa9, 0xfb, 0x23, 0x09, 0xa1, 0x62, 0x9d, 0x42, 0x71, 0xfc,
-	0x54, 0xc7, 0x3c, 0x10, 0xeb, 0x11, 0xa1, 0xa9, 0xc7, 0xa1, 0x20, 0xa6, 0x97, 0x5c, 0xfd, 0x23,
-	0xdd, 0x9f, 0x60, 0x86, 0x9b, 0xe7, 0xe5, 0x85, 0xd6, 0xfe, 0x53, 0x60, 0x39, 0x70, 0x51, 0xf4,
-	0x41, 0xe8, 0xe3, 0xba, 0x31, 0x87, 0x4a, 0x10, 0xad, 0xfa, 0x9b, 0xcf, 0x64, 0x6e, 0xd3, 0xc4,
-	0x48, 0xa4, 0xe1, 0xd1, 0x4c, 0xe0, 0xb1, 0x0e, 0xab, 0xd1, 0x66, 0x9a, 0xf7, 0x2c, 0x34, 0xfe,
-	0x55, 0xa0, 0x12, 0x3f, 0x46, 0xa1, 0x36, 0x14, 0x82, 0x73, 0x0e, 0x7a, 0x35, 0x78, 0x89, 0xd8,
-	0xf1, 0x48, 0xdd, 0x9c, 0x1f, 0x24, 0x47, 0xa5, 0xcc, 0xdb, 0x0a, 0xea, 0xc1, 0xb2, 0x3c, 0x15,
-	0xbb, 0x16, 0xd0, 0x6e, 0xcc, 0x68, 0x36, 0x6b, 0x54, 0x54, 0xdf, 0x4c, 0x17, 0x3c, 0x39, 0xef,
-	0xf8, 0xe8, 0xeb, 0x83, 0x0e, 0x11, 0xdd, 0xc1, 0x8d, 0x6e, 0xd1, 0x7e, 0x7d, 0x9c, 0xf6, 0x16,
-	0xa1, 0xf5, 0x98, 0xb9, 0xd5, 0xf9, 0xae, 0x13, 0x37, 0x08, 0xdf, 0x2c, 0xba, 0x33, 0xec, 0xbb,
-	0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x49, 0x1d, 0x72, 0x24, 0x34, 0x0b, 0x00, 0x00,
+	// 1030 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0xb7, 0x6c, 0x27, 0xd0, 0xe7, 0xc4, 0x75, 0x36, 0xae, 0x63, 0x2b, 0x84, 0x26, 0xa2, 0x29,
+	0x29, 0xa5, 0x36, 0x04, 0x66, 0x08, 0x85, 0xa4, 0x43, 0x9a, 0x0c, 0x81, 0x29, 0x69, 0xaa, 0x84,
+	0x30, 0xd3, 0x03, 0x19, 0x45, 0x59, 0xdb, 0x4b, 0x1d, 0xad, 0xba, 0xbb, 0x36, 0xf5, 0x81, 0x1b,
+	0x77, 0xbe, 0x01, 0xdf, 0x20, 0x77, 0x66, 0x38, 0x31, 0x03, 0x07, 0xbe, 0x02, 0x27, 0x6e, 0x7c,
+	0x07, 0x4e, 0x8c, 0x56, 0x6b, 0xc5, 0xfa, 0x63, 0x4b, 0x47, 0x8e, 0xda, 0x7d, 0xef, 0xb7, 0xbf,
+	0xfd, 0xbd, 0xdf, 0x3e, 0xed, 0xc2, 0x96, 0xcb, 0xe8, 0x77, 0xd8, 0x16, 0xbc, 0xd5, 0xe9, 0x51,
+	0xda, 0xb2, 0x5c, 0xd2, 0xea, 0x30, 0xd7, 0x6e, 0x0d, 0xac, 0x1e, 0xb9, 0xb0, 0x04, 0xa1, 0x4e,
+	0xcb, 0x65, 0xf4, 0xd5, 0xf0, 0xec, 0x7a, 0xa0, 0xe9, 0x32, 0x2a, 0x28, 0x9a, 0xf3, 0x12, 0x9a,
+	0x9c, 0xf6, 0x68, 0x93, 0x50, 0x7d, 0x5d, 0xa6, 0xc7, 0xc1, 0x06, 0xef, 0xfb, 0xf9, 0x7e, 0x92,
+	0xde, 0xf0, 0xe2, 0x1f, 0xbc, 0x20, 0x62, 0x34, 0xc9, 0x70, 0xdb, 0x9f, 0x32, 0xbe, 0x84, 0x95,
+	0x23, 0x2f, 0xf2, 0x34, 0x58, 0xe8, 0x18, 0xb3, 0x01, 0xb1, 0xb1, 0x89, 0x5f, 0xf6, 0x31, 0x17,
+	0xe8, 0x1e, 0xcc, 0x48, 0xa8, 0xba, 0xb6, 0xaa, 0x6d, 0x94, 0x36, 0x17, 0x9b, 0xe3, 0x04, 0x9a,
+	0x32, 0xd7, 0xf4, 0x23, 0x8c, 0x6f, 0xe1, 0xcd, 0x49, 0x58, 0xdc, 0xa5, 0x0e, 0xc7, 0xe8, 0x53,
+	0x98, 0xf3, 0xf7, 0xc5, 0xb0, 0x4b, 0x99, 0x50, 0x98, 0x8d, 0x24, 0x4c, 0x19, 0x60, 0x96, 0xdc,
+	0xeb, 0x0f, 0x63, 0x09, 0x6e, 0x1d, 0x52, 0x41, 0xda, 0xc3, 0xa7, 0x8e, 0x89, 0xf9, 0xd0, 0xb1,
+	0x15, 0x47, 0xa3, 0x0e, 0xb5, 0xe8, 0x84, 0xbf, 0xa0, 0x71, 0x0a, 0xa5, 0x31, 0x38, 0xf4, 0x39,
+	0x54, 0x7a, 0x84, 0x0b, 0xec, 0x60, 0xa6, 0x28, 0xf0, 0xba, 0xb6, 0x5a, 0xd8, 0x28, 0x6d, 0xbe,
+	0x11, 0xe6, 0xf0, 0x44, 0x45, 0x29, 0x1a, 0x37, 0x7b, 0xa1, 0x6f, 0x6e, 0x5c, 0x15, 0xa1, 0x1c,
+	0x8e, 0x41, 0x0f, 0x61, 0x16, 0x33, 0x46, 0x19, 0xaf, 0xe7, 0x25, 0xa2, 0x31, 0x0d, 0xb1, 0xb9,
+	0xef, 0x85, 0x9a, 0x2a, 0x03, 0x9d, 0x40, 0xb5, 0x2b, 0x84, 0x7b, 0x16, 0x21, 0x57, 0x2f, 0x48,
+	0x7d, 0x56, 0xc3, 0x48, 0x07, 0x42, 0xb8, 0x61, 0xb4, 0x83, 0x9c, 0x89, 0xba, 0xb1, 0x51, 0xf4,
+	0x0c, 0x16, 0x85, 0x1d, 0x07, 0x2d, 0x4a, 0xd0, 0xdb, 0x61, 0xd0, 0x13, 0x3b, 0x8e, 0xb9, 0x20,
+	0xa2, 0x83, 0xe8, 0x39, 0xd4, 0xba, 0xc3, 0x73, 0x46, 0x2e, 0x62, 0xa8, 0x33, 0x12, 0x35, 0xb2,
+	0xe9, 0x03, 0x19, 0x1b, 0x03, 0xae, 0x76, 0x13, 0xc6, 0xf5, 0xdf, 0x34, 0x98, 0x91, 0xb2, 0xa0,
+	0x4f, 0xa0, 0x28, 0x86, 0x2e, 0x96, 0xf6, 0x28, 0x6f, 0xbe, 0x9d, 0x2e, 0x64, 0xf3, 0x64, 0xe8,
+	0x62, 0x53, 0x26, 0xa1, 0x1a, 0xcc, 0x32, 0x6c, 0x71, 0xea, 0xd4, 0xf3, 0xab, 0xda, 0xc6, 0x0d,
+	0x53, 0x7d, 0x19, 0x36, 0x14, 0x4f, 0xfc, 0x79, 0x74, 0x68, 0x5d, 0xe2, 0x43, 0x2a, 0xbe, 0x76,
+	0xc8, 0xcb, 0x3e, 0x96, 0x00, 0x95, 0x1c, 0xd2, 0xa1, 0xb6, 0x4b, 0x9c, 0x8b, 0x23, 0xca, 0x44,
+	0x64, 0x4e, 0x43, 0x08, 0xca, 0xc7, 0xc7, 0x4f, 0x1e, 0x53, 0xa7, 0x4d, 0x3a, 0xfe, 0x58, 0x1e,
+	0x2d, 0xc2, 0xcd, 0x23, 0x46, 0x6d, 0xcc, 0x39, 0x71, 0xd4, 0x60, 0x61, 0xb7, 0x06, 0xd5, 0x40,
+	0x18, 0x8f, 0x8d, 0x52, 0xc7, 0xf8, 0x55, 0x83, 0x6a, 0x92, 0x18, 0xc8, 0x9c, 0x50, 0x79, 0x2d,
+	0x5b, 0xe5, 0x13, 0xeb, 0xfe, 0x34, 0xb9, 0xee, 0xf9, 0x4c, 0x75, 0x4f, 0xa8, 0xba, 0xf1, 0x4b,
+	0x11, 0x50, 0x7c, 0x6d, 0xb4, 0x13, 0x38, 0xde, 0x3f, 0x43, 0x77, 0xd3, 0xd8, 0x46, 0x5c, 0xff,
+	0x0c, 0xaa, 0x03, 0xc2, 0x44, 0xdf, 0xea, 0x9d, 0x75, 0x29, 0x17, 0xc1, 0x89, 0xf4, 0xcf, 0x4f,
+	0x84, 0xe8, 0xa9, 0x1f, 0x79, 0x40, 0xb9, 0x18, 0x6d, 0x7d, 0x10, 0x1d, 0xe2, 0x68, 0x0f, 0x5e,
+	0xff, 0xde, 0x62, 0x0e, 0x71, 0x3a, 0xbc, 0x5e, 0x90, 0x30, 0x1b, 0xa9, 0xa4, 0xbe, 0xf1, 0x13,
+	0xcc, 0x20, 0x53, 0xff, 0x61, 0x64, 0xc4, 0x47, 0x21, 0x23, 0xde, 0xcf, 0xb6, 0xbf, 0x2c, 0x66,
+	0x5c, 0x56, 0x66, 0x4c, 0x30, 0x51, 0x4e, 0xff, 0x53, 0x83, 0xd7, 0x14, 0x29, 0xf4, 0x59, 0x88,
+	0xc1, 0x83, 0xac, 0x9b, 0xc9, 0xc0, 0x01, 0x6d, 0x01, 0x70, 0x6c, 0x33, 0xec, 0x09, 0xdf, 0x56,
+	0xad, 0xa6, 0xd1, 0xb4, 0x29, 0xc3, 0xc1, 0x02, 0x26, 0xe6, 0xb4, 0xcf, 0xbc, 0x06, 0xde, 0x36,
+	0x6f, 0xf8, 0xc1, 0x26, 0x6e, 0x1b, 0xf7, 0x14, 0xfb, 0x35, 0x58, 0x39, 0xe6, 0xbd, 0xc7, 0x98,
+	0x09, 0xd2, 0x26, 0xb6, 0x25, 0xf0, 0xfe, 0x2b, 0x97, 0x30, 0xe2, 0x74, 0x14, 0x8b, 0x4a, 0xce,
+	0xf8, 0x2b, 0x0f, 0x0b, 0xb1, 0xd2, 0xa1, 0xed, 0x88, 0x73, 0xd6, 0x53, 0x6a, 0x1d, 0x31, 0xce,
+	0x0e, 0xcc, 0x33, 0xda, 0x17, 0x38, 0xe2, 0x98, 0xc8, 0x7f, 0xc4, 0xf4, 0x42, 0x94, 0x57, 0xe6,
+	0xd8, 0xf5, 0x07, 0xd7, 0xff, 0x08, 0x3a, 0xcd, 0x4e, 0x48, 0xde, 0x77, 0x32, 0xd1, 0xc8, 0x52,
+	0xdf, 0x8b, 0x94, 0x66, 0xd3, 0x80, 0x5b, 0x7b, 0xf4, 0xd2, 0x22, 0x0e, 0x8f, 0xf5, 0x9a, 0x04,
+	0x4b, 0xe4, 0x51, 0x15, 0x2a, 0xfb, 0x97, 0xae, 0x18, 0xfa, 0x49, 0xaa, 0xdb, 0x18, 0x3f, 0x17,
+	0xa0, 0x34, 0xb6, 0x4b, 0xf4, 0x51, 0x44, 0xd6, 0xdb, 0x13, 0x05, 0x89, 0x08, 0xba, 0x3d, 0x76,
+	0x6c, 0x7c, 0x2d, 0xd7, 0x26, 0xa7, 0xc6, 0xcf, 0xcb, 0x4f, 0x81, 0x9e, 0x5b, 0x21, 0x3d, 0xef,
+	0xa4, 0xac, 0x9f, 0x45, 0xc9, 0x0f, 0x95, 0x92, 0x4b, 0xb0, 0xf8, 0x85, 0x23, 0xaf, 0x43, 0x5f,
+	0x59, 0xc2, 0xee, 0x62, 0x36, 0x92, 0x32, 0x41, 0x2f, 0x4d, 0xff, 0x71, 0xec, 0x08, 0x3d, 0x0c,
+	0x71, 0xba, 0x9b, 0xba, 0xb1, 0x2c, 0xac, 0xd6, 0x15, 0xab, 0x15, 0x68, 0x28, 0x56, 0x7b, 0x98,
+	0x0b, 0xe2, 0xc8, 0x5b, 0xcf, 0xb5, 0xfb, 0xff, 0xce, 0xc3, 0x42, 0xac, 0xc3, 0xa6, 0xb9, 0x3f,
+	0x96, 0x10, 0x29, 0xd6, 0x3e, 0x54, 0xbc, 0xf6, 0x9e, 0xd0, 0x32, 0x97, 0x63, 0x40, 0x63, 0xed,
+	0xb2, 0x2c, 0xc6, 0x3f, 0xb9, 0xfe, 0x7b, 0xb6, 0x43, 0x30, 0x81, 0xcd, 0xff, 0xe5, 0x8f, 0x6b,
+	0xfc, 0xab, 0xc1, 0x7c, 0x68, 0xa3, 0xe8, 0xe3, 0xc8, 0x45, 0x6c, 0x6d, 0x8a, 0x2a, 0x61, 0x69,
+	0xf5, 0xab, 0x40, 0x93, 0xa9, 0xa6, 0x49, 0x80, 0xc8, 0xa2, 0xc7, 0x51, 0x8a, 0x1e, 0xcb, 0xb0,
+	0x14, 0x37, 0xd3, 0xb4, 0xb6, 0xb0, 0xf9, 0x8f, 0x06, 0xb5, 0xe4, 0x2b, 0x37, 0x3a, 0x83, 0x72,
+	0xf8, 0x4e, 0x8c, 0xde, 0x0a, 0x6f, 0x22, 0xf1, 0x2a, 0xad, 0xdf, 0x99, 0x1e, 0xa4, 0xae, 0xd5,
+	0xb9, 0xf7, 0x34, 0xd4, 0x83, 0x79, 0xb5, 0x2a, 0x96, 0x14, 0xd0, 0xfd, 0x84, 0x6b, 0xfc, 0xa4,
+	0x67, 0x85, 0xfe, 0x6e, 0xb6, 0xe0, 0xd1, 0x7a, 0xbb, 0x8f, 0x9e, 0x6f, 0x77, 0x88, 0xe8, 0xf6,
+	0xcf, 0x9b, 0x36, 0xbd, 0x6c, 0xc9, 0xf7, 0x0c, 0xa1, 0xad, 0x84, 0xe7, 0x8f, 0xfb, 0xa2, 0x93,
+	0xf4, 0x9e, 0x3a, 0x9f, 0x95, 0xef, 0x9d, 0x0f, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x55,
+	0x65, 0x6f, 0x7b, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -16,24 +16,26 @@ import (
 )
 
 type ApiSnapshot struct {
-	Artifacts      ArtifactList
-	Endpoints      EndpointList
-	Proxies        ProxyList
-	UpstreamGroups UpstreamGroupList
-	Secrets        SecretList
-	Upstreams      UpstreamList
-	AuthConfigs    enterprise_gloo_solo_io.AuthConfigList
+	Artifacts         ArtifactList
+	Endpoints         EndpointList
+	Proxies           ProxyList
+	UpstreamGroups    UpstreamGroupList
+	Secrets           SecretList
+	Upstreams         UpstreamList
+	AuthConfigs       enterprise_gloo_solo_io.AuthConfigList
+	ReferencePolicies ReferencePolicyList
 }
 
 func (s ApiSnapshot) Clone() ApiSnapshot {
 	return ApiSnapshot{
-		Artifacts:      s.Artifacts.Clone(),
-		Endpoints:      s.Endpoints.Clone(),
-		Proxies:        s.Proxies.Clone(),
-		UpstreamGroups: s.UpstreamGroups.Clone(),
-		Secrets:        s.Secrets.Clone(),
-		Upstreams:      s.Upstreams.Clone(),
-		AuthConfigs:    s.AuthConfigs.Clone(),
+		Artifacts:         s.Artifacts.Clone(),
+		Endpoints:         s.Endpoints.Clone(),
+		Proxies:           s.Proxies.Clone(),
+		UpstreamGroups:    s.UpstreamGroups.Clone(),
+		Secrets:           s.Secrets.Clone(),
+		Upstreams:         s.Upstreams.Clone(),
+		AuthConfigs:       s.AuthConfigs.Clone(),
+		ReferencePolicies: s.ReferencePolicies.Clone(),
 	}
 }
 
@@ -62,6 +64,9 @@ func (s ApiSnapshot) Hash(hasher hash.Hash64) (uint64, error) {
 	if _, err := s.hashAuthConfigs(hasher); err != nil {
 		return 0, err
 	}
+	if _, err := s.hashReferencePolicies(hasher); err != nil {
+		return 0, err
+	}
 	return hasher.Sum64(), nil
 }
 
@@ -101,6 +106,10 @@ func (s ApiSnapshot) hashAuthConfigs(hasher hash.Hash64) (uint64, error) {
 	return hashutils.HashAllSafe(hasher, s.AuthConfigs.AsInterfaces()...)
 }
 
+func (s ApiSnapshot) hashReferencePolicies(hasher hash.Hash64) (uint64, error) {
+	return hashutils.HashAllSafe(hasher, s.ReferencePolicies.AsInterfaces()...)
+}
+
 func (s ApiSnapshot) HashFields() []zap.Field {
 	var fields []zap.Field
 	hasher := fnv.New64()
@@ -139,6 +148,11 @@ func (s ApiSnapshot) HashFields() []zap.Field {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
 	}
 	fields = append(fields, zap.Uint64("authConfigs", AuthConfigsHash))
+	ReferencePoliciesHash, err := s.hashReferencePolicies(hasher)
+	if err != nil {
+		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
+	}
+	fields = append(fields, zap.Uint64("referencePolicies", ReferencePoliciesHash))
 	snapshotHash, err := s.Hash(hasher)
 	if err != nil {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
@@ -147,14 +161,15 @@ func (s ApiSnapshot) HashFields() []zap.Field {
 }
 
 type ApiSnapshotStringer struct {
-	Version        uint64
-	Artifacts      []string
-	Endpoints      []string
-	Proxies        []string
-	UpstreamGroups []string
-	Secrets        []string
-	Upstreams      []string
-	AuthConfigs    []string
+	Version           uint64
+	Artifacts         []string
+	Endpoints         []string
+	Proxies           []string
+	UpstreamGroups    []string
+	Secrets           []string
+	Upstreams         []string
+	AuthConfigs       []string
+	ReferencePolicies []string
 }
 
 func (ss ApiSnapshotStringer) String() string {
@@ -195,6 +210,11 @@ func (ss ApiSnapshotStringer) String() string {
 		s += fmt.Sprintf("    %v\n", name)
 	}
 
+	s += fmt.Sprintf("  ReferencePolicies %v\n", len(ss.ReferencePolicies))
+	for _, name := range ss.ReferencePolicies {
+		s += fmt.Sprintf("    %v\n", name)
+	}
+
 	return s
 }
 
@@ -204,13 +224,14 @@ func (s ApiSnapshot) Stringer() ApiSnapshotStringer {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
 	}
 	return ApiSnapshotStringer{
-		Version:        snapshotHash,
-		Artifacts:      s.Artifacts.NamespacesDotNames(),
-		Endpoints:      s.Endpoints.NamespacesDotNames(),
-		Proxies:        s.Proxies.NamespacesDotNames(),
-		UpstreamGroups: s.UpstreamGroups.NamespacesDotNames(),
-		Secrets:        s.Secrets.NamespacesDotNames(),
-		Upstreams:      s.Upstreams.NamespacesDotNames(),
-		AuthConfigs:    s.AuthConfigs.NamespacesDotNames(),
+		Version:           snapshotHash,
+		Artifacts:         s.Artifacts.NamespacesDotNames(),
+		Endpoints:         s.Endpoints.NamespacesDotNames(),
+		Proxies:           s.Proxies.NamespacesDotNames(),
+		UpstreamGroups:    s.UpstreamGroups.NamespacesDotNames(),
+		Secrets:           s.Secrets.NamespacesDotNames(),
+		Upstreams:         s.Upstreams.NamespacesDotNames(),
+		AuthConfigs:       s.AuthConfigs.NamespacesDotNames(),
+		ReferencePolicies: s.ReferencePolicies.NamespacesDotNames(),
 	}
 }
@@ -89,34 +89,37 @@ type ApiEmitter interface {
 	Secret() SecretClient
 	Upstream() UpstreamClient
 	AuthConfig() enterprise_gloo_solo_io.AuthConfigClient
+	ReferencePolicy() ReferencePolicyClient
 }
 
-func NewApiEmitter(artifactClient ArtifactClient, endpointClient EndpointClient, proxyClient ProxyClient, upstreamGroupClient UpstreamGroupClient, secretClient SecretClient, upstreamClient UpstreamClient, authConfigClient enterprise_gloo_solo_io.AuthConfigClient) ApiEmitter {
-	return NewApiEmitterWithEmit(artifactClient, endpointClient, proxyClient, upstreamGroupClient, secretClient, upstreamClient, authConfigClient, make(chan struct{}))
+func NewApiEmitter(artifactClient ArtifactClient, endpointClient EndpointClient, proxyClient ProxyClient, upstreamGroupClient UpstreamGroupClient, secretClient SecretClient, upstreamClient UpstreamClient, authConfigClient enterprise_gloo_solo_io.AuthConfigClient, referencePolicyClient ReferencePolicyClient) ApiEmitter {
+	return NewApiEmitterWithEmit(artifactClient, endpointClient, proxyClient, upstreamGroupClient, secretClient, upstreamClient, authConfigClient, referencePolicyClient, make(chan struct{}))
 }
 
-func NewApiEmitterWithEmit(artifactClient ArtifactClient, endpointClient EndpointClient, proxyClient ProxyClient, upstreamGroupClient UpstreamGroupClient, secretClient SecretClient, upstreamClient UpstreamClient, authConfigClient enterprise_gloo_solo_io.AuthConfigClient, emit <-chan struct{}) ApiEmitter {
+func NewApiEmitterWithEmit(artifactClient ArtifactClient, endpointClient EndpointClient, proxyClient ProxyClient, upstreamGroupClient UpstreamGroupClient, secretClient SecretClient, upstreamClient UpstreamClient, authConfigClient enterprise_gloo_solo_io.AuthConfigClient, referencePolicyClient ReferencePolicyClient, emit <-chan struct{}) ApiEmitter {
 	return &apiEmitter{
-		artifact:      artifactClient,
-		endpoint:      endpointClient,
-		proxy:         proxyClient,
-		upstreamGroup: upstreamGroupClient,
-		secret:        secretClient,
-		upstream:      upstreamClient,
-		authConfig:    authConfigClient,
-		forceEmit:     emit,
+		artifact:        artifactClient,
+		endpoint:        endpointClient,
+		proxy:           proxyClient,
+		upstreamGroup:   upstreamGroupClient,
+		secret:          secretClient,
+		upstream:        upstreamClient,
+		authConfig:      authConfigClient,
+		referencePolicy: referencePolicyClient,
+		forceEmit:       emit,
 	}
 }
 
 type apiEmitter struct {
-	forceEmit     <-chan struct{}
-	artifact      ArtifactClient
-	endpoint      EndpointClient
-	proxy         ProxyClient
-	upstreamGroup UpstreamGroupClient
-	secret        SecretClient
-	upstream      UpstreamClient
-	authConfig    enterprise_gloo_solo_io.AuthConfigClient
+	forceEmit       <-chan struct{}
+	artifact        ArtifactClient
+	endpoint        EndpointClient
+	proxy           ProxyClient
+	upstreamGroup   UpstreamGroupClient
+	secret          SecretClient
+	upstream        UpstreamClient
+	authConfig      enterprise_gloo_solo_io.AuthConfigClient
+	referencePolicy ReferencePolicyClient
 }
 
 func (c *apiEmitter) Register() error {
@@ -141,6 +144,9 @@ func (c *apiEmitter) Register() error {
 	if err := c.authConfig.Register(); err != nil {
 		return err
 	}
+	if err := c.referencePolicy.Register(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -172,6 +178,10 @@ func (c *apiEmitter) AuthConfig() enterprise_gloo_solo_io.AuthConfigClient {
 	return c.authConfig
 }
 
+func (c *apiEmitter) ReferencePolicy() ReferencePolicyClient {
+	return c.referencePolicy
+}
+
 func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts) (<-chan *ApiSnapshot, <-chan error, error) {
 
 	if len(watchNamespaces) == 0 {
@@ -244,6 +254,14 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 	authConfigChan := make(chan authConfigListWithNamespace)
 
 	var initialAuthConfigList enterprise_gloo_solo_io.AuthConfigList
+	/* Create channel for ReferencePolicy */
+	type referencePolicyListWithNamespace struct {
+		list      ReferencePolicyList
+		namespace string
+	}
+	referencePolicyChan := make(chan referencePolicyListWithNamespace)
+
+	var initialReferencePolicyList ReferencePolicyList
 
 	currentSnapshot := ApiSnapshot{}
 
@@ -374,6 +392,24 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 			defer done.Done()
 			errutils.AggregateErrs(ctx, errs, authConfigErrs, namespace+"-authConfigs")
 		}(namespace)
+		/* Setup namespaced watch for ReferencePolicy */
+		{
+			referencePolicies, err := c.referencePolicy.List(namespace, clients.ListOpts{Ctx: opts.Ctx, Selector: opts.Selector})
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "initial ReferencePolicy list")
+			}
+			initialReferencePolicyList = append(initialReferencePolicyList, referencePolicies...)
+		}
+		referencePolicyNamespacesChan, referencePolicyErrs, err := c.referencePolicy.Watch(namespace, opts)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "starting ReferencePolicy watch")
+		}
+
+		done.Add(1)
+		go func(namespace string) {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, referencePolicyErrs, namespace+"-referencePolicies")
+		}(namespace)
 
 		/* Watch for changes and update snapshot */
 		go func(namespace string) {
@@ -423,6 +459,12 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 						return
 					case authConfigChan <- authConfigListWithNamespace{list: authConfigList, namespace: namespace}:
 					}
+				case referencePolicyList := <-referencePolicyNamespacesChan:
+					select {
+					case <-ctx.Done():
+						return
+					case referencePolicyChan <- referencePolicyListWithNamespace{list: referencePolicyList, namespace: namespace}:
+					}
 				}
 			}
 		}(namespace)
@@ -441,6 +483,8 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 	currentSnapshot.Upstreams = initialUpstreamList.Sort()
 	/* Initialize snapshot for AuthConfigs */
 	currentSnapshot.AuthConfigs = initialAuthConfigList.Sort()
+	/* Initialize snapshot for ReferencePolicies */
+	currentSnapshot.ReferencePolicies = initialReferencePolicyList.Sort()
 
 	snapshots := make(chan *ApiSnapshot)
 	go func() {
@@ -479,6 +523,7 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 		secretsByNamespace := make(map[string]SecretList)
 		upstreamsByNamespace := make(map[string]UpstreamList)
 		authConfigsByNamespace := make(map[string]enterprise_gloo_solo_io.AuthConfigList)
+		referencePoliciesByNamespace := make(map[string]ReferencePolicyList)
 
 		for {
 			record := func() { stats.Record(ctx, mApiSnapshotIn.M(1)) }
@@ -627,6 +672,25 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 					authConfigList = append(authConfigList, authConfigs...)
 				}
 				currentSnapshot.AuthConfigs = authConfigList.Sort()
+			case referencePolicyNamespacedList := <-referencePolicyChan:
+				record()
+
+				namespace := referencePolicyNamespacedList.namespace
+
+				skstats.IncrementResourceCount(
+					ctx,
+					namespace,
+					"reference_policy",
+					mApiResourcesIn,
+				)
+
+				// merge lists by namespace
+				referencePoliciesByNamespace[namespace] = referencePolicyNamespacedList.list
+				var referencePolicyList ReferencePolicyList
+				for _, referencePolicies := range referencePoliciesByNamespace {
+					referencePolicyList = append(referencePolicyList, referencePolicies...)
+				}
+				currentSnapshot.ReferencePolicies = referencePolicyList.Sort()
 			}
 		}
 	}()
@@ -104,6 +104,8 @@ func (c *apiSimpleEmitter) Snapshots(ctx context.Context) (<-chan *ApiSnapshot,
 						currentSnapshot.Upstreams = append(currentSnapshot.Upstreams, typed)
 					case *enterprise_gloo_solo_io.AuthConfig:
 						currentSnapshot.AuthConfigs = append(currentSnapshot.AuthConfigs, typed)
+					case *ReferencePolicy:
+						currentSnapshot.ReferencePolicies = append(currentSnapshot.ReferencePolicies, typed)
 					default:
 						select {
 						case errs <- fmt.Errorf("ApiSnapshotEmitter "+
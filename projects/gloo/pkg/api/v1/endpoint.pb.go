@@ -10,6 +10,7 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
@@ -25,9 +26,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-//
-//
-//Endpoints represent dynamically discovered address/ports where an upstream service is listening
+// The health status of an endpoint, passed to envoy on the endpoint
+type HealthStatus int32
+
+const (
+	// the health status is unknown, envoy treats the endpoint as healthy until its health checks fail
+	HealthStatus_UNKNOWN HealthStatus = 0
+	// the endpoint is healthy
+	HealthStatus_HEALTHY HealthStatus = 1
+	// the endpoint is unhealthy, envoy does not send it new requests
+	HealthStatus_UNHEALTHY HealthStatus = 2
+	// the endpoint is shutting down, envoy does not send it new requests but lets in-flight requests complete
+	HealthStatus_DRAINING HealthStatus = 3
+)
+
+var HealthStatus_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "HEALTHY",
+	2: "UNHEALTHY",
+	3: "DRAINING",
+}
+
+var HealthStatus_value = map[string]int32{
+	"UNKNOWN":   0,
+	"HEALTHY":   1,
+	"UNHEALTHY": 2,
+	"DRAINING":  3,
+}
+
+func (x HealthStatus) String() string {
+	return proto.EnumName(HealthStatus_name, int32(x))
+}
+
+func (HealthStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f7969f9617648787, []int{0}
+}
+
+// Endpoints represent dynamically discovered address/ports where an upstream service is listening
 type Endpoint struct {
 	// List of the upstreams the endpoint belongs to
 	Upstreams []*core.ResourceRef `protobuf:"bytes,1,rep,name=upstreams,proto3" json:"upstreams,omitempty"`
@@ -35,6 +70,22 @@ type Endpoint struct {
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	// listening port for the endpoint
 	Port uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// the locality of the endpoint, used for locality-aware load balancing
+	Locality *Locality `protobuf:"bytes,4,opt,name=locality,proto3" json:"locality,omitempty"`
+	// health status of the endpoint, as reported by service discovery.
+	// endpoints with an unknown health status are health checked by envoy, if the upstream has health checks.
+	HealthStatus HealthStatus `protobuf:"varint,5,opt,name=health_status,json=healthStatus,proto3,enum=gloo.solo.io.HealthStatus" json:"health_status,omitempty"`
+	// relative weight of the endpoint within its locality. the endpoint gets the default weight (1) if not set,
+	// and the lowest weight envoy accepts (1) if set to 0.
+	LoadBalancingWeight *types.UInt32Value `protobuf:"bytes,6,opt,name=load_balancing_weight,json=loadBalancingWeight,proto3" json:"load_balancing_weight,omitempty"`
+	// hostname of the endpoint, if service discovery knows one (e.g. the DNS name the address was resolved from).
+	// the hostname is passed to envoy in the `io.solo.endpoint` endpoint metadata.
+	Hostname string `protobuf:"bytes,8,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// priority of the endpoint, as reported by service discovery (e.g. the priority of a DNS SRV record).
+	// lower values are preferred: envoy only sends requests to the endpoints of the next priority when the endpoints of
+	// the preferred priorities are unhealthy. the priorities of the endpoints of an upstream are renumbered from 0 for
+	// envoy, which requires contiguous priorities.
+	Priority uint32 `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
 	// Metadata contains the object metadata for this resource
 	Metadata             core.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -87,6 +138,41 @@ func (m *Endpoint) GetPort() uint32 {
 	return 0
 }
 
+func (m *Endpoint) GetLocality() *Locality {
+	if m != nil {
+		return m.Locality
+	}
+	return nil
+}
+
+func (m *Endpoint) GetHealthStatus() HealthStatus {
+	if m != nil {
+		return m.HealthStatus
+	}
+	return HealthStatus_UNKNOWN
+}
+
+func (m *Endpoint) GetLoadBalancingWeight() *types.UInt32Value {
+	if m != nil {
+		return m.LoadBalancingWeight
+	}
+	return nil
+}
+
+func (m *Endpoint) GetHostname() string {
+	if m != nil {
+		return m.Hostname
+	}
+	return ""
+}
+
+func (m *Endpoint) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 func (m *Endpoint) GetMetadata() core.Metadata {
 	if m != nil {
 		return m.Metadata
@@ -94,8 +180,69 @@ func (m *Endpoint) GetMetadata() core.Metadata {
 	return core.Metadata{}
 }
 
+// Identifies where an endpoint is running.
+// Endpoints of an upstream are grouped by locality in the load assignment sent to Envoy.
+type Locality struct {
+	// region the endpoint is running in, e.g. an AWS region or a Consul datacenter
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	// zone within the region, e.g. an AWS availability zone
+	Zone string `protobuf:"bytes,2,opt,name=zone,proto3" json:"zone,omitempty"`
+	// subzone within the zone, e.g. a rack
+	SubZone              string   `protobuf:"bytes,3,opt,name=sub_zone,json=subZone,proto3" json:"sub_zone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Locality) Reset()         { *m = Locality{} }
+func (m *Locality) String() string { return proto.CompactTextString(m) }
+func (*Locality) ProtoMessage()    {}
+func (*Locality) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7969f9617648787, []int{1}
+}
+func (m *Locality) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Locality.Unmarshal(m, b)
+}
+func (m *Locality) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Locality.Marshal(b, m, deterministic)
+}
+func (m *Locality) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Locality.Merge(m, src)
+}
+func (m *Locality) XXX_Size() int {
+	return xxx_messageInfo_Locality.Size(m)
+}
+func (m *Locality) XXX_DiscardUnknown() {
+	xxx_messageInfo_Locality.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Locality proto.InternalMessageInfo
+
+func (m *Locality) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *Locality) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *Locality) GetSubZone() string {
+	if m != nil {
+		return m.SubZone
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("gloo.solo.io.HealthStatus", HealthStatus_name, HealthStatus_value)
 	proto.RegisterType((*Endpoint)(nil), "gloo.solo.io.Endpoint")
+	proto.RegisterType((*Locality)(nil), "gloo.solo.io.Locality")
 }
 
 func init() {
@@ -103,27 +250,42 @@ func init() {
 }
 
 var fileDescriptor_f7969f9617648787 = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x3d, 0x4e, 0x03, 0x31,
-	0x10, 0x85, 0x71, 0x62, 0x91, 0xc4, 0x81, 0xc6, 0x42, 0x68, 0x13, 0x89, 0xb0, 0xa2, 0xda, 0x86,
-	0x5d, 0x08, 0x05, 0x28, 0x74, 0x91, 0x28, 0x69, 0x5c, 0xd2, 0x39, 0xbb, 0x93, 0xc5, 0xe4, 0x67,
-	0x2c, 0xdb, 0x41, 0xa9, 0x73, 0x1a, 0x8e, 0xc0, 0x11, 0x10, 0x87, 0xa0, 0xe0, 0x06, 0x29, 0xe8,
-	0xd1, 0xfe, 0x18, 0x04, 0x12, 0x12, 0xdd, 0x8c, 0xbf, 0xf7, 0x3c, 0xe3, 0x67, 0x76, 0x9d, 0x2b,
-	0x77, 0xbf, 0x9a, 0xc4, 0x29, 0x2e, 0x12, 0x8b, 0x73, 0x3c, 0x55, 0x98, 0xe4, 0x73, 0xc4, 0x44,
-	0x1b, 0x7c, 0x80, 0xd4, 0xd9, 0xaa, 0x93, 0x5a, 0x25, 0x8f, 0xe7, 0x09, 0x2c, 0x33, 0x8d, 0x6a,
-	0xe9, 0x62, 0x6d, 0xd0, 0x21, 0xdf, 0x2b, 0x58, 0x5c, 0xd8, 0x62, 0x85, 0xfd, 0x83, 0x1c, 0x73,
-	0x2c, 0x41, 0x52, 0x54, 0x95, 0xa6, 0xcf, 0x61, 0xed, 0xaa, 0x43, 0x58, 0xd7, 0xbe, 0xfe, 0xa0,
-	0x9c, 0x34, 0x53, 0xce, 0xdf, 0xbb, 0x00, 0x27, 0x33, 0xe9, 0x64, 0xcd, 0x7b, 0xbf, 0xb9, 0x81,
-	0xe9, 0x5f, 0x56, 0xdf, 0x57, 0xfc, 0xe4, 0x95, 0xb0, 0xf6, 0x4d, 0xbd, 0x25, 0xbf, 0x64, 0x9d,
-	0x95, 0xb6, 0xce, 0x80, 0x5c, 0xd8, 0x80, 0x84, 0xcd, 0xa8, 0x3b, 0xec, 0xc5, 0x29, 0x1a, 0xf0,
-	0x3b, 0xc7, 0x02, 0x2c, 0xae, 0x4c, 0x0a, 0x02, 0xa6, 0xe2, 0x5b, 0xcb, 0x03, 0xd6, 0x92, 0x59,
-	0x66, 0xc0, 0xda, 0xa0, 0x11, 0x92, 0xa8, 0x23, 0x7c, 0xcb, 0x39, 0xa3, 0x1a, 0x8d, 0x0b, 0x9a,
-	0x21, 0x89, 0xf6, 0x45, 0x59, 0xf3, 0x2b, 0xd6, 0xf6, 0x0f, 0x08, 0x5a, 0x21, 0x89, 0xba, 0xc3,
-	0xc3, 0x9f, 0x53, 0x6e, 0x6b, 0x3a, 0xa6, 0x2f, 0x6f, 0xc7, 0x3b, 0xe2, 0x4b, 0x3d, 0x3a, 0xda,
-	0x6c, 0x29, 0x65, 0x0d, 0xd0, 0x9b, 0x2d, 0xed, 0xf2, 0x8e, 0x8f, 0xd7, 0x6e, 0xb6, 0xb4, 0x11,
-	0x91, 0xf1, 0xe8, 0xf9, 0x83, 0x92, 0xa7, 0xf7, 0x01, 0xb9, 0x3b, 0xfb, 0xdf, 0x37, 0xe9, 0x59,
-	0x5e, 0xe7, 0x32, 0xd9, 0x2d, 0xf3, 0xb8, 0xf8, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x66, 0x51, 0xb5,
-	0x86, 0xe1, 0x01, 0x00, 0x00,
+	// 552 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x14, 0xac, 0x13, 0x93, 0x38, 0x9b, 0x04, 0x45, 0x0b, 0x54, 0x4e, 0x04, 0x25, 0xea, 0xc9, 0x42,
+	0xc2, 0x86, 0xf4, 0x00, 0x2a, 0x07, 0xd4, 0x88, 0x88, 0x44, 0x14, 0x03, 0x86, 0x50, 0xd1, 0x4b,
+	0xb4, 0x76, 0x36, 0xb6, 0xa9, 0xe3, 0x67, 0xed, 0xae, 0x69, 0xe1, 0x98, 0xaf, 0xe1, 0x13, 0xf8,
+	0x04, 0xf8, 0x09, 0x0e, 0xfc, 0x41, 0x0e, 0xdc, 0x91, 0xd7, 0x76, 0x48, 0x91, 0x90, 0x7a, 0xdb,
+	0xd9, 0x99, 0x91, 0xdf, 0xbc, 0x59, 0xa3, 0x27, 0x7e, 0x28, 0x82, 0xd4, 0x35, 0x3d, 0x58, 0x5a,
+	0x1c, 0x22, 0xb8, 0x1f, 0x82, 0xe5, 0x47, 0x00, 0x56, 0xc2, 0xe0, 0x23, 0xf5, 0x04, 0xcf, 0x11,
+	0x49, 0x42, 0xeb, 0xd3, 0x43, 0x8b, 0xc6, 0xf3, 0x04, 0xc2, 0x58, 0x98, 0x09, 0x03, 0x01, 0xb8,
+	0x95, 0x71, 0x66, 0x66, 0x33, 0x43, 0xe8, 0xdd, 0xf4, 0xc1, 0x07, 0x49, 0x58, 0xd9, 0x29, 0xd7,
+	0xf4, 0x30, 0xbd, 0x10, 0xf9, 0x25, 0xbd, 0x28, 0x7c, 0xbd, 0x3d, 0x1f, 0xc0, 0x8f, 0xa8, 0x25,
+	0x91, 0x9b, 0x2e, 0xac, 0x73, 0x46, 0x92, 0x84, 0x32, 0x5e, 0xf2, 0x72, 0x92, 0xb3, 0x50, 0x94,
+	0xdf, 0x5d, 0x52, 0x41, 0xe6, 0x44, 0x90, 0x82, 0xef, 0xfe, 0xcb, 0x33, 0xba, 0xf8, 0x9f, 0xb5,
+	0xc4, 0x39, 0xbf, 0xff, 0xa3, 0x8a, 0xb4, 0x51, 0x91, 0x02, 0x3f, 0x42, 0x8d, 0x34, 0xe1, 0x82,
+	0x51, 0xb2, 0xe4, 0xba, 0xd2, 0xaf, 0x1a, 0xcd, 0x41, 0xd7, 0xf4, 0x80, 0xd1, 0x32, 0x93, 0xe9,
+	0x50, 0x0e, 0x29, 0xf3, 0xa8, 0x43, 0x17, 0xce, 0x5f, 0x2d, 0xd6, 0x51, 0x9d, 0xcc, 0xe7, 0x8c,
+	0x72, 0xae, 0x57, 0xfa, 0x8a, 0xd1, 0x70, 0x4a, 0x88, 0x31, 0x52, 0x13, 0x60, 0x42, 0xaf, 0xf6,
+	0x15, 0xa3, 0xed, 0xc8, 0x33, 0x1e, 0x20, 0x2d, 0x02, 0x8f, 0x44, 0xa1, 0xf8, 0xac, 0xab, 0x7d,
+	0xc5, 0x68, 0x0e, 0x76, 0xcd, 0xed, 0xcd, 0x99, 0xc7, 0x05, 0xeb, 0x6c, 0x74, 0xf8, 0x29, 0x6a,
+	0x07, 0x94, 0x44, 0x22, 0x98, 0x71, 0x41, 0x44, 0xca, 0xf5, 0x6b, 0x7d, 0xc5, 0xb8, 0x3e, 0xe8,
+	0x5d, 0x36, 0x8e, 0xa5, 0xe4, 0xad, 0x54, 0x38, 0xad, 0x60, 0x0b, 0xe1, 0xd7, 0xe8, 0x56, 0x04,
+	0x64, 0x3e, 0x73, 0x49, 0x44, 0x62, 0x2f, 0x8c, 0xfd, 0xd9, 0x39, 0x0d, 0xfd, 0x40, 0xe8, 0x35,
+	0x39, 0xc1, 0x6d, 0x33, 0xef, 0xc0, 0x2c, 0x3b, 0x30, 0xa7, 0x93, 0x58, 0x1c, 0x0c, 0xde, 0x93,
+	0x28, 0xa5, 0xce, 0x8d, 0xcc, 0x3a, 0x2c, 0x9d, 0x27, 0xd2, 0x88, 0x7b, 0x48, 0x0b, 0x80, 0x8b,
+	0x98, 0x2c, 0xa9, 0xae, 0xc9, 0xd4, 0x1b, 0x9c, 0x71, 0x09, 0x0b, 0x81, 0x65, 0x11, 0x1b, 0x32,
+	0xfa, 0x06, 0xe3, 0xc7, 0x48, 0x2b, 0xfb, 0xd3, 0xeb, 0x45, 0xfc, 0x4b, 0x4b, 0x7e, 0x59, 0xb0,
+	0x43, 0xf5, 0xfb, 0xcf, 0xbb, 0x3b, 0xce, 0x46, 0x7d, 0x78, 0x67, 0xb5, 0x56, 0x55, 0x54, 0xa1,
+	0xc9, 0x6a, 0xad, 0x36, 0x71, 0xa3, 0x7c, 0x7d, 0x7c, 0xb5, 0x56, 0x2b, 0x86, 0xb2, 0xff, 0x06,
+	0x69, 0xe5, 0xe6, 0xf0, 0x2e, 0xaa, 0x31, 0xea, 0x87, 0x10, 0xeb, 0x8a, 0x1c, 0xad, 0x40, 0x59,
+	0x1f, 0x5f, 0x20, 0xa6, 0x45, 0x4d, 0xf2, 0x8c, 0xbb, 0x48, 0xe3, 0xa9, 0x3b, 0x93, 0xf7, 0xd5,
+	0xbc, 0x3e, 0x9e, 0xba, 0xa7, 0x10, 0xd3, 0x7b, 0x23, 0xd4, 0xda, 0xde, 0x29, 0x6e, 0xa2, 0xfa,
+	0xd4, 0x7e, 0x61, 0xbf, 0x3a, 0xb1, 0x3b, 0x3b, 0x19, 0x18, 0x8f, 0x8e, 0x8e, 0xdf, 0x8d, 0x3f,
+	0x74, 0x14, 0xdc, 0x46, 0x8d, 0xa9, 0x5d, 0xc2, 0x0a, 0x6e, 0x21, 0xed, 0x99, 0x73, 0x34, 0xb1,
+	0x27, 0xf6, 0xf3, 0x4e, 0x75, 0x78, 0xf8, 0xed, 0xb7, 0xaa, 0x7c, 0xfd, 0xb5, 0xa7, 0x9c, 0x3e,
+	0xb8, 0xda, 0xff, 0x95, 0x9c, 0xf9, 0xc5, 0x83, 0x75, 0x6b, 0xb2, 0x91, 0x83, 0x3f, 0x03, 0x00,
+	0x18, 0x80, 0xc2, 0x5c, 0x9a, 0x03, 0x00, 0x00,
 }
 
 func (this *Endpoint) Equal(that interface{}) bool {
@@ -159,6 +321,21 @@ func (this *Endpoint) Equal(that interface{}) bool {
 	if this.Port != that1.Port {
 		return false
 	}
+	if !this.Locality.Equal(that1.Locality) {
+		return false
+	}
+	if this.HealthStatus != that1.HealthStatus {
+		return false
+	}
+	if !this.LoadBalancingWeight.Equal(that1.LoadBalancingWeight) {
+		return false
+	}
+	if this.Hostname != that1.Hostname {
+		return false
+	}
+	if this.Priority != that1.Priority {
+		return false
+	}
 	if !this.Metadata.Equal(&that1.Metadata) {
 		return false
 	}
@@ -167,3 +344,36 @@ func (this *Endpoint) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Locality) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Locality)
+	if !ok {
+		that2, ok := that.(Locality)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Region != that1.Region {
+		return false
+	}
+	if this.Zone != that1.Zone {
+		return false
+	}
+	if this.SubZone != that1.SubZone {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
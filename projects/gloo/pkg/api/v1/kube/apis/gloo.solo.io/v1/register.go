@@ -33,6 +33,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&EndpointList{},
 		&Proxy{},
 		&ProxyList{},
+		&ReferencePolicy{},
+		&ReferencePolicyList{},
 		&Secret{},
 		&SecretList{},
 		&Settings{},
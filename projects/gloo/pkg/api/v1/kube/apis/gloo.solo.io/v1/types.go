@@ -188,6 +188,62 @@ type ProxyList struct {
 	Items       []Proxy `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resourceName=referencepolicies
+// +genclient
+// +genclient:noStatus
+type ReferencePolicy struct {
+	v1.TypeMeta `json:",inline"`
+	// +optional
+	v1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the implementation of this definition.
+	// +optional
+	Spec api.ReferencePolicy `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+}
+
+func (o *ReferencePolicy) MarshalJSON() ([]byte, error) {
+	spec, err := protoutils.MarshalMap(&o.Spec)
+	if err != nil {
+		return nil, err
+	}
+	delete(spec, "metadata")
+	asMap := map[string]interface{}{
+		"metadata":   o.ObjectMeta,
+		"apiVersion": o.TypeMeta.APIVersion,
+		"kind":       o.TypeMeta.Kind,
+		"spec":       spec,
+	}
+	return json.Marshal(asMap)
+}
+
+func (o *ReferencePolicy) UnmarshalJSON(data []byte) error {
+	var metaOnly metaOnly
+	if err := json.Unmarshal(data, &metaOnly); err != nil {
+		return err
+	}
+	var spec api.ReferencePolicy
+	if err := protoutils.UnmarshalResource(data, &spec); err != nil {
+		return err
+	}
+	*o = ReferencePolicy{
+		ObjectMeta: metaOnly.ObjectMeta,
+		TypeMeta:   metaOnly.TypeMeta,
+		Spec:       spec,
+	}
+
+	return nil
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// ReferencePolicyList is a collection of ReferencePolicys.
+type ReferencePolicyList struct {
+	v1.TypeMeta `json:",inline"`
+	// +optional
+	v1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items       []ReferencePolicy `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +resourceName=secrets
 // +genclient
@@ -40,6 +40,10 @@ func (c *FakeGlooV1) Proxies(namespace string) v1.ProxyInterface {
 	return &FakeProxies{c, namespace}
 }
 
+func (c *FakeGlooV1) ReferencePolicies(namespace string) v1.ReferencePolicyInterface {
+	return &FakeReferencePolicies{c, namespace}
+}
+
 func (c *FakeGlooV1) Secrets(namespace string) v1.SecretInterface {
 	return &FakeSecrets{c, namespace}
 }
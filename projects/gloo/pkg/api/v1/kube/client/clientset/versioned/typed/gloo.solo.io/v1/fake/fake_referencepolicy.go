@@ -0,0 +1,128 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	gloosoloiov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/kube/apis/gloo.solo.io/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeReferencePolicies implements ReferencePolicyInterface
+type FakeReferencePolicies struct {
+	Fake *FakeGlooV1
+	ns   string
+}
+
+var referencepoliciesResource = schema.GroupVersionResource{Group: "gloo.solo.io", Version: "v1", Resource: "referencepolicies"}
+
+var referencepoliciesKind = schema.GroupVersionKind{Group: "gloo.solo.io", Version: "v1", Kind: "ReferencePolicy"}
+
+// Get takes name of the referencePolicy, and returns the corresponding referencePolicy object, and an error if there is any.
+func (c *FakeReferencePolicies) Get(name string, options v1.GetOptions) (result *gloosoloiov1.ReferencePolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(referencepoliciesResource, c.ns, name), &gloosoloiov1.ReferencePolicy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*gloosoloiov1.ReferencePolicy), err
+}
+
+// List takes label and field selectors, and returns the list of ReferencePolicies that match those selectors.
+func (c *FakeReferencePolicies) List(opts v1.ListOptions) (result *gloosoloiov1.ReferencePolicyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(referencepoliciesResource, referencepoliciesKind, c.ns, opts), &gloosoloiov1.ReferencePolicyList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &gloosoloiov1.ReferencePolicyList{ListMeta: obj.(*gloosoloiov1.ReferencePolicyList).ListMeta}
+	for _, item := range obj.(*gloosoloiov1.ReferencePolicyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested referencePolicies.
+func (c *FakeReferencePolicies) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(referencepoliciesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a referencePolicy and creates it.  Returns the server's representation of the referencePolicy, and an error, if there is any.
+func (c *FakeReferencePolicies) Create(referencePolicy *gloosoloiov1.ReferencePolicy) (result *gloosoloiov1.ReferencePolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(referencepoliciesResource, c.ns, referencePolicy), &gloosoloiov1.ReferencePolicy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*gloosoloiov1.ReferencePolicy), err
+}
+
+// Update takes the representation of a referencePolicy and updates it. Returns the server's representation of the referencePolicy, and an error, if there is any.
+func (c *FakeReferencePolicies) Update(referencePolicy *gloosoloiov1.ReferencePolicy) (result *gloosoloiov1.ReferencePolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(referencepoliciesResource, c.ns, referencePolicy), &gloosoloiov1.ReferencePolicy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*gloosoloiov1.ReferencePolicy), err
+}
+
+// Delete takes name of the referencePolicy and deletes it. Returns an error if one occurs.
+func (c *FakeReferencePolicies) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(referencepoliciesResource, c.ns, name), &gloosoloiov1.ReferencePolicy{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeReferencePolicies) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(referencepoliciesResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &gloosoloiov1.ReferencePolicyList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched referencePolicy.
+func (c *FakeReferencePolicies) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *gloosoloiov1.ReferencePolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(referencepoliciesResource, c.ns, name, pt, data, subresources...), &gloosoloiov1.ReferencePolicy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*gloosoloiov1.ReferencePolicy), err
+}
@@ -24,6 +24,8 @@ type EndpointExpansion interface{}
 
 type ProxyExpansion interface{}
 
+type ReferencePolicyExpansion interface{}
+
 type SecretExpansion interface{}
 
 type SettingsExpansion interface{}
@@ -29,6 +29,7 @@ type GlooV1Interface interface {
 	ArtifactsGetter
 	EndpointsGetter
 	ProxiesGetter
+	ReferencePoliciesGetter
 	SecretsGetter
 	SettingsesGetter
 	UpstreamsGetter
@@ -52,6 +53,10 @@ func (c *GlooV1Client) Proxies(namespace string) ProxyInterface {
 	return newProxies(c, namespace)
 }
 
+func (c *GlooV1Client) ReferencePolicies(namespace string) ReferencePolicyInterface {
+	return newReferencePolicies(c, namespace)
+}
+
 func (c *GlooV1Client) Secrets(namespace string) SecretInterface {
 	return newSecrets(c, namespace)
 }
@@ -0,0 +1,174 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"time"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/kube/apis/gloo.solo.io/v1"
+	scheme "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/kube/client/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ReferencePoliciesGetter has a method to return a ReferencePolicyInterface.
+// A group's client should implement this interface.
+type ReferencePoliciesGetter interface {
+	ReferencePolicies(namespace string) ReferencePolicyInterface
+}
+
+// ReferencePolicyInterface has methods to work with ReferencePolicy resources.
+type ReferencePolicyInterface interface {
+	Create(*v1.ReferencePolicy) (*v1.ReferencePolicy, error)
+	Update(*v1.ReferencePolicy) (*v1.ReferencePolicy, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteCollection(options *metav1.DeleteOptions, listOptions metav1.ListOptions) error
+	Get(name string, options metav1.GetOptions) (*v1.ReferencePolicy, error)
+	List(opts metav1.ListOptions) (*v1.ReferencePolicyList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.ReferencePolicy, err error)
+	ReferencePolicyExpansion
+}
+
+// referencePolicies implements ReferencePolicyInterface
+type referencePolicies struct {
+	client rest.Interface
+	ns     string
+}
+
+// newReferencePolicies returns a ReferencePolicies
+func newReferencePolicies(c *GlooV1Client, namespace string) *referencePolicies {
+	return &referencePolicies{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the referencePolicy, and returns the corresponding referencePolicy object, and an error if there is any.
+func (c *referencePolicies) Get(name string, options metav1.GetOptions) (result *v1.ReferencePolicy, err error) {
+	result = &v1.ReferencePolicy{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("referencepolicies").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ReferencePolicies that match those selectors.
+func (c *referencePolicies) List(opts metav1.ListOptions) (result *v1.ReferencePolicyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1.ReferencePolicyList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("referencepolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested referencePolicies.
+func (c *referencePolicies) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("referencepolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a referencePolicy and creates it.  Returns the server's representation of the referencePolicy, and an error, if there is any.
+func (c *referencePolicies) Create(referencePolicy *v1.ReferencePolicy) (result *v1.ReferencePolicy, err error) {
+	result = &v1.ReferencePolicy{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("referencepolicies").
+		Body(referencePolicy).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a referencePolicy and updates it. Returns the server's representation of the referencePolicy, and an error, if there is any.
+func (c *referencePolicies) Update(referencePolicy *v1.ReferencePolicy) (result *v1.ReferencePolicy, err error) {
+	result = &v1.ReferencePolicy{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("referencepolicies").
+		Name(referencePolicy.Name).
+		Body(referencePolicy).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the referencePolicy and deletes it. Returns an error if one occurs.
+func (c *referencePolicies) Delete(name string, options *metav1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("referencepolicies").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *referencePolicies) DeleteCollection(options *metav1.DeleteOptions, listOptions metav1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("referencepolicies").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched referencePolicy.
+func (c *referencePolicies) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.ReferencePolicy, err error) {
+	result = &v1.ReferencePolicy{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("referencepolicies").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
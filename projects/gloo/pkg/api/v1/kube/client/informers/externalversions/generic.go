@@ -59,6 +59,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Gloo().V1().Endpoints().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("proxies"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Gloo().V1().Proxies().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("referencepolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Gloo().V1().ReferencePolicies().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("secrets"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Gloo().V1().Secrets().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("settings"):
@@ -30,6 +30,8 @@ type Interface interface {
 	Endpoints() EndpointInformer
 	// Proxies returns a ProxyInformer.
 	Proxies() ProxyInformer
+	// ReferencePolicies returns a ReferencePolicyInformer.
+	ReferencePolicies() ReferencePolicyInformer
 	// Secrets returns a SecretInformer.
 	Secrets() SecretInformer
 	// Settingses returns a SettingsInformer.
@@ -66,6 +68,11 @@ func (v *version) Proxies() ProxyInformer {
 	return &proxyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ReferencePolicies returns a ReferencePolicyInformer.
+func (v *version) ReferencePolicies() ReferencePolicyInformer {
+	return &referencePolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Secrets returns a SecretInformer.
 func (v *version) Secrets() SecretInformer {
 	return &secretInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
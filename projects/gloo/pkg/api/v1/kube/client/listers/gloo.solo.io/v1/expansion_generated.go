@@ -42,6 +42,14 @@ type ProxyListerExpansion interface{}
 // ProxyNamespaceLister.
 type ProxyNamespaceListerExpansion interface{}
 
+// ReferencePolicyListerExpansion allows custom methods to be added to
+// ReferencePolicyLister.
+type ReferencePolicyListerExpansion interface{}
+
+// ReferencePolicyNamespaceListerExpansion allows custom methods to be added to
+// ReferencePolicyNamespaceLister.
+type ReferencePolicyNamespaceListerExpansion interface{}
+
 // SecretListerExpansion allows custom methods to be added to
 // SecretLister.
 type SecretListerExpansion interface{}
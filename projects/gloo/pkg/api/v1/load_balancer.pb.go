@@ -45,10 +45,17 @@ type LoadBalancerConfig struct {
 	//	*LoadBalancerConfig_Random_
 	//	*LoadBalancerConfig_RingHash_
 	//	*LoadBalancerConfig_Maglev_
-	Type                 isLoadBalancerConfig_Type `protobuf_oneof:"type"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	Type isLoadBalancerConfig_Type `protobuf_oneof:"type"`
+	// Determines how endpoints in different localities are chosen.
+	// Requires endpoints with a `locality`.
+	//
+	// Types that are valid to be assigned to LocalityConfig:
+	//	*LoadBalancerConfig_ZoneAwareLbConfig_
+	//	*LoadBalancerConfig_LocalityWeightedLbConfig_
+	LocalityConfig       isLoadBalancerConfig_LocalityConfig `protobuf_oneof:"locality_config"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
 }
 
 func (m *LoadBalancerConfig) Reset()         { *m = LoadBalancerConfig{} }
@@ -79,6 +86,10 @@ type isLoadBalancerConfig_Type interface {
 	isLoadBalancerConfig_Type()
 	Equal(interface{}) bool
 }
+type isLoadBalancerConfig_LocalityConfig interface {
+	isLoadBalancerConfig_LocalityConfig()
+	Equal(interface{}) bool
+}
 
 type LoadBalancerConfig_RoundRobin_ struct {
 	RoundRobin *LoadBalancerConfig_RoundRobin `protobuf:"bytes,3,opt,name=round_robin,json=roundRobin,proto3,oneof" json:"round_robin,omitempty"`
@@ -95,12 +106,20 @@ type LoadBalancerConfig_RingHash_ struct {
 type LoadBalancerConfig_Maglev_ struct {
 	Maglev *LoadBalancerConfig_Maglev `protobuf:"bytes,7,opt,name=maglev,proto3,oneof" json:"maglev,omitempty"`
 }
+type LoadBalancerConfig_ZoneAwareLbConfig_ struct {
+	ZoneAwareLbConfig *LoadBalancerConfig_ZoneAwareLbConfig `protobuf:"bytes,8,opt,name=zone_aware_lb_config,json=zoneAwareLbConfig,proto3,oneof" json:"zone_aware_lb_config,omitempty"`
+}
+type LoadBalancerConfig_LocalityWeightedLbConfig_ struct {
+	LocalityWeightedLbConfig *LoadBalancerConfig_LocalityWeightedLbConfig `protobuf:"bytes,9,opt,name=locality_weighted_lb_config,json=localityWeightedLbConfig,proto3,oneof" json:"locality_weighted_lb_config,omitempty"`
+}
 
-func (*LoadBalancerConfig_RoundRobin_) isLoadBalancerConfig_Type()   {}
-func (*LoadBalancerConfig_LeastRequest_) isLoadBalancerConfig_Type() {}
-func (*LoadBalancerConfig_Random_) isLoadBalancerConfig_Type()       {}
-func (*LoadBalancerConfig_RingHash_) isLoadBalancerConfig_Type()     {}
-func (*LoadBalancerConfig_Maglev_) isLoadBalancerConfig_Type()       {}
+func (*LoadBalancerConfig_RoundRobin_) isLoadBalancerConfig_Type()                         {}
+func (*LoadBalancerConfig_LeastRequest_) isLoadBalancerConfig_Type()                       {}
+func (*LoadBalancerConfig_Random_) isLoadBalancerConfig_Type()                             {}
+func (*LoadBalancerConfig_RingHash_) isLoadBalancerConfig_Type()                           {}
+func (*LoadBalancerConfig_Maglev_) isLoadBalancerConfig_Type()                             {}
+func (*LoadBalancerConfig_ZoneAwareLbConfig_) isLoadBalancerConfig_LocalityConfig()        {}
+func (*LoadBalancerConfig_LocalityWeightedLbConfig_) isLoadBalancerConfig_LocalityConfig() {}
 
 func (m *LoadBalancerConfig) GetType() isLoadBalancerConfig_Type {
 	if m != nil {
@@ -108,6 +127,12 @@ func (m *LoadBalancerConfig) GetType() isLoadBalancerConfig_Type {
 	}
 	return nil
 }
+func (m *LoadBalancerConfig) GetLocalityConfig() isLoadBalancerConfig_LocalityConfig {
+	if m != nil {
+		return m.LocalityConfig
+	}
+	return nil
+}
 
 func (m *LoadBalancerConfig) GetHealthyPanicThreshold() *types.DoubleValue {
 	if m != nil {
@@ -158,6 +183,20 @@ func (m *LoadBalancerConfig) GetMaglev() *LoadBalancerConfig_Maglev {
 	return nil
 }
 
+func (m *LoadBalancerConfig) GetZoneAwareLbConfig() *LoadBalancerConfig_ZoneAwareLbConfig {
+	if x, ok := m.GetLocalityConfig().(*LoadBalancerConfig_ZoneAwareLbConfig_); ok {
+		return x.ZoneAwareLbConfig
+	}
+	return nil
+}
+
+func (m *LoadBalancerConfig) GetLocalityWeightedLbConfig() *LoadBalancerConfig_LocalityWeightedLbConfig {
+	if x, ok := m.GetLocalityConfig().(*LoadBalancerConfig_LocalityWeightedLbConfig_); ok {
+		return x.LocalityWeightedLbConfig
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*LoadBalancerConfig) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -166,6 +205,8 @@ func (*LoadBalancerConfig) XXX_OneofWrappers() []interface{} {
 		(*LoadBalancerConfig_Random_)(nil),
 		(*LoadBalancerConfig_RingHash_)(nil),
 		(*LoadBalancerConfig_Maglev_)(nil),
+		(*LoadBalancerConfig_ZoneAwareLbConfig_)(nil),
+		(*LoadBalancerConfig_LocalityWeightedLbConfig_)(nil),
 	}
 }
 
@@ -389,6 +430,104 @@ func (m *LoadBalancerConfig_Maglev) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LoadBalancerConfig_Maglev proto.InternalMessageInfo
 
+// Route requests to endpoints in the same zone as the Envoy instance, as long as that zone has enough healthy
+// endpoints. Envoy learns its own zone from its bootstrap `node.locality`, and the endpoints of its own
+// zone from the cluster named in the bootstrap `cluster_manager.local_cluster_name`.
+// see more info [here](https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/upstream/load_balancing/zone_aware).
+type LoadBalancerConfig_ZoneAwareLbConfig struct {
+	// Percentage of requests, between 0-100, to route using zone awareness. defaults to 100.
+	RoutingEnabled *types.DoubleValue `protobuf:"bytes,1,opt,name=routing_enabled,json=routingEnabled,proto3" json:"routing_enabled,omitempty"`
+	// Zone aware routing is only used if the upstream has at least this many endpoints. defaults to 6.
+	MinClusterSize *types.UInt64Value `protobuf:"bytes,2,opt,name=min_cluster_size,json=minClusterSize,proto3" json:"min_cluster_size,omitempty"`
+	// Fail requests rather than route them to other zones when the upstream is in panic mode.
+	FailTrafficOnPanic   bool     `protobuf:"varint,3,opt,name=fail_traffic_on_panic,json=failTrafficOnPanic,proto3" json:"fail_traffic_on_panic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) Reset()         { *m = LoadBalancerConfig_ZoneAwareLbConfig{} }
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) String() string { return proto.CompactTextString(m) }
+func (*LoadBalancerConfig_ZoneAwareLbConfig) ProtoMessage()    {}
+func (*LoadBalancerConfig_ZoneAwareLbConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaa1c019b03e4b0f, []int{0, 6}
+}
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadBalancerConfig_ZoneAwareLbConfig.Unmarshal(m, b)
+}
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadBalancerConfig_ZoneAwareLbConfig.Marshal(b, m, deterministic)
+}
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadBalancerConfig_ZoneAwareLbConfig.Merge(m, src)
+}
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) XXX_Size() int {
+	return xxx_messageInfo_LoadBalancerConfig_ZoneAwareLbConfig.Size(m)
+}
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadBalancerConfig_ZoneAwareLbConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadBalancerConfig_ZoneAwareLbConfig proto.InternalMessageInfo
+
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) GetRoutingEnabled() *types.DoubleValue {
+	if m != nil {
+		return m.RoutingEnabled
+	}
+	return nil
+}
+
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) GetMinClusterSize() *types.UInt64Value {
+	if m != nil {
+		return m.MinClusterSize
+	}
+	return nil
+}
+
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) GetFailTrafficOnPanic() bool {
+	if m != nil {
+		return m.FailTrafficOnPanic
+	}
+	return false
+}
+
+// Spread requests across localities in proportion to their number of endpoints, then across the endpoints
+// of the chosen locality.
+// see more info [here](https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/upstream/load_balancing/locality_weight).
+type LoadBalancerConfig_LocalityWeightedLbConfig struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadBalancerConfig_LocalityWeightedLbConfig) Reset() {
+	*m = LoadBalancerConfig_LocalityWeightedLbConfig{}
+}
+func (m *LoadBalancerConfig_LocalityWeightedLbConfig) String() string {
+	return proto.CompactTextString(m)
+}
+func (*LoadBalancerConfig_LocalityWeightedLbConfig) ProtoMessage() {}
+func (*LoadBalancerConfig_LocalityWeightedLbConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaa1c019b03e4b0f, []int{0, 7}
+}
+func (m *LoadBalancerConfig_LocalityWeightedLbConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadBalancerConfig_LocalityWeightedLbConfig.Unmarshal(m, b)
+}
+func (m *LoadBalancerConfig_LocalityWeightedLbConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadBalancerConfig_LocalityWeightedLbConfig.Marshal(b, m, deterministic)
+}
+func (m *LoadBalancerConfig_LocalityWeightedLbConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadBalancerConfig_LocalityWeightedLbConfig.Merge(m, src)
+}
+func (m *LoadBalancerConfig_LocalityWeightedLbConfig) XXX_Size() int {
+	return xxx_messageInfo_LoadBalancerConfig_LocalityWeightedLbConfig.Size(m)
+}
+func (m *LoadBalancerConfig_LocalityWeightedLbConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadBalancerConfig_LocalityWeightedLbConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadBalancerConfig_LocalityWeightedLbConfig proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*LoadBalancerConfig)(nil), "gloo.solo.io.LoadBalancerConfig")
 	proto.RegisterType((*LoadBalancerConfig_RoundRobin)(nil), "gloo.solo.io.LoadBalancerConfig.RoundRobin")
@@ -397,6 +536,8 @@ func init() {
 	proto.RegisterType((*LoadBalancerConfig_RingHashConfig)(nil), "gloo.solo.io.LoadBalancerConfig.RingHashConfig")
 	proto.RegisterType((*LoadBalancerConfig_RingHash)(nil), "gloo.solo.io.LoadBalancerConfig.RingHash")
 	proto.RegisterType((*LoadBalancerConfig_Maglev)(nil), "gloo.solo.io.LoadBalancerConfig.Maglev")
+	proto.RegisterType((*LoadBalancerConfig_ZoneAwareLbConfig)(nil), "gloo.solo.io.LoadBalancerConfig.ZoneAwareLbConfig")
+	proto.RegisterType((*LoadBalancerConfig_LocalityWeightedLbConfig)(nil), "gloo.solo.io.LoadBalancerConfig.LocalityWeightedLbConfig")
 }
 
 func init() {
@@ -404,43 +545,54 @@ func init() {
 }
 
 var fileDescriptor_aaa1c019b03e4b0f = []byte{
-	// 570 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcb, 0x6e, 0xd4, 0x3c,
-	0x14, 0xc7, 0x67, 0xfa, 0xcd, 0x17, 0x8a, 0x3b, 0x2d, 0x34, 0x80, 0x08, 0x11, 0x2a, 0x97, 0x0d,
-	0x37, 0x35, 0xa1, 0x20, 0x36, 0xac, 0x60, 0xca, 0x22, 0x8b, 0x16, 0x90, 0xa9, 0x40, 0xb0, 0x89,
-	0x9c, 0xc4, 0x75, 0x0c, 0x8e, 0x4f, 0x70, 0x9c, 0xde, 0x9e, 0x84, 0x47, 0xe0, 0x11, 0x78, 0x9b,
-	0x4a, 0xbc, 0x03, 0x7b, 0xe4, 0xcb, 0x94, 0x42, 0x55, 0xcd, 0xac, 0xc6, 0xc7, 0xe7, 0xfc, 0xfe,
-	0xe7, 0x32, 0x27, 0x46, 0x2f, 0x18, 0xd7, 0x75, 0x5f, 0x24, 0x25, 0x34, 0x69, 0x07, 0x02, 0xd6,
-	0x39, 0xa4, 0x4c, 0x00, 0xa4, 0xad, 0x82, 0xcf, 0xb4, 0xd4, 0x9d, 0xb3, 0x48, 0xcb, 0xd3, 0xbd,
-	0x8d, 0x54, 0x00, 0xa9, 0xf2, 0x82, 0x08, 0x22, 0x4b, 0xaa, 0x92, 0x56, 0x81, 0x86, 0x70, 0x6c,
-	0x02, 0x12, 0xc3, 0x26, 0x1c, 0xe2, 0x67, 0xe7, 0xc3, 0xd0, 0x6a, 0x0e, 0xb2, 0x4b, 0x45, 0x51,
-	0x93, 0xae, 0xf6, 0x3f, 0x4e, 0x24, 0xbe, 0xca, 0x80, 0x81, 0x3d, 0xa6, 0xe6, 0xe4, 0x6f, 0xd7,
-	0x18, 0x00, 0x13, 0x34, 0xb5, 0x56, 0xd1, 0xef, 0xa6, 0x55, 0xaf, 0x88, 0x11, 0x39, 0xcf, 0xbf,
-	0xaf, 0x48, 0xdb, 0x52, 0xd5, 0x79, 0x7f, 0x48, 0x0f, 0xb4, 0x13, 0xa5, 0x07, 0xda, 0xdd, 0xdd,
-	0x3d, 0x0e, 0x50, 0xb8, 0x05, 0xa4, 0x9a, 0xf8, 0x2e, 0x36, 0x41, 0xee, 0x72, 0x16, 0xee, 0xa0,
-	0xeb, 0x35, 0x25, 0x42, 0xd7, 0x87, 0x79, 0x4b, 0x24, 0x2f, 0x73, 0x5d, 0x2b, 0xda, 0xd5, 0x20,
-	0xaa, 0x68, 0x78, 0x7b, 0x78, 0x7f, 0xe9, 0xc9, 0xcd, 0xc4, 0x25, 0x4b, 0xa6, 0xc9, 0x92, 0x57,
-	0xd0, 0x17, 0x82, 0xbe, 0x27, 0xa2, 0xa7, 0xf8, 0x9a, 0x87, 0xdf, 0x1a, 0x76, 0x67, 0x8a, 0x86,
-	0x6f, 0xd0, 0x95, 0xbe, 0xad, 0x88, 0xa6, 0x79, 0x43, 0x15, 0xa3, 0xf9, 0x3e, 0x97, 0x15, 0xec,
-	0x47, 0x0b, 0x56, 0xf1, 0xc6, 0x59, 0x45, 0xdf, 0xde, 0x64, 0xf4, 0xed, 0xf8, 0xd6, 0x10, 0xaf,
-	0x3a, 0x76, 0xdb, 0xa0, 0x1f, 0x2c, 0x19, 0xbe, 0x46, 0x4b, 0x0a, 0x7a, 0x59, 0xe5, 0x0a, 0x0a,
-	0x2e, 0xa3, 0xff, 0xac, 0xd0, 0xa3, 0xe4, 0xf4, 0x5f, 0x90, 0x9c, 0xed, 0x2e, 0xc1, 0x86, 0xc1,
-	0x06, 0xc9, 0x06, 0x18, 0xa9, 0x13, 0x2b, 0xdc, 0x41, 0xcb, 0x82, 0x92, 0x4e, 0xe7, 0x8a, 0x7e,
-	0xed, 0x69, 0xa7, 0xa3, 0x91, 0x55, 0x5c, 0x9f, 0xa9, 0xb8, 0x65, 0x28, 0xec, 0xa0, 0x6c, 0x80,
-	0xc7, 0xe2, 0x94, 0x1d, 0xbe, 0x44, 0x81, 0x22, 0xb2, 0x82, 0x26, 0xfa, 0xdf, 0xca, 0xdd, 0x9b,
-	0x5d, 0xa0, 0x0d, 0xcf, 0x06, 0xd8, 0x83, 0x61, 0x86, 0x2e, 0x2a, 0x2e, 0x59, 0x6e, 0x76, 0x24,
-	0x0a, 0xac, 0xca, 0x83, 0xd9, 0x2a, 0x5c, 0xb2, 0x8c, 0x74, 0x75, 0x36, 0xc0, 0x8b, 0xca, 0x9f,
-	0x4d, 0x31, 0x0d, 0x61, 0x82, 0xee, 0x45, 0x17, 0xe6, 0x2c, 0x66, 0xdb, 0x86, 0x9b, 0x62, 0x1c,
-	0x18, 0x8f, 0x11, 0xfa, 0x33, 0xc1, 0x78, 0x03, 0x8d, 0x4f, 0x77, 0x1f, 0xde, 0x41, 0xe3, 0xb2,
-	0x06, 0x5e, 0xd2, 0xbc, 0x84, 0x5e, 0x6a, 0xbb, 0x2f, 0xcb, 0x78, 0xc9, 0xdd, 0x6d, 0x9a, 0xab,
-	0x78, 0x11, 0x05, 0xae, 0xc3, 0xb8, 0x46, 0x2b, 0xd3, 0x2a, 0xfd, 0xe6, 0x3d, 0x44, 0xab, 0x0d,
-	0x97, 0xbc, 0xe9, 0x9b, 0xdc, 0x76, 0xdc, 0xf1, 0x23, 0x6a, 0x35, 0x46, 0xf8, 0x92, 0x77, 0x18,
-	0xe2, 0x1d, 0x3f, 0xa2, 0x36, 0x96, 0x1c, 0xfc, 0x13, 0xbb, 0xe0, 0x63, 0x9d, 0x63, 0x1a, 0x1b,
-	0x53, 0xb4, 0x38, 0xcd, 0x14, 0x7e, 0x44, 0x97, 0x4f, 0xa6, 0x99, 0x97, 0x36, 0xaf, 0x5f, 0xeb,
-	0x74, 0xee, 0xa1, 0x3a, 0x13, 0xaf, 0xa8, 0xbf, 0x6c, 0xd3, 0x9a, 0x9b, 0xd7, 0x24, 0x40, 0x23,
-	0x7d, 0xd8, 0xd2, 0xc9, 0xf3, 0x1f, 0xbf, 0x46, 0xc3, 0xef, 0x3f, 0xd7, 0x86, 0x9f, 0x1e, 0xcf,
-	0xf7, 0xb8, 0xb4, 0x5f, 0x98, 0x7f, 0x23, 0x8a, 0xc0, 0x7e, 0x0b, 0x4f, 0x7f, 0x07, 0x00, 0x00,
-	0xff, 0xff, 0x0a, 0xc7, 0x09, 0xc0, 0x97, 0x04, 0x00, 0x00,
+	// 746 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xc7, 0x93, 0x25, 0x84, 0xec, 0x34, 0xdb, 0x6e, 0x86, 0x5d, 0x61, 0x0c, 0x5a, 0x3e, 0x6e,
+	0xf8, 0xd2, 0xda, 0x74, 0xf9, 0x90, 0xe0, 0x8a, 0x4d, 0x29, 0x0a, 0x52, 0x4b, 0x91, 0x09, 0x54,
+	0xf4, 0x66, 0x34, 0xb6, 0x27, 0xf6, 0xc0, 0x78, 0x8e, 0x19, 0x8f, 0x9b, 0x34, 0x4f, 0xc2, 0x23,
+	0xf0, 0x08, 0xbc, 0x02, 0x0f, 0xc0, 0x35, 0x12, 0xef, 0xc0, 0x3d, 0x9a, 0x8f, 0xb4, 0xa1, 0x25,
+	0x4a, 0xae, 0xe2, 0xf3, 0xf1, 0xfb, 0x9f, 0x39, 0x3e, 0x27, 0x63, 0xf4, 0x45, 0xc1, 0x75, 0xd9,
+	0xa6, 0x51, 0x06, 0x55, 0xdc, 0x80, 0x80, 0xa7, 0x1c, 0xe2, 0x42, 0x00, 0xc4, 0xb5, 0x82, 0x9f,
+	0x58, 0xa6, 0x1b, 0x67, 0xd1, 0x9a, 0xc7, 0x97, 0x87, 0xb1, 0x00, 0x9a, 0x93, 0x94, 0x0a, 0x2a,
+	0x33, 0xa6, 0xa2, 0x5a, 0x81, 0x06, 0x3c, 0x34, 0x09, 0x91, 0x61, 0x23, 0x0e, 0xe1, 0x27, 0x9b,
+	0x61, 0xa8, 0x35, 0x07, 0xd9, 0xc4, 0x22, 0x2d, 0x69, 0x53, 0xfa, 0x1f, 0x27, 0x12, 0x3e, 0x2a,
+	0xa0, 0x00, 0xfb, 0x18, 0x9b, 0x27, 0xef, 0x7d, 0x52, 0x00, 0x14, 0x82, 0xc5, 0xd6, 0x4a, 0xdb,
+	0x59, 0x9c, 0xb7, 0x8a, 0x1a, 0x91, 0x4d, 0xf1, 0xb9, 0xa2, 0x75, 0xcd, 0x54, 0xe3, 0xe3, 0x98,
+	0x2d, 0xb4, 0x13, 0x65, 0x0b, 0xed, 0x7c, 0x6f, 0xff, 0x81, 0x10, 0x3e, 0x01, 0x9a, 0x8f, 0x7d,
+	0x17, 0x47, 0x20, 0x67, 0xbc, 0xc0, 0x53, 0xf4, 0x4a, 0xc9, 0xa8, 0xd0, 0xe5, 0x15, 0xa9, 0xa9,
+	0xe4, 0x19, 0xd1, 0xa5, 0x62, 0x4d, 0x09, 0x22, 0x0f, 0xba, 0x6f, 0x76, 0xdf, 0xdd, 0x7b, 0xf6,
+	0x7a, 0xe4, 0x8a, 0x45, 0xab, 0x62, 0xd1, 0x97, 0xd0, 0xa6, 0x82, 0xfd, 0x40, 0x45, 0xcb, 0x92,
+	0xc7, 0x1e, 0xfe, 0xd6, 0xb0, 0xd3, 0x15, 0x8a, 0xcf, 0xd0, 0xcb, 0x6d, 0x9d, 0x53, 0xcd, 0x48,
+	0xc5, 0x54, 0xc1, 0xc8, 0x9c, 0xcb, 0x1c, 0xe6, 0xc1, 0x3d, 0xab, 0xf8, 0xea, 0x5d, 0x45, 0xdf,
+	0xde, 0xb8, 0xf7, 0xeb, 0x5f, 0x6f, 0x74, 0x93, 0x91, 0x63, 0x4f, 0x0d, 0x7a, 0x6e, 0x49, 0xfc,
+	0x0d, 0xda, 0x53, 0xd0, 0xca, 0x9c, 0x28, 0x48, 0xb9, 0x0c, 0x5e, 0xb0, 0x42, 0x1f, 0x44, 0xeb,
+	0x23, 0x88, 0xee, 0x76, 0x17, 0x25, 0x86, 0x49, 0x0c, 0x32, 0xe9, 0x24, 0x48, 0x5d, 0x5b, 0x78,
+	0x8a, 0x1e, 0x08, 0x46, 0x1b, 0x4d, 0x14, 0xfb, 0xa5, 0x65, 0x8d, 0x0e, 0x7a, 0x56, 0xf1, 0xe9,
+	0x56, 0xc5, 0x13, 0x43, 0x25, 0x0e, 0x9a, 0x74, 0x92, 0xa1, 0x58, 0xb3, 0xf1, 0x73, 0xd4, 0x57,
+	0x54, 0xe6, 0x50, 0x05, 0x2f, 0x5a, 0xb9, 0x77, 0xb6, 0x1f, 0xd0, 0xa6, 0x4f, 0x3a, 0x89, 0x07,
+	0xf1, 0x04, 0xdd, 0x57, 0x5c, 0x16, 0xc4, 0xec, 0x48, 0xd0, 0xb7, 0x2a, 0xef, 0x6d, 0x57, 0xe1,
+	0xb2, 0x98, 0xd0, 0xa6, 0x9c, 0x74, 0x92, 0x81, 0xf2, 0xcf, 0xe6, 0x30, 0x15, 0x2d, 0x04, 0xbb,
+	0x0c, 0x5e, 0xda, 0xf1, 0x30, 0xa7, 0x36, 0xdd, 0x1c, 0xc6, 0x81, 0x98, 0xa1, 0x47, 0x4b, 0x90,
+	0x8c, 0xd0, 0x39, 0x55, 0x8c, 0x88, 0x94, 0x64, 0x36, 0x31, 0x18, 0x58, 0xc1, 0x67, 0x5b, 0x05,
+	0x2f, 0x40, 0xb2, 0xe7, 0x86, 0x3d, 0x49, 0x9d, 0x67, 0xd2, 0x4d, 0x46, 0xcb, 0xdb, 0x4e, 0xbc,
+	0x44, 0xaf, 0x09, 0xc8, 0xa8, 0xe0, 0xfa, 0x8a, 0xcc, 0x19, 0x2f, 0x4a, 0xcd, 0xf2, 0xb5, 0x6a,
+	0xf7, 0x6d, 0xb5, 0xcf, 0xb6, 0x8f, 0xc6, 0x6b, 0x9c, 0x7b, 0x89, 0xb5, 0xa2, 0x81, 0xd8, 0x10,
+	0x0b, 0x87, 0x08, 0xdd, 0x2c, 0x49, 0x78, 0x88, 0x86, 0xeb, 0x03, 0xc6, 0x6f, 0xa1, 0x61, 0x56,
+	0x02, 0xcf, 0x18, 0xc9, 0xa0, 0x95, 0xda, 0xfe, 0x25, 0x1e, 0x24, 0x7b, 0xce, 0x77, 0x64, 0x5c,
+	0xe1, 0x00, 0xf5, 0xdd, 0x10, 0xc3, 0x12, 0xed, 0xaf, 0x06, 0xe1, 0x1b, 0x7b, 0x1f, 0x8d, 0x2a,
+	0x2e, 0x79, 0xd5, 0x56, 0xc4, 0x0e, 0xb5, 0xe1, 0x4b, 0x66, 0x35, 0x7a, 0xc9, 0x81, 0x0f, 0x18,
+	0xe2, 0x3b, 0xbe, 0x64, 0x36, 0x97, 0x2e, 0x6e, 0xe5, 0xde, 0xf3, 0xb9, 0x74, 0xb1, 0x9e, 0x1b,
+	0x32, 0x34, 0x58, 0x55, 0xc2, 0x3f, 0xa2, 0x87, 0xd7, 0x0b, 0xb3, 0x7a, 0x63, 0xee, 0x9f, 0x1b,
+	0xef, 0xbc, 0x37, 0xce, 0x4c, 0xf6, 0xd5, 0x7f, 0x6c, 0xd3, 0x9a, 0x5b, 0x89, 0xf0, 0xcf, 0x2e,
+	0x1a, 0xdd, 0x19, 0x26, 0x3e, 0x46, 0x07, 0x0a, 0x5a, 0x6d, 0xaa, 0x33, 0x49, 0x53, 0xc1, 0x76,
+	0xbb, 0x33, 0xf6, 0x3d, 0x74, 0xec, 0x18, 0xfc, 0x15, 0x7a, 0x58, 0x71, 0x49, 0x32, 0xd1, 0x36,
+	0x9a, 0xa9, 0x9b, 0xc6, 0xff, 0x4f, 0xe7, 0xfb, 0xaf, 0xa5, 0xfe, 0xf4, 0x63, 0xaf, 0x53, 0x71,
+	0x79, 0xe4, 0x20, 0xfb, 0x06, 0x0f, 0xd1, 0xe3, 0x19, 0xe5, 0x82, 0x68, 0x45, 0x67, 0x33, 0x9e,
+	0x11, 0x90, 0xee, 0x4a, 0xb3, 0xb7, 0xc5, 0x20, 0xc1, 0x26, 0x38, 0x75, 0xb1, 0x33, 0x69, 0x2f,
+	0xac, 0x30, 0x44, 0xc1, 0xa6, 0xad, 0x19, 0xf7, 0x51, 0x4f, 0x5f, 0xd5, 0x6c, 0x3c, 0x42, 0x07,
+	0xd7, 0xdb, 0xe9, 0xde, 0xef, 0xf8, 0xf3, 0xdf, 0xff, 0xe9, 0x75, 0x7f, 0xfb, 0xfb, 0x49, 0xf7,
+	0xe2, 0xc3, 0xdd, 0x3e, 0x23, 0xf5, 0xcf, 0x85, 0xff, 0x1a, 0xa4, 0x7d, 0xdb, 0xcb, 0x47, 0xff,
+	0x0e, 0x00, 0x34, 0xf3, 0x08, 0x5c, 0x81, 0x06, 0x00, 0x00,
 }
 
 func (this *LoadBalancerConfig) Equal(that interface{}) bool {
@@ -483,6 +635,15 @@ func (this *LoadBalancerConfig) Equal(that interface{}) bool {
 	} else if !this.Type.Equal(that1.Type) {
 		return false
 	}
+	if that1.LocalityConfig == nil {
+		if this.LocalityConfig != nil {
+			return false
+		}
+	} else if this.LocalityConfig == nil {
+		return false
+	} else if !this.LocalityConfig.Equal(that1.LocalityConfig) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -608,6 +769,54 @@ func (this *LoadBalancerConfig_Maglev_) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *LoadBalancerConfig_ZoneAwareLbConfig_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LoadBalancerConfig_ZoneAwareLbConfig_)
+	if !ok {
+		that2, ok := that.(LoadBalancerConfig_ZoneAwareLbConfig_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ZoneAwareLbConfig.Equal(that1.ZoneAwareLbConfig) {
+		return false
+	}
+	return true
+}
+func (this *LoadBalancerConfig_LocalityWeightedLbConfig_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LoadBalancerConfig_LocalityWeightedLbConfig_)
+	if !ok {
+		that2, ok := that.(LoadBalancerConfig_LocalityWeightedLbConfig_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.LocalityWeightedLbConfig.Equal(that1.LocalityWeightedLbConfig) {
+		return false
+	}
+	return true
+}
 func (this *LoadBalancerConfig_RoundRobin) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -764,3 +973,60 @@ func (this *LoadBalancerConfig_Maglev) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *LoadBalancerConfig_ZoneAwareLbConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LoadBalancerConfig_ZoneAwareLbConfig)
+	if !ok {
+		that2, ok := that.(LoadBalancerConfig_ZoneAwareLbConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RoutingEnabled.Equal(that1.RoutingEnabled) {
+		return false
+	}
+	if !this.MinClusterSize.Equal(that1.MinClusterSize) {
+		return false
+	}
+	if this.FailTrafficOnPanic != that1.FailTrafficOnPanic {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *LoadBalancerConfig_LocalityWeightedLbConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LoadBalancerConfig_LocalityWeightedLbConfig)
+	if !ok {
+		that2, ok := that.(LoadBalancerConfig_LocalityWeightedLbConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -58,7 +58,26 @@ type UpstreamSpec struct {
 	// If set, will use the EC2 public IP address. Defaults to the private IP address.
 	PublicIp bool `protobuf:"varint,4,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`
 	// If set, will use this port on EC2 instances. Defaults to port 80.
-	Port                 uint32   `protobuf:"varint,5,opt,name=port,proto3" json:"port,omitempty"`
+	Port uint32 `protobuf:"varint,5,opt,name=port,proto3" json:"port,omitempty"`
+	// Additional AWS Regions where the desired EC2 instances exist.
+	// Instances are listed once per region and credentials, and the endpoints of all the regions are merged.
+	Regions []string `protobuf:"bytes,8,rep,name=regions,proto3" json:"regions,omitempty"`
+	// Only select instances in one of these states, as reported by EC2 (`pending`, `running`, `shutting-down`,
+	// `stopping` or `stopped`). Defaults to `running`.
+	InstanceStates []string `protobuf:"bytes,9,rep,name=instance_states,json=instanceStates,proto3" json:"instance_states,omitempty"`
+	// Only select instances with one of these lifecycles (`on-demand`, `spot` or `scheduled`).
+	// Defaults to instances of any lifecycle.
+	InstanceLifecycles []string `protobuf:"bytes,10,rep,name=instance_lifecycles,json=instanceLifecycles,proto3" json:"instance_lifecycles,omitempty"`
+	// Only select instances that are members of one of these Auto Scaling groups, as reported by the
+	// `aws:autoscaling:groupName` instance tag.
+	AutoScalingGroups []string `protobuf:"bytes,11,rep,name=auto_scaling_groups,json=autoScalingGroups,proto3" json:"auto_scaling_groups,omitempty"`
+	// If set, the port of each instance is read from the value of the instance tag with this key.
+	// Instances without a valid port in the tag use `port`.
+	PortTag string `protobuf:"bytes,12,opt,name=port_tag,json=portTag,proto3" json:"port_tag,omitempty"`
+	// If set, the health of the endpoints is set from the EC2 status checks of their instance:
+	// instances that pass both the instance and the system status checks are healthy, instances that fail either
+	// are unhealthy. Otherwise the health of the endpoints is set from the state of their instance.
+	UseStatusChecks      bool     `protobuf:"varint,13,opt,name=use_status_checks,json=useStatusChecks,proto3" json:"use_status_checks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -130,6 +149,48 @@ func (m *UpstreamSpec) GetPort() uint32 {
 	return 0
 }
 
+func (m *UpstreamSpec) GetRegions() []string {
+	if m != nil {
+		return m.Regions
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetInstanceStates() []string {
+	if m != nil {
+		return m.InstanceStates
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetInstanceLifecycles() []string {
+	if m != nil {
+		return m.InstanceLifecycles
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetAutoScalingGroups() []string {
+	if m != nil {
+		return m.AutoScalingGroups
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetPortTag() string {
+	if m != nil {
+		return m.PortTag
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetUseStatusChecks() bool {
+	if m != nil {
+		return m.UseStatusChecks
+	}
+	return false
+}
+
 type TagFilter struct {
 	// Types that are valid to be assigned to Spec:
 	//	*TagFilter_Key
@@ -267,33 +328,40 @@ func init() {
 }
 
 var fileDescriptor_b14583d3ecc23381 = []byte{
-	// 407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xb1, 0x6e, 0xd4, 0x40,
-	0x10, 0xcd, 0x72, 0x17, 0xdf, 0x79, 0x03, 0x12, 0x5a, 0x45, 0xc8, 0x77, 0x20, 0x64, 0xa5, 0xc1,
-	0x0d, 0x6b, 0x38, 0x1a, 0x4a, 0x92, 0x02, 0x25, 0xa1, 0x41, 0x0b, 0x34, 0x34, 0x96, 0x6f, 0x35,
-	0x36, 0x8b, 0x9d, 0x9b, 0xd5, 0xec, 0xde, 0x11, 0xfe, 0x87, 0x82, 0x4f, 0xe0, 0x7b, 0xf8, 0x01,
-	0x2a, 0x7a, 0xe4, 0xdd, 0x33, 0xa2, 0x21, 0x4a, 0xe5, 0x79, 0xcf, 0x6f, 0xe6, 0xcd, 0xd3, 0x0e,
-	0xbf, 0x6c, 0x8d, 0xff, 0xb4, 0x5d, 0x4b, 0x8d, 0x57, 0xa5, 0xc3, 0x1e, 0x9f, 0x1a, 0x2c, 0xdb,
-	0x1e, 0xb1, 0xb4, 0x84, 0x9f, 0x41, 0x7b, 0x17, 0x51, 0x6d, 0x4d, 0xb9, 0x7b, 0x5e, 0xa2, 0xf5,
-	0x06, 0x37, 0xae, 0xac, 0xbf, 0xb8, 0x12, 0xf4, 0x6a, 0xf8, 0x56, 0xa0, 0x57, 0xd2, 0x12, 0x7a,
-	0x14, 0x8f, 0x46, 0xb8, 0x97, 0xc9, 0xa1, 0x55, 0x0e, 0x53, 0xa5, 0xc1, 0xe5, 0x71, 0x8b, 0x2d,
-	0x06, 0x61, 0x39, 0x54, 0xb1, 0x67, 0x29, 0xe0, 0xda, 0x47, 0x12, 0xae, 0xfd, 0x9e, 0x5b, 0x84,
-	0x45, 0x3a, 0xe3, 0x47, 0x5b, 0x82, 0x26, 0xfe, 0x3a, 0xf9, 0xc5, 0xf8, 0xdd, 0x0f, 0xd6, 0x79,
-	0x82, 0xfa, 0xea, 0x9d, 0x05, 0x2d, 0x1e, 0xf0, 0x84, 0xa0, 0x35, 0xb8, 0xc9, 0x58, 0xce, 0x8a,
-	0x54, 0xed, 0x91, 0x78, 0xc9, 0xb9, 0x03, 0x4d, 0xe0, 0x2b, 0x82, 0x26, 0xbb, 0x93, 0xb3, 0xe2,
-	0x68, 0xb5, 0x90, 0x1a, 0x09, 0xc6, 0x85, 0xa4, 0x02, 0x87, 0x5b, 0xd2, 0xa0, 0xa0, 0x51, 0x69,
-	0x14, 0x2b, 0x68, 0xc4, 0x82, 0xcf, 0x09, 0x7b, 0xa8, 0x6a, 0xda, 0x64, 0xb3, 0x30, 0x73, 0x36,
-	0xe0, 0x53, 0xda, 0x88, 0x53, 0x3e, 0x6b, 0x4c, 0xef, 0x81, 0x5c, 0x36, 0xc9, 0x27, 0xc5, 0xd1,
-	0xea, 0x89, 0xbc, 0x29, 0xb2, 0x7c, 0x5f, 0xb7, 0xaf, 0x83, 0x5e, 0x8d, 0x7d, 0xe2, 0x21, 0x4f,
-	0xed, 0x76, 0xdd, 0x1b, 0x5d, 0x19, 0x9b, 0x4d, 0x73, 0x56, 0xcc, 0xd5, 0x3c, 0x12, 0x17, 0x56,
-	0x08, 0x3e, 0xb5, 0x48, 0x3e, 0x3b, 0xcc, 0x59, 0x71, 0x4f, 0x85, 0xfa, 0xe4, 0x1b, 0xe3, 0xe9,
-	0xdf, 0x39, 0x42, 0xf0, 0x49, 0x07, 0x5f, 0x63, 0xd6, 0xf3, 0x03, 0x35, 0x00, 0x71, 0xc1, 0x67,
-	0xdd, 0xae, 0xb2, 0xb5, 0xa1, 0x7d, 0x4e, 0x79, 0xcb, 0xad, 0xe4, 0x9b, 0xdd, 0xdb, 0xda, 0xd0,
-	0xf9, 0x81, 0x4a, 0xba, 0x50, 0x2d, 0x9f, 0xf1, 0x24, 0x72, 0xe2, 0xfe, 0x3f, 0x46, 0xd1, 0xe6,
-	0x98, 0x1f, 0xee, 0xea, 0x7e, 0x0b, 0xc1, 0x24, 0x55, 0x11, 0x9c, 0x25, 0x7c, 0xea, 0x2c, 0xe8,
-	0xb3, 0xcb, 0x1f, 0xbf, 0xa7, 0xec, 0xfb, 0xcf, 0xc7, 0xec, 0xe3, 0xab, 0xdb, 0x5d, 0x94, 0xed,
-	0xda, 0xff, 0x5c, 0xd5, 0x3a, 0x09, 0x6f, 0xfd, 0xe2, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x37,
-	0x4d, 0xe4, 0xa9, 0x9c, 0x02, 0x00, 0x00,
+	// 525 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0x5f, 0x68, 0xd7, 0x36, 0xee, 0xc6, 0x98, 0x37, 0x21, 0xb7, 0x20, 0x14, 0xed, 0xb2, 0x0a,
+	0x09, 0x07, 0xca, 0x85, 0x23, 0x1b, 0x12, 0x6c, 0x83, 0x03, 0x4a, 0xc7, 0x85, 0x4b, 0xe4, 0x5a,
+	0x5f, 0x3c, 0x93, 0x2c, 0xb6, 0x6c, 0xa7, 0x6c, 0xef, 0xc3, 0x81, 0x47, 0xe0, 0x59, 0x38, 0xf2,
+	0x0e, 0xdc, 0x51, 0xec, 0xa6, 0xe2, 0x02, 0xda, 0x29, 0xfe, 0xfd, 0xf9, 0xfc, 0xfd, 0xec, 0x2f,
+	0x46, 0x17, 0x42, 0xba, 0xab, 0x66, 0x49, 0xb9, 0xba, 0x4e, 0xad, 0xaa, 0xd4, 0x33, 0xa9, 0x52,
+	0x51, 0x29, 0x95, 0x6a, 0xa3, 0xbe, 0x00, 0x77, 0x36, 0x20, 0xa6, 0x65, 0xba, 0x7a, 0x91, 0x2a,
+	0xed, 0xa4, 0xaa, 0x6d, 0xca, 0xbe, 0xda, 0x14, 0xf8, 0xbc, 0xfd, 0xe6, 0xc0, 0xe7, 0x54, 0x1b,
+	0xe5, 0x14, 0x7e, 0xdc, 0xc1, 0xb5, 0x8d, 0xb6, 0xa5, 0xb4, 0xdd, 0x95, 0x4a, 0x35, 0x3d, 0x14,
+	0x4a, 0x28, 0x6f, 0x4c, 0xdb, 0x55, 0xa8, 0x99, 0x62, 0xb8, 0x71, 0x81, 0x84, 0x1b, 0xb7, 0xe6,
+	0x26, 0x3e, 0x48, 0x29, 0x5d, 0xd7, 0xd6, 0x40, 0x11, 0xa4, 0xa3, 0x9f, 0x3d, 0xb4, 0xf3, 0x49,
+	0x5b, 0x67, 0x80, 0x5d, 0x2f, 0x34, 0x70, 0xfc, 0x10, 0x0d, 0x0c, 0x08, 0xa9, 0x6a, 0x12, 0x25,
+	0xd1, 0x2c, 0xce, 0xd6, 0x08, 0xbf, 0x42, 0xc8, 0x02, 0x37, 0xe0, 0x72, 0x03, 0x05, 0xb9, 0x97,
+	0x44, 0xb3, 0xf1, 0x7c, 0x42, 0xb9, 0x32, 0xd0, 0x05, 0xa2, 0x19, 0x58, 0xd5, 0x18, 0x0e, 0x19,
+	0x14, 0x59, 0x1c, 0xcc, 0x19, 0x14, 0x78, 0x82, 0x46, 0x46, 0x55, 0x90, 0x33, 0x53, 0x93, 0xa1,
+	0xdf, 0x73, 0xd8, 0xe2, 0x13, 0x53, 0xe3, 0x13, 0x34, 0x2c, 0x64, 0xe5, 0xc0, 0x58, 0xd2, 0x4b,
+	0x7a, 0xb3, 0xf1, 0xfc, 0x98, 0xfe, 0xef, 0xc8, 0xf4, 0x92, 0x89, 0xb7, 0xde, 0x9f, 0x75, 0x75,
+	0xf8, 0x11, 0x8a, 0x75, 0xb3, 0xac, 0x24, 0xcf, 0xa5, 0x26, 0xfd, 0x24, 0x9a, 0x8d, 0xb2, 0x51,
+	0x20, 0xce, 0x35, 0xc6, 0xa8, 0xaf, 0x95, 0x71, 0x64, 0x3b, 0x89, 0x66, 0xbb, 0x99, 0x5f, 0x63,
+	0x82, 0x86, 0xe1, 0x48, 0x96, 0x8c, 0x92, 0x9e, 0x4f, 0x13, 0x20, 0x3e, 0x46, 0x7b, 0xb2, 0xb6,
+	0x8e, 0xd5, 0x1c, 0x72, 0xeb, 0x98, 0x03, 0x4b, 0x62, 0xef, 0xb8, 0xdf, 0xd1, 0x0b, 0xcf, 0xe2,
+	0x14, 0x1d, 0x6c, 0x8c, 0x95, 0x2c, 0x80, 0xdf, 0xf2, 0x0a, 0x2c, 0x41, 0xde, 0x8c, 0x3b, 0xe9,
+	0xc3, 0x46, 0xc1, 0x14, 0x1d, 0xb0, 0xc6, 0xa9, 0xdc, 0x72, 0x56, 0xc9, 0x5a, 0xe4, 0xc2, 0xa8,
+	0x46, 0x5b, 0x32, 0xf6, 0x05, 0xfb, 0xad, 0xb4, 0x08, 0xca, 0x3b, 0x2f, 0xb4, 0x57, 0xd6, 0x66,
+	0xcd, 0x1d, 0x13, 0x64, 0x27, 0x5c, 0x59, 0x8b, 0x2f, 0x99, 0xc0, 0x4f, 0xd1, 0x7e, 0x63, 0x43,
+	0xbe, 0xc6, 0xe6, 0xfc, 0x0a, 0x78, 0x69, 0xc9, 0xae, 0x3f, 0xf7, 0x5e, 0x63, 0x7d, 0xc2, 0xc6,
+	0xbe, 0xf1, 0xf4, 0xd1, 0xb7, 0x08, 0xc5, 0x9b, 0x2b, 0xc3, 0x18, 0xf5, 0x4a, 0xb8, 0x0d, 0x63,
+	0x3d, 0xdb, 0xca, 0x5a, 0x80, 0xcf, 0xd1, 0xb0, 0x5c, 0xe5, 0x9a, 0x49, 0xb3, 0x1e, 0x29, 0xbd,
+	0xe3, 0x00, 0xe8, 0xfb, 0xd5, 0x47, 0x26, 0xcd, 0xd9, 0x56, 0x36, 0x28, 0xfd, 0x6a, 0xfa, 0x1c,
+	0x0d, 0x02, 0x87, 0x1f, 0xfc, 0xd5, 0x28, 0xb4, 0x39, 0x44, 0xdb, 0x2b, 0x56, 0x35, 0xe0, 0x9b,
+	0xc4, 0x59, 0x00, 0xa7, 0x03, 0xd4, 0xb7, 0x1a, 0xf8, 0xe9, 0xc5, 0x8f, 0xdf, 0xfd, 0xe8, 0xfb,
+	0xaf, 0x27, 0xd1, 0xe7, 0xd7, 0x77, 0x7b, 0x3c, 0xba, 0x14, 0xff, 0x78, 0x40, 0xcb, 0x81, 0xff,
+	0xad, 0x5f, 0xfe, 0x19, 0x00, 0x99, 0x62, 0x77, 0x6d, 0x87, 0x03, 0x00, 0x00,
 }
 
 func (this *UpstreamSpec) Equal(that interface{}) bool {
@@ -338,6 +406,44 @@ func (this *UpstreamSpec) Equal(that interface{}) bool {
 	if this.Port != that1.Port {
 		return false
 	}
+	if len(this.Regions) != len(that1.Regions) {
+		return false
+	}
+	for i := range this.Regions {
+		if this.Regions[i] != that1.Regions[i] {
+			return false
+		}
+	}
+	if len(this.InstanceStates) != len(that1.InstanceStates) {
+		return false
+	}
+	for i := range this.InstanceStates {
+		if this.InstanceStates[i] != that1.InstanceStates[i] {
+			return false
+		}
+	}
+	if len(this.InstanceLifecycles) != len(that1.InstanceLifecycles) {
+		return false
+	}
+	for i := range this.InstanceLifecycles {
+		if this.InstanceLifecycles[i] != that1.InstanceLifecycles[i] {
+			return false
+		}
+	}
+	if len(this.AutoScalingGroups) != len(that1.AutoScalingGroups) {
+		return false
+	}
+	for i := range this.AutoScalingGroups {
+		if this.AutoScalingGroups[i] != that1.AutoScalingGroups[i] {
+			return false
+		}
+	}
+	if this.PortTag != that1.PortTag {
+		return false
+	}
+	if this.UseStatusChecks != that1.UseStatusChecks {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -0,0 +1,207 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/aws/ecs/aws_ecs.proto
+
+package ecs
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Upstream Spec for AWS ECS Upstreams
+// ECS Upstreams represent the running tasks of an ECS service, e.g. a service running on Fargate.
+// Gloo creates an endpoint for the container of each task, at the IP address of the task.
+type UpstreamSpec struct {
+	// The AWS Region where the ECS cluster exists
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	// Optional, if not set, Gloo will try to use the default AWS secret specified by environment variables.
+	// If set, a [Gloo Secret Ref](https://gloo.solo.io/introduction/concepts/#Secrets) to an AWS Secret
+	// AWS Secrets can be created with `glooctl secret create aws ...`
+	// Gloo will create an ECS API client with this credential, in the same way as for EC2 Upstreams.
+	SecretRef *core.ResourceRef `protobuf:"bytes,2,opt,name=secret_ref,json=secretRef,proto3" json:"secret_ref,omitempty"`
+	// Optional, Amazon Resource Number (ARN) referring to IAM Role that should be assumed when the Upstream
+	// queries for the tasks of the service.
+	RoleArn string `protobuf:"bytes,3,opt,name=role_arn,json=roleArn,proto3" json:"role_arn,omitempty"`
+	// The name or ARN of the ECS cluster that runs the service. Defaults to the `default` cluster.
+	Cluster string `protobuf:"bytes,4,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// The name of the ECS service whose tasks should be made into endpoints.
+	ServiceName string `protobuf:"bytes,5,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// The name of the container to route to, as named in the task definition.
+	// Defaults to the first container of each task.
+	ContainerName string `protobuf:"bytes,6,opt,name=container_name,json=containerName,proto3" json:"container_name,omitempty"`
+	// If set, will use this port on the container. Defaults to the first container port of the container's
+	// port mappings in the task definition.
+	Port                 uint32   `protobuf:"varint,7,opt,name=port,proto3" json:"port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpstreamSpec) Reset()         { *m = UpstreamSpec{} }
+func (m *UpstreamSpec) String() string { return proto.CompactTextString(m) }
+func (*UpstreamSpec) ProtoMessage()    {}
+func (*UpstreamSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_578c725082508558, []int{0}
+}
+func (m *UpstreamSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpstreamSpec.Unmarshal(m, b)
+}
+func (m *UpstreamSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpstreamSpec.Marshal(b, m, deterministic)
+}
+func (m *UpstreamSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpstreamSpec.Merge(m, src)
+}
+func (m *UpstreamSpec) XXX_Size() int {
+	return xxx_messageInfo_UpstreamSpec.Size(m)
+}
+func (m *UpstreamSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpstreamSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpstreamSpec proto.InternalMessageInfo
+
+func (m *UpstreamSpec) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetSecretRef() *core.ResourceRef {
+	if m != nil {
+		return m.SecretRef
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetRoleArn() string {
+	if m != nil {
+		return m.RoleArn
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetCluster() string {
+	if m != nil {
+		return m.Cluster
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetServiceName() string {
+	if m != nil {
+		return m.ServiceName
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetContainerName() string {
+	if m != nil {
+		return m.ContainerName
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*UpstreamSpec)(nil), "aws_ecs.options.gloo.solo.io.UpstreamSpec")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/options/aws/ecs/aws_ecs.proto", fileDescriptor_578c725082508558)
+}
+
+var fileDescriptor_578c725082508558 = []byte{
+	// 327 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0x15, 0x28, 0x2d, 0x75, 0x5b, 0x06, 0x0b, 0xa1, 0xb4, 0x42, 0xa8, 0x20, 0x21, 0x75,
+	0x21, 0x16, 0xb0, 0x30, 0x02, 0x63, 0x07, 0x86, 0x20, 0x16, 0x96, 0x28, 0xb5, 0x2e, 0xc1, 0x34,
+	0xf1, 0x59, 0x67, 0xb7, 0xf4, 0x91, 0x78, 0x04, 0x9e, 0x87, 0x57, 0x40, 0xec, 0x28, 0x76, 0xca,
+	0x86, 0xc4, 0x94, 0xfb, 0xff, 0xff, 0xbb, 0xcb, 0xc9, 0xc7, 0xe6, 0xa5, 0x72, 0x2f, 0xab, 0x45,
+	0x22, 0xb1, 0x16, 0x16, 0x2b, 0xbc, 0x50, 0x28, 0xca, 0x0a, 0x51, 0x18, 0xc2, 0x57, 0x90, 0xce,
+	0x06, 0x95, 0x1b, 0x25, 0xd6, 0x97, 0x02, 0x8d, 0x53, 0xa8, 0xad, 0xc8, 0xdf, 0xac, 0x00, 0xe9,
+	0xbf, 0x19, 0x48, 0x9b, 0x18, 0x42, 0x87, 0xfc, 0x78, 0x2b, 0x5b, 0x2c, 0x69, 0x5a, 0x93, 0x66,
+	0x6a, 0xa2, 0x70, 0x72, 0x58, 0x62, 0x89, 0x1e, 0x14, 0x4d, 0x15, 0x7a, 0x26, 0x1c, 0x36, 0x2e,
+	0x98, 0xb0, 0x71, 0xad, 0x37, 0xf6, 0x8b, 0x2c, 0x95, 0xdb, 0xfe, 0x96, 0xa0, 0x08, 0xd1, 0xd9,
+	0x57, 0xc4, 0x86, 0x4f, 0xc6, 0x3a, 0x82, 0xbc, 0x7e, 0x34, 0x20, 0xf9, 0x11, 0xeb, 0x12, 0x94,
+	0x0a, 0x75, 0x1c, 0x4d, 0xa3, 0x59, 0x3f, 0x6d, 0x15, 0xbf, 0x61, 0xcc, 0x82, 0x24, 0x70, 0x19,
+	0x41, 0x11, 0xef, 0x4c, 0xa3, 0xd9, 0xe0, 0x6a, 0x9c, 0x48, 0x24, 0xd8, 0x2e, 0x94, 0xa4, 0x60,
+	0x71, 0x45, 0x12, 0x52, 0x28, 0xd2, 0x7e, 0x80, 0x53, 0x28, 0xf8, 0x98, 0xed, 0x13, 0x56, 0x90,
+	0xe5, 0xa4, 0xe3, 0x5d, 0x3f, 0xb3, 0xd7, 0xe8, 0x3b, 0xd2, 0x3c, 0x66, 0x3d, 0x59, 0xad, 0xac,
+	0x03, 0x8a, 0x3b, 0x21, 0x69, 0x25, 0x3f, 0x65, 0x43, 0x0b, 0xb4, 0x56, 0x12, 0x32, 0x9d, 0xd7,
+	0x10, 0xef, 0xf9, 0x78, 0xd0, 0x7a, 0x0f, 0x79, 0x0d, 0xfc, 0x9c, 0x1d, 0x48, 0xd4, 0x2e, 0x57,
+	0x1a, 0x28, 0x40, 0x5d, 0x0f, 0x8d, 0x7e, 0x5d, 0x8f, 0x71, 0xd6, 0x31, 0x48, 0x2e, 0xee, 0x4d,
+	0xa3, 0xd9, 0x28, 0xf5, 0xf5, 0xfd, 0xfc, 0xe3, 0xbb, 0x13, 0xbd, 0x7f, 0x9e, 0x44, 0xcf, 0xb7,
+	0xff, 0x3b, 0x97, 0x59, 0x96, 0x7f, 0x9c, 0x6c, 0xd1, 0xf5, 0x0f, 0x79, 0xfd, 0x33, 0x00, 0x32,
+	0x57, 0x7f, 0x4a, 0xf9, 0x01, 0x00, 0x00,
+}
+
+func (this *UpstreamSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSpec)
+	if !ok {
+		that2, ok := that.(UpstreamSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Region != that1.Region {
+		return false
+	}
+	if !this.SecretRef.Equal(that1.SecretRef) {
+		return false
+	}
+	if this.RoleArn != that1.RoleArn {
+		return false
+	}
+	if this.Cluster != that1.Cluster {
+		return false
+	}
+	if this.ServiceName != that1.ServiceName {
+		return false
+	}
+	if this.ContainerName != that1.ContainerName {
+		return false
+	}
+	if this.Port != that1.Port {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -48,6 +48,8 @@ type UpstreamSpec struct {
 	// An optional Service Spec describing the service listening at this address
 	ServiceSpec *options.ServiceSpec `protobuf:"bytes,3,opt,name=service_spec,json=serviceSpec,proto3" json:"service_spec,omitempty"`
 	// Is this consul service connect enabled.
+	// If true, the endpoints of the upstream are the Connect proxies of the service, and Gloo connects to them
+	// with mutual TLS, using a leaf certificate and the CA roots served by the Consul agent.
 	ConnectEnabled bool `protobuf:"varint,4,opt,name=connect_enabled,json=connectEnabled,proto3" json:"connect_enabled,omitempty"`
 	// The data centers in which the service instance represented by this upstream is registered.
 	DataCenters          []string `protobuf:"bytes,5,rep,name=data_centers,json=dataCenters,proto3" json:"data_centers,omitempty"`
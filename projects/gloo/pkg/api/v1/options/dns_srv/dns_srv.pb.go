@@ -0,0 +1,179 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/dns_srv/dns_srv.proto
+
+package dns_srv
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Upstream Spec for DNS SRV Upstreams
+// DNS SRV Upstreams route to the targets of the SRV records of a DNS name, such as the services registered in Nomad.
+// Gloo resolves the records periodically, and creates an endpoint for each address of each target, with the port,
+// weight and priority of its record.
+type UpstreamSpec struct {
+	// The name of the SRV records, e.g. `_http._tcp.example.com`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional, the address (`host:port`) of the DNS server to query.
+	// Defaults to the name servers in `/etc/resolv.conf`.
+	DnsServer string `protobuf:"bytes,2,opt,name=dns_server,json=dnsServer,proto3" json:"dns_server,omitempty"`
+	// How often the records are resolved. Defaults to 30 seconds.
+	RefreshInterval *types.Duration `protobuf:"bytes,3,opt,name=refresh_interval,json=refreshInterval,proto3" json:"refresh_interval,omitempty"`
+	// If set, the records are resolved again when their TTL expires, if it expires before the refresh interval.
+	RespectTtl bool `protobuf:"varint,4,opt,name=respect_ttl,json=respectTtl,proto3" json:"respect_ttl,omitempty"`
+	// When respecting TTLs, the shortest time between two resolutions, so that records with very short TTLs
+	// do not overload the DNS server. Defaults to 5 seconds.
+	MinTtl               *types.Duration `protobuf:"bytes,5,opt,name=min_ttl,json=minTtl,proto3" json:"min_ttl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *UpstreamSpec) Reset()         { *m = UpstreamSpec{} }
+func (m *UpstreamSpec) String() string { return proto.CompactTextString(m) }
+func (*UpstreamSpec) ProtoMessage()    {}
+func (*UpstreamSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d32b2c56c0a5f1a, []int{0}
+}
+func (m *UpstreamSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpstreamSpec.Unmarshal(m, b)
+}
+func (m *UpstreamSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpstreamSpec.Marshal(b, m, deterministic)
+}
+func (m *UpstreamSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpstreamSpec.Merge(m, src)
+}
+func (m *UpstreamSpec) XXX_Size() int {
+	return xxx_messageInfo_UpstreamSpec.Size(m)
+}
+func (m *UpstreamSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpstreamSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpstreamSpec proto.InternalMessageInfo
+
+func (m *UpstreamSpec) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetDnsServer() string {
+	if m != nil {
+		return m.DnsServer
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetRefreshInterval() *types.Duration {
+	if m != nil {
+		return m.RefreshInterval
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetRespectTtl() bool {
+	if m != nil {
+		return m.RespectTtl
+	}
+	return false
+}
+
+func (m *UpstreamSpec) GetMinTtl() *types.Duration {
+	if m != nil {
+		return m.MinTtl
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*UpstreamSpec)(nil), "dns_srv.options.gloo.solo.io.UpstreamSpec")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/options/dns_srv/dns_srv.proto", fileDescriptor_2d32b2c56c0a5f1a)
+}
+
+var fileDescriptor_2d32b2c56c0a5f1a = []byte{
+	// 305 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xb1, 0x4e, 0x2b, 0x31,
+	0x10, 0xd4, 0xbd, 0x17, 0x02, 0x71, 0x90, 0x40, 0x16, 0xc5, 0x11, 0x41, 0x88, 0xa8, 0xd2, 0x60,
+	0x8b, 0xf0, 0x03, 0x08, 0xa5, 0x21, 0x65, 0x12, 0x1a, 0x9a, 0xe8, 0x72, 0xd9, 0x38, 0x06, 0x9f,
+	0xd7, 0xb2, 0x37, 0xa7, 0x7c, 0x12, 0x9f, 0xc0, 0xc7, 0x50, 0xf1, 0x0f, 0xf4, 0xe8, 0x7c, 0x97,
+	0x12, 0x41, 0xe5, 0x9d, 0x99, 0x9d, 0x19, 0x4b, 0xcb, 0x26, 0x4a, 0xd3, 0x66, 0xbb, 0x14, 0x39,
+	0x16, 0x32, 0xa0, 0xc1, 0x1b, 0x8d, 0x52, 0x19, 0x44, 0xe9, 0x3c, 0xbe, 0x40, 0x4e, 0xa1, 0x46,
+	0x99, 0xd3, 0xb2, 0xbc, 0x95, 0xe8, 0x48, 0xa3, 0x0d, 0x72, 0x65, 0xc3, 0x22, 0xf8, 0x72, 0xff,
+	0x0a, 0xe7, 0x91, 0x90, 0x5f, 0xec, 0x61, 0xb3, 0x26, 0x2a, 0xab, 0xa8, 0x52, 0x85, 0xc6, 0xde,
+	0x99, 0x42, 0x85, 0x71, 0x51, 0x56, 0x53, 0xed, 0xe9, 0x71, 0xd8, 0x51, 0x4d, 0xc2, 0x8e, 0x1a,
+	0xae, 0xaf, 0x10, 0x95, 0x01, 0x19, 0xd1, 0x72, 0xbb, 0x96, 0xab, 0xad, 0xcf, 0xaa, 0xc4, 0x5a,
+	0xbf, 0xfe, 0x48, 0xd8, 0xf1, 0x93, 0x0b, 0xe4, 0x21, 0x2b, 0x66, 0x0e, 0x72, 0xce, 0x59, 0xcb,
+	0x66, 0x05, 0xa4, 0xc9, 0x20, 0x19, 0x76, 0xa6, 0x71, 0xe6, 0x97, 0x8c, 0xc5, 0xef, 0x80, 0x2f,
+	0xc1, 0xa7, 0xff, 0xa2, 0xd2, 0x59, 0xd9, 0x30, 0x8b, 0x04, 0x1f, 0xb3, 0x53, 0x0f, 0x6b, 0x0f,
+	0x61, 0xb3, 0xd0, 0x96, 0xc0, 0x97, 0x99, 0x49, 0xff, 0x0f, 0x92, 0x61, 0x77, 0x74, 0x2e, 0xea,
+	0x7a, 0xb1, 0xaf, 0x17, 0xe3, 0xa6, 0x7e, 0x7a, 0xd2, 0x58, 0x1e, 0x1b, 0x07, 0xbf, 0x62, 0x5d,
+	0x0f, 0xc1, 0x41, 0x4e, 0x0b, 0x22, 0x93, 0xb6, 0x06, 0xc9, 0xf0, 0x68, 0xca, 0x1a, 0x6a, 0x4e,
+	0x86, 0x8f, 0xd8, 0x61, 0xa1, 0x6d, 0x14, 0x0f, 0x7e, 0x4b, 0x6f, 0x17, 0xda, 0xce, 0xc9, 0x3c,
+	0x4c, 0xde, 0xbf, 0x5a, 0xc9, 0xdb, 0x67, 0x3f, 0x79, 0xbe, 0xff, 0xdb, 0x71, 0xdc, 0xab, 0xfa,
+	0xe1, 0x40, 0xcb, 0x76, 0xac, 0xb9, 0xfb, 0x1e, 0x00, 0x06, 0xae, 0xcd, 0xc5, 0xe7, 0x01, 0x00,
+	0x00,
+}
+
+func (this *UpstreamSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSpec)
+	if !ok {
+		that2, ok := that.(UpstreamSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.DnsServer != that1.DnsServer {
+		return false
+	}
+	if !this.RefreshInterval.Equal(that1.RefreshInterval) {
+		return false
+	}
+	if this.RespectTtl != that1.RespectTtl {
+		return false
+	}
+	if !this.MinTtl.Equal(that1.MinTtl) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
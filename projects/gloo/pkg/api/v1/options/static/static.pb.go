@@ -7,10 +7,11 @@ import (
 	bytes "bytes"
 	fmt "fmt"
 	math "math"
+	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
-	_ "github.com/gogo/protobuf/types"
+	types "github.com/gogo/protobuf/types"
 	options "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 )
@@ -19,6 +20,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +28,68 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// How Envoy resolves hostnames in this upstream.
+// This and the other DNS options can only be set if at least one host is a DNS name.
+type UpstreamSpec_DnsDiscoveryType int32
+
+const (
+	// Resolve every host and load balance across all the returned addresses (default).
+	UpstreamSpec_STRICT_DNS UpstreamSpec_DnsDiscoveryType = 0
+	// Use only the first address returned by DNS for new connections.
+	// Requires exactly one host.
+	UpstreamSpec_LOGICAL_DNS UpstreamSpec_DnsDiscoveryType = 1
+)
+
+var UpstreamSpec_DnsDiscoveryType_name = map[int32]string{
+	0: "STRICT_DNS",
+	1: "LOGICAL_DNS",
+}
+
+var UpstreamSpec_DnsDiscoveryType_value = map[string]int32{
+	"STRICT_DNS":  0,
+	"LOGICAL_DNS": 1,
+}
+
+func (x UpstreamSpec_DnsDiscoveryType) String() string {
+	return proto.EnumName(UpstreamSpec_DnsDiscoveryType_name, int32(x))
+}
+
+func (UpstreamSpec_DnsDiscoveryType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c08b3c87c0f36512, []int{0, 0}
+}
+
+// Address families used when resolving hostnames.
+type UpstreamSpec_DnsLookupFamily int32
+
+const (
+	// Resolve only IPv4 addresses (default).
+	UpstreamSpec_V4_ONLY UpstreamSpec_DnsLookupFamily = 0
+	// Resolve only IPv6 addresses.
+	UpstreamSpec_V6_ONLY UpstreamSpec_DnsLookupFamily = 1
+	// Prefer IPv6 addresses, falling back to IPv4.
+	UpstreamSpec_AUTO UpstreamSpec_DnsLookupFamily = 2
+)
+
+var UpstreamSpec_DnsLookupFamily_name = map[int32]string{
+	0: "V4_ONLY",
+	1: "V6_ONLY",
+	2: "AUTO",
+}
+
+var UpstreamSpec_DnsLookupFamily_value = map[string]int32{
+	"V4_ONLY": 0,
+	"V6_ONLY": 1,
+	"AUTO":    2,
+}
+
+func (x UpstreamSpec_DnsLookupFamily) String() string {
+	return proto.EnumName(UpstreamSpec_DnsLookupFamily_name, int32(x))
+}
+
+func (UpstreamSpec_DnsLookupFamily) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c08b3c87c0f36512, []int{0, 1}
+}
+
 // Static upstreams are used to route request to services listening at fixed IP/Host & Port pairs.
 // Static upstreams can be used to proxy any kind of service, and therefore contain a ServiceSpec
 // for additional service-specific configuration.
@@ -38,10 +102,20 @@ type UpstreamSpec struct {
 	// Gloo will automatically set this to true for port 443
 	UseTls bool `protobuf:"varint,3,opt,name=use_tls,json=useTls,proto3" json:"use_tls,omitempty"`
 	// An optional Service Spec describing the service listening at this address
-	ServiceSpec          *options.ServiceSpec `protobuf:"bytes,5,opt,name=service_spec,json=serviceSpec,proto3" json:"service_spec,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	ServiceSpec      *options.ServiceSpec          `protobuf:"bytes,5,opt,name=service_spec,json=serviceSpec,proto3" json:"service_spec,omitempty"`
+	DnsDiscoveryType UpstreamSpec_DnsDiscoveryType `protobuf:"varint,6,opt,name=dns_discovery_type,json=dnsDiscoveryType,proto3,enum=static.options.gloo.solo.io.UpstreamSpec_DnsDiscoveryType" json:"dns_discovery_type,omitempty"`
+	DnsLookupFamily  UpstreamSpec_DnsLookupFamily  `protobuf:"varint,7,opt,name=dns_lookup_family,json=dnsLookupFamily,proto3,enum=static.options.gloo.solo.io.UpstreamSpec_DnsLookupFamily" json:"dns_lookup_family,omitempty"`
+	// Use the TTL of the DNS records as the refresh rate, instead of `dns_refresh_rate`.
+	RespectDnsTtl bool `protobuf:"varint,8,opt,name=respect_dns_ttl,json=respectDnsTtl,proto3" json:"respect_dns_ttl,omitempty"`
+	// How often hostnames are resolved again. Envoy defaults to 5 seconds if not set.
+	// Must be greater than 1ms.
+	DnsRefreshRate *time.Duration `protobuf:"bytes,9,opt,name=dns_refresh_rate,json=dnsRefreshRate,proto3,stdduration" json:"dns_refresh_rate,omitempty"`
+	// Custom DNS resolvers to use instead of the system ones, in the form `ip` or `ip:port`.
+	// The port defaults to 53.
+	DnsResolvers         []string `protobuf:"bytes,10,rep,name=dns_resolvers,json=dnsResolvers,proto3" json:"dns_resolvers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UpstreamSpec) Reset()         { *m = UpstreamSpec{} }
@@ -89,12 +163,54 @@ func (m *UpstreamSpec) GetServiceSpec() *options.ServiceSpec {
 	return nil
 }
 
+func (m *UpstreamSpec) GetDnsDiscoveryType() UpstreamSpec_DnsDiscoveryType {
+	if m != nil {
+		return m.DnsDiscoveryType
+	}
+	return UpstreamSpec_STRICT_DNS
+}
+
+func (m *UpstreamSpec) GetDnsLookupFamily() UpstreamSpec_DnsLookupFamily {
+	if m != nil {
+		return m.DnsLookupFamily
+	}
+	return UpstreamSpec_V4_ONLY
+}
+
+func (m *UpstreamSpec) GetRespectDnsTtl() bool {
+	if m != nil {
+		return m.RespectDnsTtl
+	}
+	return false
+}
+
+func (m *UpstreamSpec) GetDnsRefreshRate() *time.Duration {
+	if m != nil {
+		return m.DnsRefreshRate
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetDnsResolvers() []string {
+	if m != nil {
+		return m.DnsResolvers
+	}
+	return nil
+}
+
 // Represents a single instance of an upstream
 type Host struct {
 	// Address (hostname or IP)
 	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 	// Port the instance is listening on
-	Port                 uint32   `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// Relative weight of the instance when load balancing between the hosts.
+	// The instance gets the default weight (1) if not set. Must be at least 1 if set.
+	LoadBalancingWeight *types.UInt32Value `protobuf:"bytes,3,opt,name=load_balancing_weight,json=loadBalancingWeight,proto3" json:"load_balancing_weight,omitempty"`
+	// SNI to send when connecting to this host over TLS.
+	// Defaults to the first hostname of the upstream if not set.
+	// Requires the upstream to use TLS, with `use_tls`, port 443 or an ssl config.
+	SniAddr              string   `protobuf:"bytes,4,opt,name=sni_addr,json=sniAddr,proto3" json:"sni_addr,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -138,7 +254,23 @@ func (m *Host) GetPort() uint32 {
 	return 0
 }
 
+func (m *Host) GetLoadBalancingWeight() *types.UInt32Value {
+	if m != nil {
+		return m.LoadBalancingWeight
+	}
+	return nil
+}
+
+func (m *Host) GetSniAddr() string {
+	if m != nil {
+		return m.SniAddr
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("static.options.gloo.solo.io.UpstreamSpec_DnsDiscoveryType", UpstreamSpec_DnsDiscoveryType_name, UpstreamSpec_DnsDiscoveryType_value)
+	proto.RegisterEnum("static.options.gloo.solo.io.UpstreamSpec_DnsLookupFamily", UpstreamSpec_DnsLookupFamily_name, UpstreamSpec_DnsLookupFamily_value)
 	proto.RegisterType((*UpstreamSpec)(nil), "static.options.gloo.solo.io.UpstreamSpec")
 	proto.RegisterType((*Host)(nil), "static.options.gloo.solo.io.Host")
 }
@@ -148,27 +280,46 @@ func init() {
 }
 
 var fileDescriptor_c08b3c87c0f36512 = []byte{
-	// 310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xcb, 0x4e, 0xc3, 0x30,
-	0x10, 0x94, 0xe9, 0x03, 0x70, 0xcb, 0xc5, 0x42, 0x22, 0x2a, 0x52, 0x15, 0x7a, 0xca, 0x05, 0x5b,
-	0x3c, 0x24, 0x8e, 0x48, 0x88, 0x43, 0xb9, 0xa6, 0x70, 0xe1, 0x52, 0xa5, 0xa9, 0x71, 0x0d, 0x29,
-	0x6b, 0x79, 0x37, 0xa5, 0x5f, 0x84, 0xf8, 0x04, 0xbe, 0x87, 0x7f, 0xe0, 0x8e, 0x92, 0x58, 0x82,
-	0x43, 0x85, 0x7a, 0xca, 0xcc, 0x64, 0x76, 0x76, 0xe4, 0xe5, 0x63, 0x63, 0x69, 0x51, 0xce, 0x64,
-	0x0e, 0x4b, 0x85, 0x50, 0xc0, 0xa9, 0x05, 0x65, 0x0a, 0x00, 0xe5, 0x3c, 0x3c, 0xeb, 0x9c, 0xb0,
-	0x61, 0x99, 0xb3, 0x6a, 0x75, 0xa6, 0xc0, 0x91, 0x85, 0x57, 0x54, 0x48, 0x19, 0xd9, 0x3c, 0x7c,
-	0xa4, 0xf3, 0x40, 0x20, 0x8e, 0x03, 0x0b, 0x1e, 0x59, 0xcd, 0xc9, 0x2a, 0x52, 0x5a, 0x18, 0x1c,
-	0x1a, 0x30, 0x50, 0xfb, 0x54, 0x85, 0x9a, 0x91, 0x81, 0xd0, 0x6b, 0x6a, 0x44, 0xbd, 0xa6, 0xa0,
-	0x0d, 0x0d, 0x80, 0x29, 0xb4, 0xaa, 0xd9, 0xac, 0x7c, 0x52, 0x6f, 0x3e, 0x73, 0x4e, 0x7b, 0x0c,
-	0xff, 0x2f, 0xb7, 0x68, 0xa7, 0xfd, 0xca, 0xe6, 0x7a, 0x8a, 0x4e, 0x87, 0x72, 0xa3, 0x77, 0xc6,
-	0xfb, 0x0f, 0x0e, 0xc9, 0xeb, 0x6c, 0x39, 0x71, 0x3a, 0x17, 0x57, 0xbc, 0xb3, 0x00, 0x24, 0x8c,
-	0x58, 0xdc, 0x4a, 0x7a, 0xe7, 0x27, 0xf2, 0x9f, 0xf6, 0x72, 0x0c, 0x48, 0x69, 0xe3, 0x17, 0x47,
-	0x7c, 0xb7, 0x44, 0x3d, 0xa5, 0x02, 0xa3, 0x56, 0xcc, 0x92, 0xbd, 0xb4, 0x5b, 0xa2, 0xbe, 0x2f,
-	0x50, 0xdc, 0xf2, 0xfe, 0xdf, 0xc5, 0x51, 0x27, 0x66, 0x75, 0xf0, 0xc6, 0xc4, 0x49, 0xe3, 0xac,
-	0xaa, 0xa4, 0x3d, 0xfc, 0x25, 0x23, 0xc9, 0xdb, 0xd5, 0x36, 0x21, 0x78, 0x3b, 0x9b, 0xcf, 0x7d,
-	0xc4, 0x62, 0x96, 0xec, 0xa7, 0x35, 0xae, 0x34, 0x07, 0x9e, 0xa2, 0x9d, 0x98, 0x25, 0x07, 0x69,
-	0x8d, 0x6f, 0xee, 0x3e, 0xbf, 0xdb, 0xec, 0xe3, 0x6b, 0xc8, 0x1e, 0xaf, 0xb7, 0xbb, 0xa4, 0x7b,
-	0x31, 0x9b, 0xaf, 0x39, 0xeb, 0xd6, 0x4f, 0x75, 0xf1, 0x13, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x39,
-	0xc5, 0x9b, 0x13, 0x02, 0x00, 0x00,
+	// 617 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0x5e, 0xb6, 0x6c, 0xed, 0xdc, 0x6d, 0x2d, 0x06, 0x44, 0x36, 0xd0, 0x08, 0x45, 0x42, 0xbd,
+	0x21, 0x11, 0xdd, 0x00, 0xc1, 0x0d, 0xda, 0x56, 0xc1, 0x2a, 0x55, 0x1b, 0x72, 0xbb, 0x21, 0xb8,
+	0xb1, 0xdc, 0xc4, 0x4b, 0xcd, 0xbc, 0xd8, 0xb2, 0x9d, 0x6e, 0x7d, 0x13, 0x24, 0x5e, 0x80, 0x47,
+	0xe0, 0x6d, 0x90, 0x78, 0x07, 0xae, 0xb8, 0x41, 0x71, 0x32, 0x31, 0xca, 0x98, 0xc6, 0x55, 0x7c,
+	0xbe, 0x9c, 0xef, 0xc7, 0x47, 0x47, 0x06, 0xbb, 0x09, 0x33, 0xa3, 0x6c, 0x18, 0x44, 0xe2, 0x24,
+	0xd4, 0x82, 0x8b, 0xc7, 0x4c, 0x84, 0x09, 0x17, 0x22, 0x94, 0x4a, 0x7c, 0xa4, 0x91, 0xd1, 0x45,
+	0x45, 0x24, 0x0b, 0xc7, 0x4f, 0x42, 0x21, 0x0d, 0x13, 0xa9, 0x0e, 0xb5, 0x21, 0x86, 0x45, 0xe5,
+	0x27, 0x90, 0x4a, 0x18, 0x01, 0xef, 0x96, 0x55, 0xd9, 0x13, 0xe4, 0xbc, 0x20, 0x97, 0x0c, 0x98,
+	0x58, 0xbb, 0x95, 0x88, 0x44, 0xd8, 0xbe, 0x30, 0x3f, 0x15, 0x94, 0x35, 0x48, 0xcf, 0x4c, 0x01,
+	0xd2, 0x33, 0x53, 0x62, 0xeb, 0x89, 0x10, 0x09, 0xa7, 0xa1, 0xad, 0x86, 0xd9, 0x51, 0x78, 0xaa,
+	0x88, 0x94, 0x54, 0xe9, 0x7f, 0xfd, 0x8f, 0x33, 0x45, 0x72, 0xc7, 0xf2, 0xff, 0xe6, 0x35, 0xd2,
+	0x53, 0x35, 0x66, 0x11, 0xc5, 0x5a, 0xd2, 0x32, 0x7c, 0xf3, 0xa7, 0x0b, 0x96, 0x0e, 0xa4, 0x36,
+	0x8a, 0x92, 0x93, 0xbe, 0xa4, 0x11, 0x7c, 0x0e, 0xe6, 0x47, 0x42, 0x1b, 0xed, 0x39, 0xfe, 0x5c,
+	0xab, 0xd6, 0x7e, 0x10, 0x5c, 0x71, 0xbb, 0x60, 0x57, 0x68, 0x83, 0x8a, 0x7e, 0x78, 0x07, 0x54,
+	0x32, 0x4d, 0xb1, 0xe1, 0xda, 0x9b, 0xf3, 0x9d, 0x56, 0x15, 0x2d, 0x64, 0x9a, 0x0e, 0xb8, 0x86,
+	0x1d, 0xb0, 0x74, 0xd1, 0xd8, 0x9b, 0xf7, 0x1d, 0x2b, 0x7c, 0xa9, 0x62, 0xbf, 0xe8, 0xcc, 0xa3,
+	0xa0, 0x9a, 0xfe, 0x5d, 0xc0, 0x11, 0x80, 0x71, 0xaa, 0x71, 0xcc, 0x74, 0x24, 0xc6, 0x54, 0x4d,
+	0xb0, 0x99, 0x48, 0xea, 0x2d, 0xf8, 0x4e, 0x6b, 0xa5, 0xfd, 0xf2, 0xca, 0x90, 0x17, 0xaf, 0x17,
+	0x74, 0x52, 0xdd, 0x39, 0x97, 0x18, 0x4c, 0x24, 0x45, 0x8d, 0x78, 0x0a, 0x81, 0x14, 0xdc, 0xc8,
+	0x9d, 0xb8, 0x10, 0xc7, 0x99, 0xc4, 0x47, 0xe4, 0x84, 0xf1, 0x89, 0x57, 0xb1, 0x46, 0x2f, 0xfe,
+	0xcb, 0xa8, 0x67, 0x15, 0x5e, 0x5b, 0x01, 0x54, 0x8f, 0xff, 0x04, 0xe0, 0x23, 0x50, 0x57, 0x34,
+	0x1f, 0x88, 0xc1, 0xb9, 0x9d, 0x31, 0xdc, 0xab, 0xda, 0xb9, 0x2d, 0x97, 0x70, 0x27, 0xd5, 0x03,
+	0xc3, 0x61, 0x17, 0xe4, 0x11, 0xb1, 0xa2, 0x47, 0x8a, 0xea, 0x11, 0x56, 0xc4, 0x50, 0x6f, 0xd1,
+	0x8e, 0x70, 0x35, 0x28, 0x56, 0x22, 0x38, 0x5f, 0x89, 0xa0, 0x53, 0xae, 0xc4, 0xb6, 0xfb, 0xe9,
+	0xdb, 0x7d, 0x07, 0xad, 0xc4, 0xa9, 0x46, 0x05, 0x0f, 0x11, 0x43, 0xe1, 0x43, 0xb0, 0x5c, 0x48,
+	0x69, 0xc1, 0xc7, 0x54, 0x69, 0x0f, 0xf8, 0x73, 0xad, 0x45, 0xb4, 0x64, 0xdb, 0x4a, 0xac, 0xb9,
+	0x01, 0x1a, 0xd3, 0x43, 0x82, 0x2b, 0x00, 0xf4, 0x07, 0xa8, 0xbb, 0x33, 0xc0, 0x9d, 0xbd, 0x7e,
+	0x63, 0x06, 0xd6, 0x41, 0xad, 0xb7, 0xff, 0xa6, 0xbb, 0xb3, 0xd5, 0xb3, 0x80, 0xd3, 0x7c, 0x0a,
+	0xea, 0x53, 0x17, 0x86, 0x35, 0x50, 0x39, 0xdc, 0xc4, 0xfb, 0x7b, 0xbd, 0xf7, 0x8d, 0x19, 0x5b,
+	0x3c, 0x2b, 0x0a, 0x07, 0x56, 0x81, 0xbb, 0x75, 0x30, 0xd8, 0x6f, 0xcc, 0x36, 0x3f, 0x3b, 0xc0,
+	0xcd, 0x77, 0x08, 0x42, 0xe0, 0x92, 0x38, 0x56, 0x9e, 0xe3, 0x3b, 0xad, 0x45, 0x64, 0xcf, 0x39,
+	0x26, 0x85, 0x32, 0xde, 0xac, 0xef, 0xb4, 0x96, 0x91, 0x3d, 0xc3, 0xb7, 0xe0, 0x36, 0x17, 0x24,
+	0xc6, 0x43, 0xc2, 0x49, 0x1a, 0xb1, 0x34, 0xc1, 0xa7, 0x94, 0x25, 0x23, 0x63, 0x57, 0xae, 0xd6,
+	0xbe, 0xf7, 0xd7, 0x44, 0x0e, 0xba, 0xa9, 0xd9, 0x68, 0x1f, 0x12, 0x9e, 0x51, 0x74, 0x33, 0xa7,
+	0x6e, 0x9f, 0x33, 0xdf, 0x59, 0x22, 0x5c, 0x05, 0x55, 0x9d, 0x32, 0x6c, 0xdd, 0x5d, 0xeb, 0x5e,
+	0xd1, 0x29, 0xdb, 0x8a, 0x63, 0xb5, 0xdd, 0xfd, 0xfa, 0xc3, 0x75, 0xbe, 0x7c, 0x5f, 0x77, 0x3e,
+	0xbc, 0xba, 0xde, 0x63, 0x21, 0x8f, 0x93, 0xcb, 0x1f, 0x8c, 0xe1, 0x82, 0x0d, 0xb4, 0xf1, 0x6b,
+	0x00, 0xa4, 0xcd, 0xf7, 0xce, 0x76, 0x04, 0x00, 0x00,
 }
 
 func (this *UpstreamSpec) Equal(that interface{}) bool {
@@ -204,6 +355,32 @@ func (this *UpstreamSpec) Equal(that interface{}) bool {
 	if !this.ServiceSpec.Equal(that1.ServiceSpec) {
 		return false
 	}
+	if this.DnsDiscoveryType != that1.DnsDiscoveryType {
+		return false
+	}
+	if this.DnsLookupFamily != that1.DnsLookupFamily {
+		return false
+	}
+	if this.RespectDnsTtl != that1.RespectDnsTtl {
+		return false
+	}
+	if this.DnsRefreshRate != nil && that1.DnsRefreshRate != nil {
+		if *this.DnsRefreshRate != *that1.DnsRefreshRate {
+			return false
+		}
+	} else if this.DnsRefreshRate != nil {
+		return false
+	} else if that1.DnsRefreshRate != nil {
+		return false
+	}
+	if len(this.DnsResolvers) != len(that1.DnsResolvers) {
+		return false
+	}
+	for i := range this.DnsResolvers {
+		if this.DnsResolvers[i] != that1.DnsResolvers[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -234,6 +411,12 @@ func (this *Host) Equal(that interface{}) bool {
 	if this.Port != that1.Port {
 		return false
 	}
+	if !this.LoadBalancingWeight.Equal(that1.LoadBalancingWeight) {
+		return false
+	}
+	if this.SniAddr != that1.SniAddr {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
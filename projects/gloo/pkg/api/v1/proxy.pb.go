@@ -63,7 +63,7 @@ func (x RedirectAction_RedirectResponseCode) String() string {
 }
 
 func (RedirectAction_RedirectResponseCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{14, 0}
+	return fileDescriptor_c6a47f72e9923590, []int{17, 0}
 }
 
 //
@@ -155,8 +155,10 @@ type Listener struct {
 	// Types that are valid to be assigned to ListenerType:
 	//	*Listener_HttpListener
 	//	*Listener_TcpListener
+	//	*Listener_HybridListener
 	ListenerType isListener_ListenerType `protobuf_oneof:"ListenerType"`
 	// SSL Config is optional for the listener. If provided, the listener will serve TLS for connections on this port.
+	// For hybrid listeners, the SSL Configs apply to the HTTP listener.
 	// Multiple SslConfigs are supported for the purpose of SNI. Be aware that the SNI domain provided in the SSL Config
 	SslConfigurations []*SslConfig `protobuf:"bytes,6,rep,name=ssl_configurations,json=sslConfigurations,proto3" json:"ssl_configurations,omitempty"`
 	// Enable ProxyProtocol support for this listener
@@ -208,9 +210,13 @@ type Listener_HttpListener struct {
 type Listener_TcpListener struct {
 	TcpListener *TcpListener `protobuf:"bytes,5,opt,name=tcp_listener,json=tcpListener,proto3,oneof" json:"tcp_listener,omitempty"`
 }
+type Listener_HybridListener struct {
+	HybridListener *HybridListener `protobuf:"bytes,10,opt,name=hybrid_listener,json=hybridListener,proto3,oneof" json:"hybrid_listener,omitempty"`
+}
 
-func (*Listener_HttpListener) isListener_ListenerType() {}
-func (*Listener_TcpListener) isListener_ListenerType()  {}
+func (*Listener_HttpListener) isListener_ListenerType()   {}
+func (*Listener_TcpListener) isListener_ListenerType()    {}
+func (*Listener_HybridListener) isListener_ListenerType() {}
 
 func (m *Listener) GetListenerType() isListener_ListenerType {
 	if m != nil {
@@ -254,6 +260,13 @@ func (m *Listener) GetTcpListener() *TcpListener {
 	return nil
 }
 
+func (m *Listener) GetHybridListener() *HybridListener {
+	if x, ok := m.GetListenerType().(*Listener_HybridListener); ok {
+		return x.HybridListener
+	}
+	return nil
+}
+
 func (m *Listener) GetSslConfigurations() []*SslConfig {
 	if m != nil {
 		return m.SslConfigurations
@@ -287,6 +300,7 @@ func (*Listener) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Listener_HttpListener)(nil),
 		(*Listener_TcpListener)(nil),
+		(*Listener_HybridListener)(nil),
 	}
 }
 
@@ -356,11 +370,16 @@ type TcpHost struct {
 	// Name of the destinations the gateway can route to.
 	// Note: the destination spec and subsets are not supported in this context and will be ignored.
 	Destination *RouteAction `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
-	// If provided, the Gateway will serve TLS/SSL traffic for this set of routes
-	SslConfig            *SslConfig `protobuf:"bytes,3,opt,name=ssl_config,json=sslConfig,proto3" json:"ssl_config,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	// If provided, the Gateway will serve TLS/SSL traffic for this set of routes.
+	// If the SSL Config does not specify any secret, TLS connections whose SNI server name matches the `sniDomains`
+	// are passed through to the destination without being terminated.
+	SslConfig *SslConfig `protobuf:"bytes,3,opt,name=ssl_config,json=sslConfig,proto3" json:"ssl_config,omitempty"`
+	// If provided, only connections that match all of the given criteria are routed to this tcp host.
+	// Connections are matched against the tcp hosts of a listener from the most to the least specific criteria.
+	Matcher              *TcpHostMatcher `protobuf:"bytes,4,opt,name=matcher,proto3" json:"matcher,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *TcpHost) Reset()         { *m = TcpHost{} }
@@ -408,6 +427,173 @@ func (m *TcpHost) GetSslConfig() *SslConfig {
 	return nil
 }
 
+func (m *TcpHost) GetMatcher() *TcpHostMatcher {
+	if m != nil {
+		return m.Matcher
+	}
+	return nil
+}
+
+// Criteria for matching connections to a tcp host, in addition to the SNI domains of its SSL Config.
+type TcpHostMatcher struct {
+	// Match connections whose source address is in any of these ranges.
+	SourcePrefixRanges []*CidrRange `protobuf:"bytes,1,rep,name=source_prefix_ranges,json=sourcePrefixRanges,proto3" json:"source_prefix_ranges,omitempty"`
+	// Match connections whose original destination port is this port.
+	// This is only useful when connections are redirected to the listener, e.g. when proxying transparently.
+	DestinationPort *types.UInt32Value `protobuf:"bytes,2,opt,name=destination_port,json=destinationPort,proto3" json:"destination_port,omitempty"`
+	// Match TLS connections that offer any of these protocols in their ALPN extension, e.g. "h2" or "mqtt".
+	ApplicationProtocols []string `protobuf:"bytes,3,rep,name=application_protocols,json=applicationProtocols,proto3" json:"application_protocols,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TcpHostMatcher) Reset()         { *m = TcpHostMatcher{} }
+func (m *TcpHostMatcher) String() string { return proto.CompactTextString(m) }
+func (*TcpHostMatcher) ProtoMessage()    {}
+func (*TcpHostMatcher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a47f72e9923590, []int{4}
+}
+func (m *TcpHostMatcher) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TcpHostMatcher.Unmarshal(m, b)
+}
+func (m *TcpHostMatcher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TcpHostMatcher.Marshal(b, m, deterministic)
+}
+func (m *TcpHostMatcher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TcpHostMatcher.Merge(m, src)
+}
+func (m *TcpHostMatcher) XXX_Size() int {
+	return xxx_messageInfo_TcpHostMatcher.Size(m)
+}
+func (m *TcpHostMatcher) XXX_DiscardUnknown() {
+	xxx_messageInfo_TcpHostMatcher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TcpHostMatcher proto.InternalMessageInfo
+
+func (m *TcpHostMatcher) GetSourcePrefixRanges() []*CidrRange {
+	if m != nil {
+		return m.SourcePrefixRanges
+	}
+	return nil
+}
+
+func (m *TcpHostMatcher) GetDestinationPort() *types.UInt32Value {
+	if m != nil {
+		return m.DestinationPort
+	}
+	return nil
+}
+
+func (m *TcpHostMatcher) GetApplicationProtocols() []string {
+	if m != nil {
+		return m.ApplicationProtocols
+	}
+	return nil
+}
+
+// An IPv4 or IPv6 address range in CIDR notation.
+type CidrRange struct {
+	// The address prefix, e.g. "10.0.0.0" or "2001:db8::"
+	AddressPrefix string `protobuf:"bytes,1,opt,name=address_prefix,json=addressPrefix,proto3" json:"address_prefix,omitempty"`
+	// The length of the prefix, e.g. 8. Defaults to the length of the address, matching a single address.
+	PrefixLen            *types.UInt32Value `protobuf:"bytes,2,opt,name=prefix_len,json=prefixLen,proto3" json:"prefix_len,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CidrRange) Reset()         { *m = CidrRange{} }
+func (m *CidrRange) String() string { return proto.CompactTextString(m) }
+func (*CidrRange) ProtoMessage()    {}
+func (*CidrRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a47f72e9923590, []int{5}
+}
+func (m *CidrRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CidrRange.Unmarshal(m, b)
+}
+func (m *CidrRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CidrRange.Marshal(b, m, deterministic)
+}
+func (m *CidrRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CidrRange.Merge(m, src)
+}
+func (m *CidrRange) XXX_Size() int {
+	return xxx_messageInfo_CidrRange.Size(m)
+}
+func (m *CidrRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_CidrRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CidrRange proto.InternalMessageInfo
+
+func (m *CidrRange) GetAddressPrefix() string {
+	if m != nil {
+		return m.AddressPrefix
+	}
+	return ""
+}
+
+func (m *CidrRange) GetPrefixLen() *types.UInt32Value {
+	if m != nil {
+		return m.PrefixLen
+	}
+	return nil
+}
+
+// Use this listener to serve HTTP and TCP traffic on the same port.
+// The HTTP listener terminates TLS for the SNI domains of the SSL Configs of the listener,
+// while the TCP listener serves the SNI domains of the SSL Configs of its TCP hosts.
+// SNI domains must not be shared by the HTTP listener and a TCP host.
+type HybridListener struct {
+	// the HTTP listener
+	HttpListener *HttpListener `protobuf:"bytes,1,opt,name=http_listener,json=httpListener,proto3" json:"http_listener,omitempty"`
+	// the TCP listener
+	TcpListener          *TcpListener `protobuf:"bytes,2,opt,name=tcp_listener,json=tcpListener,proto3" json:"tcp_listener,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *HybridListener) Reset()         { *m = HybridListener{} }
+func (m *HybridListener) String() string { return proto.CompactTextString(m) }
+func (*HybridListener) ProtoMessage()    {}
+func (*HybridListener) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a47f72e9923590, []int{6}
+}
+func (m *HybridListener) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HybridListener.Unmarshal(m, b)
+}
+func (m *HybridListener) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HybridListener.Marshal(b, m, deterministic)
+}
+func (m *HybridListener) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HybridListener.Merge(m, src)
+}
+func (m *HybridListener) XXX_Size() int {
+	return xxx_messageInfo_HybridListener.Size(m)
+}
+func (m *HybridListener) XXX_DiscardUnknown() {
+	xxx_messageInfo_HybridListener.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HybridListener proto.InternalMessageInfo
+
+func (m *HybridListener) GetHttpListener() *HttpListener {
+	if m != nil {
+		return m.HttpListener
+	}
+	return nil
+}
+
+func (m *HybridListener) GetTcpListener() *TcpListener {
+	if m != nil {
+		return m.TcpListener
+	}
+	return nil
+}
+
 // Use this listener to configure proxy behavior for any HTTP-level features including defining routes (via virtual services).
 // HttpListeners also contain optional configuration that applies globally across all virtual hosts on the listener.
 // Some traffic policies can be configured to work both on the listener and virtual host level (e.g., the rate limit feature)
@@ -432,7 +618,7 @@ func (m *HttpListener) Reset()         { *m = HttpListener{} }
 func (m *HttpListener) String() string { return proto.CompactTextString(m) }
 func (*HttpListener) ProtoMessage()    {}
 func (*HttpListener) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{4}
+	return fileDescriptor_c6a47f72e9923590, []int{7}
 }
 func (m *HttpListener) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HttpListener.Unmarshal(m, b)
@@ -509,7 +695,7 @@ func (m *VirtualHost) Reset()         { *m = VirtualHost{} }
 func (m *VirtualHost) String() string { return proto.CompactTextString(m) }
 func (*VirtualHost) ProtoMessage()    {}
 func (*VirtualHost) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{5}
+	return fileDescriptor_c6a47f72e9923590, []int{8}
 }
 func (m *VirtualHost) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VirtualHost.Unmarshal(m, b)
@@ -564,7 +750,7 @@ func (m *VirtualHost) GetMetadata() *types.Struct {
 	return nil
 }
 
-//*
+//
 // Routes declare the entry points on virtual hosts and the action to take for matched requests.
 type Route struct {
 	// Matchers contain parameters for matching requests (i.e., based on HTTP path, headers, etc.)
@@ -597,7 +783,7 @@ func (m *Route) Reset()         { *m = Route{} }
 func (m *Route) String() string { return proto.CompactTextString(m) }
 func (*Route) ProtoMessage()    {}
 func (*Route) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{6}
+	return fileDescriptor_c6a47f72e9923590, []int{9}
 }
 func (m *Route) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Route.Unmarshal(m, b)
@@ -721,7 +907,7 @@ func (m *RouteAction) Reset()         { *m = RouteAction{} }
 func (m *RouteAction) String() string { return proto.CompactTextString(m) }
 func (*RouteAction) ProtoMessage()    {}
 func (*RouteAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{7}
+	return fileDescriptor_c6a47f72e9923590, []int{10}
 }
 func (m *RouteAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteAction.Unmarshal(m, b)
@@ -823,7 +1009,7 @@ func (m *Destination) Reset()         { *m = Destination{} }
 func (m *Destination) String() string { return proto.CompactTextString(m) }
 func (*Destination) ProtoMessage()    {}
 func (*Destination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{8}
+	return fileDescriptor_c6a47f72e9923590, []int{11}
 }
 func (m *Destination) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Destination.Unmarshal(m, b)
@@ -928,7 +1114,7 @@ func (m *KubernetesServiceDestination) Reset()         { *m = KubernetesServiceD
 func (m *KubernetesServiceDestination) String() string { return proto.CompactTextString(m) }
 func (*KubernetesServiceDestination) ProtoMessage()    {}
 func (*KubernetesServiceDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{9}
+	return fileDescriptor_c6a47f72e9923590, []int{12}
 }
 func (m *KubernetesServiceDestination) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KubernetesServiceDestination.Unmarshal(m, b)
@@ -984,7 +1170,7 @@ func (m *ConsulServiceDestination) Reset()         { *m = ConsulServiceDestinati
 func (m *ConsulServiceDestination) String() string { return proto.CompactTextString(m) }
 func (*ConsulServiceDestination) ProtoMessage()    {}
 func (*ConsulServiceDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{10}
+	return fileDescriptor_c6a47f72e9923590, []int{13}
 }
 func (m *ConsulServiceDestination) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConsulServiceDestination.Unmarshal(m, b)
@@ -1042,7 +1228,7 @@ func (m *UpstreamGroup) Reset()         { *m = UpstreamGroup{} }
 func (m *UpstreamGroup) String() string { return proto.CompactTextString(m) }
 func (*UpstreamGroup) ProtoMessage()    {}
 func (*UpstreamGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{11}
+	return fileDescriptor_c6a47f72e9923590, []int{14}
 }
 func (m *UpstreamGroup) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpstreamGroup.Unmarshal(m, b)
@@ -1098,7 +1284,7 @@ func (m *MultiDestination) Reset()         { *m = MultiDestination{} }
 func (m *MultiDestination) String() string { return proto.CompactTextString(m) }
 func (*MultiDestination) ProtoMessage()    {}
 func (*MultiDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{12}
+	return fileDescriptor_c6a47f72e9923590, []int{15}
 }
 func (m *MultiDestination) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MultiDestination.Unmarshal(m, b)
@@ -1142,7 +1328,7 @@ func (m *WeightedDestination) Reset()         { *m = WeightedDestination{} }
 func (m *WeightedDestination) String() string { return proto.CompactTextString(m) }
 func (*WeightedDestination) ProtoMessage()    {}
 func (*WeightedDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{13}
+	return fileDescriptor_c6a47f72e9923590, []int{16}
 }
 func (m *WeightedDestination) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WeightedDestination.Unmarshal(m, b)
@@ -1208,7 +1394,7 @@ func (m *RedirectAction) Reset()         { *m = RedirectAction{} }
 func (m *RedirectAction) String() string { return proto.CompactTextString(m) }
 func (*RedirectAction) ProtoMessage()    {}
 func (*RedirectAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{14}
+	return fileDescriptor_c6a47f72e9923590, []int{17}
 }
 func (m *RedirectAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RedirectAction.Unmarshal(m, b)
@@ -1319,7 +1505,7 @@ func (m *DirectResponseAction) Reset()         { *m = DirectResponseAction{} }
 func (m *DirectResponseAction) String() string { return proto.CompactTextString(m) }
 func (*DirectResponseAction) ProtoMessage()    {}
 func (*DirectResponseAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{15}
+	return fileDescriptor_c6a47f72e9923590, []int{18}
 }
 func (m *DirectResponseAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DirectResponseAction.Unmarshal(m, b)
@@ -1359,6 +1545,9 @@ func init() {
 	proto.RegisterType((*Listener)(nil), "gloo.solo.io.Listener")
 	proto.RegisterType((*TcpListener)(nil), "gloo.solo.io.TcpListener")
 	proto.RegisterType((*TcpHost)(nil), "gloo.solo.io.TcpHost")
+	proto.RegisterType((*TcpHostMatcher)(nil), "gloo.solo.io.TcpHostMatcher")
+	proto.RegisterType((*CidrRange)(nil), "gloo.solo.io.CidrRange")
+	proto.RegisterType((*HybridListener)(nil), "gloo.solo.io.HybridListener")
 	proto.RegisterType((*HttpListener)(nil), "gloo.solo.io.HttpListener")
 	proto.RegisterType((*VirtualHost)(nil), "gloo.solo.io.VirtualHost")
 	proto.RegisterType((*Route)(nil), "gloo.solo.io.Route")
@@ -1378,105 +1567,116 @@ func init() {
 }
 
 var fileDescriptor_c6a47f72e9923590 = []byte{
-	// 1558 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6e, 0x1b, 0xc9,
-	0x11, 0xd6, 0x88, 0x14, 0x45, 0x16, 0x49, 0xfd, 0xb4, 0x65, 0x79, 0xac, 0x58, 0xb2, 0x3c, 0x86,
-	0x6d, 0x21, 0x3f, 0x64, 0x2c, 0x1b, 0xb6, 0x23, 0x03, 0x89, 0x45, 0x89, 0x36, 0x03, 0x5b, 0x3f,
-	0x69, 0xc9, 0x0a, 0xec, 0xcb, 0x60, 0x38, 0x6c, 0x52, 0x13, 0x53, 0xec, 0x49, 0x77, 0x8f, 0x7e,
-	0xae, 0x7e, 0x84, 0x3c, 0x40, 0xce, 0x39, 0x04, 0x39, 0xfb, 0x90, 0x07, 0xc8, 0x25, 0xd7, 0xbd,
-	0x2c, 0xe0, 0x05, 0xf6, 0x0d, 0xb4, 0xc0, 0xde, 0xf6, 0xb0, 0xe8, 0x9e, 0x9e, 0xe1, 0x0c, 0x45,
-	0xca, 0x36, 0xe0, 0xc3, 0xde, 0xa6, 0xab, 0xbe, 0xaa, 0xae, 0xae, 0xfa, 0xba, 0xba, 0x48, 0x78,
-	0xd2, 0xf1, 0xc4, 0x61, 0xd0, 0xac, 0xb8, 0xf4, 0xa8, 0xca, 0x69, 0x97, 0xfe, 0xce, 0xa3, 0xd5,
-	0x4e, 0x97, 0xd2, 0xaa, 0xcf, 0xe8, 0xdf, 0x88, 0x2b, 0x78, 0xb8, 0x72, 0x7c, 0xaf, 0x7a, 0x7c,
-	0x5f, 0x0a, 0x4f, 0xcf, 0x2a, 0x3e, 0xa3, 0x82, 0xa2, 0x92, 0x54, 0x54, 0xa4, 0x4d, 0xc5, 0xa3,
-	0x0b, 0x4b, 0x1d, 0x4a, 0x3b, 0x5d, 0x52, 0x55, 0xba, 0x66, 0xd0, 0xae, 0x9e, 0x30, 0xc7, 0xf7,
-	0x09, 0xe3, 0x21, 0x7a, 0xe1, 0xc6, 0xa0, 0x9e, 0x0b, 0x16, 0xb8, 0x42, 0x6b, 0xe7, 0x3a, 0xb4,
-	0x43, 0xd5, 0x67, 0x55, 0x7e, 0x69, 0x29, 0x22, 0xa7, 0x22, 0x14, 0x92, 0xd3, 0x08, 0xb9, 0xa4,
-	0x82, 0x7c, 0xe7, 0x89, 0x28, 0xa4, 0x23, 0x22, 0x9c, 0x96, 0x23, 0x9c, 0x68, 0x9f, 0x41, 0x3d,
-	0x17, 0x8e, 0x08, 0xa2, 0x28, 0xae, 0x0f, 0x6a, 0x19, 0x69, 0x8f, 0x72, 0x1c, 0xad, 0xb5, 0xfe,
-	0xf6, 0xe8, 0xac, 0x70, 0xde, 0xd5, 0xa0, 0xbb, 0x97, 0x80, 0x82, 0x26, 0x27, 0x91, 0xb3, 0x7b,
-	0xa3, 0x71, 0xd4, 0x17, 0x1e, 0xed, 0x45, 0x01, 0x3f, 0x1a, 0x0d, 0x74, 0x29, 0x23, 0xd5, 0x23,
-	0x47, 0xb8, 0x87, 0x84, 0xf1, 0xf8, 0x23, 0xb4, 0xb3, 0xbe, 0x35, 0x60, 0x62, 0x57, 0x16, 0x0b,
-	0x3d, 0x84, 0x42, 0xd7, 0xe3, 0x82, 0xf4, 0x08, 0xe3, 0xe6, 0xf8, 0x72, 0x66, 0xa5, 0xb8, 0x3a,
-	0x5f, 0x49, 0x96, 0xae, 0xf2, 0x4a, 0xab, 0x71, 0x1f, 0x88, 0x5e, 0x42, 0x2e, 0x4c, 0x9c, 0x99,
-	0x5b, 0x36, 0x56, 0x8a, 0xab, 0x73, 0x15, 0xb9, 0x5d, 0x6c, 0xb2, 0xa7, 0x74, 0xb5, 0xc5, 0x0f,
-	0x3f, 0x66, 0x8d, 0xff, 0x7d, 0xbc, 0x39, 0xf6, 0xc3, 0xc7, 0x9b, 0xb3, 0x82, 0x70, 0xd1, 0xf2,
-	0xda, 0xed, 0x35, 0xcb, 0xeb, 0xf4, 0x28, 0x23, 0x16, 0xd6, 0x2e, 0xd0, 0x13, 0xc8, 0x47, 0x55,
-	0x32, 0x27, 0x95, 0xbb, 0xf9, 0xb4, 0xbb, 0x2d, 0xad, 0xad, 0x65, 0xa5, 0x33, 0x1c, 0xa3, 0xd7,
-	0xae, 0xbe, 0x3f, 0xcf, 0x66, 0x61, 0xdc, 0x3f, 0x7d, 0x7f, 0x9e, 0x2d, 0xa0, 0x49, 0x49, 0x3f,
-	0x8f, 0x70, 0xeb, 0x3c, 0x03, 0xf9, 0x28, 0x6a, 0x84, 0x20, 0xdb, 0x73, 0x8e, 0x88, 0x69, 0x2c,
-	0x1b, 0x2b, 0x05, 0xac, 0xbe, 0xd1, 0x2d, 0x28, 0x35, 0xbd, 0x5e, 0xcb, 0x76, 0x5a, 0x2d, 0x46,
-	0xb8, 0x3c, 0xb7, 0xd4, 0x15, 0xa5, 0x6c, 0x3d, 0x14, 0xa1, 0x5f, 0x41, 0x41, 0x41, 0x7c, 0xca,
-	0x84, 0x99, 0x59, 0x36, 0x56, 0xca, 0x38, 0x2f, 0x05, 0xbb, 0x94, 0x09, 0xb4, 0x0e, 0xe5, 0x43,
-	0x21, 0x7c, 0x3b, 0x4a, 0x88, 0x99, 0x55, 0x61, 0x2f, 0xa4, 0x13, 0xd7, 0x10, 0xc2, 0x8f, 0xc2,
-	0x68, 0x8c, 0xe1, 0xd2, 0x61, 0x62, 0x8d, 0xfe, 0x08, 0x25, 0xe1, 0x26, 0x3c, 0x4c, 0x28, 0x0f,
-	0xd7, 0xd3, 0x1e, 0xf6, 0xdd, 0xa4, 0x83, 0xa2, 0xe8, 0x2f, 0xd1, 0x73, 0x40, 0x9c, 0x77, 0x6d,
-	0x97, 0xf6, 0xda, 0x5e, 0x27, 0x60, 0x8e, 0x62, 0x85, 0x99, 0x53, 0x05, 0xbc, 0x96, 0xf6, 0xb2,
-	0xc7, 0xbb, 0x1b, 0x0a, 0x86, 0x67, 0x79, 0xf4, 0x19, 0x59, 0xa0, 0x1a, 0x4c, 0x07, 0x9c, 0xd8,
-	0xea, 0xe6, 0xda, 0x8a, 0x1c, 0xba, 0x06, 0x0b, 0x95, 0xf0, 0x4a, 0x56, 0xa2, 0x2b, 0x59, 0xa9,
-	0x51, 0xda, 0x3d, 0x70, 0xba, 0x01, 0xc1, 0xe5, 0x80, 0x13, 0x45, 0x9f, 0x5d, 0x75, 0xd5, 0x1f,
-	0xc3, 0xa4, 0xa6, 0xa5, 0x99, 0x57, 0xb6, 0x8b, 0xc3, 0x19, 0xb4, 0x13, 0x82, 0x70, 0x84, 0x46,
-	0x7f, 0x48, 0x54, 0xbe, 0xa0, 0x2c, 0xaf, 0x5d, 0xd8, 0x75, 0x4f, 0x35, 0x82, 0x5a, 0x56, 0x72,
-	0xa9, 0x5f, 0xfa, 0xda, 0x14, 0x94, 0x22, 0xb7, 0xfb, 0x67, 0x3e, 0xb1, 0xfe, 0x69, 0x40, 0x31,
-	0x91, 0x2e, 0xb4, 0x0a, 0x05, 0x99, 0xdf, 0x43, 0xca, 0x05, 0x37, 0x0d, 0x95, 0x96, 0xab, 0x17,
-	0x92, 0xdb, 0xa0, 0x5c, 0xe0, 0xbc, 0x08, 0x3f, 0x38, 0x5a, 0x1b, 0x3c, 0xc7, 0xf2, 0xc8, 0x72,
-	0x5c, 0x38, 0xca, 0x4d, 0x28, 0x4a, 0x3a, 0xdb, 0x3e, 0x23, 0x6d, 0xef, 0x54, 0x31, 0xa6, 0x80,
-	0x41, 0x8a, 0x76, 0x95, 0xc4, 0xfa, 0x87, 0x01, 0x93, 0x7a, 0xcb, 0xa1, 0x9c, 0x7c, 0x0a, 0xc5,
-	0x16, 0xe1, 0xc2, 0xeb, 0xa9, 0xc2, 0x28, 0x4a, 0x5e, 0xe0, 0x03, 0xa6, 0x81, 0x20, 0xeb, 0xae,
-	0x04, 0xe0, 0x24, 0x1a, 0x3d, 0x02, 0xe8, 0xb3, 0x41, 0x6d, 0x7e, 0x09, 0x0b, 0x0a, 0x31, 0x0b,
-	0xac, 0x7f, 0x1b, 0x50, 0x6a, 0xa4, 0x69, 0x59, 0x3e, 0xf6, 0x98, 0x08, 0x9c, 0x6e, 0x2a, 0x75,
-	0x03, 0x71, 0x1c, 0x84, 0x10, 0x95, 0xbe, 0xd2, 0x71, 0x7f, 0xc1, 0xd1, 0xd3, 0x7e, 0x0a, 0xc3,
-	0x13, 0xdc, 0x1a, 0x7d, 0x27, 0xbe, 0x3c, 0x87, 0xdf, 0x19, 0x50, 0x4c, 0xec, 0x3d, 0x34, 0x8f,
-	0x26, 0x4c, 0xb6, 0xe8, 0x91, 0xe3, 0xf5, 0xc2, 0x76, 0x56, 0xc0, 0xd1, 0x12, 0xfd, 0x06, 0x72,
-	0x4c, 0x26, 0x90, 0x9b, 0x19, 0x75, 0xa8, 0x2b, 0x43, 0x92, 0x8b, 0x35, 0x24, 0xc9, 0x85, 0xec,
-	0x30, 0x2e, 0x24, 0xc2, 0xb8, 0x94, 0xd6, 0xb9, 0x2f, 0xa2, 0xb5, 0xf5, 0xdf, 0x0c, 0x4c, 0xa8,
-	0x40, 0xd0, 0x9f, 0x20, 0x1f, 0x35, 0x6d, 0x5d, 0x84, 0xdb, 0x95, 0xb8, 0x8b, 0xab, 0xf6, 0x98,
-	0x8a, 0x67, 0x2b, 0x54, 0xe1, 0xd8, 0x48, 0x76, 0x18, 0x75, 0x16, 0xdb, 0x71, 0x3f, 0x8b, 0x51,
-	0xb2, 0xc3, 0xb0, 0xfe, 0x12, 0xbd, 0x80, 0x69, 0x46, 0x5a, 0x1e, 0x23, 0xae, 0x88, 0x5c, 0x84,
-	0xc4, 0xba, 0x31, 0xe0, 0x42, 0x83, 0x62, 0x2f, 0x53, 0x2c, 0x25, 0x41, 0x6f, 0x61, 0x5e, 0xbb,
-	0x61, 0x84, 0xfb, 0xb4, 0xc7, 0xe3, 0x90, 0xc2, 0xcc, 0x5a, 0x69, 0x7f, 0x9b, 0x0a, 0x8b, 0x35,
-	0x34, 0xf6, 0x3a, 0xd7, 0x1a, 0x22, 0x47, 0x0f, 0xfb, 0x65, 0x9a, 0x18, 0xd6, 0x83, 0xd5, 0xf9,
-	0xbe, 0x62, 0x81, 0x62, 0xca, 0x4d, 0xf6, 0x29, 0x57, 0xcb, 0x43, 0x2e, 0x3c, 0x90, 0xf5, 0x7f,
-	0x03, 0x8a, 0x89, 0x94, 0xa2, 0x07, 0x90, 0xe3, 0x5e, 0xaf, 0xd3, 0x0d, 0x29, 0x7a, 0x21, 0xfb,
-	0x9b, 0xfd, 0x2b, 0xdc, 0x18, 0xc3, 0x1a, 0x8a, 0x1e, 0xc1, 0xc4, 0x51, 0xd0, 0x15, 0x9e, 0xae,
-	0xd8, 0xd2, 0x40, 0xa1, 0xa5, 0x2a, 0x6d, 0x18, 0xc2, 0x51, 0x0d, 0xa6, 0x02, 0x9f, 0x0b, 0x46,
-	0x9c, 0x23, 0xbb, 0xc3, 0x68, 0xe0, 0xeb, 0x7a, 0x5d, 0x4f, 0xbf, 0xa6, 0x98, 0x70, 0x1a, 0x30,
-	0x97, 0x60, 0xd2, 0x6e, 0x8c, 0xe1, 0x72, 0x64, 0xf2, 0x42, 0x5a, 0xd4, 0xca, 0xa9, 0x2e, 0x64,
-	0x7d, 0x33, 0x0e, 0xc5, 0xc4, 0x5e, 0xe8, 0x31, 0xe4, 0x23, 0xbc, 0x09, 0x9f, 0x76, 0x1e, 0x83,
-	0xd1, 0x33, 0xc8, 0xbe, 0x0b, 0x9a, 0xc4, 0x2c, 0x2a, 0xa3, 0x5f, 0xa7, 0x8f, 0xf4, 0x32, 0x68,
-	0x12, 0xd6, 0x23, 0x82, 0xf0, 0x3d, 0xc2, 0x8e, 0x3d, 0x97, 0xa4, 0x8f, 0xa7, 0x2c, 0xd1, 0x33,
-	0xc8, 0xb9, 0xb4, 0xc7, 0x83, 0xae, 0x59, 0x52, 0x3e, 0xee, 0xa6, 0x7d, 0x6c, 0x28, 0xdd, 0x50,
-	0x7b, 0x6d, 0x87, 0x1a, 0x30, 0x93, 0x38, 0x9b, 0xcd, 0x7d, 0xe2, 0xea, 0x14, 0x2f, 0x8e, 0x2c,
-	0xcb, 0x9e, 0x4f, 0x5c, 0x3c, 0xdd, 0x4a, 0x0b, 0xd0, 0x6f, 0x21, 0x17, 0xce, 0x6b, 0x3a, 0xc3,
-	0x73, 0x03, 0xad, 0x56, 0xe9, 0xb0, 0xc6, 0xd4, 0x50, 0x7a, 0x5f, 0x21, 0x9f, 0x2b, 0x02, 0x37,
-	0x2e, 0x3b, 0x35, 0xba, 0x0f, 0x19, 0x46, 0xda, 0x31, 0x6b, 0x46, 0xe5, 0x58, 0x4f, 0x44, 0x12,
-	0x2b, 0x99, 0xa9, 0x86, 0x95, 0x71, 0x35, 0xac, 0xa8, 0x6f, 0x4b, 0x80, 0x39, 0x2a, 0x31, 0x72,
-	0x08, 0xe2, 0xa1, 0xd4, 0x4e, 0x34, 0xd1, 0xa2, 0x96, 0x6d, 0xcb, 0x5e, 0x8a, 0x20, 0x2b, 0x9c,
-	0x4e, 0xd4, 0x48, 0xd5, 0xb7, 0x34, 0x93, 0x17, 0xc1, 0x76, 0x49, 0x4f, 0xc8, 0xde, 0x94, 0x51,
-	0xba, 0xa2, 0x94, 0x6d, 0x84, 0x22, 0xeb, 0x27, 0x03, 0xca, 0xaf, 0x93, 0xb4, 0x42, 0x75, 0x28,
-	0x25, 0x52, 0x10, 0x35, 0xb4, 0x81, 0xb7, 0xe1, 0xaf, 0xc4, 0xeb, 0x1c, 0x0a, 0xd2, 0x4a, 0x04,
-	0x89, 0x53, 0x66, 0xbf, 0x94, 0xb1, 0x73, 0x31, 0x1c, 0x3b, 0x83, 0xce, 0xfb, 0xf3, 0xec, 0x2c,
-	0x9a, 0x4e, 0x5f, 0x3a, 0x6e, 0xbd, 0x81, 0x99, 0xc1, 0x4b, 0xfa, 0x95, 0x12, 0x60, 0xfd, 0xc7,
-	0x80, 0x2b, 0x43, 0x50, 0x83, 0xc3, 0xc3, 0xa7, 0x9a, 0x4d, 0x7a, 0x78, 0x98, 0x87, 0xdc, 0x89,
-	0xf2, 0xa9, 0xa9, 0xa3, 0x57, 0xa8, 0xd6, 0xef, 0xad, 0x21, 0xcd, 0x57, 0x3e, 0x19, 0xee, 0x60,
-	0xa7, 0xb5, 0x3e, 0x64, 0x60, 0x2a, 0xfd, 0x40, 0xa0, 0xdb, 0x50, 0x96, 0xa3, 0x85, 0x1d, 0xbd,
-	0x12, 0x9a, 0x78, 0x25, 0x29, 0x8c, 0xa0, 0xe8, 0x0e, 0x94, 0x7d, 0x47, 0x1c, 0xf6, 0x41, 0x6a,
-	0x44, 0x97, 0x53, 0xb4, 0x14, 0xc7, 0xb0, 0x7b, 0x30, 0x15, 0x0e, 0x0b, 0x36, 0x23, 0x27, 0xcc,
-	0x13, 0x44, 0xbd, 0x02, 0x12, 0x57, 0x0e, 0xe5, 0x38, 0x14, 0xa3, 0x03, 0x28, 0xc7, 0x8f, 0x8f,
-	0x4b, 0x5b, 0x44, 0x9d, 0x68, 0x6a, 0xf5, 0xfe, 0x65, 0x4f, 0x59, 0xbc, 0x8c, 0xde, 0x9c, 0x0d,
-	0xda, 0x22, 0xb8, 0xc4, 0x12, 0x2b, 0x74, 0x07, 0xa6, 0xe4, 0x58, 0xcf, 0xfb, 0x81, 0xca, 0x37,
-	0x2d, 0x8f, 0xd5, 0xef, 0x03, 0x1e, 0xc7, 0xa9, 0x26, 0x1b, 0xe6, 0xf9, 0xf6, 0xdf, 0x03, 0xc2,
-	0xce, 0x14, 0x7b, 0xf3, 0x72, 0xb2, 0x61, 0x9e, 0xff, 0x17, 0x29, 0xb1, 0x4e, 0x60, 0x6e, 0xd8,
-	0x6e, 0xe8, 0x2a, 0xcc, 0x6e, 0xed, 0x1c, 0xd4, 0x37, 0xed, 0xdd, 0x3a, 0xde, 0x5a, 0xdf, 0xae,
-	0x6f, 0xef, 0xbf, 0x7a, 0x33, 0x33, 0x86, 0x0a, 0x30, 0xf1, 0x7c, 0xe7, 0xf5, 0xf6, 0xe6, 0x8c,
-	0x81, 0xca, 0x50, 0xd8, 0xab, 0xd7, 0xed, 0x9d, 0xfd, 0x46, 0x1d, 0xcf, 0x8c, 0xa3, 0x79, 0x40,
-	0xfb, 0xf5, 0xad, 0xdd, 0x1d, 0xbc, 0x8e, 0xdf, 0xd8, 0xb8, 0xbe, 0xf9, 0x67, 0x5c, 0xdf, 0xd8,
-	0x9f, 0xc9, 0x48, 0x79, 0xec, 0xa2, 0x2f, 0xcf, 0xd6, 0x4c, 0x98, 0xd7, 0x89, 0x56, 0x89, 0x52,
-	0x5d, 0xd1, 0x6b, 0x7b, 0x84, 0x59, 0x35, 0x98, 0x1b, 0xf6, 0x14, 0x4b, 0xba, 0xe8, 0x4b, 0x68,
-	0x84, 0x74, 0xd1, 0xf7, 0x09, 0x41, 0xb6, 0x49, 0x5b, 0x67, 0xfa, 0xc7, 0x94, 0xfa, 0xae, 0xad,
-	0xc9, 0xab, 0xf8, 0xaf, 0xef, 0x97, 0x8c, 0xb7, 0xbf, 0xff, 0xbc, 0x3f, 0x12, 0xfc, 0x77, 0x1d,
-	0xfd, 0x03, 0xb6, 0x99, 0x53, 0x4f, 0xf1, 0x83, 0x9f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x12,
-	0x11, 0xd9, 0x83, 0x10, 0x00, 0x00,
+	// 1735 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x8a, 0x14, 0x45, 0x3e, 0x7e, 0x58, 0x9e, 0xc8, 0x0a, 0xed, 0xfa, 0x43, 0x59, 0x23,
+	0x89, 0xd0, 0x0f, 0xaa, 0x96, 0x03, 0x27, 0xb5, 0x8b, 0x26, 0xa2, 0xc4, 0x58, 0x46, 0x2c, 0x4b,
+	0x1d, 0xc9, 0x2e, 0x9c, 0x0b, 0xb1, 0x5c, 0x0e, 0xc9, 0xad, 0x57, 0x3b, 0xdb, 0x99, 0x59, 0x5b,
+	0xba, 0xe6, 0x1f, 0xe8, 0x7f, 0xd0, 0x73, 0x0f, 0x45, 0xcf, 0x41, 0xd1, 0x3f, 0xa0, 0x28, 0xd0,
+	0x6b, 0x8b, 0x5e, 0x52, 0xa0, 0xff, 0x41, 0x0a, 0x14, 0xe8, 0xb1, 0x98, 0xaf, 0xe5, 0xee, 0x9a,
+	0x94, 0x1c, 0xc0, 0x87, 0xdc, 0x76, 0xde, 0xfb, 0xbd, 0x37, 0x6f, 0xde, 0x37, 0x09, 0x9f, 0x8c,
+	0x03, 0x31, 0x49, 0x06, 0x1d, 0x9f, 0x9e, 0x6c, 0x72, 0x1a, 0xd2, 0x9f, 0x04, 0x74, 0x73, 0x1c,
+	0x52, 0xba, 0x19, 0x33, 0xfa, 0x6b, 0xe2, 0x0b, 0xae, 0x4f, 0x5e, 0x1c, 0x6c, 0xbe, 0xbc, 0x23,
+	0x89, 0xa7, 0x67, 0x9d, 0x98, 0x51, 0x41, 0x51, 0x43, 0x32, 0x3a, 0x52, 0xa6, 0x13, 0xd0, 0x6b,
+	0x37, 0xc7, 0x94, 0x8e, 0x43, 0xb2, 0xa9, 0x78, 0x83, 0x64, 0xb4, 0xf9, 0x8a, 0x79, 0x71, 0x4c,
+	0x18, 0xd7, 0xe8, 0x6b, 0xd7, 0x8b, 0x7c, 0x2e, 0x58, 0xe2, 0x0b, 0xc3, 0x5d, 0x1d, 0xd3, 0x31,
+	0x55, 0x9f, 0x9b, 0xf2, 0xcb, 0x50, 0x11, 0x39, 0x15, 0x9a, 0x48, 0x4e, 0x2d, 0xf2, 0xa6, 0x32,
+	0xf2, 0x45, 0x20, 0xac, 0x49, 0x27, 0x44, 0x78, 0x43, 0x4f, 0x78, 0xf6, 0x9e, 0x22, 0x9f, 0x0b,
+	0x4f, 0x24, 0xd6, 0x8a, 0xab, 0x45, 0x2e, 0x23, 0xa3, 0x79, 0x8a, 0xed, 0xd9, 0xf0, 0x6f, 0xcf,
+	0xf7, 0x0a, 0xe7, 0xa1, 0x01, 0x7d, 0x70, 0x0e, 0x28, 0x19, 0x70, 0x62, 0x95, 0x7d, 0x38, 0x1f,
+	0x47, 0x63, 0x11, 0xd0, 0xc8, 0x1a, 0x7c, 0x6f, 0x3e, 0xd0, 0xa7, 0x8c, 0x6c, 0x9e, 0x78, 0xc2,
+	0x9f, 0x10, 0xc6, 0xd3, 0x0f, 0x2d, 0xe7, 0xfe, 0xc3, 0x81, 0xa5, 0x43, 0x19, 0x2c, 0xf4, 0x11,
+	0xd4, 0xc2, 0x80, 0x0b, 0x12, 0x11, 0xc6, 0xdb, 0x8b, 0xeb, 0xa5, 0x8d, 0xfa, 0xd6, 0x5a, 0x27,
+	0x1b, 0xba, 0xce, 0x63, 0xc3, 0xc6, 0x53, 0x20, 0xfa, 0x02, 0x2a, 0xda, 0x71, 0xed, 0xca, 0xba,
+	0xb3, 0x51, 0xdf, 0x5a, 0xed, 0xc8, 0xeb, 0x52, 0x91, 0x23, 0xc5, 0xeb, 0xde, 0xf8, 0xfa, 0xbf,
+	0x65, 0xe7, 0x2f, 0xdf, 0xdc, 0x5a, 0xf8, 0xcf, 0x37, 0xb7, 0x2e, 0x0b, 0xc2, 0xc5, 0x30, 0x18,
+	0x8d, 0xee, 0xbb, 0xc1, 0x38, 0xa2, 0x8c, 0xb8, 0xd8, 0xa8, 0x40, 0x9f, 0x40, 0xd5, 0x46, 0xa9,
+	0xbd, 0xac, 0xd4, 0xad, 0xe5, 0xd5, 0xed, 0x1b, 0x6e, 0xb7, 0x2c, 0x95, 0xe1, 0x14, 0x7d, 0xff,
+	0xf2, 0x57, 0xdf, 0x96, 0x9b, 0xb0, 0x18, 0x9f, 0xa2, 0x65, 0x99, 0x7a, 0x01, 0xe1, 0xee, 0x9f,
+	0xca, 0x50, 0xb5, 0x16, 0x23, 0x04, 0xe5, 0xc8, 0x3b, 0x21, 0x6d, 0x67, 0xdd, 0xd9, 0xa8, 0x61,
+	0xf5, 0x8d, 0xde, 0x83, 0xc6, 0x20, 0x88, 0x86, 0x7d, 0x6f, 0x38, 0x64, 0x84, 0xcb, 0x37, 0x4b,
+	0x5e, 0x5d, 0xd2, 0xb6, 0x35, 0x09, 0xfd, 0x00, 0x6a, 0x0a, 0x12, 0x53, 0x26, 0xda, 0xa5, 0x75,
+	0x67, 0xa3, 0x89, 0xab, 0x92, 0x70, 0x48, 0x99, 0x40, 0xdb, 0xd0, 0x9c, 0x08, 0x11, 0xf7, 0xad,
+	0x33, 0xda, 0x65, 0x65, 0xf2, 0xb5, 0xbc, 0xd3, 0xf6, 0x84, 0x88, 0xad, 0x19, 0x7b, 0x0b, 0xb8,
+	0x31, 0xc9, 0x9c, 0xd1, 0x2f, 0xa0, 0x21, 0xfc, 0x8c, 0x86, 0x25, 0xa5, 0xe1, 0x6a, 0x5e, 0xc3,
+	0xb1, 0x9f, 0x55, 0x50, 0x17, 0xd3, 0x23, 0x7a, 0x08, 0x97, 0x26, 0x67, 0x03, 0x16, 0x0c, 0xa7,
+	0x2a, 0x40, 0xa9, 0xb8, 0x5e, 0x30, 0x42, 0x81, 0x32, 0x5a, 0x5a, 0x93, 0x1c, 0x05, 0x7d, 0x0e,
+	0x88, 0xf3, 0xb0, 0xef, 0xd3, 0x68, 0x14, 0x8c, 0x13, 0xe6, 0xa9, 0xd4, 0x6a, 0x57, 0x54, 0x16,
+	0xbc, 0x9b, 0xd7, 0x75, 0xc4, 0xc3, 0x1d, 0x05, 0xc3, 0x97, 0xb9, 0xfd, 0xb4, 0x12, 0xa8, 0x0b,
+	0x97, 0x12, 0x4e, 0xfa, 0xaa, 0xfc, 0xfb, 0x2a, 0xc3, 0x4c, 0x20, 0xaf, 0x75, 0x74, 0x5d, 0x77,
+	0x6c, 0x5d, 0x77, 0xba, 0x94, 0x86, 0xcf, 0xbc, 0x30, 0x21, 0xb8, 0x99, 0x70, 0xa2, 0x72, 0xf0,
+	0x50, 0xf5, 0x8b, 0x8f, 0x61, 0xd9, 0xe4, 0x76, 0xbb, 0xaa, 0x64, 0x6f, 0xcc, 0x4e, 0xc3, 0x03,
+	0x0d, 0xc2, 0x16, 0x8d, 0x7e, 0x96, 0x49, 0x9f, 0x9a, 0x92, 0x7c, 0xf7, 0xb5, 0x5b, 0x8f, 0x54,
+	0x37, 0xe9, 0x96, 0x65, 0x42, 0x4e, 0xf3, 0xa7, 0xdb, 0x82, 0x86, 0x55, 0x7b, 0x7c, 0x16, 0x13,
+	0xf7, 0x77, 0x0e, 0xd4, 0x33, 0x7e, 0x47, 0x5b, 0x50, 0x93, 0x81, 0x9a, 0x50, 0x2e, 0x78, 0xdb,
+	0x51, 0x6e, 0xb9, 0xf2, 0x5a, 0x94, 0xf6, 0x28, 0x17, 0xb8, 0x2a, 0xf4, 0x07, 0x47, 0xf7, 0x8b,
+	0xef, 0x58, 0x9f, 0x1b, 0xd7, 0xd7, 0x9e, 0x72, 0x0b, 0xea, 0xb2, 0x26, 0xfa, 0x31, 0x23, 0xa3,
+	0xe0, 0x54, 0xa5, 0x5e, 0x0d, 0x83, 0x24, 0x1d, 0x2a, 0x8a, 0xfb, 0x57, 0x07, 0x96, 0xcd, 0x95,
+	0x33, 0x93, 0xfb, 0x01, 0xd4, 0x87, 0x84, 0x8b, 0x20, 0x52, 0x81, 0x51, 0xb9, 0xfd, 0x5a, 0x62,
+	0x61, 0x9a, 0x08, 0xb2, 0xed, 0x4b, 0x00, 0xce, 0xa2, 0xd1, 0x3d, 0x80, 0x69, 0x36, 0xa8, 0xcb,
+	0xcf, 0xc9, 0x82, 0x5a, 0x9a, 0x05, 0xe8, 0x1e, 0x2c, 0x9b, 0xf6, 0x62, 0x6a, 0xe1, 0xfa, 0x4c,
+	0x1f, 0xed, 0x6b, 0x0c, 0xb6, 0x60, 0xf7, 0x9f, 0x0e, 0xb4, 0xf2, 0x3c, 0xf4, 0x08, 0x56, 0x39,
+	0x4d, 0x98, 0x4f, 0x8c, 0x0b, 0xfa, 0xcc, 0x8b, 0xc6, 0xc4, 0xfa, 0xbe, 0x60, 0xcc, 0x4e, 0x30,
+	0x64, 0x58, 0xf2, 0x31, 0xd2, 0x42, 0xda, 0x49, 0x8a, 0xc4, 0xd1, 0x43, 0x58, 0xc9, 0x3c, 0x4e,
+	0xd7, 0xf2, 0xa2, 0x35, 0xaf, 0x90, 0x1e, 0x4f, 0x1f, 0x45, 0xe2, 0xee, 0x96, 0x4e, 0xcb, 0x4b,
+	0x19, 0x29, 0x55, 0xf0, 0x77, 0xe1, 0x8a, 0x17, 0xc7, 0x61, 0xe0, 0x1b, 0x45, 0x52, 0xc8, 0xa7,
+	0x21, 0x6f, 0x97, 0xd6, 0x4b, 0x1b, 0x35, 0xbc, 0x9a, 0x61, 0x1e, 0x5a, 0x9e, 0x4b, 0xa1, 0x96,
+	0x9a, 0x87, 0xde, 0x87, 0x96, 0xe9, 0x36, 0x36, 0xb2, 0x3a, 0x66, 0x4d, 0x43, 0xd5, 0x76, 0xa3,
+	0x07, 0x00, 0xe6, 0xd5, 0x21, 0x89, 0xde, 0xc8, 0xd6, 0x9a, 0xc6, 0x3f, 0x26, 0x91, 0xfb, 0x5b,
+	0x07, 0x5a, 0xf9, 0x7a, 0x47, 0x9f, 0x16, 0x3b, 0x95, 0x73, 0x51, 0xa7, 0x2a, 0xf4, 0xa9, 0x9f,
+	0x17, 0xfa, 0xd4, 0xe2, 0x05, 0x7d, 0x2a, 0xd7, 0xa5, 0xdc, 0x3f, 0x38, 0xd0, 0xd8, 0xcb, 0xb7,
+	0xbd, 0xe6, 0xcb, 0x80, 0x89, 0xc4, 0x0b, 0x73, 0x15, 0x55, 0xd0, 0xf7, 0x4c, 0x43, 0x54, 0x55,
+	0x35, 0x5e, 0x4e, 0x0f, 0x1c, 0x3d, 0x98, 0x56, 0x96, 0xb6, 0xe4, 0xbd, 0xf9, 0x2f, 0xf9, 0xee,
+	0xa5, 0xf5, 0x2f, 0x07, 0xea, 0x99, 0xbb, 0x67, 0x96, 0x57, 0x1b, 0x96, 0x87, 0xf4, 0xc4, 0x0b,
+	0x22, 0x3d, 0x2a, 0x6b, 0xd8, 0x1e, 0xd1, 0x8f, 0xa0, 0xc2, 0x64, 0x5d, 0xe9, 0xac, 0xa8, 0x6f,
+	0xbd, 0x33, 0xa3, 0xe6, 0xb0, 0x81, 0x64, 0x5b, 0x44, 0x79, 0x56, 0x8b, 0xc8, 0x98, 0x71, 0x6e,
+	0xb7, 0xab, 0x7c, 0xa7, 0x6e, 0xe7, 0xfe, 0xb9, 0x04, 0x4b, 0xca, 0x10, 0xf4, 0x29, 0x54, 0xed,
+	0x42, 0x60, 0x82, 0x70, 0xbb, 0x93, 0x6e, 0x08, 0x6a, 0xf4, 0xe6, 0xec, 0xb1, 0x95, 0x9b, 0x0a,
+	0xc9, 0x09, 0xa6, 0xde, 0xd2, 0xf7, 0xfc, 0x37, 0x6a, 0x34, 0x72, 0x82, 0xb1, 0xe9, 0x51, 0x4e,
+	0x30, 0x46, 0x86, 0x01, 0x23, 0xbe, 0xb0, 0x2a, 0x4a, 0xb3, 0x5a, 0x07, 0x36, 0xa0, 0x54, 0x4b,
+	0x8b, 0xe5, 0x28, 0xe8, 0x4b, 0x58, 0x33, 0x6a, 0x18, 0xe1, 0x31, 0x8d, 0x78, 0x6a, 0x92, 0xf6,
+	0xac, 0x9b, 0xd7, 0xb7, 0xab, 0xb0, 0xd8, 0x40, 0x53, 0xad, 0xab, 0xc3, 0x19, 0x74, 0xf4, 0xd1,
+	0x34, 0x4c, 0x4b, 0xb3, 0x2a, 0x47, 0xbd, 0xef, 0x2d, 0x06, 0x28, 0x4d, 0xb9, 0xe5, 0x69, 0xca,
+	0x75, 0xab, 0x50, 0xd1, 0x0f, 0x72, 0xff, 0xe6, 0x40, 0x3d, 0xe3, 0x52, 0x74, 0x17, 0x2a, 0x3c,
+	0x88, 0xc6, 0x21, 0x31, 0x75, 0x5d, 0xf0, 0xfe, 0xee, 0xb4, 0x8d, 0xed, 0x2d, 0x60, 0x03, 0x45,
+	0xf7, 0x60, 0xe9, 0x24, 0x09, 0x45, 0x60, 0x22, 0x76, 0xb3, 0x10, 0x68, 0xc9, 0xca, 0x0b, 0x6a,
+	0x38, 0xea, 0x42, 0x2b, 0x89, 0xb9, 0x60, 0xc4, 0x3b, 0xe9, 0x8f, 0x19, 0x4d, 0x62, 0x13, 0xaf,
+	0xab, 0xf9, 0x4d, 0x0d, 0x13, 0xdd, 0x89, 0x31, 0x19, 0xed, 0x2d, 0xe0, 0xa6, 0x15, 0x79, 0x28,
+	0x25, 0xba, 0xcd, 0xdc, 0x70, 0x72, 0xff, 0xbe, 0x08, 0xf5, 0xcc, 0x5d, 0xe8, 0x63, 0xa8, 0x5a,
+	0xbc, 0x59, 0x67, 0xce, 0x55, 0x9e, 0x82, 0xd1, 0x67, 0x50, 0x7e, 0x91, 0x0c, 0x48, 0xbb, 0xae,
+	0x84, 0x7e, 0x98, 0x7f, 0xd2, 0x17, 0xc9, 0x80, 0xb0, 0x88, 0x08, 0xc2, 0x8f, 0x08, 0x7b, 0x19,
+	0xf8, 0x24, 0xff, 0x3c, 0x25, 0x89, 0x3e, 0x83, 0x8a, 0x4f, 0x23, 0x9e, 0x84, 0xed, 0x86, 0xd2,
+	0xf1, 0x41, 0x61, 0xd0, 0x28, 0xde, 0x4c, 0x79, 0x23, 0x87, 0xf6, 0xf2, 0xd3, 0x86, 0xc7, 0xc4,
+	0x37, 0x2e, 0xbe, 0x31, 0x37, 0x2c, 0x47, 0x31, 0xf1, 0x73, 0xe3, 0x46, 0x12, 0xd0, 0x8f, 0xa1,
+	0xa2, 0x7f, 0x0b, 0x18, 0x0f, 0xaf, 0x16, 0x26, 0xb0, 0xe2, 0x61, 0x83, 0xe9, 0xa2, 0xfc, 0xbd,
+	0x42, 0x6e, 0x31, 0x04, 0xae, 0x9f, 0xf7, 0x6a, 0x74, 0x07, 0x4a, 0x8c, 0x8c, 0xd2, 0xac, 0x99,
+	0xe7, 0x63, 0xb3, 0x6d, 0x4b, 0xac, 0xcc, 0xcc, 0x74, 0x80, 0x36, 0xb1, 0xfa, 0x76, 0x05, 0xb4,
+	0xe7, 0x39, 0x46, 0x2e, 0xd9, 0x5c, 0x53, 0xfb, 0x99, 0x26, 0x5a, 0x37, 0xb4, 0x27, 0xb2, 0x97,
+	0x22, 0x28, 0x0b, 0x6f, 0x6c, 0x1b, 0xa9, 0xfa, 0x96, 0x62, 0xb2, 0x10, 0xfa, 0x3e, 0x89, 0x84,
+	0xec, 0x4d, 0x7a, 0xc2, 0xd6, 0x25, 0x6d, 0x47, 0x93, 0xdc, 0xff, 0x39, 0xd0, 0x7c, 0x9a, 0x4d,
+	0x2b, 0xd4, 0x83, 0x46, 0xc6, 0x05, 0xb6, 0xa1, 0x15, 0x66, 0xc3, 0xaf, 0x48, 0x30, 0x9e, 0x08,
+	0x32, 0xcc, 0x18, 0x89, 0x73, 0x62, 0xdf, 0x97, 0x9f, 0x34, 0x57, 0xbf, 0xfa, 0xb6, 0x7c, 0x05,
+	0x16, 0x93, 0x31, 0xba, 0x94, 0x2f, 0x38, 0xee, 0x3e, 0x87, 0x95, 0x62, 0x81, 0xbe, 0xa5, 0xc7,
+	0xbb, 0x7f, 0x74, 0xe0, 0x9d, 0x19, 0xa8, 0xe2, 0x3e, 0x79, 0x51, 0xa3, 0xc9, 0xef, 0x93, 0x6b,
+	0x50, 0x79, 0xa5, 0x74, 0x9a, 0xb4, 0x31, 0x27, 0xd4, 0x9d, 0xf6, 0x55, 0x9d, 0xe2, 0x1b, 0x17,
+	0x9a, 0x5b, 0xec, 0xb2, 0xee, 0xd7, 0x25, 0x68, 0xe5, 0x87, 0x03, 0xba, 0x0d, 0x4d, 0xb9, 0x56,
+	0xf4, 0xed, 0x84, 0x30, 0x49, 0xd7, 0x90, 0x44, 0x0b, 0x45, 0xef, 0x43, 0x33, 0xf6, 0xc4, 0x64,
+	0x0a, 0x52, 0x3f, 0xff, 0xe4, 0x2f, 0x34, 0x49, 0x4e, 0x61, 0x1f, 0x42, 0xcb, 0x2e, 0xa0, 0xe4,
+	0x15, 0x0b, 0x04, 0x51, 0x13, 0x40, 0xe2, 0x9a, 0x9a, 0x8e, 0x35, 0x19, 0x3d, 0x83, 0x66, 0x3a,
+	0x78, 0x7c, 0x3a, 0x24, 0xea, 0x45, 0xad, 0xad, 0x3b, 0xe7, 0x8d, 0xb1, 0xf4, 0x68, 0xe7, 0xcd,
+	0x0e, 0x1d, 0x12, 0xdc, 0x60, 0x99, 0x93, 0x5c, 0x19, 0xe5, 0x2a, 0xc6, 0xa7, 0x86, 0xca, 0x79,
+	0x56, 0xc5, 0x6a, 0xa3, 0xe3, 0xa9, 0x9d, 0x6a, 0xab, 0x61, 0x41, 0xdc, 0xff, 0x4d, 0x42, 0xd8,
+	0x99, 0xca, 0xdc, 0xaa, 0xdc, 0x6a, 0x58, 0x10, 0xff, 0x52, 0x52, 0xdc, 0x57, 0xb0, 0x3a, 0xeb,
+	0x36, 0x74, 0x05, 0x2e, 0xef, 0x1f, 0x3c, 0xeb, 0xed, 0xf6, 0x0f, 0x7b, 0x78, 0x7f, 0xfb, 0x49,
+	0xef, 0xc9, 0xf1, 0xe3, 0xe7, 0x2b, 0x0b, 0xa8, 0x06, 0x4b, 0x9f, 0x1f, 0x3c, 0x7d, 0xb2, 0xbb,
+	0xe2, 0xa0, 0x26, 0xd4, 0x8e, 0x7a, 0xbd, 0xfe, 0xc1, 0xf1, 0x5e, 0x0f, 0xaf, 0x2c, 0xa2, 0x35,
+	0x40, 0xc7, 0xbd, 0xfd, 0xc3, 0x03, 0xbc, 0x8d, 0x9f, 0xf7, 0x71, 0x6f, 0xf7, 0x11, 0xee, 0xed,
+	0x1c, 0xaf, 0x94, 0x24, 0x3d, 0x55, 0x31, 0xa5, 0x97, 0xbb, 0x6d, 0x58, 0x33, 0x8e, 0x56, 0x8e,
+	0x52, 0x1d, 0x31, 0x18, 0x05, 0x84, 0xb9, 0x5d, 0x58, 0x9d, 0x35, 0x86, 0x65, 0xba, 0x98, 0x02,
+	0x74, 0x74, 0xba, 0x98, 0x5a, 0x42, 0x50, 0x1e, 0xd0, 0xe1, 0x99, 0xf9, 0xa1, 0xae, 0xbe, 0xbb,
+	0xf7, 0x65, 0x19, 0xfe, 0xfe, 0xdf, 0x37, 0x9d, 0x2f, 0x7f, 0xfa, 0x66, 0x7f, 0x50, 0xc5, 0x2f,
+	0xc6, 0xe6, 0x8f, 0x91, 0x41, 0x45, 0x8d, 0xe1, 0xbb, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xd3,
+	0x76, 0xcc, 0x67, 0xdb, 0x12, 0x00, 0x00,
 }
 
 func (this *Proxy) Equal(that interface{}) bool {
@@ -1624,6 +1824,30 @@ func (this *Listener_TcpListener) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Listener_HybridListener) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Listener_HybridListener)
+	if !ok {
+		that2, ok := that.(Listener_HybridListener)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.HybridListener.Equal(that1.HybridListener) {
+		return false
+	}
+	return true
+}
 func (this *TcpListener) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1690,6 +1914,112 @@ func (this *TcpHost) Equal(that interface{}) bool {
 	if !this.SslConfig.Equal(that1.SslConfig) {
 		return false
 	}
+	if !this.Matcher.Equal(that1.Matcher) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *TcpHostMatcher) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TcpHostMatcher)
+	if !ok {
+		that2, ok := that.(TcpHostMatcher)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.SourcePrefixRanges) != len(that1.SourcePrefixRanges) {
+		return false
+	}
+	for i := range this.SourcePrefixRanges {
+		if !this.SourcePrefixRanges[i].Equal(that1.SourcePrefixRanges[i]) {
+			return false
+		}
+	}
+	if !this.DestinationPort.Equal(that1.DestinationPort) {
+		return false
+	}
+	if len(this.ApplicationProtocols) != len(that1.ApplicationProtocols) {
+		return false
+	}
+	for i := range this.ApplicationProtocols {
+		if this.ApplicationProtocols[i] != that1.ApplicationProtocols[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *CidrRange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CidrRange)
+	if !ok {
+		that2, ok := that.(CidrRange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.AddressPrefix != that1.AddressPrefix {
+		return false
+	}
+	if !this.PrefixLen.Equal(that1.PrefixLen) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *HybridListener) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HybridListener)
+	if !ok {
+		that2, ok := that.(HybridListener)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.HttpListener.Equal(that1.HttpListener) {
+		return false
+	}
+	if !this.TcpListener.Equal(that1.TcpListener) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -0,0 +1,306 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/reference_policy.proto
+
+package v1
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// The kinds of resources that can be referenced.
+type ReferencePolicyRule_Kind int32
+
+const (
+	// Matches all the kinds below.
+	ReferencePolicyRule_ANY            ReferencePolicyRule_Kind = 0
+	ReferencePolicyRule_UPSTREAM       ReferencePolicyRule_Kind = 1
+	ReferencePolicyRule_UPSTREAM_GROUP ReferencePolicyRule_Kind = 2
+	ReferencePolicyRule_ROUTE_TABLE    ReferencePolicyRule_Kind = 3
+)
+
+var ReferencePolicyRule_Kind_name = map[int32]string{
+	0: "ANY",
+	1: "UPSTREAM",
+	2: "UPSTREAM_GROUP",
+	3: "ROUTE_TABLE",
+}
+
+var ReferencePolicyRule_Kind_value = map[string]int32{
+	"ANY":            0,
+	"UPSTREAM":       1,
+	"UPSTREAM_GROUP": 2,
+	"ROUTE_TABLE":    3,
+}
+
+func (x ReferencePolicyRule_Kind) String() string {
+	return proto.EnumName(ReferencePolicyRule_Kind_name, int32(x))
+}
+
+func (ReferencePolicyRule_Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_45fc97ca0bad60cd, []int{1, 0}
+}
+
+//
+//A ReferencePolicy is published by the owners of a namespace to control which other namespaces
+//may reference the resources that live in it.
+//
+//By default, resources in a namespace can be referenced from any namespace. As soon as at least one
+//ReferencePolicy exists in a namespace, references to Upstreams, UpstreamGroups and RouteTables in that namespace
+//are only allowed from the namespace itself and from the namespaces allowed by the rules of its ReferencePolicies.
+//
+//References that are not allowed are reported as errors on the referencing resource:
+//- the gateway translator checks the Upstreams, UpstreamGroups and RouteTables referenced by
+// Virtual Services and Route Tables (including the ones matched by a delegation selector),
+// as well as the Upstreams referenced by the TCP hosts of a Gateway.
+//- the gloo translator checks the Upstreams referenced by UpstreamGroups.
+//
+//For example, the following policy allows Virtual Services in the `team-a` namespace to route to
+//the Upstreams of the `backends` namespace, while RouteTables in `backends` can still be delegated to
+//from any namespace:
+//
+//```yaml
+//apiVersion: gloo.solo.io/v1
+//kind: ReferencePolicy
+//metadata:
+// name: allow-team-a
+// namespace: backends
+//spec:
+// rules:
+// - kinds:
+// - UPSTREAM
+// namespaces:
+// - team-a
+// - kinds:
+// - ROUTE_TABLE
+// namespaces:
+// - '*'
+//```
+type ReferencePolicy struct {
+	// The rules that define which namespaces may reference the resources in the namespace of this policy.
+	Rules []*ReferencePolicyRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	// Metadata contains the object metadata for this resource
+	Metadata             core.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReferencePolicy) Reset()         { *m = ReferencePolicy{} }
+func (m *ReferencePolicy) String() string { return proto.CompactTextString(m) }
+func (*ReferencePolicy) ProtoMessage()    {}
+func (*ReferencePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45fc97ca0bad60cd, []int{0}
+}
+func (m *ReferencePolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReferencePolicy.Unmarshal(m, b)
+}
+func (m *ReferencePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReferencePolicy.Marshal(b, m, deterministic)
+}
+func (m *ReferencePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferencePolicy.Merge(m, src)
+}
+func (m *ReferencePolicy) XXX_Size() int {
+	return xxx_messageInfo_ReferencePolicy.Size(m)
+}
+func (m *ReferencePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferencePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferencePolicy proto.InternalMessageInfo
+
+func (m *ReferencePolicy) GetRules() []*ReferencePolicyRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+func (m *ReferencePolicy) GetMetadata() core.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return core.Metadata{}
+}
+
+// A rule that allows resources of the given kinds to be referenced from the given namespaces.
+type ReferencePolicyRule struct {
+	// The kinds of resources this rule applies to. If empty, the rule applies to all kinds.
+	Kinds []ReferencePolicyRule_Kind `protobuf:"varint,1,rep,packed,name=kinds,proto3,enum=gloo.solo.io.ReferencePolicyRule_Kind" json:"kinds,omitempty"`
+	// The namespaces that are allowed to reference the resources.
+	// Setting '*' will allow all namespaces.
+	Namespaces           []string `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReferencePolicyRule) Reset()         { *m = ReferencePolicyRule{} }
+func (m *ReferencePolicyRule) String() string { return proto.CompactTextString(m) }
+func (*ReferencePolicyRule) ProtoMessage()    {}
+func (*ReferencePolicyRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45fc97ca0bad60cd, []int{1}
+}
+func (m *ReferencePolicyRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReferencePolicyRule.Unmarshal(m, b)
+}
+func (m *ReferencePolicyRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReferencePolicyRule.Marshal(b, m, deterministic)
+}
+func (m *ReferencePolicyRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferencePolicyRule.Merge(m, src)
+}
+func (m *ReferencePolicyRule) XXX_Size() int {
+	return xxx_messageInfo_ReferencePolicyRule.Size(m)
+}
+func (m *ReferencePolicyRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferencePolicyRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferencePolicyRule proto.InternalMessageInfo
+
+func (m *ReferencePolicyRule) GetKinds() []ReferencePolicyRule_Kind {
+	if m != nil {
+		return m.Kinds
+	}
+	return nil
+}
+
+func (m *ReferencePolicyRule) GetNamespaces() []string {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("gloo.solo.io.ReferencePolicyRule_Kind", ReferencePolicyRule_Kind_name, ReferencePolicyRule_Kind_value)
+	proto.RegisterType((*ReferencePolicy)(nil), "gloo.solo.io.ReferencePolicy")
+	proto.RegisterType((*ReferencePolicyRule)(nil), "gloo.solo.io.ReferencePolicyRule")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/reference_policy.proto", fileDescriptor_45fc97ca0bad60cd)
+}
+
+var fileDescriptor_45fc97ca0bad60cd = []byte{
+	// 378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xb1, 0x4e, 0xc2, 0x40,
+	0x18, 0xc7, 0x29, 0x2d, 0x82, 0x07, 0x81, 0xe6, 0x34, 0xa6, 0x21, 0x06, 0x91, 0xc1, 0xb0, 0x78,
+	0x55, 0x1c, 0x34, 0xc4, 0x85, 0x1a, 0xe2, 0xa0, 0x08, 0x39, 0x61, 0xd0, 0x85, 0x94, 0x72, 0xd6,
+	0x93, 0xd2, 0x6b, 0xda, 0xc3, 0xe0, 0xea, 0xa3, 0x38, 0xf9, 0x06, 0xfa, 0x08, 0x3e, 0x85, 0x83,
+	0x6f, 0xe0, 0xe0, 0x6e, 0xda, 0xa3, 0x84, 0x10, 0x8d, 0x6e, 0xf7, 0x7d, 0xff, 0xdf, 0x77, 0xff,
+	0x7f, 0xee, 0x3b, 0x70, 0x62, 0x53, 0x7e, 0x3b, 0x19, 0x20, 0x8b, 0x8d, 0xf5, 0x80, 0x39, 0x6c,
+	0x97, 0x32, 0xdd, 0x76, 0x18, 0xd3, 0x3d, 0x9f, 0xdd, 0x11, 0x8b, 0x07, 0xa2, 0x32, 0x3d, 0xaa,
+	0xdf, 0xef, 0xeb, 0x3e, 0xb9, 0x21, 0x3e, 0x71, 0x2d, 0xd2, 0xf7, 0x98, 0x43, 0xad, 0x07, 0xe4,
+	0xf9, 0x8c, 0x33, 0x98, 0x0b, 0x19, 0x14, 0x8e, 0x23, 0xca, 0x8a, 0xeb, 0x36, 0xb3, 0x59, 0x24,
+	0xe8, 0xe1, 0x49, 0x30, 0x45, 0x48, 0xa6, 0x5c, 0x34, 0xc9, 0x94, 0xcf, 0x7a, 0xa5, 0xc8, 0x71,
+	0x44, 0x79, 0x7c, 0xff, 0x98, 0x70, 0x73, 0x68, 0x72, 0xf3, 0x37, 0x3d, 0xae, 0x85, 0x5e, 0x79,
+	0x92, 0x40, 0x01, 0xc7, 0x91, 0x3a, 0x51, 0x22, 0x78, 0x08, 0x52, 0xfe, 0xc4, 0x21, 0x81, 0x26,
+	0x95, 0xe5, 0x6a, 0xb6, 0xb6, 0x8d, 0x16, 0xb3, 0xa1, 0x25, 0x1a, 0x4f, 0x1c, 0x82, 0x05, 0x0f,
+	0x8f, 0x40, 0x26, 0xb6, 0xd7, 0xd2, 0x65, 0xa9, 0x9a, 0xad, 0x6d, 0x20, 0x8b, 0xf9, 0x64, 0x3e,
+	0xdb, 0x9a, 0xa9, 0x86, 0xf2, 0xf6, 0xbe, 0x95, 0xc0, 0x73, 0xba, 0xbe, 0xf9, 0xf8, 0xa9, 0x68,
+	0x20, 0xe9, 0x7b, 0x10, 0x2e, 0x3d, 0x10, 0x25, 0x41, 0xe5, 0x45, 0x02, 0x6b, 0x3f, 0xd8, 0xc2,
+	0x63, 0x90, 0x1a, 0x51, 0x77, 0x28, 0x82, 0xe6, 0x6b, 0x3b, 0x7f, 0x06, 0x45, 0x67, 0xd4, 0x1d,
+	0x62, 0x31, 0x04, 0x4b, 0x00, 0xb8, 0xe6, 0x98, 0x04, 0x9e, 0x69, 0x91, 0x40, 0x4b, 0x96, 0xe5,
+	0xea, 0x2a, 0x5e, 0xe8, 0x54, 0x0c, 0xa0, 0x84, 0x38, 0x4c, 0x03, 0xb9, 0x71, 0x71, 0xa5, 0x26,
+	0x60, 0x0e, 0x64, 0x7a, 0x9d, 0xcb, 0x2e, 0x6e, 0x36, 0x5a, 0xaa, 0x04, 0x21, 0xc8, 0xc7, 0x55,
+	0xff, 0x14, 0xb7, 0x7b, 0x1d, 0x35, 0x09, 0x0b, 0x20, 0x8b, 0xdb, 0xbd, 0x6e, 0xb3, 0xdf, 0x6d,
+	0x18, 0xe7, 0x4d, 0x55, 0x36, 0xea, 0xaf, 0x5f, 0x8a, 0xf4, 0xfc, 0x51, 0x92, 0xae, 0xf7, 0xfe,
+	0xf7, 0x4b, 0xbc, 0x91, 0x3d, 0xdb, 0xd4, 0x60, 0x25, 0xda, 0xd0, 0xc1, 0x77, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0x13, 0xfa, 0x8c, 0x05, 0x60, 0x02, 0x00, 0x00,
+}
+
+func (this *ReferencePolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReferencePolicy)
+	if !ok {
+		that2, ok := that.(ReferencePolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Rules) != len(that1.Rules) {
+		return false
+	}
+	for i := range this.Rules {
+		if !this.Rules[i].Equal(that1.Rules[i]) {
+			return false
+		}
+	}
+	if !this.Metadata.Equal(&that1.Metadata) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ReferencePolicyRule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReferencePolicyRule)
+	if !ok {
+		that2, ok := that.(ReferencePolicyRule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Kinds) != len(that1.Kinds) {
+		return false
+	}
+	for i := range this.Kinds {
+		if this.Kinds[i] != that1.Kinds[i] {
+			return false
+		}
+	}
+	if len(this.Namespaces) != len(that1.Namespaces) {
+		return false
+	}
+	for i := range this.Namespaces {
+		if this.Namespaces[i] != that1.Namespaces[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -319,7 +319,12 @@ type TlsSecret struct {
 	// provided by `glooctl create secret tls`
 	PrivateKey string `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
 	// provided by `glooctl create secret tls`
-	RootCa               string   `protobuf:"bytes,3,opt,name=root_ca,json=rootCa,proto3" json:"root_ca,omitempty"`
+	RootCa string `protobuf:"bytes,3,opt,name=root_ca,json=rootCa,proto3" json:"root_ca,omitempty"`
+	// optional. DER encoded OCSP response stapled during the TLS handshake.
+	// provided by `glooctl create secret tls`
+	OcspStaple []byte `protobuf:"bytes,4,opt,name=ocsp_staple,json=ocspStaple,proto3" json:"ocsp_staple,omitempty"`
+	// optional. PEM encoded certificate revocation list used during peer certificate validation.
+	Crl                  string   `protobuf:"bytes,5,opt,name=crl,proto3" json:"crl,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -370,6 +375,20 @@ func (m *TlsSecret) GetRootCa() string {
 	return ""
 }
 
+func (m *TlsSecret) GetOcspStaple() []byte {
+	if m != nil {
+		return m.OcspStaple
+	}
+	return nil
+}
+
+func (m *TlsSecret) GetCrl() string {
+	if m != nil {
+		return m.Crl
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Secret)(nil), "gloo.solo.io.Secret")
 	proto.RegisterType((*AwsSecret)(nil), "gloo.solo.io.AwsSecret")
@@ -383,42 +402,44 @@ func init() {
 }
 
 var fileDescriptor_c2f79c35f1213791 = []byte{
-	// 557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcb, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8e, 0x63, 0xc7, 0xa9, 0x4f, 0xba, 0xf8, 0x35, 0xaa, 0x5a, 0xff, 0x91, 0xda, 0xa2, 0x08,
-	0x50, 0x05, 0xc2, 0xa6, 0x65, 0x53, 0x22, 0x16, 0x24, 0x55, 0xa4, 0xa2, 0x0a, 0x21, 0x19, 0x56,
-	0x6c, 0xa2, 0xa9, 0x33, 0x4a, 0x86, 0xb8, 0x1e, 0x6b, 0x66, 0x92, 0x26, 0x2c, 0xbb, 0xe6, 0x41,
-	0x90, 0x78, 0x01, 0x1e, 0x81, 0xa7, 0x60, 0xc1, 0x1b, 0x74, 0xc1, 0x1e, 0xcd, 0x25, 0x8e, 0x8b,
-	0x08, 0x62, 0x37, 0xe7, 0x7c, 0x97, 0xcc, 0x77, 0xce, 0x38, 0xf0, 0x7c, 0x4c, 0xe5, 0x64, 0x76,
-	0x19, 0xa5, 0xec, 0x2a, 0x16, 0x2c, 0x63, 0x4f, 0x28, 0x8b, 0xc7, 0x19, 0x63, 0x71, 0xc1, 0xd9,
-	0x07, 0x92, 0x4a, 0x61, 0x2a, 0x5c, 0xd0, 0x78, 0x7e, 0x1c, 0x0b, 0x92, 0x72, 0x22, 0xa3, 0x82,
-	0x33, 0xc9, 0xd0, 0xb6, 0x42, 0x22, 0x25, 0x8a, 0x28, 0x6b, 0xef, 0x8c, 0xd9, 0x98, 0x69, 0x20,
-	0x56, 0x27, 0xc3, 0x69, 0x23, 0xb2, 0x90, 0xa6, 0x49, 0x16, 0x56, 0xd7, 0x7e, 0xb4, 0xd9, 0x9f,
-	0x2c, 0x24, 0xc9, 0x05, 0x65, 0xb9, 0xb0, 0xdc, 0xc1, 0x5f, 0xb8, 0xb9, 0x24, 0xbc, 0xe0, 0x54,
-	0x90, 0x98, 0x15, 0x52, 0x69, 0x94, 0x1c, 0xcf, 0xe4, 0xc4, 0x3a, 0xa9, 0xa3, 0xb5, 0x39, 0xd0,
-	0xd1, 0xa6, 0x54, 0xae, 0xc4, 0x57, 0x44, 0xe2, 0x11, 0x96, 0x78, 0x13, 0xbe, 0xaa, 0x0d, 0xde,
-	0xf9, 0xe2, 0x82, 0xff, 0x56, 0x67, 0x47, 0x8f, 0xc1, 0xc5, 0xd7, 0x22, 0x74, 0xee, 0x39, 0x47,
-	0xad, 0x93, 0xbd, 0xa8, 0x3a, 0x83, 0xa8, 0x77, 0x2d, 0x0c, 0xeb, 0xbc, 0x96, 0x28, 0x16, 0x3a,
-	0x86, 0x06, 0xfe, 0x38, 0xe3, 0x24, 0xac, 0x6b, 0xfa, 0xff, 0xbf, 0xd1, 0x15, 0x54, 0x0a, 0x0c,
-	0x53, 0xf9, 0xcb, 0x4c, 0x84, 0xee, 0x9f, 0xfc, 0xdf, 0x65, 0x15, 0x7f, 0x99, 0x09, 0xf4, 0x02,
-	0x1a, 0x4c, 0xc5, 0x0c, 0x1b, 0x9a, 0x7e, 0x3f, 0x5a, 0x0f, 0xe5, 0xae, 0xf2, 0x8d, 0x62, 0xad,
-	0x7f, 0x4a, 0x8b, 0xd0, 0x4b, 0x68, 0xe2, 0x82, 0x0e, 0xa7, 0x64, 0x19, 0xfa, 0x5a, 0xff, 0x60,
-	0xa3, 0xbe, 0x57, 0xd0, 0x0b, 0xb2, 0x2c, 0x0d, 0x7c, 0xac, 0x6b, 0xd4, 0x05, 0x58, 0xaf, 0x2c,
-	0xf4, 0xb4, 0x49, 0x78, 0x57, 0x39, 0x28, 0xf1, 0xf3, 0x5a, 0x52, 0x61, 0xa3, 0x53, 0xd8, 0x5a,
-	0x6d, 0x21, 0x6c, 0x6a, 0xe5, 0x6e, 0x94, 0x32, 0x4e, 0x4a, 0xe5, 0x6b, 0x8b, 0xf6, 0xbd, 0x6f,
-	0xdf, 0x0f, 0x6b, 0x49, 0xc9, 0xee, 0xee, 0xde, 0xdc, 0x7a, 0x0d, 0x70, 0x05, 0x49, 0x6f, 0x6e,
-	0xbd, 0x00, 0x35, 0xcd, 0xab, 0x14, 0x7d, 0x1f, 0xbc, 0x29, 0xcd, 0x47, 0x9d, 0x57, 0x10, 0x94,
-	0x9b, 0x40, 0xfb, 0x00, 0x38, 0x4d, 0x89, 0x10, 0x3a, 0xa7, 0x5a, 0x5b, 0x90, 0x04, 0xa6, 0xa3,
-	0x12, 0xec, 0x03, 0x18, 0xb9, 0x86, 0xeb, 0x06, 0x36, 0x9d, 0x0b, 0xb2, 0xec, 0x7c, 0x72, 0xa0,
-	0x55, 0x59, 0x13, 0xea, 0xc1, 0x96, 0x1d, 0x99, 0x7a, 0x02, 0xee, 0x51, 0xeb, 0xe4, 0xe1, 0xc6,
-	0x9d, 0xda, 0xa1, 0x89, 0x41, 0x2e, 0xf9, 0x32, 0x69, 0x9a, 0x91, 0x89, 0x76, 0x17, 0xb6, 0xab,
-	0x00, 0xfa, 0x0f, 0xdc, 0xf5, 0xcd, 0xd4, 0x11, 0xed, 0x40, 0x63, 0x8e, 0xb3, 0x19, 0xb1, 0xd7,
-	0x31, 0x45, 0xb7, 0x7e, 0xea, 0x74, 0x46, 0x10, 0x94, 0x6f, 0x40, 0x5d, 0x3d, 0x25, 0x5c, 0x0e,
-	0xd3, 0x09, 0xa6, 0xf9, 0x2a, 0x99, 0xea, 0x9c, 0xa9, 0x06, 0x3a, 0x84, 0x56, 0xc1, 0xe9, 0x1c,
-	0x4b, 0x52, 0x89, 0x06, 0xb6, 0xa5, 0xa2, 0xef, 0x41, 0x93, 0x33, 0x26, 0x87, 0x29, 0xd6, 0xaf,
-	0x2d, 0x48, 0x7c, 0x55, 0x9e, 0xe1, 0x7e, 0xf7, 0xeb, 0x4f, 0xcf, 0xf9, 0xfc, 0xe3, 0xc0, 0x79,
-	0xff, 0xf4, 0xdf, 0xfe, 0x1d, 0x8a, 0xe9, 0xd8, 0x7e, 0x38, 0x97, 0xbe, 0xfe, 0x60, 0x9e, 0xfd,
-	0x0a, 0x00, 0x00, 0xff, 0xff, 0x53, 0xe8, 0xd1, 0x63, 0x58, 0x04, 0x00, 0x00,
+	// 582 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xae, 0xeb, 0xc4, 0xa9, 0x27, 0xd5, 0xaf, 0x5f, 0xab, 0x8a, 0x9a, 0x48, 0x6d, 0x51, 0x04,
+	0xa8, 0x02, 0x61, 0xd3, 0x72, 0x29, 0x11, 0x07, 0xda, 0xaa, 0x52, 0x51, 0x85, 0x90, 0x5c, 0x4e,
+	0x5c, 0xa2, 0xed, 0x76, 0x95, 0x2c, 0x71, 0xbd, 0xd6, 0xee, 0x26, 0x4d, 0x38, 0x72, 0xe6, 0xce,
+	0x23, 0xc0, 0x23, 0xf0, 0x08, 0x3c, 0x05, 0x07, 0xde, 0x80, 0x03, 0x77, 0x34, 0xbb, 0x8e, 0xe3,
+	0x22, 0x82, 0xb8, 0xed, 0xcc, 0xf7, 0x7d, 0x93, 0x99, 0xf9, 0xc6, 0x81, 0xa7, 0x03, 0x61, 0x86,
+	0xe3, 0x8b, 0x98, 0xc9, 0xab, 0x44, 0xcb, 0x4c, 0x3e, 0x12, 0x32, 0x19, 0x64, 0x52, 0x26, 0x85,
+	0x92, 0x6f, 0x39, 0x33, 0xda, 0x45, 0xb4, 0x10, 0xc9, 0x64, 0x2f, 0xd1, 0x9c, 0x29, 0x6e, 0xe2,
+	0x42, 0x49, 0x23, 0xc9, 0x3a, 0x22, 0x31, 0x8a, 0x62, 0x21, 0x3b, 0x1b, 0x03, 0x39, 0x90, 0x16,
+	0x48, 0xf0, 0xe5, 0x38, 0x1d, 0xc2, 0xa7, 0xc6, 0x25, 0xf9, 0xb4, 0xd4, 0x75, 0x1e, 0x2c, 0xaf,
+	0xcf, 0xa7, 0x86, 0xe7, 0x5a, 0xc8, 0x5c, 0x97, 0xdc, 0x93, 0xbf, 0x70, 0x73, 0xc3, 0x55, 0xa1,
+	0x84, 0xe6, 0x89, 0x2c, 0x0c, 0x6a, 0x50, 0x4e, 0xc7, 0x66, 0x58, 0x56, 0xc2, 0x67, 0x59, 0x66,
+	0xdb, 0x8e, 0x36, 0x12, 0x66, 0x2e, 0xbe, 0xe2, 0x86, 0x5e, 0x52, 0x43, 0x97, 0xe1, 0xf3, 0xd8,
+	0xe1, 0xdd, 0x4f, 0x3e, 0x04, 0xe7, 0x76, 0x76, 0xf2, 0x10, 0x7c, 0x7a, 0xad, 0x23, 0xef, 0x8e,
+	0xb7, 0xdb, 0xde, 0xdf, 0x8c, 0xeb, 0x3b, 0x88, 0x0f, 0xaf, 0xb5, 0x63, 0x9d, 0xae, 0xa4, 0xc8,
+	0x22, 0x7b, 0xd0, 0xa4, 0xef, 0xc6, 0x8a, 0x47, 0xab, 0x96, 0x7e, 0xfb, 0x37, 0x3a, 0x42, 0x95,
+	0xc0, 0x31, 0xb1, 0xbe, 0xc9, 0x74, 0xe4, 0xff, 0xa9, 0xfe, 0xeb, 0xac, 0x56, 0xdf, 0x64, 0x9a,
+	0x3c, 0x83, 0xa6, 0xc4, 0x31, 0xa3, 0xa6, 0xa5, 0xdf, 0x8d, 0x17, 0x4b, 0xb9, 0xa9, 0x7c, 0x85,
+	0xac, 0xc5, 0x4f, 0x59, 0x11, 0x79, 0x0e, 0x2d, 0x5a, 0x88, 0xfe, 0x88, 0xcf, 0xa2, 0xc0, 0xea,
+	0xef, 0x2d, 0xd5, 0x1f, 0x16, 0xe2, 0x8c, 0xcf, 0xaa, 0x02, 0x01, 0xb5, 0x31, 0xe9, 0x01, 0x2c,
+	0x2c, 0x8b, 0x1a, 0xb6, 0x48, 0x74, 0x53, 0x79, 0x52, 0xe1, 0xa7, 0x2b, 0x69, 0x8d, 0x4d, 0x0e,
+	0x60, 0x6d, 0xee, 0x42, 0xd4, 0xb2, 0xca, 0x5b, 0x31, 0x93, 0x8a, 0x57, 0xca, 0x97, 0x25, 0x7a,
+	0xd4, 0xf8, 0xfa, 0x6d, 0x67, 0x25, 0xad, 0xd8, 0x3d, 0xf2, 0xfe, 0x47, 0xe3, 0x3f, 0xf0, 0x35,
+	0x67, 0xa4, 0xe5, 0x2e, 0x52, 0x1f, 0x05, 0xd0, 0x18, 0x89, 0xfc, 0xb2, 0xfb, 0x02, 0xc2, 0xca,
+	0x05, 0xb2, 0x05, 0x40, 0x19, 0xe3, 0x5a, 0xdb, 0x19, 0xd1, 0xb2, 0x30, 0x0d, 0x5d, 0x06, 0xbb,
+	0xdf, 0x02, 0x70, 0x72, 0x0b, 0xaf, 0x3a, 0xd8, 0x65, 0xce, 0xf8, 0xac, 0xfb, 0xc1, 0x83, 0x76,
+	0xcd, 0x22, 0x72, 0x08, 0x6b, 0xe5, 0xba, 0xd0, 0x7e, 0x7f, 0xb7, 0xbd, 0x7f, 0x7f, 0xa9, 0x9f,
+	0xe5, 0xc2, 0xf4, 0x49, 0x6e, 0xd4, 0x2c, 0x6d, 0xb9, 0x75, 0xe9, 0x4e, 0x0f, 0xd6, 0xeb, 0x00,
+	0xf9, 0x1f, 0xfc, 0x45, 0x67, 0xf8, 0x24, 0x1b, 0xd0, 0x9c, 0xd0, 0x6c, 0xcc, 0xcb, 0x76, 0x5c,
+	0xd0, 0x5b, 0x3d, 0xf0, 0xba, 0x1f, 0x3d, 0x08, 0xab, 0x03, 0xc0, 0xde, 0x19, 0x57, 0xa6, 0xcf,
+	0x86, 0x54, 0xe4, 0xf3, 0xd1, 0x30, 0x73, 0x8c, 0x09, 0xb2, 0x03, 0xed, 0x42, 0x89, 0x09, 0x35,
+	0xbc, 0x36, 0x1b, 0x94, 0x29, 0x9c, 0x7d, 0x13, 0x5a, 0x4a, 0x4a, 0xd3, 0x67, 0xd4, 0x9e, 0x5a,
+	0x98, 0x06, 0x18, 0x1e, 0x53, 0x54, 0x4a, 0xa6, 0x8b, 0xbe, 0x36, 0xb4, 0xc8, 0xb8, 0xf5, 0x74,
+	0x3d, 0x05, 0x4c, 0x9d, 0xdb, 0x0c, 0xf6, 0xcc, 0x54, 0x66, 0x2f, 0x2e, 0x4c, 0xf1, 0x79, 0xd4,
+	0xfb, 0xf2, 0xb3, 0xe1, 0x7d, 0xfe, 0xbe, 0xed, 0xbd, 0x79, 0xfc, 0x6f, 0xff, 0x26, 0xc5, 0x68,
+	0x50, 0x7e, 0x68, 0x17, 0x81, 0xfd, 0xc0, 0x9e, 0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x7e,
+	0xa9, 0x6f, 0x88, 0x04, 0x00, 0x00,
 }
 
 func (this *Secret) Equal(that interface{}) bool {
@@ -691,6 +712,12 @@ func (this *TlsSecret) Equal(that interface{}) bool {
 	if this.RootCa != that1.RootCa {
 		return false
 	}
+	if !bytes.Equal(this.OcspStaple, that1.OcspStaple) {
+		return false
+	}
+	if this.Crl != that1.Crl {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
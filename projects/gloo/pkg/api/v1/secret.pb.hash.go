@@ -243,5 +243,13 @@ func (m *TlsSecret) Hash(hasher hash.Hash64) (uint64, error) {
 		return 0, err
 	}
 
+	if _, err = hasher.Write(m.GetOcspStaple()); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetCrl())); err != nil {
+		return 0, err
+	}
+
 	return hasher.Sum64(), nil
 }
@@ -65,7 +65,7 @@ func (x Settings_DiscoveryOptions_FdsMode) String() string {
 }
 
 func (Settings_DiscoveryOptions_FdsMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_bd7533c2495e1752, []int{0, 7, 0}
+	return fileDescriptor_bd7533c2495e1752, []int{0, 10, 0}
 }
 
 // Represents global settings for all the Gloo components.
@@ -86,6 +86,7 @@ type Settings struct {
 	//	*Settings_KubernetesConfigSource
 	//	*Settings_DirectoryConfigSource
 	//	*Settings_ConsulKvSource
+	//	*Settings_GitConfigSource
 	ConfigSource isSettings_ConfigSource `protobuf_oneof:"config_source"`
 	// Determines where Gloo will read/write secrets from/to.
 	//
@@ -93,7 +94,12 @@ type Settings struct {
 	//	*Settings_KubernetesSecretSource
 	//	*Settings_VaultSecretSource
 	//	*Settings_DirectorySecretSource
+	//	*Settings_ConsulKvSecretSource
+	//	*Settings_AwsSecretSource
 	SecretSource isSettings_SecretSource `protobuf_oneof:"secret_source"`
+	// Decrypt secrets written with `glooctl create secret --encrypt`, and encrypt the secrets Gloo writes.
+	// Use with the directory and Consul Key-Value secret sources, which store secrets in plaintext.
+	SecretEncryption *Settings_SecretEncryption `protobuf:"bytes,31,opt,name=secret_encryption,json=secretEncryption,proto3" json:"secret_encryption,omitempty"`
 	// Where to read artifacts from.
 	//
 	// Types that are valid to be assigned to ArtifactSource:
@@ -197,6 +203,9 @@ type Settings_DirectoryConfigSource struct {
 type Settings_ConsulKvSource struct {
 	ConsulKvSource *Settings_ConsulKv `protobuf:"bytes,21,opt,name=consul_kv_source,json=consulKvSource,proto3,oneof" json:"consul_kv_source,omitempty"`
 }
+type Settings_GitConfigSource struct {
+	GitConfigSource *Settings_GitRepository `protobuf:"bytes,33,opt,name=git_config_source,json=gitConfigSource,proto3,oneof" json:"git_config_source,omitempty"`
+}
 type Settings_KubernetesSecretSource struct {
 	KubernetesSecretSource *Settings_KubernetesSecrets `protobuf:"bytes,6,opt,name=kubernetes_secret_source,json=kubernetesSecretSource,proto3,oneof" json:"kubernetes_secret_source,omitempty"`
 }
@@ -206,6 +215,12 @@ type Settings_VaultSecretSource struct {
 type Settings_DirectorySecretSource struct {
 	DirectorySecretSource *Settings_Directory `protobuf:"bytes,8,opt,name=directory_secret_source,json=directorySecretSource,proto3,oneof" json:"directory_secret_source,omitempty"`
 }
+type Settings_ConsulKvSecretSource struct {
+	ConsulKvSecretSource *Settings_ConsulKv `protobuf:"bytes,30,opt,name=consul_kv_secret_source,json=consulKvSecretSource,proto3,oneof" json:"consul_kv_secret_source,omitempty"`
+}
+type Settings_AwsSecretSource struct {
+	AwsSecretSource *Settings_AwsSecrets `protobuf:"bytes,32,opt,name=aws_secret_source,json=awsSecretSource,proto3,oneof" json:"aws_secret_source,omitempty"`
+}
 type Settings_KubernetesArtifactSource struct {
 	KubernetesArtifactSource *Settings_KubernetesConfigmaps `protobuf:"bytes,9,opt,name=kubernetes_artifact_source,json=kubernetesArtifactSource,proto3,oneof" json:"kubernetes_artifact_source,omitempty"`
 }
@@ -219,9 +234,12 @@ type Settings_ConsulKvArtifactSource struct {
 func (*Settings_KubernetesConfigSource) isSettings_ConfigSource()     {}
 func (*Settings_DirectoryConfigSource) isSettings_ConfigSource()      {}
 func (*Settings_ConsulKvSource) isSettings_ConfigSource()             {}
+func (*Settings_GitConfigSource) isSettings_ConfigSource()            {}
 func (*Settings_KubernetesSecretSource) isSettings_SecretSource()     {}
 func (*Settings_VaultSecretSource) isSettings_SecretSource()          {}
 func (*Settings_DirectorySecretSource) isSettings_SecretSource()      {}
+func (*Settings_ConsulKvSecretSource) isSettings_SecretSource()       {}
+func (*Settings_AwsSecretSource) isSettings_SecretSource()            {}
 func (*Settings_KubernetesArtifactSource) isSettings_ArtifactSource() {}
 func (*Settings_DirectoryArtifactSource) isSettings_ArtifactSource()  {}
 func (*Settings_ConsulKvArtifactSource) isSettings_ArtifactSource()   {}
@@ -280,6 +298,13 @@ func (m *Settings) GetConsulKvSource() *Settings_ConsulKv {
 	return nil
 }
 
+func (m *Settings) GetGitConfigSource() *Settings_GitRepository {
+	if x, ok := m.GetConfigSource().(*Settings_GitConfigSource); ok {
+		return x.GitConfigSource
+	}
+	return nil
+}
+
 func (m *Settings) GetKubernetesSecretSource() *Settings_KubernetesSecrets {
 	if x, ok := m.GetSecretSource().(*Settings_KubernetesSecretSource); ok {
 		return x.KubernetesSecretSource
@@ -301,6 +326,27 @@ func (m *Settings) GetDirectorySecretSource() *Settings_Directory {
 	return nil
 }
 
+func (m *Settings) GetConsulKvSecretSource() *Settings_ConsulKv {
+	if x, ok := m.GetSecretSource().(*Settings_ConsulKvSecretSource); ok {
+		return x.ConsulKvSecretSource
+	}
+	return nil
+}
+
+func (m *Settings) GetAwsSecretSource() *Settings_AwsSecrets {
+	if x, ok := m.GetSecretSource().(*Settings_AwsSecretSource); ok {
+		return x.AwsSecretSource
+	}
+	return nil
+}
+
+func (m *Settings) GetSecretEncryption() *Settings_SecretEncryption {
+	if m != nil {
+		return m.SecretEncryption
+	}
+	return nil
+}
+
 func (m *Settings) GetKubernetesArtifactSource() *Settings_KubernetesConfigmaps {
 	if x, ok := m.GetArtifactSource().(*Settings_KubernetesArtifactSource); ok {
 		return x.KubernetesArtifactSource
@@ -440,9 +486,12 @@ func (*Settings) XXX_OneofWrappers() []interface{} {
 		(*Settings_KubernetesConfigSource)(nil),
 		(*Settings_DirectoryConfigSource)(nil),
 		(*Settings_ConsulKvSource)(nil),
+		(*Settings_GitConfigSource)(nil),
 		(*Settings_KubernetesSecretSource)(nil),
 		(*Settings_VaultSecretSource)(nil),
 		(*Settings_DirectorySecretSource)(nil),
+		(*Settings_ConsulKvSecretSource)(nil),
+		(*Settings_AwsSecretSource)(nil),
 		(*Settings_KubernetesArtifactSource)(nil),
 		(*Settings_DirectoryArtifactSource)(nil),
 		(*Settings_ConsulKvArtifactSource)(nil),
@@ -512,8 +561,12 @@ func (m *Settings_KubernetesSecrets) XXX_DiscardUnknown() {
 var xxx_messageInfo_Settings_KubernetesSecrets proto.InternalMessageInfo
 
 // Use [HashiCorp Vault](https://www.vaultproject.io/) as storage for secret data.
+// Secrets are stored in the KV version 2 secrets engine mounted at `secret/`, the versions of the secrets
+// are used as their resource versions.
+// Whether Vault is available is reported in the `vault` field of the status details of the settings.
 type Settings_VaultSecrets struct {
-	// the Token used to authenticate to Vault
+	// the Token used to authenticate to Vault.
+	// ignored if `kubernetesAuth` or `appRoleAuth` is set.
 	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	// address is the address of the Vault server. This should be a complete
 	// URL such as http://solo.io
@@ -536,10 +589,14 @@ type Settings_VaultSecrets struct {
 	// all keys stored in Vault will begin with this Vault
 	// this can be used to run multiple instances of Gloo against the same Consul cluster
 	// defaults to `gloo`
-	RootKey              string   `protobuf:"bytes,9,opt,name=root_key,json=rootKey,proto3" json:"root_key,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	RootKey string `protobuf:"bytes,9,opt,name=root_key,json=rootKey,proto3" json:"root_key,omitempty"`
+	// Log in to Vault with the Kubernetes service account of the Gloo pod
+	KubernetesAuth *Settings_VaultSecrets_KubernetesAuth `protobuf:"bytes,10,opt,name=kubernetes_auth,json=kubernetesAuth,proto3" json:"kubernetes_auth,omitempty"`
+	// Log in to Vault with an AppRole
+	AppRoleAuth          *Settings_VaultSecrets_AppRoleAuth `protobuf:"bytes,11,opt,name=app_role_auth,json=appRoleAuth,proto3" json:"app_role_auth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
 }
 
 func (m *Settings_VaultSecrets) Reset()         { *m = Settings_VaultSecrets{} }
@@ -629,6 +686,303 @@ func (m *Settings_VaultSecrets) GetRootKey() string {
 	return ""
 }
 
+func (m *Settings_VaultSecrets) GetKubernetesAuth() *Settings_VaultSecrets_KubernetesAuth {
+	if m != nil {
+		return m.KubernetesAuth
+	}
+	return nil
+}
+
+func (m *Settings_VaultSecrets) GetAppRoleAuth() *Settings_VaultSecrets_AppRoleAuth {
+	if m != nil {
+		return m.AppRoleAuth
+	}
+	return nil
+}
+
+// Authenticate with the [Kubernetes auth method](https://www.vaultproject.io/docs/auth/kubernetes).
+// The token obtained is renewed for as long as possible, then Gloo logs in again.
+type Settings_VaultSecrets_KubernetesAuth struct {
+	// the Vault role bound to the service account
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// the path to the service account token.
+	// defaults to `/var/run/secrets/kubernetes.io/serviceaccount/token`
+	ServiceAccountTokenFile string `protobuf:"bytes,2,opt,name=service_account_token_file,json=serviceAccountTokenFile,proto3" json:"service_account_token_file,omitempty"`
+	// the path the auth method is mounted at. defaults to `kubernetes`
+	MountPath            string   `protobuf:"bytes,3,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Settings_VaultSecrets_KubernetesAuth) Reset()         { *m = Settings_VaultSecrets_KubernetesAuth{} }
+func (m *Settings_VaultSecrets_KubernetesAuth) String() string { return proto.CompactTextString(m) }
+func (*Settings_VaultSecrets_KubernetesAuth) ProtoMessage()    {}
+func (*Settings_VaultSecrets_KubernetesAuth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{0, 2, 0}
+}
+func (m *Settings_VaultSecrets_KubernetesAuth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Settings_VaultSecrets_KubernetesAuth.Unmarshal(m, b)
+}
+func (m *Settings_VaultSecrets_KubernetesAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Settings_VaultSecrets_KubernetesAuth.Marshal(b, m, deterministic)
+}
+func (m *Settings_VaultSecrets_KubernetesAuth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Settings_VaultSecrets_KubernetesAuth.Merge(m, src)
+}
+func (m *Settings_VaultSecrets_KubernetesAuth) XXX_Size() int {
+	return xxx_messageInfo_Settings_VaultSecrets_KubernetesAuth.Size(m)
+}
+func (m *Settings_VaultSecrets_KubernetesAuth) XXX_DiscardUnknown() {
+	xxx_messageInfo_Settings_VaultSecrets_KubernetesAuth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Settings_VaultSecrets_KubernetesAuth proto.InternalMessageInfo
+
+func (m *Settings_VaultSecrets_KubernetesAuth) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *Settings_VaultSecrets_KubernetesAuth) GetServiceAccountTokenFile() string {
+	if m != nil {
+		return m.ServiceAccountTokenFile
+	}
+	return ""
+}
+
+func (m *Settings_VaultSecrets_KubernetesAuth) GetMountPath() string {
+	if m != nil {
+		return m.MountPath
+	}
+	return ""
+}
+
+// Authenticate with the [AppRole auth method](https://www.vaultproject.io/docs/auth/approle).
+// The token obtained is renewed for as long as possible, then Gloo logs in again.
+type Settings_VaultSecrets_AppRoleAuth struct {
+	// the RoleID of the AppRole
+	RoleId string `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	// the SecretID of the AppRole
+	SecretId string `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	// the path the auth method is mounted at. defaults to `approle`
+	MountPath            string   `protobuf:"bytes,3,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Settings_VaultSecrets_AppRoleAuth) Reset()         { *m = Settings_VaultSecrets_AppRoleAuth{} }
+func (m *Settings_VaultSecrets_AppRoleAuth) String() string { return proto.CompactTextString(m) }
+func (*Settings_VaultSecrets_AppRoleAuth) ProtoMessage()    {}
+func (*Settings_VaultSecrets_AppRoleAuth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{0, 2, 1}
+}
+func (m *Settings_VaultSecrets_AppRoleAuth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Settings_VaultSecrets_AppRoleAuth.Unmarshal(m, b)
+}
+func (m *Settings_VaultSecrets_AppRoleAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Settings_VaultSecrets_AppRoleAuth.Marshal(b, m, deterministic)
+}
+func (m *Settings_VaultSecrets_AppRoleAuth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Settings_VaultSecrets_AppRoleAuth.Merge(m, src)
+}
+func (m *Settings_VaultSecrets_AppRoleAuth) XXX_Size() int {
+	return xxx_messageInfo_Settings_VaultSecrets_AppRoleAuth.Size(m)
+}
+func (m *Settings_VaultSecrets_AppRoleAuth) XXX_DiscardUnknown() {
+	xxx_messageInfo_Settings_VaultSecrets_AppRoleAuth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Settings_VaultSecrets_AppRoleAuth proto.InternalMessageInfo
+
+func (m *Settings_VaultSecrets_AppRoleAuth) GetRoleId() string {
+	if m != nil {
+		return m.RoleId
+	}
+	return ""
+}
+
+func (m *Settings_VaultSecrets_AppRoleAuth) GetSecretId() string {
+	if m != nil {
+		return m.SecretId
+	}
+	return ""
+}
+
+func (m *Settings_VaultSecrets_AppRoleAuth) GetMountPath() string {
+	if m != nil {
+		return m.MountPath
+	}
+	return ""
+}
+
+// Serve config from a Git repository. The repository is cloned into a local directory
+// which is then used like a `directoryConfigSource`, and polled for new commits.
+// Resources written by Gloo, such as proxies, are kept in the local checkout only.
+// Gloo reports the served commit in the `gitCommit` field of the status details of the settings.
+// Authentication is configured through the git configuration of the environment, e.g. `GIT_SSH_COMMAND`.
+type Settings_GitRepository struct {
+	// the URL of the repository to clone
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// the branch, tag or commit to serve. defaults to the default branch of the repository
+	Ref string `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	// the directory of the repository containing the resources, defaults to the root of the repository
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// the local directory to clone the repository into.
+	// defaults to a directory in the temporary directory of the system.
+	CheckoutDirectory string `protobuf:"bytes,4,opt,name=checkout_directory,json=checkoutDirectory,proto3" json:"checkout_directory,omitempty"`
+	// how often to poll for new commits, defaults to the `refreshRate` of the settings
+	PollInterval         *types.Duration `protobuf:"bytes,5,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Settings_GitRepository) Reset()         { *m = Settings_GitRepository{} }
+func (m *Settings_GitRepository) String() string { return proto.CompactTextString(m) }
+func (*Settings_GitRepository) ProtoMessage()    {}
+func (*Settings_GitRepository) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{0, 3}
+}
+func (m *Settings_GitRepository) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Settings_GitRepository.Unmarshal(m, b)
+}
+func (m *Settings_GitRepository) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Settings_GitRepository.Marshal(b, m, deterministic)
+}
+func (m *Settings_GitRepository) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Settings_GitRepository.Merge(m, src)
+}
+func (m *Settings_GitRepository) XXX_Size() int {
+	return xxx_messageInfo_Settings_GitRepository.Size(m)
+}
+func (m *Settings_GitRepository) XXX_DiscardUnknown() {
+	xxx_messageInfo_Settings_GitRepository.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Settings_GitRepository proto.InternalMessageInfo
+
+func (m *Settings_GitRepository) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Settings_GitRepository) GetRef() string {
+	if m != nil {
+		return m.Ref
+	}
+	return ""
+}
+
+func (m *Settings_GitRepository) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *Settings_GitRepository) GetCheckoutDirectory() string {
+	if m != nil {
+		return m.CheckoutDirectory
+	}
+	return ""
+}
+
+func (m *Settings_GitRepository) GetPollInterval() *types.Duration {
+	if m != nil {
+		return m.PollInterval
+	}
+	return nil
+}
+
+// Read secrets from [AWS Secrets Manager](https://aws.amazon.com/secrets-manager/) and
+// [SSM Parameter Store](https://docs.aws.amazon.com/systems-manager/latest/userguide/systems-manager-parameter-store.html).
+// The secret `<namespace>/<name>` is read from the Secrets Manager secret `<secretsManagerPrefix><namespace>/<name>`,
+// or from the parameter `<parameterStorePath><namespace>/<name>`. Values hold the JSON or YAML
+// of a Gloo secret without metadata, e.g. `{"tls": {"certChain": "...", "privateKey": "..."}}`.
+// Secrets are polled at the `refreshRate` of the settings, and only fetched again when their version changes.
+// Credentials are taken from the default AWS credential chain. This secret source is read-only.
+type Settings_AwsSecrets struct {
+	// the AWS region, defaults to the region of the environment
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	// overrides the AWS endpoint, e.g. `http://localhost:4566` to use LocalStack
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// the name prefix of the Secrets Manager secrets to read. defaults to `gloo/`, a trailing `/` is added if missing
+	SecretsManagerPrefix string `protobuf:"bytes,3,opt,name=secrets_manager_prefix,json=secretsManagerPrefix,proto3" json:"secrets_manager_prefix,omitempty"`
+	// the path of the SSM parameters to read, e.g. `/gloo/`. a trailing `/` is added if missing.
+	// parameters are not read if unset.
+	ParameterStorePath string `protobuf:"bytes,4,opt,name=parameter_store_path,json=parameterStorePath,proto3" json:"parameter_store_path,omitempty"`
+	// do not read secrets from Secrets Manager
+	DisableSecretsManager bool     `protobuf:"varint,5,opt,name=disable_secrets_manager,json=disableSecretsManager,proto3" json:"disable_secrets_manager,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *Settings_AwsSecrets) Reset()         { *m = Settings_AwsSecrets{} }
+func (m *Settings_AwsSecrets) String() string { return proto.CompactTextString(m) }
+func (*Settings_AwsSecrets) ProtoMessage()    {}
+func (*Settings_AwsSecrets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{0, 4}
+}
+func (m *Settings_AwsSecrets) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Settings_AwsSecrets.Unmarshal(m, b)
+}
+func (m *Settings_AwsSecrets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Settings_AwsSecrets.Marshal(b, m, deterministic)
+}
+func (m *Settings_AwsSecrets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Settings_AwsSecrets.Merge(m, src)
+}
+func (m *Settings_AwsSecrets) XXX_Size() int {
+	return xxx_messageInfo_Settings_AwsSecrets.Size(m)
+}
+func (m *Settings_AwsSecrets) XXX_DiscardUnknown() {
+	xxx_messageInfo_Settings_AwsSecrets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Settings_AwsSecrets proto.InternalMessageInfo
+
+func (m *Settings_AwsSecrets) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *Settings_AwsSecrets) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *Settings_AwsSecrets) GetSecretsManagerPrefix() string {
+	if m != nil {
+		return m.SecretsManagerPrefix
+	}
+	return ""
+}
+
+func (m *Settings_AwsSecrets) GetParameterStorePath() string {
+	if m != nil {
+		return m.ParameterStorePath
+	}
+	return ""
+}
+
+func (m *Settings_AwsSecrets) GetDisableSecretsManager() bool {
+	if m != nil {
+		return m.DisableSecretsManager
+	}
+	return false
+}
+
 // Use [HashiCorp Consul Key-Value](https://www.consul.io/api/kv.html/) as storage for config data.
 // Configuration options for connecting to Consul can be configured in the Settings' root
 // `consul` field
@@ -646,7 +1000,7 @@ func (m *Settings_ConsulKv) Reset()         { *m = Settings_ConsulKv{} }
 func (m *Settings_ConsulKv) String() string { return proto.CompactTextString(m) }
 func (*Settings_ConsulKv) ProtoMessage()    {}
 func (*Settings_ConsulKv) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd7533c2495e1752, []int{0, 3}
+	return fileDescriptor_bd7533c2495e1752, []int{0, 5}
 }
 func (m *Settings_ConsulKv) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Settings_ConsulKv.Unmarshal(m, b)
@@ -673,6 +1027,48 @@ func (m *Settings_ConsulKv) GetRootKey() string {
 	return ""
 }
 
+// Secrets are encrypted with AES-256-GCM under a random data key, which is itself
+// encrypted with the key in `keyFile`.
+type Settings_SecretEncryption struct {
+	// path to a file containing a base64 encoded 32 byte key,
+	// e.g. generated with `head -c 32 /dev/urandom | base64`
+	KeyFile              string   `protobuf:"bytes,1,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Settings_SecretEncryption) Reset()         { *m = Settings_SecretEncryption{} }
+func (m *Settings_SecretEncryption) String() string { return proto.CompactTextString(m) }
+func (*Settings_SecretEncryption) ProtoMessage()    {}
+func (*Settings_SecretEncryption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{0, 6}
+}
+func (m *Settings_SecretEncryption) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Settings_SecretEncryption.Unmarshal(m, b)
+}
+func (m *Settings_SecretEncryption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Settings_SecretEncryption.Marshal(b, m, deterministic)
+}
+func (m *Settings_SecretEncryption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Settings_SecretEncryption.Merge(m, src)
+}
+func (m *Settings_SecretEncryption) XXX_Size() int {
+	return xxx_messageInfo_Settings_SecretEncryption.Size(m)
+}
+func (m *Settings_SecretEncryption) XXX_DiscardUnknown() {
+	xxx_messageInfo_Settings_SecretEncryption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Settings_SecretEncryption proto.InternalMessageInfo
+
+func (m *Settings_SecretEncryption) GetKeyFile() string {
+	if m != nil {
+		return m.KeyFile
+	}
+	return ""
+}
+
 // Use Kubernetes ConfigMaps as storage.
 type Settings_KubernetesConfigmaps struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -684,7 +1080,7 @@ func (m *Settings_KubernetesConfigmaps) Reset()         { *m = Settings_Kubernet
 func (m *Settings_KubernetesConfigmaps) String() string { return proto.CompactTextString(m) }
 func (*Settings_KubernetesConfigmaps) ProtoMessage()    {}
 func (*Settings_KubernetesConfigmaps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd7533c2495e1752, []int{0, 4}
+	return fileDescriptor_bd7533c2495e1752, []int{0, 7}
 }
 func (m *Settings_KubernetesConfigmaps) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Settings_KubernetesConfigmaps.Unmarshal(m, b)
@@ -706,6 +1102,8 @@ var xxx_messageInfo_Settings_KubernetesConfigmaps proto.InternalMessageInfo
 
 // As an alternative to Kubernetes CRDs, Gloo is able to store resources in a local file system.
 // This option determines the root of the directory tree used to this end.
+// When used as the config source, changes are picked up through filesystem notifications,
+// and a file may hold several resources separated by `---`.
 type Settings_Directory struct {
 	Directory            string   `protobuf:"bytes,1,opt,name=directory,proto3" json:"directory,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -717,7 +1115,7 @@ func (m *Settings_Directory) Reset()         { *m = Settings_Directory{} }
 func (m *Settings_Directory) String() string { return proto.CompactTextString(m) }
 func (*Settings_Directory) ProtoMessage()    {}
 func (*Settings_Directory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd7533c2495e1752, []int{0, 5}
+	return fileDescriptor_bd7533c2495e1752, []int{0, 8}
 }
 func (m *Settings_Directory) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Settings_Directory.Unmarshal(m, b)
@@ -766,7 +1164,7 @@ func (m *Settings_KnativeOptions) Reset()         { *m = Settings_KnativeOptions
 func (m *Settings_KnativeOptions) String() string { return proto.CompactTextString(m) }
 func (*Settings_KnativeOptions) ProtoMessage()    {}
 func (*Settings_KnativeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd7533c2495e1752, []int{0, 6}
+	return fileDescriptor_bd7533c2495e1752, []int{0, 9}
 }
 func (m *Settings_KnativeOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Settings_KnativeOptions.Unmarshal(m, b)
@@ -818,7 +1216,7 @@ func (m *Settings_DiscoveryOptions) Reset()         { *m = Settings_DiscoveryOpt
 func (m *Settings_DiscoveryOptions) String() string { return proto.CompactTextString(m) }
 func (*Settings_DiscoveryOptions) ProtoMessage()    {}
 func (*Settings_DiscoveryOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd7533c2495e1752, []int{0, 7}
+	return fileDescriptor_bd7533c2495e1752, []int{0, 10}
 }
 func (m *Settings_DiscoveryOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Settings_DiscoveryOptions.Unmarshal(m, b)
@@ -897,17 +1295,22 @@ type Settings_ConsulConfiguration struct {
 	WaitTime *types.Duration `protobuf:"bytes,11,opt,name=wait_time,json=waitTime,proto3" json:"wait_time,omitempty"`
 	// Enable Service Discovery via Consul with this field
 	// set to empty struct `{}` to enable with defaults
-	ServiceDiscovery     *Settings_ConsulConfiguration_ServiceDiscoveryOptions `protobuf:"bytes,12,opt,name=service_discovery,json=serviceDiscovery,proto3" json:"service_discovery,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                              `json:"-"`
-	XXX_unrecognized     []byte                                                `json:"-"`
-	XXX_sizecache        int32                                                 `json:"-"`
+	ServiceDiscovery *Settings_ConsulConfiguration_ServiceDiscoveryOptions `protobuf:"bytes,12,opt,name=service_discovery,json=serviceDiscovery,proto3" json:"service_discovery,omitempty"`
+	// Register every listener of the Gloo proxies as a service in the Consul catalog, named after its proxy,
+	// so that Consul-native services can find the gateway proxies. The services are deregistered when Gloo stops
+	// or receives SIGTERM or SIGINT.
+	// Set to empty struct `{}` to enable with defaults.
+	ServiceRegistration  *Settings_ConsulConfiguration_ServiceRegistrationOptions `protobuf:"bytes,16,opt,name=service_registration,json=serviceRegistration,proto3" json:"service_registration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                                 `json:"-"`
+	XXX_unrecognized     []byte                                                   `json:"-"`
+	XXX_sizecache        int32                                                    `json:"-"`
 }
 
 func (m *Settings_ConsulConfiguration) Reset()         { *m = Settings_ConsulConfiguration{} }
 func (m *Settings_ConsulConfiguration) String() string { return proto.CompactTextString(m) }
 func (*Settings_ConsulConfiguration) ProtoMessage()    {}
 func (*Settings_ConsulConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd7533c2495e1752, []int{0, 8}
+	return fileDescriptor_bd7533c2495e1752, []int{0, 11}
 }
 func (m *Settings_ConsulConfiguration) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Settings_ConsulConfiguration.Unmarshal(m, b)
@@ -1033,14 +1436,38 @@ func (m *Settings_ConsulConfiguration) GetServiceDiscovery() *Settings_ConsulCon
 	return nil
 }
 
+func (m *Settings_ConsulConfiguration) GetServiceRegistration() *Settings_ConsulConfiguration_ServiceRegistrationOptions {
+	if m != nil {
+		return m.ServiceRegistration
+	}
+	return nil
+}
+
 // service discovery options for Consul
 type Settings_ConsulConfiguration_ServiceDiscoveryOptions struct {
 	// Use this parameter to restrict the data centers that will be considered when discovering and routing to
 	// services. If not provided, Gloo will use all available data centers.
-	DataCenters          []string `protobuf:"bytes,1,rep,name=data_centers,json=dataCenters,proto3" json:"data_centers,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	DataCenters []string `protobuf:"bytes,1,rep,name=data_centers,json=dataCenters,proto3" json:"data_centers,omitempty"`
+	// Discover endpoints with the Consul health API instead of the catalog.
+	// Instances with passing checks are healthy, instances with warning checks are healthy with their
+	// warning weight, instances with critical checks are unhealthy and instances in maintenance are draining.
+	// Endpoints of Connect-enabled upstreams are the Connect proxies of their service.
+	UseHealthChecks bool `protobuf:"varint,2,opt,name=use_health_checks,json=useHealthChecks,proto3" json:"use_health_checks,omitempty"`
+	// The name of the service Gloo is registered as in Consul Connect.
+	// Gloo presents the leaf certificate the Consul agent issues for this service to Connect-enabled upstreams,
+	// so intentions must allow this service to connect to them.
+	// Defaults to "gloo".
+	ConnectServiceName string `protobuf:"bytes,3,opt,name=connect_service_name,json=connectServiceName,proto3" json:"connect_service_name,omitempty"`
+	// Fail over between data centers with Envoy priority levels.
+	// When set, endpoints in the local data center (the `datacenter` above, or else the data center of the
+	// Consul agent) have priority 0, endpoints in the preferred data centers follow in order, and endpoints
+	// in any other data center come last, nearest first.
+	// Envoy only sends traffic to a lower priority when the endpoints of the higher ones become unhealthy,
+	// so failover relies on `use_health_checks` or on health checks configured on the upstreams.
+	DataCenterFailover   *Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover `protobuf:"bytes,4,opt,name=data_center_failover,json=dataCenterFailover,proto3" json:"data_center_failover,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                                                 `json:"-"`
+	XXX_unrecognized     []byte                                                                   `json:"-"`
+	XXX_sizecache        int32                                                                    `json:"-"`
 }
 
 func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions) Reset() {
@@ -1051,7 +1478,7 @@ func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions) String() string {
 }
 func (*Settings_ConsulConfiguration_ServiceDiscoveryOptions) ProtoMessage() {}
 func (*Settings_ConsulConfiguration_ServiceDiscoveryOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd7533c2495e1752, []int{0, 8, 0}
+	return fileDescriptor_bd7533c2495e1752, []int{0, 11, 0}
 }
 func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Settings_ConsulConfiguration_ServiceDiscoveryOptions.Unmarshal(m, b)
@@ -1078,84 +1505,225 @@ func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions) GetDataCenters()
 	return nil
 }
 
-// Provides overrides for the default configuration parameters used to interact with Kubernetes.
-type Settings_KubernetesConfiguration struct {
-	// Rate limits for the kubernetes clients
-	RateLimits           *Settings_KubernetesConfiguration_RateLimits `protobuf:"bytes,1,opt,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
-	XXX_unrecognized     []byte                                       `json:"-"`
-	XXX_sizecache        int32                                        `json:"-"`
+func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions) GetUseHealthChecks() bool {
+	if m != nil {
+		return m.UseHealthChecks
+	}
+	return false
 }
 
-func (m *Settings_KubernetesConfiguration) Reset()         { *m = Settings_KubernetesConfiguration{} }
-func (m *Settings_KubernetesConfiguration) String() string { return proto.CompactTextString(m) }
-func (*Settings_KubernetesConfiguration) ProtoMessage()    {}
-func (*Settings_KubernetesConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd7533c2495e1752, []int{0, 9}
-}
-func (m *Settings_KubernetesConfiguration) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Settings_KubernetesConfiguration.Unmarshal(m, b)
-}
-func (m *Settings_KubernetesConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Settings_KubernetesConfiguration.Marshal(b, m, deterministic)
-}
-func (m *Settings_KubernetesConfiguration) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Settings_KubernetesConfiguration.Merge(m, src)
-}
-func (m *Settings_KubernetesConfiguration) XXX_Size() int {
-	return xxx_messageInfo_Settings_KubernetesConfiguration.Size(m)
-}
-func (m *Settings_KubernetesConfiguration) XXX_DiscardUnknown() {
-	xxx_messageInfo_Settings_KubernetesConfiguration.DiscardUnknown(m)
+func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions) GetConnectServiceName() string {
+	if m != nil {
+		return m.ConnectServiceName
+	}
+	return ""
 }
 
-var xxx_messageInfo_Settings_KubernetesConfiguration proto.InternalMessageInfo
-
-func (m *Settings_KubernetesConfiguration) GetRateLimits() *Settings_KubernetesConfiguration_RateLimits {
+func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions) GetDataCenterFailover() *Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover {
 	if m != nil {
-		return m.RateLimits
+		return m.DataCenterFailover
 	}
 	return nil
 }
 
-type Settings_KubernetesConfiguration_RateLimits struct {
-	// The maximum queries-per-second Gloo can make to the Kubernetes API Server.
-	QPS float32 `protobuf:"fixed32,1,opt,name=QPS,proto3" json:"QPS,omitempty"`
-	// Maximum burst for throttle. When a steady state of QPS requests per second,
-	// this is an additional number of allowed, to allow for short bursts.
-	Burst                uint32   `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`
+// data center failover options
+type Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover struct {
+	// The remote data centers to fail over to, in order of preference.
+	DataCenters          []string `protobuf:"bytes,1,rep,name=data_centers,json=dataCenters,proto3" json:"data_centers,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Settings_KubernetesConfiguration_RateLimits) Reset() {
-	*m = Settings_KubernetesConfiguration_RateLimits{}
+func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover) Reset() {
+	*m = Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover{}
 }
-func (m *Settings_KubernetesConfiguration_RateLimits) String() string {
+func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover) String() string {
 	return proto.CompactTextString(m)
 }
-func (*Settings_KubernetesConfiguration_RateLimits) ProtoMessage() {}
-func (*Settings_KubernetesConfiguration_RateLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd7533c2495e1752, []int{0, 9, 0}
+func (*Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover) ProtoMessage() {}
+func (*Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{0, 11, 0, 0}
 }
-func (m *Settings_KubernetesConfiguration_RateLimits) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Settings_KubernetesConfiguration_RateLimits.Unmarshal(m, b)
+func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover.Unmarshal(m, b)
 }
-func (m *Settings_KubernetesConfiguration_RateLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Settings_KubernetesConfiguration_RateLimits.Marshal(b, m, deterministic)
+func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover.Marshal(b, m, deterministic)
 }
-func (m *Settings_KubernetesConfiguration_RateLimits) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Settings_KubernetesConfiguration_RateLimits.Merge(m, src)
+func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover.Merge(m, src)
 }
-func (m *Settings_KubernetesConfiguration_RateLimits) XXX_Size() int {
-	return xxx_messageInfo_Settings_KubernetesConfiguration_RateLimits.Size(m)
+func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover) XXX_Size() int {
+	return xxx_messageInfo_Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover.Size(m)
 }
-func (m *Settings_KubernetesConfiguration_RateLimits) XXX_DiscardUnknown() {
-	xxx_messageInfo_Settings_KubernetesConfiguration_RateLimits.DiscardUnknown(m)
+func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover) XXX_DiscardUnknown() {
+	xxx_messageInfo_Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Settings_KubernetesConfiguration_RateLimits proto.InternalMessageInfo
+var xxx_messageInfo_Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover proto.InternalMessageInfo
+
+func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover) GetDataCenters() []string {
+	if m != nil {
+		return m.DataCenters
+	}
+	return nil
+}
+
+// service registration options for Consul
+type Settings_ConsulConfiguration_ServiceRegistrationOptions struct {
+	// The address Consul-native services reach the gateway proxies at.
+	// If not provided, Consul uses the address of the agent Gloo registers the services with, and listeners
+	// that are not bound to a specific address are health checked at the address of that agent.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// How often Consul checks that the listeners accept TCP connections.
+	// Defaults to 10s.
+	CheckInterval *types.Duration `protobuf:"bytes,2,opt,name=check_interval,json=checkInterval,proto3" json:"check_interval,omitempty"`
+	// Tags added to every registered service, in addition to the domains of the listener's virtual hosts.
+	Tags []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// How long the health check of a listener may fail before Consul removes its service from the catalog.
+	// This removes the services of proxies that went away while Gloo could not deregister them, e.g. because
+	// it was killed. Defaults to 1m, the shortest time Consul supports.
+	DeregisterCriticalServiceAfter *types.Duration `protobuf:"bytes,4,opt,name=deregister_critical_service_after,json=deregisterCriticalServiceAfter,proto3" json:"deregister_critical_service_after,omitempty"`
+	XXX_NoUnkeyedLiteral           struct{}        `json:"-"`
+	XXX_unrecognized               []byte          `json:"-"`
+	XXX_sizecache                  int32           `json:"-"`
+}
+
+func (m *Settings_ConsulConfiguration_ServiceRegistrationOptions) Reset() {
+	*m = Settings_ConsulConfiguration_ServiceRegistrationOptions{}
+}
+func (m *Settings_ConsulConfiguration_ServiceRegistrationOptions) String() string {
+	return proto.CompactTextString(m)
+}
+func (*Settings_ConsulConfiguration_ServiceRegistrationOptions) ProtoMessage() {}
+func (*Settings_ConsulConfiguration_ServiceRegistrationOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{0, 11, 1}
+}
+func (m *Settings_ConsulConfiguration_ServiceRegistrationOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Settings_ConsulConfiguration_ServiceRegistrationOptions.Unmarshal(m, b)
+}
+func (m *Settings_ConsulConfiguration_ServiceRegistrationOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Settings_ConsulConfiguration_ServiceRegistrationOptions.Marshal(b, m, deterministic)
+}
+func (m *Settings_ConsulConfiguration_ServiceRegistrationOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Settings_ConsulConfiguration_ServiceRegistrationOptions.Merge(m, src)
+}
+func (m *Settings_ConsulConfiguration_ServiceRegistrationOptions) XXX_Size() int {
+	return xxx_messageInfo_Settings_ConsulConfiguration_ServiceRegistrationOptions.Size(m)
+}
+func (m *Settings_ConsulConfiguration_ServiceRegistrationOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_Settings_ConsulConfiguration_ServiceRegistrationOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Settings_ConsulConfiguration_ServiceRegistrationOptions proto.InternalMessageInfo
+
+func (m *Settings_ConsulConfiguration_ServiceRegistrationOptions) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Settings_ConsulConfiguration_ServiceRegistrationOptions) GetCheckInterval() *types.Duration {
+	if m != nil {
+		return m.CheckInterval
+	}
+	return nil
+}
+
+func (m *Settings_ConsulConfiguration_ServiceRegistrationOptions) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Settings_ConsulConfiguration_ServiceRegistrationOptions) GetDeregisterCriticalServiceAfter() *types.Duration {
+	if m != nil {
+		return m.DeregisterCriticalServiceAfter
+	}
+	return nil
+}
+
+// Provides overrides for the default configuration parameters used to interact with Kubernetes.
+type Settings_KubernetesConfiguration struct {
+	// Rate limits for the kubernetes clients
+	RateLimits           *Settings_KubernetesConfiguration_RateLimits `protobuf:"bytes,1,opt,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *Settings_KubernetesConfiguration) Reset()         { *m = Settings_KubernetesConfiguration{} }
+func (m *Settings_KubernetesConfiguration) String() string { return proto.CompactTextString(m) }
+func (*Settings_KubernetesConfiguration) ProtoMessage()    {}
+func (*Settings_KubernetesConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{0, 12}
+}
+func (m *Settings_KubernetesConfiguration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Settings_KubernetesConfiguration.Unmarshal(m, b)
+}
+func (m *Settings_KubernetesConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Settings_KubernetesConfiguration.Marshal(b, m, deterministic)
+}
+func (m *Settings_KubernetesConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Settings_KubernetesConfiguration.Merge(m, src)
+}
+func (m *Settings_KubernetesConfiguration) XXX_Size() int {
+	return xxx_messageInfo_Settings_KubernetesConfiguration.Size(m)
+}
+func (m *Settings_KubernetesConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_Settings_KubernetesConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Settings_KubernetesConfiguration proto.InternalMessageInfo
+
+func (m *Settings_KubernetesConfiguration) GetRateLimits() *Settings_KubernetesConfiguration_RateLimits {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+type Settings_KubernetesConfiguration_RateLimits struct {
+	// The maximum queries-per-second Gloo can make to the Kubernetes API Server.
+	QPS float32 `protobuf:"fixed32,1,opt,name=QPS,proto3" json:"QPS,omitempty"`
+	// Maximum burst for throttle. When a steady state of QPS requests per second,
+	// this is an additional number of allowed, to allow for short bursts.
+	Burst                uint32   `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Settings_KubernetesConfiguration_RateLimits) Reset() {
+	*m = Settings_KubernetesConfiguration_RateLimits{}
+}
+func (m *Settings_KubernetesConfiguration_RateLimits) String() string {
+	return proto.CompactTextString(m)
+}
+func (*Settings_KubernetesConfiguration_RateLimits) ProtoMessage() {}
+func (*Settings_KubernetesConfiguration_RateLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{0, 12, 0}
+}
+func (m *Settings_KubernetesConfiguration_RateLimits) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Settings_KubernetesConfiguration_RateLimits.Unmarshal(m, b)
+}
+func (m *Settings_KubernetesConfiguration_RateLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Settings_KubernetesConfiguration_RateLimits.Marshal(b, m, deterministic)
+}
+func (m *Settings_KubernetesConfiguration_RateLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Settings_KubernetesConfiguration_RateLimits.Merge(m, src)
+}
+func (m *Settings_KubernetesConfiguration_RateLimits) XXX_Size() int {
+	return xxx_messageInfo_Settings_KubernetesConfiguration_RateLimits.Size(m)
+}
+func (m *Settings_KubernetesConfiguration_RateLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_Settings_KubernetesConfiguration_RateLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Settings_KubernetesConfiguration_RateLimits proto.InternalMessageInfo
 
 func (m *Settings_KubernetesConfiguration_RateLimits) GetQPS() float32 {
 	if m != nil {
@@ -1203,9 +1771,18 @@ type GlooOptions struct {
 	// set to false if you wish to reset the envoy configuration to a clean slate with no routes.
 	// If not specified, defaults to `false`.
 	DisableProxyGarbageCollection *types.BoolValue `protobuf:"bytes,9,opt,name=disable_proxy_garbage_collection,json=disableProxyGarbageCollection,proto3" json:"disable_proxy_garbage_collection,omitempty"`
-	XXX_NoUnkeyedLiteral          struct{}         `json:"-"`
-	XXX_unrecognized              []byte           `json:"-"`
-	XXX_sizecache                 int32            `json:"-"`
+	// Gloo warns on the status of virtual services whose TLS secret holds a certificate expiring within this window.
+	// If not specified, defaults to 30 days. Set to 0 to disable the warnings.
+	CertificateExpiryWarningWindow *types.Duration `protobuf:"bytes,10,opt,name=certificate_expiry_warning_window,json=certificateExpiryWarningWindow,proto3" json:"certificate_expiry_warning_window,omitempty"`
+	// If set, TLS secrets referenced by ssl configs are served to envoy by the secret discovery service (SDS)
+	// of the `gloo` xDS server, instead of being inlined in the listener and cluster configuration.
+	// Certificates are then rotated without updating the listeners and clusters that use them.
+	// Only the secrets referenced by the ssl configs of proxies and upstreams are served, but any client that can
+	// connect to the xDS port can request all of them.
+	SecretDiscovery      *GlooOptions_SecretDiscoveryOptions `protobuf:"bytes,11,opt,name=secret_discovery,json=secretDiscovery,proto3" json:"secret_discovery,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
 }
 
 func (m *GlooOptions) Reset()         { *m = GlooOptions{} }
@@ -1295,6 +1872,20 @@ func (m *GlooOptions) GetDisableProxyGarbageCollection() *types.BoolValue {
 	return nil
 }
 
+func (m *GlooOptions) GetCertificateExpiryWarningWindow() *types.Duration {
+	if m != nil {
+		return m.CertificateExpiryWarningWindow
+	}
+	return nil
+}
+
+func (m *GlooOptions) GetSecretDiscovery() *GlooOptions_SecretDiscoveryOptions {
+	if m != nil {
+		return m.SecretDiscovery
+	}
+	return nil
+}
+
 type GlooOptions_AWSOptions struct {
 	// Enable credential discovery via IAM; when this is set, there's no need provide a secret
 	// on the upstream when running on AWS environment.
@@ -1403,6 +1994,46 @@ func (m *GlooOptions_InvalidConfigPolicy) GetInvalidRouteResponseBody() string {
 	return ""
 }
 
+type GlooOptions_SecretDiscoveryOptions struct {
+	// The name of the cluster in the envoy bootstrap configuration that connects to the `gloo` xDS server.
+	// Defaults to `gloo.<settings namespace>.svc.cluster.local:9977`, the name used by the gloo helm chart.
+	XdsClusterName       string   `protobuf:"bytes,1,opt,name=xds_cluster_name,json=xdsClusterName,proto3" json:"xds_cluster_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GlooOptions_SecretDiscoveryOptions) Reset()         { *m = GlooOptions_SecretDiscoveryOptions{} }
+func (m *GlooOptions_SecretDiscoveryOptions) String() string { return proto.CompactTextString(m) }
+func (*GlooOptions_SecretDiscoveryOptions) ProtoMessage()    {}
+func (*GlooOptions_SecretDiscoveryOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{1, 2}
+}
+func (m *GlooOptions_SecretDiscoveryOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GlooOptions_SecretDiscoveryOptions.Unmarshal(m, b)
+}
+func (m *GlooOptions_SecretDiscoveryOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GlooOptions_SecretDiscoveryOptions.Marshal(b, m, deterministic)
+}
+func (m *GlooOptions_SecretDiscoveryOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlooOptions_SecretDiscoveryOptions.Merge(m, src)
+}
+func (m *GlooOptions_SecretDiscoveryOptions) XXX_Size() int {
+	return xxx_messageInfo_GlooOptions_SecretDiscoveryOptions.Size(m)
+}
+func (m *GlooOptions_SecretDiscoveryOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlooOptions_SecretDiscoveryOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GlooOptions_SecretDiscoveryOptions proto.InternalMessageInfo
+
+func (m *GlooOptions_SecretDiscoveryOptions) GetXdsClusterName() string {
+	if m != nil {
+		return m.XdsClusterName
+	}
+	return ""
+}
+
 // Settings specific to the Gateway controller
 type GatewayOptions struct {
 	// Address of the `gloo` config validation server. Defaults to `gloo:9988`
@@ -1562,18 +2193,26 @@ func init() {
 	proto.RegisterType((*Settings_KubernetesCrds)(nil), "gloo.solo.io.Settings.KubernetesCrds")
 	proto.RegisterType((*Settings_KubernetesSecrets)(nil), "gloo.solo.io.Settings.KubernetesSecrets")
 	proto.RegisterType((*Settings_VaultSecrets)(nil), "gloo.solo.io.Settings.VaultSecrets")
+	proto.RegisterType((*Settings_VaultSecrets_KubernetesAuth)(nil), "gloo.solo.io.Settings.VaultSecrets.KubernetesAuth")
+	proto.RegisterType((*Settings_VaultSecrets_AppRoleAuth)(nil), "gloo.solo.io.Settings.VaultSecrets.AppRoleAuth")
+	proto.RegisterType((*Settings_GitRepository)(nil), "gloo.solo.io.Settings.GitRepository")
+	proto.RegisterType((*Settings_AwsSecrets)(nil), "gloo.solo.io.Settings.AwsSecrets")
 	proto.RegisterType((*Settings_ConsulKv)(nil), "gloo.solo.io.Settings.ConsulKv")
+	proto.RegisterType((*Settings_SecretEncryption)(nil), "gloo.solo.io.Settings.SecretEncryption")
 	proto.RegisterType((*Settings_KubernetesConfigmaps)(nil), "gloo.solo.io.Settings.KubernetesConfigmaps")
 	proto.RegisterType((*Settings_Directory)(nil), "gloo.solo.io.Settings.Directory")
 	proto.RegisterType((*Settings_KnativeOptions)(nil), "gloo.solo.io.Settings.KnativeOptions")
 	proto.RegisterType((*Settings_DiscoveryOptions)(nil), "gloo.solo.io.Settings.DiscoveryOptions")
 	proto.RegisterType((*Settings_ConsulConfiguration)(nil), "gloo.solo.io.Settings.ConsulConfiguration")
 	proto.RegisterType((*Settings_ConsulConfiguration_ServiceDiscoveryOptions)(nil), "gloo.solo.io.Settings.ConsulConfiguration.ServiceDiscoveryOptions")
+	proto.RegisterType((*Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover)(nil), "gloo.solo.io.Settings.ConsulConfiguration.ServiceDiscoveryOptions.DataCenterFailover")
+	proto.RegisterType((*Settings_ConsulConfiguration_ServiceRegistrationOptions)(nil), "gloo.solo.io.Settings.ConsulConfiguration.ServiceRegistrationOptions")
 	proto.RegisterType((*Settings_KubernetesConfiguration)(nil), "gloo.solo.io.Settings.KubernetesConfiguration")
 	proto.RegisterType((*Settings_KubernetesConfiguration_RateLimits)(nil), "gloo.solo.io.Settings.KubernetesConfiguration.RateLimits")
 	proto.RegisterType((*GlooOptions)(nil), "gloo.solo.io.GlooOptions")
 	proto.RegisterType((*GlooOptions_AWSOptions)(nil), "gloo.solo.io.GlooOptions.AWSOptions")
 	proto.RegisterType((*GlooOptions_InvalidConfigPolicy)(nil), "gloo.solo.io.GlooOptions.InvalidConfigPolicy")
+	proto.RegisterType((*GlooOptions_SecretDiscoveryOptions)(nil), "gloo.solo.io.GlooOptions.SecretDiscoveryOptions")
 	proto.RegisterType((*GatewayOptions)(nil), "gloo.solo.io.GatewayOptions")
 	proto.RegisterType((*GatewayOptions_ValidationOptions)(nil), "gloo.solo.io.GatewayOptions.ValidationOptions")
 }
@@ -1583,146 +2222,188 @@ func init() {
 }
 
 var fileDescriptor_bd7533c2495e1752 = []byte{
-	// 2226 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x4d, 0x73, 0x1b, 0x49,
-	0x19, 0x8e, 0x1c, 0xdb, 0x92, 0x5e, 0xf9, 0x43, 0x6e, 0x7b, 0xed, 0xb1, 0xfc, 0xb9, 0x86, 0x85,
-	0xec, 0x52, 0x91, 0x96, 0xb0, 0x84, 0x25, 0xc9, 0x92, 0xb2, 0x64, 0x3b, 0x36, 0x76, 0x42, 0x18,
-	0x79, 0x93, 0xaa, 0x14, 0xc5, 0x54, 0x6b, 0xa6, 0x25, 0x0f, 0x1a, 0x4d, 0x4f, 0x75, 0xb7, 0x64,
-	0xeb, 0xc8, 0xde, 0x39, 0xf2, 0x1f, 0xa8, 0xda, 0x3f, 0xc0, 0x91, 0x23, 0x1c, 0xb9, 0x70, 0x63,
-	0x0f, 0xfc, 0x83, 0xa5, 0x8a, 0x3b, 0xd5, 0x1f, 0xf3, 0x21, 0xd9, 0x72, 0x9c, 0x8b, 0x6a, 0xba,
-	0xdf, 0xe7, 0x79, 0x7a, 0xe6, 0xed, 0x7e, 0x3f, 0x5a, 0xf0, 0xb4, 0xe3, 0x8b, 0x8b, 0x7e, 0xab,
-	0xea, 0xd2, 0x5e, 0x8d, 0xd3, 0x80, 0x3e, 0xf4, 0x69, 0xad, 0x13, 0x50, 0x5a, 0x8b, 0x18, 0xfd,
-	0x03, 0x71, 0x05, 0xd7, 0x23, 0x1c, 0xf9, 0xb5, 0xc1, 0x4f, 0x6b, 0x9c, 0x08, 0xe1, 0x87, 0x1d,
-	0x5e, 0x8d, 0x18, 0x15, 0x14, 0xcd, 0x49, 0x5b, 0x55, 0xd2, 0xaa, 0x3e, 0xad, 0xac, 0x74, 0x68,
-	0x87, 0x2a, 0x43, 0x4d, 0x3e, 0x69, 0x4c, 0x05, 0x91, 0x2b, 0xa1, 0x27, 0xc9, 0x95, 0x30, 0x73,
-	0xdb, 0x6a, 0xa5, 0xae, 0x2f, 0x62, 0xdd, 0x1e, 0x11, 0xd8, 0xc3, 0x02, 0x1b, 0xfb, 0xe6, 0xb8,
-	0x9d, 0x0b, 0x2c, 0xfa, 0x7c, 0x12, 0x3b, 0x1e, 0x1b, 0xfb, 0x67, 0x93, 0xdf, 0x9f, 0x5c, 0x09,
-	0x12, 0x72, 0x9f, 0x86, 0xb1, 0xd6, 0xd1, 0x2d, 0xd8, 0x50, 0x10, 0x16, 0x31, 0x9f, 0x93, 0x1a,
-	0x8d, 0x84, 0xe4, 0xd4, 0x18, 0x16, 0x24, 0xf0, 0x7b, 0xbe, 0x48, 0x9f, 0x8c, 0xce, 0xe1, 0x07,
-	0xe9, 0x90, 0x2b, 0x81, 0xfb, 0xe2, 0xc2, 0xbc, 0x91, 0x7c, 0x34, 0x32, 0xcf, 0x3e, 0xec, 0x75,
-	0x5a, 0xd8, 0x55, 0x3f, 0x86, 0x7d, 0xcb, 0xc6, 0xb9, 0x3e, 0x73, 0xfb, 0xbe, 0x70, 0x5a, 0x8c,
-	0xe0, 0x2e, 0x61, 0xb1, 0x27, 0x3b, 0x94, 0x76, 0x02, 0x52, 0x53, 0xa3, 0x56, 0xbf, 0x5d, 0xf3,
-	0xfa, 0x0c, 0x4b, 0xed, 0x49, 0xf6, 0x4b, 0x86, 0xa3, 0x88, 0x30, 0xe3, 0xbd, 0xbd, 0x3f, 0x6d,
-	0x41, 0xa1, 0x69, 0x8e, 0x04, 0xaa, 0xc1, 0xb2, 0xe7, 0x73, 0x97, 0x0e, 0x08, 0x1b, 0x3a, 0x21,
-	0xee, 0x11, 0x1e, 0x61, 0x97, 0x58, 0xb9, 0xdd, 0xdc, 0x83, 0xa2, 0x8d, 0x12, 0xd3, 0xab, 0xd8,
-	0x82, 0x3e, 0x85, 0xf2, 0x25, 0x16, 0xee, 0x45, 0x0a, 0xe6, 0xd6, 0xd4, 0xee, 0xfd, 0x07, 0x45,
-	0x7b, 0x51, 0xcd, 0x27, 0x48, 0x8e, 0x30, 0x58, 0xdd, 0x7e, 0x8b, 0xb0, 0x90, 0x08, 0xc2, 0x1d,
-	0x97, 0x86, 0x6d, 0xbf, 0xe3, 0x70, 0xda, 0x67, 0x2e, 0xb1, 0xa6, 0x77, 0x73, 0x0f, 0x4a, 0x8f,
-	0x3e, 0xa9, 0x66, 0xcf, 0x62, 0x35, 0x7e, 0xab, 0xea, 0x69, 0x42, 0x6b, 0x30, 0x8f, 0x1f, 0xdf,
-	0xb3, 0x57, 0x53, 0xa1, 0x86, 0xd2, 0x69, 0x2a, 0x19, 0xf4, 0x0e, 0xd6, 0x3c, 0x9f, 0x11, 0x57,
-	0x50, 0x36, 0x1c, 0x5b, 0x61, 0x46, 0xad, 0xb0, 0x3b, 0x61, 0x85, 0x83, 0x98, 0x75, 0x7c, 0xcf,
-	0xfe, 0x28, 0x91, 0x18, 0xd1, 0x3e, 0x85, 0xb2, 0x4b, 0x43, 0xde, 0x0f, 0x9c, 0xee, 0x20, 0x16,
-	0xfd, 0x48, 0x89, 0xee, 0x4c, 0x10, 0x6d, 0x28, 0xf8, 0xe9, 0xe0, 0xf8, 0x9e, 0xbd, 0xe0, 0x9a,
-	0x67, 0x23, 0xe6, 0x8d, 0xf8, 0x82, 0x13, 0x97, 0x11, 0x11, 0x8b, 0xce, 0x2a, 0xd1, 0x07, 0xef,
-	0xf5, 0x45, 0x53, 0xb1, 0xf8, 0x71, 0x2e, 0xeb, 0x0e, 0x3d, 0x69, 0x56, 0xf9, 0x1a, 0x96, 0x07,
-	0xb8, 0x1f, 0x88, 0xb1, 0x05, 0xf2, 0x6a, 0x81, 0x1f, 0x4c, 0x58, 0xe0, 0x8d, 0x64, 0xa4, 0xda,
-	0x4b, 0x83, 0x74, 0x7c, 0x93, 0x97, 0x47, 0xa5, 0x0b, 0x77, 0xf4, 0x72, 0x2e, 0xe3, 0xe5, 0x11,
-	0xed, 0x2e, 0x54, 0x32, 0x8e, 0xc1, 0x4c, 0xf8, 0x6d, 0xec, 0x26, 0xf2, 0x45, 0x25, 0xff, 0x93,
-	0xf7, 0x1f, 0x13, 0xb5, 0x71, 0x3d, 0x1c, 0xf1, 0xe3, 0x29, 0x3b, 0xe3, 0xe9, 0x7d, 0xa3, 0x67,
-	0x16, 0xfb, 0x3d, 0xac, 0xa7, 0x1f, 0x32, 0xbe, 0x16, 0xdc, 0xf1, 0x53, 0xa6, 0xec, 0xd4, 0x1b,
-	0x63, 0xfa, 0xbf, 0x83, 0xf5, 0xf4, 0xc8, 0x8c, 0xeb, 0xaf, 0xdd, 0xed, 0xec, 0x4c, 0xd9, 0xab,
-	0xf1, 0xd9, 0x19, 0x53, 0x7f, 0x06, 0x73, 0x8c, 0xb4, 0x19, 0xe1, 0x17, 0x8e, 0xcc, 0x64, 0xd6,
-	0x9c, 0x12, 0x5c, 0xaf, 0xea, 0x78, 0xaf, 0xc6, 0xf1, 0x5e, 0x3d, 0x30, 0xf9, 0xc0, 0x2e, 0x19,
-	0xb8, 0x8d, 0x05, 0x41, 0xeb, 0x50, 0xf0, 0xc8, 0xc0, 0xe9, 0x51, 0x8f, 0x58, 0xf3, 0xbb, 0xb9,
-	0x07, 0x05, 0x3b, 0xef, 0x91, 0xc1, 0x4b, 0xea, 0x11, 0x64, 0x41, 0x3e, 0xf0, 0xc3, 0x2e, 0x61,
-	0x9e, 0xb5, 0xa4, 0x2d, 0x66, 0x88, 0x9e, 0x43, 0xbe, 0x1b, 0x62, 0xe1, 0x0f, 0x88, 0x85, 0x6e,
-	0x8f, 0x58, 0x8d, 0xfa, 0x8d, 0x4e, 0x72, 0x76, 0xcc, 0x42, 0x87, 0x50, 0x4c, 0x92, 0x88, 0xb5,
-	0xac, 0x24, 0x7e, 0x3c, 0xd1, 0xc3, 0x06, 0x17, 0x8b, 0xa4, 0x4c, 0xf4, 0x10, 0xa6, 0x25, 0xc9,
-	0xb2, 0xe2, 0x4f, 0xce, 0x2a, 0xbc, 0x08, 0x28, 0x8d, 0x39, 0x0a, 0x86, 0x1e, 0x43, 0xbe, 0x83,
-	0x05, 0xb9, 0xc4, 0x43, 0x6b, 0x5d, 0x31, 0x36, 0xc7, 0x18, 0xda, 0x98, 0xbc, 0xad, 0x01, 0xa3,
-	0x3a, 0xcc, 0x6a, 0xdf, 0x5b, 0x2b, 0x8a, 0xf6, 0xd9, 0xad, 0x9b, 0xa5, 0x0f, 0x5d, 0xec, 0x6c,
-	0xc3, 0x44, 0xaf, 0x00, 0xd2, 0xf3, 0x67, 0xad, 0x2a, 0x9d, 0xea, 0x1d, 0x0f, 0x70, 0xac, 0x95,
-	0x51, 0x40, 0x5f, 0x02, 0xa4, 0x05, 0xd0, 0x2a, 0x2b, 0x3d, 0x6b, 0x54, 0xef, 0x30, 0xb1, 0xdb,
-	0x19, 0x2c, 0x7a, 0x09, 0xc5, 0xa4, 0xe2, 0x59, 0x15, 0x45, 0xac, 0x55, 0xd3, 0x1a, 0x68, 0x0a,
-	0xd2, 0xf8, 0xab, 0xb1, 0x81, 0xef, 0x92, 0xf8, 0x0d, 0xed, 0x54, 0x01, 0x35, 0xa1, 0x9c, 0x0c,
-	0x1c, 0x4e, 0xd8, 0x80, 0x30, 0x6b, 0xc3, 0xa4, 0xae, 0xf7, 0xaa, 0x1a, 0xb9, 0xc5, 0x04, 0xd8,
-	0x54, 0x02, 0xe8, 0x17, 0x30, 0x2d, 0x6b, 0xa1, 0xb5, 0x69, 0x52, 0x94, 0x2a, 0x8c, 0xb7, 0x6b,
-	0x28, 0x02, 0x7a, 0x0a, 0x79, 0x53, 0x85, 0xad, 0x2d, 0xc5, 0xfd, 0xb8, 0x9a, 0x16, 0xdb, 0x09,
-	0xcc, 0x98, 0x81, 0xbe, 0x84, 0x42, 0xdc, 0xbc, 0x58, 0x0b, 0x8a, 0xbd, 0x5a, 0x75, 0x29, 0x23,
-	0x09, 0xe5, 0xa5, 0xb1, 0xd6, 0xa7, 0xff, 0xfe, 0xdd, 0xce, 0x3d, 0x3b, 0x41, 0xa3, 0x53, 0x98,
-	0xd5, 0x6d, 0x8d, 0xb5, 0xa8, 0x78, 0x2b, 0xa3, 0xbc, 0xa6, 0xb2, 0xd5, 0xb7, 0xfe, 0xfa, 0xbf,
-	0xe9, 0x9c, 0x64, 0xfe, 0xf7, 0xbb, 0x9d, 0x25, 0x41, 0xb8, 0xf0, 0xfc, 0x76, 0xfb, 0xc9, 0x9e,
-	0xdf, 0x09, 0x29, 0x23, 0x7b, 0xb6, 0x91, 0xa8, 0x94, 0x61, 0x61, 0xb4, 0xd2, 0x55, 0x96, 0x61,
-	0xe9, 0x5a, 0xbe, 0xaf, 0x7c, 0x3b, 0x05, 0x73, 0xd9, 0x24, 0x8d, 0x56, 0x60, 0x46, 0xd0, 0x2e,
-	0x09, 0x4d, 0x99, 0xd6, 0x03, 0x19, 0xc5, 0xd8, 0xf3, 0x18, 0xe1, 0xb2, 0x20, 0xcb, 0xf9, 0x78,
-	0x88, 0xd6, 0x20, 0xef, 0x62, 0xc7, 0x25, 0x4c, 0x58, 0xf7, 0x95, 0x65, 0xd6, 0xc5, 0x0d, 0xc2,
-	0x84, 0x31, 0x44, 0x58, 0x5c, 0xa8, 0x82, 0xac, 0x0c, 0xaf, 0xb1, 0xb8, 0x40, 0x3b, 0x50, 0x72,
-	0x03, 0x9f, 0x84, 0x42, 0xb3, 0x66, 0x94, 0x11, 0xf4, 0x94, 0x62, 0x6e, 0x81, 0x19, 0x39, 0x5d,
-	0x32, 0x54, 0x15, 0xac, 0x68, 0x17, 0xf5, 0xcc, 0x29, 0x19, 0xa2, 0x1f, 0xc1, 0xa2, 0x08, 0xb8,
-	0x39, 0x25, 0xaa, 0x55, 0x50, 0x45, 0xa8, 0x68, 0xcf, 0x8b, 0x80, 0xeb, 0xad, 0x97, 0x8d, 0x02,
-	0x7a, 0x0c, 0x05, 0x3f, 0xe4, 0xc4, 0xed, 0xb3, 0xb8, 0x94, 0x54, 0xae, 0xa5, 0xb3, 0x3a, 0xa5,
-	0xc1, 0x1b, 0x1c, 0xf4, 0x89, 0x9d, 0x60, 0x65, 0x32, 0x63, 0x94, 0xea, 0xc5, 0x8b, 0xfa, 0x63,
-	0xe5, 0xf8, 0x94, 0x0c, 0x2b, 0x9f, 0x40, 0x21, 0xce, 0xa5, 0x23, 0xb0, 0xdc, 0x28, 0x6c, 0x15,
-	0x56, 0x6e, 0x2a, 0x1f, 0x95, 0x4f, 0xa1, 0x98, 0xa4, 0x7a, 0xb4, 0x29, 0xb3, 0x97, 0x19, 0x18,
-	0x81, 0x74, 0xa2, 0xf2, 0xef, 0x1c, 0x2c, 0x8c, 0xe6, 0x3d, 0xb4, 0x0f, 0x5b, 0x6e, 0xd0, 0xe7,
-	0x82, 0x30, 0xc7, 0x0f, 0x3b, 0xd2, 0xf9, 0x4e, 0xc4, 0xe8, 0xd5, 0xd0, 0x89, 0x77, 0x46, 0x8b,
-	0x54, 0x0c, 0xe8, 0x44, 0x63, 0x5e, 0x4b, 0xc8, 0xbe, 0xd9, 0xac, 0x06, 0x6c, 0x9b, 0xe4, 0xe9,
-	0xc8, 0x58, 0x66, 0x21, 0x0e, 0xc6, 0x34, 0xf4, 0xee, 0x6e, 0x18, 0xd4, 0xa1, 0x01, 0x4d, 0x12,
-	0xf1, 0xc3, 0x1b, 0x45, 0xee, 0x8f, 0x88, 0x9c, 0x84, 0xd7, 0x45, 0x2a, 0x7f, 0xce, 0x41, 0x79,
-	0x3c, 0x29, 0xa3, 0x5f, 0x43, 0xa1, 0xed, 0x71, 0x5d, 0x46, 0xe4, 0xc7, 0x2c, 0x3c, 0xaa, 0xdd,
-	0x31, 0x9f, 0x57, 0x8f, 0x3c, 0x2e, 0xcb, 0x8d, 0x9d, 0x6f, 0xeb, 0x87, 0xbd, 0x9f, 0x43, 0xde,
-	0xcc, 0xa1, 0x79, 0x28, 0xd6, 0xcf, 0xf6, 0x1b, 0xa7, 0x67, 0x27, 0xcd, 0xf3, 0xf2, 0x3d, 0x39,
-	0x7c, 0x7b, 0x7c, 0x72, 0x7e, 0xa8, 0x86, 0x39, 0x34, 0x07, 0x85, 0x83, 0x93, 0xe6, 0x7e, 0xfd,
-	0xec, 0xf0, 0xa0, 0x3c, 0x55, 0xf9, 0xe7, 0x0c, 0x2c, 0xdf, 0x90, 0x81, 0xd1, 0x66, 0x1a, 0x00,
-	0xca, 0xcd, 0xf5, 0x29, 0x2b, 0x97, 0x06, 0xc1, 0xc7, 0x30, 0x77, 0x21, 0x44, 0x94, 0x38, 0x60,
-	0x5e, 0x39, 0xa0, 0x24, 0xe7, 0x62, 0xaf, 0xed, 0x40, 0xc9, 0x0b, 0x79, 0x82, 0x58, 0xd0, 0xa7,
-	0xde, 0x0b, 0x79, 0x0c, 0x38, 0x85, 0x15, 0x09, 0x88, 0x68, 0x10, 0xf8, 0x61, 0x47, 0xbb, 0x76,
-	0x80, 0x03, 0x93, 0x0b, 0x6e, 0xa9, 0xc4, 0xc8, 0x0b, 0xf9, 0x6b, 0xcd, 0x3a, 0x31, 0x24, 0xb4,
-	0x0d, 0x20, 0x53, 0x8a, 0xab, 0xd2, 0x96, 0xd9, 0xd4, 0xcc, 0x0c, 0xaa, 0x40, 0xa1, 0xcf, 0xe5,
-	0xae, 0xf4, 0x88, 0xd9, 0xad, 0x64, 0x2c, 0x6d, 0x11, 0xe6, 0xfc, 0x92, 0x32, 0xcf, 0x44, 0x6e,
-	0x32, 0x4e, 0xb3, 0xc3, 0x4c, 0x36, 0x3b, 0xe8, 0x50, 0x6f, 0xfb, 0x01, 0x31, 0xd1, 0x3a, 0xeb,
-	0xe2, 0x23, 0x3f, 0x20, 0xd9, 0x1c, 0x90, 0x1f, 0xc9, 0x01, 0x1b, 0x50, 0x94, 0xc1, 0xaf, 0x39,
-	0x05, 0xbd, 0x88, 0x9c, 0x50, 0xac, 0x75, 0x28, 0x74, 0xc9, 0x50, 0xdb, 0x4c, 0x00, 0x76, 0xc9,
-	0x50, 0x99, 0xce, 0x60, 0x25, 0x8e, 0x53, 0x87, 0x77, 0xfd, 0xc8, 0x19, 0x10, 0xe6, 0xb7, 0x87,
-	0xa6, 0xbf, 0xba, 0x2d, 0xbe, 0x51, 0xcc, 0x6b, 0x76, 0xfd, 0xe8, 0x8d, 0x62, 0xa1, 0xc7, 0x50,
-	0xbc, 0xc4, 0xbe, 0x70, 0x84, 0xdf, 0x23, 0x56, 0xe9, 0x7d, 0x7e, 0x2e, 0x48, 0xec, 0xb9, 0xdf,
-	0x23, 0x88, 0xc2, 0x12, 0xd7, 0xb5, 0xcc, 0x49, 0x1b, 0x10, 0xdd, 0x31, 0xd5, 0xef, 0x5e, 0xd5,
-	0xe3, 0x7a, 0x78, 0xad, 0x37, 0x29, 0xf3, 0x31, 0x43, 0xe5, 0x19, 0xac, 0x4d, 0x00, 0xcb, 0xa3,
-	0x27, 0xf7, 0xd5, 0xd1, 0x1b, 0x2b, 0x4f, 0xa7, 0xbc, 0x2f, 0x95, 0xe4, 0x5c, 0x43, 0x4f, 0x55,
-	0xbe, 0xcd, 0xc1, 0xda, 0x84, 0x6e, 0x00, 0xbd, 0x83, 0x92, 0x2c, 0x9b, 0x8e, 0xaa, 0x9b, 0xfa,
-	0x6c, 0x97, 0x1e, 0xfd, 0xf2, 0xc3, 0x5a, 0x8a, 0xaa, 0xec, 0x01, 0xcf, 0x94, 0x80, 0x0d, 0x2c,
-	0x79, 0xae, 0x7c, 0x01, 0x90, 0x5a, 0x50, 0x19, 0xee, 0xff, 0xf6, 0x75, 0x53, 0xad, 0x30, 0x65,
-	0xcb, 0x47, 0x79, 0x98, 0x5a, 0x7d, 0xc6, 0x85, 0x3a, 0x9f, 0xf3, 0xb6, 0x1e, 0x3c, 0x59, 0xfd,
-	0xe6, 0xfb, 0xe9, 0x69, 0x98, 0xe2, 0xe2, 0x9b, 0xef, 0xa7, 0x01, 0x15, 0xe2, 0x3f, 0x18, 0xea,
-	0x8b, 0x30, 0x3f, 0x72, 0x09, 0x93, 0x13, 0x23, 0xf7, 0x85, 0xfa, 0x12, 0x2c, 0x8e, 0xf5, 0xc5,
-	0x7b, 0x7f, 0xcb, 0x43, 0x29, 0xd3, 0xc2, 0xa1, 0x3d, 0x98, 0xbf, 0xf2, 0xb8, 0xd3, 0xf2, 0x43,
-	0x4f, 0x85, 0xa2, 0xc9, 0x99, 0xa5, 0x2b, 0x8f, 0xd7, 0xfd, 0xd0, 0x93, 0xb1, 0x88, 0x3e, 0x87,
-	0x95, 0x01, 0x0e, 0x7c, 0x4f, 0x7d, 0x5b, 0x06, 0xaa, 0xa3, 0x08, 0xa5, 0xb6, 0x84, 0xf1, 0x12,
-	0xca, 0x63, 0xd7, 0x69, 0x9d, 0x03, 0x4b, 0x8f, 0xf6, 0x46, 0x3d, 0xd9, 0xd0, 0xa8, 0xba, 0x06,
-	0x69, 0x27, 0xda, 0x8b, 0xee, 0xc8, 0x2c, 0x47, 0x5f, 0xc3, 0x3a, 0x09, 0xbd, 0x88, 0xfa, 0xa1,
-	0xe0, 0xce, 0x25, 0x66, 0x3d, 0x99, 0x0f, 0xe4, 0x19, 0xa5, 0x7d, 0x61, 0x2e, 0xb7, 0xb7, 0x1c,
-	0xd3, 0xb5, 0x84, 0xfb, 0x56, 0x53, 0xcf, 0x35, 0x13, 0x1d, 0x42, 0x09, 0x5f, 0x72, 0xc7, 0x34,
-	0x40, 0xe6, 0x0e, 0xfb, 0xc3, 0x89, 0xed, 0x6e, 0x75, 0xff, 0x6d, 0x33, 0x3e, 0x91, 0x80, 0x2f,
-	0x79, 0xec, 0x42, 0x0c, 0x1f, 0xf9, 0xa1, 0x72, 0x42, 0x7c, 0x29, 0x8e, 0x68, 0xe0, 0xbb, 0x43,
-	0x73, 0xd5, 0x7c, 0x38, 0x59, 0xf0, 0x44, 0xd3, 0xf4, 0x67, 0xbf, 0x56, 0x24, 0x7b, 0xd9, 0xbf,
-	0x3e, 0x89, 0x8e, 0x60, 0xc7, 0xf3, 0x39, 0x6e, 0x05, 0xc4, 0xc9, 0xdc, 0xdf, 0x3c, 0xc2, 0x85,
-	0x1f, 0x62, 0xfd, 0xf6, 0x79, 0x75, 0x97, 0xd8, 0x32, 0xb0, 0xf4, 0x60, 0x1e, 0x64, 0x40, 0xe8,
-	0x00, 0xca, 0xb1, 0x4e, 0x87, 0x45, 0xae, 0x73, 0x49, 0x5a, 0x77, 0xe8, 0x04, 0x16, 0x0c, 0xe7,
-	0x05, 0x8b, 0xdc, 0xb7, 0xa4, 0x85, 0x5c, 0xd8, 0x8d, 0x55, 0x74, 0x99, 0xeb, 0x60, 0xd6, 0xc2,
-	0x1d, 0xe2, 0xb8, 0x34, 0x08, 0x88, 0x2b, 0x97, 0x32, 0x77, 0xc9, 0xdb, 0x54, 0xe3, 0x57, 0x55,
-	0x55, 0xf0, 0x85, 0x56, 0x68, 0x24, 0x02, 0x95, 0x33, 0x80, 0xd4, 0xdf, 0xe8, 0x57, 0xb0, 0x41,
-	0x42, 0xb5, 0xa2, 0xcb, 0x88, 0x47, 0x42, 0xe1, 0xe3, 0x80, 0xc7, 0xb9, 0x46, 0x77, 0x0b, 0x05,
-	0x7b, 0x5d, 0x43, 0x1a, 0x29, 0xc2, 0x24, 0x87, 0x61, 0xe5, 0x1f, 0x39, 0x58, 0xbe, 0xc1, 0xdb,
-	0xe8, 0x0b, 0x58, 0x65, 0x24, 0x0a, 0xb0, 0x2b, 0x4b, 0xb7, 0xde, 0x43, 0x46, 0xfb, 0xf2, 0x2e,
-	0xa1, 0x25, 0x57, 0x8c, 0xd5, 0x70, 0x6d, 0x65, 0x43, 0x5f, 0xc1, 0xc6, 0x08, 0xda, 0x61, 0x84,
-	0x47, 0x34, 0xe4, 0xd2, 0x03, 0x1e, 0x31, 0xd1, 0x6b, 0xf9, 0x19, 0x8e, 0x6d, 0x00, 0x0d, 0x59,
-	0x7e, 0x27, 0xd3, 0x5b, 0xd4, 0x1b, 0x9a, 0xf2, 0x73, 0x23, 0xbd, 0x4e, 0xbd, 0xe1, 0xde, 0x1f,
-	0x67, 0x60, 0x61, 0xf4, 0x4e, 0x25, 0x3f, 0x23, 0x13, 0xa1, 0xa6, 0x11, 0xcc, 0x84, 0x73, 0x26,
-	0x7e, 0x75, 0x3f, 0xa8, 0xa2, 0xf4, 0x15, 0x40, 0x3a, 0x6f, 0xe2, 0xb3, 0x7a, 0xdb, 0xdd, 0xad,
-	0xfa, 0x26, 0x81, 0x27, 0x81, 0x90, 0x2a, 0xa0, 0x63, 0xf8, 0x98, 0x11, 0xec, 0x39, 0xe6, 0x82,
-	0xc7, 0x9d, 0x36, 0xa3, 0x3d, 0x07, 0x07, 0x41, 0xf6, 0xef, 0xab, 0x69, 0x7d, 0x4e, 0x25, 0xd0,
-	0x88, 0xf3, 0x23, 0x46, 0x7b, 0xfb, 0x41, 0x90, 0xf9, 0x33, 0xeb, 0x08, 0xb6, 0x71, 0xa0, 0x24,
-	0x38, 0x65, 0xc2, 0x78, 0x49, 0xa8, 0xfd, 0x37, 0xdb, 0x23, 0x83, 0xb5, 0xa0, 0x7a, 0x8e, 0x8a,
-	0x46, 0x36, 0x29, 0x13, 0xca, 0x57, 0xe7, 0x12, 0xa6, 0x37, 0xaa, 0xf2, 0xaf, 0x29, 0x58, 0xba,
-	0xf6, 0xce, 0xe8, 0x39, 0x6c, 0xea, 0x73, 0x3b, 0xc1, 0x67, 0x3a, 0xaf, 0xad, 0x2b, 0xcc, 0x9b,
-	0x9b, 0x1c, 0xf7, 0x15, 0x6c, 0x64, 0xa8, 0x97, 0xa4, 0x75, 0x41, 0x69, 0xd7, 0x91, 0x3d, 0x78,
-	0xa6, 0xed, 0xb7, 0x52, 0xc8, 0x5b, 0x8d, 0x38, 0x0f, 0xb8, 0x6a, 0xe7, 0x9f, 0x42, 0x65, 0x02,
-	0x5d, 0xb6, 0xce, 0xba, 0xc3, 0x58, 0xbb, 0x89, 0x2d, 0x9b, 0xfd, 0x06, 0x6c, 0xeb, 0x9b, 0x8d,
-	0x23, 0x37, 0x2a, 0xfb, 0x09, 0x6d, 0xec, 0x07, 0xb2, 0xb5, 0x57, 0xae, 0xb1, 0x37, 0x34, 0x4a,
-	0xa6, 0x9b, 0xf4, 0x1b, 0x8e, 0x34, 0x04, 0x3d, 0x87, 0x79, 0xe3, 0x5f, 0xec, 0xba, 0x24, 0x12,
-	0x26, 0x55, 0xdd, 0x16, 0xae, 0x73, 0x9a, 0xb0, 0xaf, 0xf0, 0xf5, 0x27, 0xf2, 0xce, 0xf5, 0x97,
-	0xff, 0x6c, 0xe7, 0xde, 0x7d, 0x7e, 0xb7, 0x7f, 0xc7, 0xa3, 0x6e, 0xc7, 0xfc, 0xd1, 0xda, 0x9a,
-	0x55, 0xea, 0x3f, 0xfb, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x13, 0x84, 0xed, 0xf2, 0x58, 0x17,
+	// 2898 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x59, 0x5b, 0x6f, 0x1b, 0xc7,
+	0xf5, 0x37, 0x65, 0x5d, 0xc8, 0x43, 0x5d, 0x47, 0xb2, 0xb4, 0x5a, 0xd9, 0xb2, 0xad, 0xff, 0x3f,
+	0xad, 0x93, 0xc0, 0x54, 0xea, 0xa6, 0x4e, 0x1a, 0xe7, 0x52, 0x91, 0x92, 0x2c, 0xd5, 0x76, 0xe2,
+	0x2e, 0x15, 0xbb, 0x48, 0x8a, 0x2e, 0x86, 0xbb, 0x43, 0x6a, 0xca, 0xe5, 0xce, 0x62, 0x66, 0x28,
+	0x89, 0x6f, 0x6d, 0x5e, 0xfa, 0x09, 0xfa, 0x1d, 0x0a, 0xf4, 0xbd, 0xe8, 0x07, 0x28, 0xd0, 0xf6,
+	0x0b, 0xb4, 0x4f, 0x09, 0x8a, 0x7c, 0x83, 0x04, 0xe8, 0x7b, 0x31, 0x97, 0xbd, 0x90, 0x12, 0x2d,
+	0x19, 0x7d, 0x21, 0x76, 0xce, 0xe5, 0x37, 0x33, 0xe7, 0x9c, 0x39, 0xe7, 0xcc, 0x10, 0x1e, 0x75,
+	0xa8, 0x3c, 0xee, 0xb7, 0x6a, 0x01, 0xeb, 0x6d, 0x0b, 0x16, 0xb1, 0xfb, 0x94, 0x6d, 0x77, 0x22,
+	0xc6, 0xb6, 0x13, 0xce, 0x7e, 0x43, 0x02, 0x29, 0xcc, 0x08, 0x27, 0x74, 0xfb, 0xe4, 0x47, 0xdb,
+	0x82, 0x48, 0x49, 0xe3, 0x8e, 0xa8, 0x25, 0x9c, 0x49, 0x86, 0x66, 0x15, 0xaf, 0xa6, 0xd4, 0x6a,
+	0x94, 0xb9, 0x2b, 0x1d, 0xd6, 0x61, 0x9a, 0xb1, 0xad, 0xbe, 0x8c, 0x8c, 0x8b, 0xc8, 0x99, 0x34,
+	0x44, 0x72, 0x26, 0x2d, 0x6d, 0x53, 0xcf, 0xd4, 0xa5, 0x32, 0xc5, 0xed, 0x11, 0x89, 0x43, 0x2c,
+	0xb1, 0xe5, 0xdf, 0x1c, 0xe5, 0x0b, 0x89, 0x65, 0x5f, 0x8c, 0xd3, 0x4e, 0xc7, 0x96, 0xff, 0xd6,
+	0xf8, 0xf5, 0x93, 0x33, 0x49, 0x62, 0x41, 0x59, 0x9c, 0x62, 0xed, 0xbf, 0x42, 0x36, 0x96, 0x84,
+	0x27, 0x9c, 0x0a, 0xb2, 0xcd, 0x12, 0xa9, 0x74, 0xb6, 0x39, 0x96, 0x24, 0xa2, 0x3d, 0x2a, 0xf3,
+	0x2f, 0x8b, 0xb3, 0xf7, 0x5a, 0x38, 0xe4, 0x4c, 0xe2, 0xbe, 0x3c, 0xb6, 0x2b, 0x52, 0x9f, 0x16,
+	0xe6, 0xc3, 0xd7, 0x5b, 0x4e, 0x0b, 0x07, 0xfa, 0xc7, 0x6a, 0xbf, 0xc2, 0x71, 0x01, 0xe5, 0x41,
+	0x9f, 0x4a, 0xbf, 0xc5, 0x09, 0xee, 0x12, 0x9e, 0x5a, 0xb2, 0xc3, 0x58, 0x27, 0x22, 0xdb, 0x7a,
+	0xd4, 0xea, 0xb7, 0xb7, 0xc3, 0x3e, 0xc7, 0x0a, 0x7b, 0x1c, 0xff, 0x94, 0xe3, 0x24, 0x21, 0xdc,
+	0x5a, 0x6f, 0xeb, 0x5f, 0x6f, 0x43, 0xb9, 0x69, 0x43, 0x02, 0x6d, 0xc3, 0x72, 0x48, 0x45, 0xc0,
+	0x4e, 0x08, 0x1f, 0xf8, 0x31, 0xee, 0x11, 0x91, 0xe0, 0x80, 0x38, 0xa5, 0x3b, 0xa5, 0x7b, 0x15,
+	0x0f, 0x65, 0xac, 0x4f, 0x53, 0x0e, 0x7a, 0x13, 0x16, 0x4f, 0xb1, 0x0c, 0x8e, 0x73, 0x61, 0xe1,
+	0x4c, 0xdc, 0xb9, 0x7e, 0xaf, 0xe2, 0x2d, 0x68, 0x7a, 0x26, 0x29, 0x10, 0x06, 0xa7, 0xdb, 0x6f,
+	0x11, 0x1e, 0x13, 0x49, 0x84, 0x1f, 0xb0, 0xb8, 0x4d, 0x3b, 0xbe, 0x60, 0x7d, 0x1e, 0x10, 0x67,
+	0xf2, 0x4e, 0xe9, 0x5e, 0xf5, 0xc1, 0x1b, 0xb5, 0x62, 0x2c, 0xd6, 0xd2, 0x55, 0xd5, 0x9e, 0x64,
+	0x6a, 0x0d, 0x1e, 0x8a, 0x83, 0x6b, 0xde, 0x6a, 0x0e, 0xd4, 0xd0, 0x38, 0x4d, 0x0d, 0x83, 0xbe,
+	0x80, 0xb5, 0x90, 0x72, 0x12, 0x48, 0xc6, 0x07, 0x23, 0x33, 0x4c, 0xe9, 0x19, 0xee, 0x8c, 0x99,
+	0x61, 0x37, 0xd5, 0x3a, 0xb8, 0xe6, 0xdd, 0xc8, 0x20, 0x86, 0xb0, 0x9f, 0xc0, 0x62, 0xc0, 0x62,
+	0xd1, 0x8f, 0xfc, 0xee, 0x49, 0x0a, 0x7a, 0x43, 0x83, 0xde, 0x1e, 0x03, 0xda, 0xd0, 0xe2, 0x4f,
+	0x4e, 0x0e, 0xae, 0x79, 0xf3, 0x81, 0xfd, 0xb6, 0x60, 0x1e, 0x2c, 0x75, 0xa8, 0x1c, 0x59, 0xe2,
+	0x5d, 0x8d, 0xf6, 0xff, 0x63, 0xd0, 0x1e, 0x53, 0xe9, 0x91, 0x84, 0x09, 0x6a, 0x97, 0xb9, 0xd0,
+	0xa1, 0x72, 0x68, 0x81, 0xe1, 0x90, 0x7d, 0x05, 0x09, 0x38, 0x91, 0x29, 0xf4, 0xb4, 0x86, 0xbe,
+	0x77, 0xa9, 0x7d, 0x9b, 0x5a, 0x4b, 0x1c, 0x94, 0x8a, 0x26, 0x36, 0x44, 0x3b, 0xcb, 0xe7, 0xb0,
+	0x7c, 0x82, 0xfb, 0x91, 0x1c, 0x99, 0x60, 0x46, 0x4f, 0xf0, 0x7f, 0x63, 0x26, 0x78, 0xa1, 0x34,
+	0x72, 0xec, 0xa5, 0x93, 0x7c, 0x7c, 0x91, 0xe7, 0x86, 0xa1, 0xcb, 0x57, 0xf4, 0x5c, 0xa9, 0xe0,
+	0xb9, 0x21, 0xec, 0x5f, 0xc2, 0x5a, 0xc1, 0x73, 0x43, 0xd8, 0x9b, 0x57, 0x73, 0x60, 0xc9, 0x5b,
+	0xc9, 0x1c, 0x58, 0x44, 0xfe, 0x0c, 0x96, 0xf0, 0xe9, 0xa8, 0xad, 0xef, 0x68, 0xcc, 0xbb, 0x63,
+	0x30, 0x77, 0x4e, 0x0b, 0x46, 0x5e, 0xc0, 0xa7, 0xc3, 0xd6, 0x3d, 0x82, 0x25, 0x0b, 0x46, 0xe2,
+	0x80, 0x0f, 0x74, 0x8e, 0x70, 0x6e, 0x6b, 0xc0, 0x1f, 0x8e, 0x01, 0x34, 0xfa, 0x7b, 0x99, 0xb8,
+	0xb7, 0x28, 0x46, 0x28, 0xa8, 0x0b, 0x6e, 0x21, 0x32, 0x30, 0x97, 0xb4, 0x8d, 0x83, 0x6c, 0xbd,
+	0x15, 0x0d, 0xff, 0xf6, 0xe5, 0x67, 0x4f, 0x07, 0x5b, 0x0f, 0x27, 0xe2, 0x60, 0xc2, 0x2b, 0x84,
+	0xda, 0x8e, 0xc5, 0xb3, 0x5b, 0xf8, 0x35, 0xac, 0xe7, 0x9e, 0x1c, 0x9d, 0x0b, 0xae, 0xe8, 0xcb,
+	0x09, 0x2f, 0x0f, 0x87, 0x11, 0xfc, 0x5f, 0xc1, 0x7a, 0xee, 0xcd, 0x51, 0xfc, 0xb5, 0xab, 0xf9,
+	0x73, 0xc2, 0x5b, 0x4d, 0xfd, 0x39, 0x82, 0xfe, 0x21, 0xcc, 0x72, 0xd2, 0xe6, 0x44, 0x1c, 0xfb,
+	0xaa, 0x3c, 0x38, 0xb3, 0x1a, 0x70, 0xbd, 0x66, 0x92, 0x68, 0x2d, 0x4d, 0xa2, 0xb5, 0x5d, 0x9b,
+	0x64, 0xbd, 0xaa, 0x15, 0xf7, 0xb0, 0x24, 0x68, 0x1d, 0xca, 0x21, 0x39, 0xf1, 0x7b, 0x2c, 0x24,
+	0xce, 0xdc, 0x9d, 0xd2, 0xbd, 0xb2, 0x37, 0x13, 0x92, 0x93, 0x67, 0x2c, 0x24, 0xc8, 0x81, 0x99,
+	0x88, 0xc6, 0x5d, 0xc2, 0x43, 0x67, 0xc9, 0x70, 0xec, 0x10, 0x7d, 0x02, 0x33, 0xdd, 0x18, 0x4b,
+	0x7a, 0x42, 0x1c, 0xf4, 0xea, 0x34, 0x68, 0xa4, 0x3e, 0x33, 0x95, 0xc3, 0x4b, 0xb5, 0xd0, 0x1e,
+	0x54, 0xb2, 0xcc, 0xec, 0x2c, 0xbf, 0x32, 0x58, 0x76, 0x53, 0xb9, 0x14, 0x24, 0xd7, 0x44, 0xf7,
+	0x61, 0x52, 0x29, 0x39, 0x4e, 0xba, 0xe5, 0x22, 0xc2, 0xe3, 0x88, 0xb1, 0x54, 0x47, 0x8b, 0xa1,
+	0x87, 0x30, 0xd3, 0xc1, 0x92, 0x9c, 0xe2, 0x81, 0xb3, 0xae, 0x35, 0x6e, 0x8e, 0x68, 0x18, 0x66,
+	0xb6, 0x5a, 0x2b, 0x8c, 0xea, 0x30, 0x6d, 0x6c, 0xef, 0xac, 0x68, 0xb5, 0xb7, 0x5e, 0xe9, 0x2c,
+	0x13, 0x74, 0xa9, 0xb1, 0xad, 0x26, 0xfa, 0x14, 0x20, 0x8f, 0x3f, 0x67, 0x55, 0xe3, 0xd4, 0xae,
+	0x18, 0xc0, 0x29, 0x56, 0x01, 0x01, 0xbd, 0x0f, 0x90, 0x77, 0x15, 0xce, 0xa2, 0xc6, 0x73, 0x86,
+	0xf1, 0xf6, 0x32, 0xbe, 0x57, 0x90, 0x45, 0xcf, 0xa0, 0x92, 0xb5, 0x11, 0x8e, 0xab, 0x15, 0xb7,
+	0x6b, 0x19, 0xa5, 0x66, 0xab, 0xfc, 0xe8, 0xd2, 0xf8, 0x09, 0x0d, 0x48, 0xba, 0x42, 0x2f, 0x47,
+	0x40, 0x4d, 0x58, 0xcc, 0x06, 0xbe, 0x20, 0xfc, 0x84, 0x70, 0x67, 0xc3, 0xe6, 0xee, 0x4b, 0x51,
+	0x2d, 0xdc, 0x42, 0x26, 0xd8, 0xd4, 0x00, 0xe8, 0x3d, 0x98, 0x54, 0x0d, 0x86, 0x73, 0xd3, 0xe6,
+	0x68, 0x35, 0xb8, 0x04, 0x43, 0x2b, 0xa0, 0x47, 0x30, 0x63, 0x5b, 0x1b, 0xe7, 0x96, 0x4d, 0x6a,
+	0x79, 0x07, 0x33, 0x46, 0x33, 0xd5, 0x40, 0xef, 0x43, 0x39, 0xed, 0x08, 0x9d, 0x79, 0xad, 0xbd,
+	0x5a, 0x0b, 0x18, 0x27, 0x99, 0xca, 0x33, 0xcb, 0xad, 0x4f, 0xfe, 0xfd, 0x9b, 0xdb, 0xd7, 0xbc,
+	0x4c, 0x1a, 0x3d, 0x81, 0x69, 0xd3, 0x2b, 0x3a, 0x0b, 0x5a, 0x6f, 0x65, 0x58, 0xaf, 0xa9, 0x79,
+	0xf5, 0x5b, 0x7f, 0xf9, 0xcf, 0x64, 0x49, 0x69, 0x7e, 0xff, 0xcd, 0xed, 0x25, 0x49, 0x84, 0x0c,
+	0x69, 0xbb, 0xfd, 0xc1, 0x16, 0xed, 0xc4, 0x8c, 0x93, 0x2d, 0xcf, 0x42, 0xb8, 0x8b, 0x30, 0x3f,
+	0xdc, 0x3e, 0xb8, 0xcb, 0xb0, 0x74, 0xae, 0xe0, 0xb9, 0x7f, 0x9d, 0x82, 0xd9, 0x62, 0x95, 0x42,
+	0x2b, 0x30, 0x25, 0x59, 0x97, 0xc4, 0xb6, 0xf7, 0x31, 0x03, 0x75, 0x8a, 0x71, 0x18, 0x72, 0x22,
+	0x54, 0x97, 0xa3, 0xe8, 0xe9, 0x10, 0xad, 0xc1, 0x4c, 0x80, 0xfd, 0x80, 0x70, 0xe9, 0x5c, 0xd7,
+	0x9c, 0xe9, 0x00, 0x37, 0x08, 0x97, 0x96, 0x91, 0x60, 0x79, 0xec, 0x4c, 0xa6, 0x8c, 0xe7, 0x58,
+	0x1e, 0xa3, 0xdb, 0x50, 0x0d, 0x22, 0x4a, 0x62, 0x69, 0xb4, 0xa6, 0x34, 0x13, 0x0c, 0x49, 0x6b,
+	0xde, 0x02, 0x3b, 0xf2, 0xbb, 0x64, 0xa0, 0x4b, 0x78, 0xc5, 0xab, 0x18, 0xca, 0x13, 0x32, 0x40,
+	0x3f, 0x80, 0x05, 0x19, 0x09, 0x1b, 0x25, 0xba, 0xff, 0xd2, 0x55, 0xb8, 0xe2, 0xcd, 0xc9, 0x48,
+	0x18, 0xd7, 0xab, 0xee, 0x0b, 0x3d, 0x84, 0x32, 0x8d, 0x05, 0x09, 0xfa, 0x3c, 0xad, 0xa5, 0xee,
+	0xb9, 0x74, 0x56, 0x67, 0x2c, 0x7a, 0x81, 0xa3, 0x3e, 0xf1, 0x32, 0x59, 0x95, 0xcc, 0x38, 0x63,
+	0x66, 0xf2, 0x8a, 0xd9, 0xac, 0x1a, 0xab, 0xa9, 0xbf, 0x84, 0x85, 0x62, 0x41, 0x51, 0x01, 0x62,
+	0x32, 0xfb, 0x83, 0x2b, 0x34, 0x00, 0x85, 0x13, 0xb9, 0xd3, 0x97, 0xc7, 0xde, 0x7c, 0x77, 0x68,
+	0x8c, 0x9a, 0x30, 0x87, 0x93, 0xc4, 0xe7, 0x2c, 0x22, 0x06, 0xba, 0x6a, 0x8f, 0xd5, 0x15, 0xa0,
+	0x77, 0x92, 0xc4, 0x63, 0x11, 0xd1, 0xb8, 0x55, 0x9c, 0x0f, 0xdc, 0xdf, 0x96, 0x8a, 0x71, 0xa0,
+	0xe7, 0x41, 0x30, 0xa9, 0xe6, 0xb0, 0x0e, 0xd6, 0xdf, 0xe8, 0x11, 0xb8, 0xc2, 0x9c, 0x4e, 0x1f,
+	0x07, 0x01, 0xeb, 0xc7, 0xd2, 0xd7, 0x8e, 0xf7, 0xdb, 0x34, 0x22, 0xd6, 0xe5, 0x6b, 0x56, 0x62,
+	0xc7, 0x08, 0x1c, 0x29, 0xfe, 0x3e, 0x8d, 0x88, 0xf2, 0x57, 0x4f, 0xab, 0x68, 0x67, 0x9b, 0x28,
+	0xa8, 0x68, 0x8a, 0xf2, 0xb7, 0xdb, 0x82, 0x6a, 0x61, 0x79, 0x2a, 0x2e, 0xf4, 0x16, 0x69, 0x68,
+	0x57, 0x30, 0xad, 0x86, 0x87, 0x21, 0xda, 0x80, 0x8a, 0xed, 0x01, 0x68, 0x68, 0xa7, 0x2c, 0x1b,
+	0xc2, 0x61, 0x78, 0xd9, 0x1c, 0x7f, 0x2e, 0xc1, 0xdc, 0x50, 0xa3, 0x88, 0x16, 0xe1, 0x7a, 0x9f,
+	0x47, 0x76, 0x0a, 0xf5, 0xa9, 0x28, 0x9c, 0xb4, 0x2d, 0xb2, 0xfa, 0x54, 0x96, 0x28, 0xc0, 0xe9,
+	0x6f, 0x74, 0x1f, 0x50, 0x70, 0x4c, 0x82, 0x2e, 0xeb, 0x4b, 0x3f, 0x2b, 0xc5, 0x36, 0x82, 0x97,
+	0x52, 0x4e, 0x56, 0xb0, 0xd1, 0xc7, 0x30, 0x97, 0xb0, 0x28, 0xf2, 0xa9, 0xca, 0x0f, 0x27, 0x38,
+	0x72, 0xa6, 0x2e, 0x2b, 0x9c, 0xb3, 0x4a, 0xfe, 0xd0, 0x8a, 0xbb, 0xff, 0x2e, 0x01, 0xe4, 0xad,
+	0x11, 0x5a, 0x85, 0x69, 0x4e, 0x3a, 0xaa, 0xf9, 0x49, 0x6d, 0xa3, 0x47, 0xc8, 0x85, 0x32, 0x89,
+	0xc3, 0x84, 0xd1, 0x58, 0xa6, 0xa6, 0x49, 0xc7, 0xe8, 0x5d, 0x58, 0x35, 0x66, 0x12, 0x7e, 0x0f,
+	0xc7, 0xb8, 0x43, 0xb8, 0x9f, 0x70, 0xd2, 0xa6, 0x67, 0x76, 0x5f, 0x2b, 0x96, 0xfb, 0xcc, 0x30,
+	0x9f, 0x6b, 0x1e, 0x7a, 0x07, 0x56, 0x12, 0xcc, 0x71, 0x8f, 0x48, 0xc2, 0x7d, 0x21, 0x19, 0x27,
+	0xc5, 0xb3, 0x8a, 0x32, 0x5e, 0x53, 0xb1, 0xf4, 0xb9, 0x7d, 0xa8, 0x5a, 0x55, 0x81, 0x5b, 0x11,
+	0xf1, 0x47, 0xe6, 0xd3, 0x9b, 0x2e, 0x7b, 0x37, 0x2c, 0xbb, 0x39, 0x34, 0x9f, 0xfb, 0x06, 0x94,
+	0xd3, 0x06, 0x64, 0xe8, 0x6c, 0x95, 0x86, 0xce, 0x96, 0x7b, 0x1f, 0x16, 0x47, 0x5b, 0x3a, 0x25,
+	0xde, 0x25, 0x03, 0x13, 0x84, 0x56, 0xbc, 0x4b, 0x06, 0x2a, 0xe8, 0xdc, 0x55, 0x58, 0xb9, 0xa8,
+	0x45, 0x73, 0xdf, 0x84, 0x4a, 0xee, 0x9d, 0x9b, 0xaa, 0x43, 0x48, 0x7d, 0x68, 0x00, 0x72, 0x82,
+	0xfb, 0xb5, 0x3a, 0x1b, 0x43, 0xbd, 0x05, 0xda, 0x81, 0x5b, 0x41, 0xd4, 0x17, 0xca, 0x26, 0x34,
+	0xee, 0x70, 0x22, 0x84, 0x9f, 0x70, 0x76, 0x36, 0xf0, 0xd3, 0xec, 0x67, 0x40, 0x5c, 0x2b, 0x74,
+	0x68, 0x64, 0x9e, 0x2b, 0x91, 0x1d, 0x9b, 0x10, 0x1b, 0xb0, 0x69, 0x1b, 0x14, 0x5f, 0xd5, 0x4b,
+	0x1e, 0xe3, 0x68, 0x04, 0xc3, 0x38, 0x70, 0xc3, 0x4a, 0xed, 0x59, 0xa1, 0x71, 0x20, 0x34, 0xbe,
+	0x10, 0xe4, 0xfa, 0x10, 0xc8, 0x61, 0x7c, 0x1e, 0xc4, 0xfd, 0x43, 0x09, 0x16, 0x47, 0x1b, 0x1f,
+	0xf4, 0x73, 0x28, 0xb7, 0x43, 0x61, 0x5a, 0x35, 0xb5, 0x99, 0xf9, 0xb1, 0x09, 0x66, 0x54, 0xb5,
+	0xb6, 0x1f, 0x0a, 0xd5, 0xd2, 0x79, 0x33, 0x6d, 0xf3, 0xb1, 0xf5, 0x13, 0x98, 0xb1, 0x34, 0x34,
+	0x07, 0x95, 0xfa, 0xd3, 0x9d, 0xc6, 0x93, 0xa7, 0x87, 0xcd, 0xa3, 0xc5, 0x6b, 0x6a, 0xf8, 0xf2,
+	0xe0, 0xf0, 0x68, 0x4f, 0x0f, 0x4b, 0x68, 0x16, 0xca, 0xbb, 0x87, 0xcd, 0x9d, 0xfa, 0xd3, 0xbd,
+	0xdd, 0xc5, 0x09, 0xf7, 0x5b, 0x80, 0xe5, 0x0b, 0xba, 0x1c, 0x74, 0x33, 0x2f, 0x32, 0xda, 0xcc,
+	0xf5, 0x09, 0xa7, 0x94, 0x17, 0x9a, 0xbb, 0x30, 0x7b, 0x2c, 0x65, 0x92, 0x19, 0x60, 0x4e, 0x1b,
+	0xa0, 0xaa, 0x68, 0xa9, 0xd5, 0x6e, 0x43, 0x35, 0x8c, 0x45, 0x26, 0x31, 0xaf, 0x25, 0x20, 0x8c,
+	0x45, 0x2a, 0xf0, 0x04, 0x56, 0x94, 0x80, 0x3a, 0x81, 0x34, 0xee, 0xe4, 0x87, 0x76, 0xe1, 0xb2,
+	0x43, 0x8b, 0xc2, 0x58, 0x3c, 0x37, 0x5a, 0xe9, 0xd1, 0x45, 0x9b, 0x00, 0xaa, 0x6c, 0x07, 0xba,
+	0x35, 0xb0, 0x4e, 0x2d, 0x50, 0xd4, 0x99, 0xed, 0x0b, 0xe5, 0x95, 0x1e, 0xb1, 0xde, 0xca, 0xc6,
+	0x8a, 0x97, 0x60, 0x21, 0x4e, 0x19, 0x0f, 0xed, 0x89, 0xcb, 0xc6, 0x79, 0x05, 0x9e, 0x2a, 0x56,
+	0x60, 0x53, 0x4e, 0xf5, 0x49, 0x98, 0x4e, 0xcb, 0xa9, 0xce, 0xbe, 0x85, 0x3a, 0x3b, 0x33, 0x54,
+	0x67, 0x37, 0xa0, 0xa2, 0x0a, 0xac, 0xd1, 0x29, 0x9b, 0x49, 0x14, 0x41, 0x6b, 0x15, 0x4f, 0x56,
+	0x65, 0xe8, 0x64, 0xa1, 0xa7, 0xb0, 0x92, 0xd6, 0x42, 0x5f, 0x74, 0x69, 0xe2, 0x9f, 0x10, 0x4e,
+	0xdb, 0x03, 0x07, 0x2e, 0xad, 0xa1, 0x28, 0xd5, 0x6b, 0x76, 0x69, 0xf2, 0x42, 0x6b, 0xa1, 0x87,
+	0x50, 0x39, 0xc5, 0x54, 0xfa, 0x92, 0xf6, 0x88, 0x53, 0xbd, 0xcc, 0xce, 0x65, 0x25, 0x7b, 0x44,
+	0x7b, 0x04, 0x31, 0x58, 0xb2, 0xf5, 0xc6, 0xcf, 0x9b, 0x7c, 0x73, 0x2b, 0xa9, 0x5f, 0xbd, 0x73,
+	0x4e, 0x7b, 0xce, 0x73, 0xfd, 0xff, 0xa2, 0x18, 0x61, 0xa0, 0x33, 0x58, 0x49, 0x27, 0x54, 0x49,
+	0x57, 0x48, 0x83, 0x60, 0xbb, 0xe2, 0xbd, 0xd7, 0x9f, 0xd3, 0x2b, 0xa0, 0xa4, 0xd3, 0x2e, 0x8b,
+	0xf3, 0x3c, 0xf7, 0xeb, 0x09, 0x58, 0x1b, 0xb3, 0x4e, 0x15, 0xf5, 0x2a, 0xa4, 0x7c, 0x13, 0x53,
+	0xea, 0x60, 0xa8, 0x37, 0xa6, 0xaa, 0xa2, 0x35, 0x0c, 0x09, 0xbd, 0x05, 0x4b, 0x7d, 0x41, 0xfc,
+	0x63, 0x82, 0x23, 0x79, 0xec, 0xeb, 0x12, 0x65, 0x72, 0x4c, 0xd9, 0x5b, 0xe8, 0x0b, 0x72, 0xa0,
+	0xe9, 0x0d, 0x4d, 0x56, 0x59, 0x3f, 0x60, 0x71, 0x4c, 0x02, 0xe9, 0xa7, 0x9b, 0x2d, 0xc4, 0x27,
+	0xb2, 0x3c, 0xbb, 0x18, 0xdd, 0x45, 0xfd, 0xbe, 0x04, 0x2b, 0x85, 0x15, 0xf8, 0x6d, 0x4c, 0x23,
+	0xb5, 0x42, 0xfb, 0x74, 0xf5, 0xf9, 0xff, 0xee, 0x8b, 0xda, 0x6e, 0xb6, 0x99, 0x7d, 0x0b, 0xee,
+	0xa1, 0xf0, 0x1c, 0xcd, 0x7d, 0x0f, 0xd0, 0x79, 0xc9, 0x2b, 0x18, 0xc8, 0xfd, 0xbe, 0x04, 0xee,
+	0x78, 0x9f, 0x20, 0x67, 0x24, 0xed, 0xe4, 0x29, 0xe7, 0x67, 0x30, 0xaf, 0xcd, 0x99, 0x27, 0x8a,
+	0x89, 0xcb, 0x02, 0x78, 0x4e, 0x2b, 0x64, 0x39, 0x02, 0xc1, 0xa4, 0xc4, 0x1d, 0x95, 0xad, 0xd5,
+	0xaa, 0xf4, 0x37, 0x0a, 0xe1, 0x6e, 0x48, 0x4c, 0x88, 0x11, 0xee, 0x07, 0x9c, 0x4a, 0x1a, 0xe0,
+	0x28, 0xf3, 0x07, 0x6e, 0xcb, 0xcc, 0xba, 0xaf, 0x98, 0x68, 0x33, 0xc7, 0x68, 0x58, 0x08, 0xbb,
+	0xc7, 0x1d, 0x05, 0xe0, 0xfe, 0xa9, 0x04, 0x6b, 0x63, 0xae, 0x80, 0xe8, 0x0b, 0xa8, 0x72, 0x2c,
+	0x89, 0xaf, 0x2f, 0x4b, 0x66, 0xd7, 0xd5, 0x07, 0x3f, 0x7d, 0xbd, 0x7b, 0x64, 0x4d, 0x5d, 0xfc,
+	0x9f, 0x6a, 0x00, 0x0f, 0x78, 0xf6, 0xed, 0xbe, 0x0b, 0x90, 0x73, 0x54, 0xcf, 0xf5, 0x8b, 0xe7,
+	0x4d, 0x3d, 0xc3, 0x84, 0xa7, 0x3e, 0x55, 0x76, 0x6b, 0xf5, 0xb9, 0x30, 0x6d, 0xcc, 0x9c, 0x67,
+	0x06, 0x1f, 0xac, 0x7e, 0xf5, 0xdd, 0xe4, 0x24, 0x4c, 0x08, 0xf9, 0xd5, 0x77, 0x93, 0x80, 0xca,
+	0xe9, 0x53, 0x7d, 0x7d, 0x01, 0xe6, 0x86, 0xde, 0x0a, 0x15, 0x61, 0xe8, 0xd5, 0xa9, 0xbe, 0x04,
+	0x0b, 0x23, 0x8f, 0x21, 0x5b, 0x7f, 0xab, 0x40, 0xb5, 0x70, 0x6f, 0x47, 0x5b, 0x30, 0x77, 0x16,
+	0x0a, 0xbf, 0x45, 0xe3, 0x50, 0xd7, 0x06, 0xeb, 0xe6, 0xea, 0x59, 0x28, 0xea, 0x34, 0x0e, 0x55,
+	0x71, 0x50, 0x07, 0xe3, 0x04, 0x47, 0x34, 0xd4, 0x7b, 0x2b, 0x88, 0x9a, 0xb4, 0x8e, 0x72, 0x5e,
+	0xa6, 0xf1, 0x0c, 0x16, 0x47, 0x1e, 0xa6, 0x4d, 0x51, 0xae, 0x3e, 0xd8, 0x1a, 0xb6, 0x64, 0xc3,
+	0x48, 0xd5, 0x8d, 0x90, 0x31, 0xa2, 0xb7, 0x10, 0x0c, 0x51, 0x05, 0xfa, 0x1c, 0xd6, 0xd3, 0x8e,
+	0x4e, 0xf8, 0xa7, 0x98, 0xf7, 0x54, 0x81, 0x52, 0x49, 0x93, 0xf5, 0xe5, 0xe5, 0xd1, 0xb0, 0x96,
+	0xe9, 0xbe, 0x34, 0xaa, 0x47, 0x46, 0x13, 0xed, 0x41, 0x55, 0xbd, 0xd4, 0xd9, 0x5b, 0xaf, 0x33,
+	0x75, 0xd1, 0x53, 0x6b, 0xc1, 0x56, 0xb5, 0x9d, 0x97, 0x4d, 0xfb, 0xe9, 0x01, 0x3e, 0x15, 0xa9,
+	0x09, 0x31, 0xdc, 0xa0, 0xb1, 0x36, 0x42, 0xfa, 0x76, 0x9b, 0xb0, 0x88, 0x06, 0x03, 0xfb, 0xc0,
+	0x7a, 0x7f, 0x3c, 0xe0, 0xa1, 0x51, 0x33, 0xdb, 0x7e, 0xae, 0x95, 0xbc, 0x65, 0x7a, 0x9e, 0x88,
+	0xf6, 0xe1, 0x76, 0xda, 0x5e, 0x16, 0xee, 0x58, 0x21, 0x11, 0x92, 0xc6, 0xd8, 0xac, 0x7e, 0x46,
+	0x27, 0xb5, 0x5b, 0x56, 0x2c, 0x0f, 0xcc, 0xdd, 0x82, 0x10, 0xda, 0x85, 0xc5, 0x14, 0xa7, 0xc3,
+	0x93, 0xc0, 0x3f, 0x25, 0xad, 0x2b, 0x5c, 0xff, 0xe6, 0xad, 0xce, 0x63, 0x9e, 0x04, 0x2f, 0x49,
+	0x0b, 0x05, 0x70, 0x27, 0x45, 0x31, 0x7d, 0x57, 0x07, 0xf3, 0x16, 0xee, 0x10, 0x3f, 0x60, 0x51,
+	0x44, 0x02, 0x5d, 0x19, 0x2a, 0x97, 0xa2, 0xa6, 0x4b, 0xd5, 0x6d, 0xd9, 0x63, 0x83, 0xd0, 0xc8,
+	0x00, 0x54, 0x26, 0x50, 0x05, 0x99, 0xb6, 0x69, 0xa0, 0x8e, 0x23, 0x39, 0x4b, 0x28, 0x1f, 0x28,
+	0xe7, 0xc7, 0xca, 0xf9, 0xa7, 0x34, 0x0e, 0xd9, 0xa9, 0x03, 0x97, 0xf9, 0x7e, 0xb3, 0x80, 0xb1,
+	0xa7, 0x21, 0x5e, 0x1a, 0x84, 0x97, 0x1a, 0x00, 0x7d, 0x09, 0xf6, 0x65, 0xb4, 0x50, 0x48, 0x4d,
+	0x21, 0x7e, 0x67, 0xbc, 0xdb, 0x4c, 0x2b, 0x7e, 0xae, 0x6c, 0x2e, 0x88, 0x61, 0xba, 0xfb, 0x14,
+	0x20, 0x0f, 0x19, 0xf4, 0x31, 0x6c, 0x90, 0x58, 0x1b, 0x2d, 0xe0, 0x24, 0x24, 0xb1, 0xa4, 0x38,
+	0x12, 0xe9, 0xb4, 0xa6, 0x03, 0x2f, 0x7b, 0xeb, 0x46, 0xa4, 0x91, 0x4b, 0x58, 0xb8, 0x81, 0xfb,
+	0x8f, 0x12, 0x2c, 0x5f, 0x10, 0x30, 0xea, 0x8a, 0xc3, 0x49, 0x12, 0xe1, 0x40, 0xb5, 0xc3, 0x26,
+	0x0c, 0x39, 0xeb, 0x4b, 0x22, 0x2c, 0xe4, 0x8a, 0xe5, 0x5a, 0x5d, 0x4f, 0xf3, 0xd0, 0x47, 0xb0,
+	0x31, 0x24, 0xed, 0x73, 0x22, 0x12, 0x16, 0x0b, 0xe5, 0xc4, 0x90, 0xd8, 0x04, 0xe4, 0xd0, 0x82,
+	0x8e, 0x67, 0x05, 0x1a, 0xaa, 0xa5, 0x1d, 0xaf, 0xde, 0x62, 0xe1, 0xc0, 0x96, 0xcc, 0x0b, 0xd5,
+	0xeb, 0x2c, 0x1c, 0xb8, 0x75, 0x58, 0xbd, 0xd8, 0x88, 0xe8, 0x1e, 0x2c, 0xaa, 0x7c, 0x94, 0x5e,
+	0x34, 0x74, 0x01, 0x36, 0x29, 0x69, 0xfe, 0x2c, 0x14, 0x0d, 0x43, 0x56, 0xc5, 0x77, 0xeb, 0x77,
+	0x53, 0x30, 0x3f, 0xfc, 0x9e, 0xa8, 0x4c, 0x51, 0x48, 0x54, 0xf6, 0x11, 0xa4, 0x90, 0xd5, 0x0a,
+	0x69, 0xcc, 0xbc, 0x85, 0xe8, 0x64, 0xf5, 0x29, 0x40, 0x4e, 0x77, 0xae, 0x5f, 0xf4, 0x70, 0x38,
+	0x3c, 0x4f, 0xed, 0x45, 0x26, 0x9e, 0xe5, 0x83, 0x1c, 0x01, 0x1d, 0xc0, 0x5d, 0x4e, 0x70, 0xe8,
+	0xdb, 0xc7, 0x4d, 0xe1, 0xb7, 0x39, 0xeb, 0xf9, 0x38, 0x8a, 0x8a, 0xff, 0x87, 0x4d, 0x9a, 0xe3,
+	0xaa, 0x04, 0x2d, 0xb8, 0xd8, 0xe7, 0xac, 0xb7, 0x13, 0x45, 0x85, 0x7f, 0xc7, 0xf6, 0x61, 0x13,
+	0x47, 0x1a, 0x42, 0x30, 0x2e, 0xad, 0xa5, 0xa5, 0x8e, 0x21, 0xeb, 0x62, 0x7d, 0xb9, 0xd4, 0x77,
+	0x01, 0xd7, 0x48, 0x36, 0x19, 0x97, 0xda, 0xde, 0x47, 0x4a, 0x4c, 0x7f, 0x09, 0xf7, 0x9f, 0x13,
+	0xb0, 0x74, 0x6e, 0xcd, 0xe8, 0x13, 0xb8, 0x69, 0x8e, 0xef, 0x18, 0x9b, 0x99, 0xf4, 0xbe, 0xae,
+	0x65, 0x5e, 0x5c, 0x64, 0xb8, 0x8f, 0x60, 0xa3, 0xa0, 0x7a, 0x4a, 0x5a, 0xc7, 0x8c, 0x75, 0x7d,
+	0xf5, 0xfe, 0x54, 0x78, 0xf2, 0x72, 0x72, 0x91, 0x97, 0x46, 0xe2, 0x28, 0x12, 0xfa, 0x29, 0xeb,
+	0x11, 0xb8, 0x63, 0xd4, 0xd5, 0x0d, 0xd8, 0x74, 0xfe, 0x6b, 0x17, 0x69, 0xab, 0xd7, 0xa6, 0x06,
+	0x6c, 0x9a, 0x57, 0x3d, 0x5f, 0x39, 0xaa, 0xb8, 0x05, 0xd5, 0x84, 0xa9, 0x67, 0x2d, 0x73, 0xef,
+	0xde, 0x30, 0x52, 0xea, 0xf8, 0xe6, 0x7b, 0xd8, 0x37, 0x22, 0xe8, 0x13, 0x98, 0xb3, 0xf6, 0xc5,
+	0x41, 0x40, 0x12, 0xe9, 0x4c, 0x5f, 0x9a, 0xb5, 0x66, 0x8d, 0xc2, 0x8e, 0x96, 0xaf, 0x7f, 0xa0,
+	0xde, 0x1b, 0xff, 0xf8, 0xed, 0x66, 0xe9, 0x8b, 0x77, 0xae, 0xf6, 0x77, 0x7b, 0xd2, 0xed, 0xd8,
+	0x7f, 0x6e, 0x5b, 0xd3, 0x1a, 0xfd, 0xc7, 0xff, 0x1d, 0x00, 0x60, 0x6e, 0x80, 0x42, 0xa9, 0x1f,
 	0x00, 0x00,
 }
 
@@ -1774,6 +2455,9 @@ func (this *Settings) Equal(that interface{}) bool {
 	} else if !this.SecretSource.Equal(that1.SecretSource) {
 		return false
 	}
+	if !this.SecretEncryption.Equal(that1.SecretEncryption) {
+		return false
+	}
 	if that1.ArtifactSource == nil {
 		if this.ArtifactSource != nil {
 			return false
@@ -1908,6 +2592,30 @@ func (this *Settings_ConsulKvSource) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Settings_GitConfigSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_GitConfigSource)
+	if !ok {
+		that2, ok := that.(Settings_GitConfigSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GitConfigSource.Equal(that1.GitConfigSource) {
+		return false
+	}
+	return true
+}
 func (this *Settings_KubernetesSecretSource) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1980,6 +2688,54 @@ func (this *Settings_DirectorySecretSource) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Settings_ConsulKvSecretSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_ConsulKvSecretSource)
+	if !ok {
+		that2, ok := that.(Settings_ConsulKvSecretSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ConsulKvSecretSource.Equal(that1.ConsulKvSecretSource) {
+		return false
+	}
+	return true
+}
+func (this *Settings_AwsSecretSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_AwsSecretSource)
+	if !ok {
+		that2, ok := that.(Settings_AwsSecretSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.AwsSecretSource.Equal(that1.AwsSecretSource) {
+		return false
+	}
+	return true
+}
 func (this *Settings_KubernetesArtifactSource) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2146,6 +2902,156 @@ func (this *Settings_VaultSecrets) Equal(that interface{}) bool {
 	if this.RootKey != that1.RootKey {
 		return false
 	}
+	if !this.KubernetesAuth.Equal(that1.KubernetesAuth) {
+		return false
+	}
+	if !this.AppRoleAuth.Equal(that1.AppRoleAuth) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Settings_VaultSecrets_KubernetesAuth) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_VaultSecrets_KubernetesAuth)
+	if !ok {
+		that2, ok := that.(Settings_VaultSecrets_KubernetesAuth)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if this.ServiceAccountTokenFile != that1.ServiceAccountTokenFile {
+		return false
+	}
+	if this.MountPath != that1.MountPath {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Settings_VaultSecrets_AppRoleAuth) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_VaultSecrets_AppRoleAuth)
+	if !ok {
+		that2, ok := that.(Settings_VaultSecrets_AppRoleAuth)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RoleId != that1.RoleId {
+		return false
+	}
+	if this.SecretId != that1.SecretId {
+		return false
+	}
+	if this.MountPath != that1.MountPath {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Settings_GitRepository) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_GitRepository)
+	if !ok {
+		that2, ok := that.(Settings_GitRepository)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Url != that1.Url {
+		return false
+	}
+	if this.Ref != that1.Ref {
+		return false
+	}
+	if this.Path != that1.Path {
+		return false
+	}
+	if this.CheckoutDirectory != that1.CheckoutDirectory {
+		return false
+	}
+	if !this.PollInterval.Equal(that1.PollInterval) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Settings_AwsSecrets) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_AwsSecrets)
+	if !ok {
+		that2, ok := that.(Settings_AwsSecrets)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Region != that1.Region {
+		return false
+	}
+	if this.Endpoint != that1.Endpoint {
+		return false
+	}
+	if this.SecretsManagerPrefix != that1.SecretsManagerPrefix {
+		return false
+	}
+	if this.ParameterStorePath != that1.ParameterStorePath {
+		return false
+	}
+	if this.DisableSecretsManager != that1.DisableSecretsManager {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -2178,6 +3084,33 @@ func (this *Settings_ConsulKv) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Settings_SecretEncryption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_SecretEncryption)
+	if !ok {
+		that2, ok := that.(Settings_SecretEncryption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.KeyFile != that1.KeyFile {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *Settings_KubernetesConfigmaps) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2353,6 +3286,9 @@ func (this *Settings_ConsulConfiguration) Equal(that interface{}) bool {
 	if !this.ServiceDiscovery.Equal(that1.ServiceDiscovery) {
 		return false
 	}
+	if !this.ServiceRegistration.Equal(that1.ServiceRegistration) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -2385,6 +3321,88 @@ func (this *Settings_ConsulConfiguration_ServiceDiscoveryOptions) Equal(that int
 			return false
 		}
 	}
+	if this.UseHealthChecks != that1.UseHealthChecks {
+		return false
+	}
+	if this.ConnectServiceName != that1.ConnectServiceName {
+		return false
+	}
+	if !this.DataCenterFailover.Equal(that1.DataCenterFailover) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover)
+	if !ok {
+		that2, ok := that.(Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.DataCenters) != len(that1.DataCenters) {
+		return false
+	}
+	for i := range this.DataCenters {
+		if this.DataCenters[i] != that1.DataCenters[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Settings_ConsulConfiguration_ServiceRegistrationOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_ConsulConfiguration_ServiceRegistrationOptions)
+	if !ok {
+		that2, ok := that.(Settings_ConsulConfiguration_ServiceRegistrationOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.CheckInterval.Equal(that1.CheckInterval) {
+		return false
+	}
+	if len(this.Tags) != len(that1.Tags) {
+		return false
+	}
+	for i := range this.Tags {
+		if this.Tags[i] != that1.Tags[i] {
+			return false
+		}
+	}
+	if !this.DeregisterCriticalServiceAfter.Equal(that1.DeregisterCriticalServiceAfter) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -2493,6 +3511,12 @@ func (this *GlooOptions) Equal(that interface{}) bool {
 	if !this.DisableProxyGarbageCollection.Equal(that1.DisableProxyGarbageCollection) {
 		return false
 	}
+	if !this.CertificateExpiryWarningWindow.Equal(that1.CertificateExpiryWarningWindow) {
+		return false
+	}
+	if !this.SecretDiscovery.Equal(that1.SecretDiscovery) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -2558,6 +3582,33 @@ func (this *GlooOptions_InvalidConfigPolicy) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *GlooOptions_SecretDiscoveryOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GlooOptions_SecretDiscoveryOptions)
+	if !ok {
+		that2, ok := that.(GlooOptions_SecretDiscoveryOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.XdsClusterName != that1.XdsClusterName {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *GatewayOptions) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -79,10 +79,20 @@ type SslConfig struct {
 	Parameters           *SslParameters `protobuf:"bytes,6,opt,name=parameters,proto3" json:"parameters,omitempty"`
 	// Set Application Level Protocol Negotiation
 	// If empty, defaults to ["h2", "http/1.1"].
-	AlpnProtocols        []string `protobuf:"bytes,7,rep,name=alpn_protocols,json=alpnProtocols,proto3" json:"alpn_protocols,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	AlpnProtocols []string `protobuf:"bytes,7,rep,name=alpn_protocols,json=alpnProtocols,proto3" json:"alpn_protocols,omitempty"`
+	// Verify that the hex encoded SHA-256 hash of the client certificate is one of the specified values.
+	// note that a root_ca must be provided if this option is used.
+	VerifyCertificateHash []string `protobuf:"bytes,8,rep,name=verify_certificate_hash,json=verifyCertificateHash,proto3" json:"verify_certificate_hash,omitempty"`
+	// Verify that the base64 encoded SHA-256 hash of the client certificate's Subject Public Key Information
+	// is one of the specified values.
+	// note that a root_ca must be provided if this option is used.
+	VerifyCertificateSpki []string `protobuf:"bytes,9,rep,name=verify_certificate_spki,json=verifyCertificateSpki,proto3" json:"verify_certificate_spki,omitempty"`
+	// If true, client certificates are validated when presented, but connections without one are accepted.
+	// By default, a client certificate is required whenever a root_ca is provided.
+	OptionalClientCertificate bool     `protobuf:"varint,10,opt,name=optional_client_certificate,json=optionalClientCertificate,proto3" json:"optional_client_certificate,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
 }
 
 func (m *SslConfig) Reset()         { *m = SslConfig{} }
@@ -184,6 +194,27 @@ func (m *SslConfig) GetAlpnProtocols() []string {
 	return nil
 }
 
+func (m *SslConfig) GetVerifyCertificateHash() []string {
+	if m != nil {
+		return m.VerifyCertificateHash
+	}
+	return nil
+}
+
+func (m *SslConfig) GetVerifyCertificateSpki() []string {
+	if m != nil {
+		return m.VerifyCertificateSpki
+	}
+	return nil
+}
+
+func (m *SslConfig) GetOptionalClientCertificate() bool {
+	if m != nil {
+		return m.OptionalClientCertificate
+	}
+	return false
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*SslConfig) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -198,7 +229,13 @@ type SSLFiles struct {
 	TlsCert string `protobuf:"bytes,1,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
 	TlsKey  string `protobuf:"bytes,2,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
 	// for client cert validation. optional
-	RootCa               string   `protobuf:"bytes,3,opt,name=root_ca,json=rootCa,proto3" json:"root_ca,omitempty"`
+	RootCa string `protobuf:"bytes,3,opt,name=root_ca,json=rootCa,proto3" json:"root_ca,omitempty"`
+	// optional. path to a DER encoded OCSP response to staple during the TLS handshake.
+	// requires tls_cert and tls_key to be set.
+	OcspStaple string `protobuf:"bytes,4,opt,name=ocsp_staple,json=ocspStaple,proto3" json:"ocsp_staple,omitempty"`
+	// optional. path to a PEM encoded certificate revocation list used during client cert validation.
+	// requires root_ca to be set.
+	Crl                  string   `protobuf:"bytes,5,opt,name=crl,proto3" json:"crl,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -249,6 +286,20 @@ func (m *SSLFiles) GetRootCa() string {
 	return ""
 }
 
+func (m *SSLFiles) GetOcspStaple() string {
+	if m != nil {
+		return m.OcspStaple
+	}
+	return ""
+}
+
+func (m *SSLFiles) GetCrl() string {
+	if m != nil {
+		return m.Crl
+	}
+	return ""
+}
+
 // SslConfig contains the options necessary to configure a virtual host or listener to use TLS
 type UpstreamSslConfig struct {
 	// Types that are valid to be assigned to SslSecrets:
@@ -262,9 +313,12 @@ type UpstreamSslConfig struct {
 	// note that a root_ca must be provided if this option is used.
 	VerifySubjectAltName []string       `protobuf:"bytes,5,rep,name=verify_subject_alt_name,json=verifySubjectAltName,proto3" json:"verify_subject_alt_name,omitempty"`
 	Parameters           *SslParameters `protobuf:"bytes,7,opt,name=parameters,proto3" json:"parameters,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	// Set Application Level Protocol Negotiation.
+	// If empty, no ALPN protocols are offered to the upstream.
+	AlpnProtocols        []string `protobuf:"bytes,8,rep,name=alpn_protocols,json=alpnProtocols,proto3" json:"alpn_protocols,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UpstreamSslConfig) Reset()         { *m = UpstreamSslConfig{} }
@@ -359,6 +413,13 @@ func (m *UpstreamSslConfig) GetParameters() *SslParameters {
 	return nil
 }
 
+func (m *UpstreamSslConfig) GetAlpnProtocols() []string {
+	if m != nil {
+		return m.AlpnProtocols
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*UpstreamSslConfig) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -526,11 +587,16 @@ func (m *CallCredentials_FileCredentialSource) GetHeader() string {
 type SslParameters struct {
 	MinimumProtocolVersion SslParameters_ProtocolVersion `protobuf:"varint,1,opt,name=minimum_protocol_version,json=minimumProtocolVersion,proto3,enum=gloo.solo.io.SslParameters_ProtocolVersion" json:"minimum_protocol_version,omitempty"`
 	MaximumProtocolVersion SslParameters_ProtocolVersion `protobuf:"varint,2,opt,name=maximum_protocol_version,json=maximumProtocolVersion,proto3,enum=gloo.solo.io.SslParameters_ProtocolVersion" json:"maximum_protocol_version,omitempty"`
-	CipherSuites           []string                      `protobuf:"bytes,3,rep,name=cipher_suites,json=cipherSuites,proto3" json:"cipher_suites,omitempty"`
-	EcdhCurves             []string                      `protobuf:"bytes,4,rep,name=ecdh_curves,json=ecdhCurves,proto3" json:"ecdh_curves,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}                      `json:"-"`
-	XXX_unrecognized       []byte                        `json:"-"`
-	XXX_sizecache          int32                         `json:"-"`
+	// If specified, only the listed cipher suites will be negotiated, in order of preference.
+	// Equal-preference groups may be given as `[CIPHER-A|CIPHER-B]`.
+	// Unknown cipher suites are rejected during translation.
+	CipherSuites []string `protobuf:"bytes,3,rep,name=cipher_suites,json=cipherSuites,proto3" json:"cipher_suites,omitempty"`
+	// If specified, only the listed ECDH curves (e.g. `X25519`, `P-256`) will be negotiated.
+	// Unknown curves are rejected during translation.
+	EcdhCurves           []string `protobuf:"bytes,4,rep,name=ecdh_curves,json=ecdhCurves,proto3" json:"ecdh_curves,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SslParameters) Reset()         { *m = SslParameters{} }
@@ -601,57 +667,62 @@ func init() {
 }
 
 var fileDescriptor_c4a65e8067d81add = []byte{
-	// 790 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x51, 0x6f, 0x23, 0x35,
-	0x10, 0x6e, 0x92, 0xd2, 0x76, 0xa7, 0xcd, 0x35, 0x58, 0x25, 0xdd, 0x16, 0x1d, 0x9c, 0x82, 0x40,
-	0x27, 0x9d, 0xd8, 0x5c, 0x7b, 0xba, 0x13, 0x3a, 0x9e, 0xee, 0x72, 0x42, 0x95, 0xa8, 0xe0, 0xb4,
-	0xdb, 0x1e, 0x12, 0x2f, 0x96, 0xeb, 0xcc, 0x26, 0xa6, 0xde, 0xf5, 0xca, 0x76, 0xa2, 0xf6, 0x1f,
-	0xf1, 0x13, 0xf8, 0x09, 0xbc, 0xf3, 0x0f, 0x78, 0xe0, 0x1f, 0x80, 0x78, 0x44, 0xb6, 0x77, 0x9b,
-	0x34, 0xaa, 0x2a, 0x40, 0xbc, 0xf0, 0xe6, 0xf9, 0xbe, 0x19, 0xcf, 0xcc, 0x37, 0xe3, 0x5d, 0x78,
-	0x31, 0x11, 0x76, 0x3a, 0xbb, 0x48, 0xb8, 0x2a, 0x86, 0x46, 0x49, 0xf5, 0xb9, 0x50, 0xc3, 0x89,
-	0x54, 0x6a, 0x58, 0x69, 0xf5, 0x03, 0x72, 0x6b, 0x82, 0xc5, 0x2a, 0x31, 0x9c, 0x1f, 0x0d, 0x8d,
-	0x91, 0x49, 0xa5, 0x95, 0x55, 0x64, 0xc7, 0xc1, 0x89, 0x8b, 0x48, 0x84, 0x3a, 0xdc, 0x9b, 0xa8,
-	0x89, 0xf2, 0xc4, 0xd0, 0x9d, 0x82, 0xcf, 0x21, 0xc1, 0x2b, 0x1b, 0x40, 0xbc, 0xb2, 0x35, 0x76,
-	0xe0, 0x93, 0x5c, 0x0a, 0xdb, 0x5c, 0xa9, 0x31, 0x0f, 0xd4, 0xe0, 0x8f, 0x36, 0x44, 0x99, 0x91,
-	0x23, 0x55, 0xe6, 0x62, 0x42, 0x5e, 0x02, 0x18, 0xe4, 0x1a, 0x2d, 0xd5, 0x98, 0xc7, 0xad, 0x47,
-	0xad, 0xc7, 0xdb, 0xc7, 0x07, 0x09, 0x57, 0x1a, 0x9b, 0xac, 0x49, 0x8a, 0x46, 0xcd, 0x34, 0xc7,
-	0x14, 0xf3, 0x93, 0xb5, 0x34, 0x0a, 0xee, 0x29, 0xe6, 0xe4, 0x39, 0x44, 0xc6, 0x48, 0x9a, 0x0b,
-	0x89, 0x26, 0x6e, 0xfb, 0xd0, 0x7e, 0xb2, 0x5c, 0x70, 0x92, 0x65, 0xa7, 0x5f, 0x39, 0xf6, 0x64,
-	0x2d, 0xdd, 0x32, 0x46, 0xfa, 0x33, 0x79, 0x02, 0x1d, 0x33, 0x36, 0xf1, 0xba, 0x0f, 0xd8, 0x5f,
-	0x09, 0x78, 0x93, 0x85, 0xc2, 0x4e, 0xd6, 0x52, 0xe7, 0x45, 0x3e, 0x86, 0x6d, 0x53, 0x0a, 0x3a,
-	0x56, 0x05, 0x13, 0xa5, 0x89, 0x3b, 0x8f, 0x3a, 0x8f, 0xa3, 0x14, 0x4c, 0x29, 0xde, 0x04, 0x84,
-	0x3c, 0x87, 0xfd, 0x39, 0x6a, 0x91, 0x5f, 0x53, 0x33, 0xbb, 0x70, 0x52, 0x52, 0x26, 0x2d, 0x2d,
-	0x59, 0x81, 0xf1, 0x7b, 0xde, 0x79, 0x2f, 0xd0, 0x59, 0x60, 0x5f, 0x49, 0xfb, 0x0d, 0x2b, 0x90,
-	0x7c, 0x09, 0x50, 0x31, 0xcd, 0x0a, 0xb4, 0xa8, 0x4d, 0xbc, 0xe1, 0x6b, 0xf9, 0x70, 0xa5, 0x16,
-	0x23, 0xdf, 0xde, 0xb8, 0xa4, 0x4b, 0xee, 0xe4, 0x53, 0x78, 0xc0, 0x64, 0x55, 0x52, 0x2f, 0x28,
-	0x57, 0xd2, 0xc4, 0x9b, 0x3e, 0x55, 0xd7, 0xa1, 0x6f, 0x1b, 0xf0, 0x75, 0x17, 0xb6, 0x9d, 0x3e,
-	0x41, 0x30, 0x33, 0xf8, 0x0e, 0xb6, 0x1a, 0x3d, 0xc8, 0x01, 0x6c, 0x59, 0x69, 0x28, 0x47, 0x6d,
-	0xbd, 0xe8, 0x51, 0xba, 0x69, 0xa5, 0x19, 0xa1, 0xb6, 0x64, 0x1f, 0xdc, 0x91, 0x5e, 0xe2, 0xb5,
-	0xd7, 0x34, 0x4a, 0x37, 0xac, 0x34, 0x5f, 0xe3, 0xb5, 0x23, 0xb4, 0x52, 0x96, 0x72, 0x16, 0x77,
-	0x02, 0xe1, 0xcc, 0x11, 0x1b, 0xfc, 0xdc, 0x86, 0xf7, 0xcf, 0x2b, 0x63, 0x35, 0xb2, 0xe2, 0xff,
-	0x33, 0xd9, 0x1e, 0x74, 0x4c, 0x29, 0xea, 0x56, 0xdc, 0xf1, 0xbf, 0x19, 0xe5, 0xe6, 0x3f, 0x1a,
-	0xe5, 0xea, 0x8c, 0x7e, 0x6b, 0x41, 0x74, 0x53, 0x29, 0x79, 0x08, 0x60, 0x99, 0x9e, 0xa0, 0xa5,
-	0x33, 0x2d, 0xea, 0x39, 0x45, 0x01, 0x39, 0xd7, 0x82, 0x9c, 0x40, 0x8f, 0x33, 0x29, 0x29, 0xd7,
-	0x38, 0xc6, 0xd2, 0x0a, 0x26, 0x1b, 0xb1, 0x1e, 0xde, 0x4e, 0x3f, 0x62, 0x52, 0x8e, 0x16, 0x4e,
-	0xe9, 0x2e, 0xbf, 0x0d, 0x90, 0x2f, 0x20, 0x76, 0xab, 0x20, 0x72, 0xc1, 0x99, 0x45, 0x53, 0x97,
-	0x13, 0x5a, 0x0f, 0x02, 0xf5, 0x97, 0xf9, 0xcc, 0xd3, 0xbe, 0xf9, 0x17, 0xb0, 0x3f, 0x67, 0x52,
-	0x8c, 0x99, 0x15, 0xaa, 0xa4, 0x5c, 0x95, 0x16, 0xaf, 0xea, 0xc0, 0x75, 0x1f, 0xf8, 0xc1, 0x82,
-	0x1e, 0x05, 0xd6, 0xc5, 0x0d, 0x7e, 0x69, 0xc1, 0xee, 0x4a, 0x59, 0x64, 0x0a, 0x7d, 0x37, 0xf1,
-	0xa5, 0x7e, 0x68, 0xd8, 0x8f, 0x7a, 0x7b, 0x8e, 0xef, 0xed, 0x2a, 0x71, 0x3b, 0xb0, 0xb0, 0xb3,
-	0xb0, 0x59, 0x7b, 0xf9, 0x1d, 0xe8, 0xe1, 0x3b, 0xd8, 0xbb, 0xcb, 0x9b, 0x7c, 0x06, 0xbb, 0x56,
-	0x5d, 0x62, 0xe9, 0x37, 0x2f, 0x74, 0x11, 0x54, 0xef, 0x7a, 0xd8, 0xc5, 0xf8, 0xae, 0xfb, 0xb0,
-	0x31, 0x45, 0x36, 0x46, 0xdd, 0x3c, 0x91, 0x60, 0x0d, 0xfe, 0x6c, 0x43, 0xf7, 0xd6, 0xac, 0x09,
-	0x42, 0x5c, 0x88, 0x52, 0x14, 0xb3, 0xe2, 0xe6, 0xb5, 0xd2, 0x39, 0x6a, 0x23, 0x54, 0xe9, 0xaf,
-	0x7e, 0x70, 0xfc, 0xe4, 0x9e, 0x55, 0x49, 0x9a, 0xc7, 0xfc, 0x2e, 0x84, 0xa4, 0xfd, 0xfa, 0xb2,
-	0x15, 0xdc, 0xa7, 0x61, 0x57, 0x77, 0xa7, 0x69, 0xff, 0x9b, 0x34, 0xe1, 0xb2, 0xd5, 0x34, 0x9f,
-	0x40, 0x97, 0x8b, 0x6a, 0x8a, 0x9a, 0x9a, 0x99, 0xb0, 0xd8, 0x7c, 0x0f, 0x77, 0x02, 0x98, 0x79,
-	0xcc, 0x7d, 0x32, 0x91, 0x8f, 0xa7, 0x94, 0xcf, 0xf4, 0x1c, 0xdd, 0x6b, 0xf4, 0x9f, 0x4c, 0x07,
-	0x8d, 0x3c, 0x32, 0xc8, 0x60, 0x77, 0xf5, 0xe2, 0x1d, 0xd8, 0x3a, 0x3b, 0xcd, 0xe8, 0xab, 0xf3,
-	0xb3, 0x6f, 0x7b, 0x6b, 0x64, 0x1b, 0x36, 0xcf, 0x4e, 0xb3, 0xf9, 0x11, 0x7d, 0xda, 0x6b, 0x2d,
-	0x8c, 0xa3, 0x5e, 0x7b, 0x61, 0x1c, 0xf7, 0x3a, 0x0b, 0xe3, 0x59, 0x6f, 0xfd, 0xf5, 0xcb, 0x9f,
-	0x7e, 0x5f, 0x6f, 0xfd, 0xf8, 0xeb, 0x47, 0xad, 0xef, 0x9f, 0xfe, 0xbd, 0x7f, 0x5d, 0x75, 0x39,
-	0xa9, 0x7f, 0x4e, 0x17, 0x1b, 0x5e, 0xb3, 0x67, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x6c, 0xcc,
-	0xd1, 0x4b, 0x26, 0x07, 0x00, 0x00,
+	// 880 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcf, 0x6e, 0x1b, 0x45,
+	0x18, 0x8f, 0xe3, 0x34, 0xf1, 0x7e, 0x89, 0x1b, 0x33, 0x4a, 0x9d, 0x4d, 0xaa, 0x42, 0x64, 0x04,
+	0x8a, 0x54, 0xb1, 0x6e, 0x52, 0xb5, 0x42, 0x45, 0x42, 0x6a, 0x5d, 0x21, 0x4b, 0x44, 0x50, 0xed,
+	0x26, 0x3d, 0x70, 0x19, 0x4d, 0xc6, 0x9f, 0xed, 0xc1, 0xb3, 0x3b, 0xab, 0x99, 0xb1, 0x95, 0xbc,
+	0x02, 0x0f, 0x82, 0x78, 0x04, 0xde, 0x83, 0x37, 0xe0, 0xc0, 0x95, 0x13, 0x12, 0x47, 0x34, 0x33,
+	0xde, 0xd8, 0xb1, 0xd2, 0x0a, 0x01, 0x17, 0x6e, 0xf3, 0xfd, 0x7e, 0xdf, 0xff, 0xf9, 0xed, 0x0e,
+	0x3c, 0x1f, 0x09, 0x3b, 0x9e, 0x5e, 0x26, 0x5c, 0xe5, 0x5d, 0xa3, 0xa4, 0xfa, 0x4c, 0xa8, 0xee,
+	0x48, 0x2a, 0xd5, 0x2d, 0xb5, 0xfa, 0x1e, 0xb9, 0x35, 0xc1, 0x62, 0xa5, 0xe8, 0xce, 0x4e, 0xba,
+	0xc6, 0xc8, 0xa4, 0xd4, 0xca, 0x2a, 0xb2, 0xe3, 0xe0, 0xc4, 0x45, 0x24, 0x42, 0x1d, 0xee, 0x8d,
+	0xd4, 0x48, 0x79, 0xa2, 0xeb, 0x4e, 0xc1, 0xe7, 0x90, 0xe0, 0x95, 0x0d, 0x20, 0x5e, 0xd9, 0x39,
+	0x76, 0xe0, 0x8b, 0x4c, 0x84, 0xad, 0x52, 0x6a, 0x1c, 0x06, 0xaa, 0xf3, 0xe3, 0x06, 0x44, 0x99,
+	0x91, 0x3d, 0x55, 0x0c, 0xc5, 0x88, 0xbc, 0x00, 0x30, 0xc8, 0x35, 0x5a, 0xaa, 0x71, 0x18, 0xd7,
+	0x8e, 0x6a, 0xc7, 0xdb, 0xa7, 0x07, 0x09, 0x57, 0x1a, 0xab, 0xaa, 0x49, 0x8a, 0x46, 0x4d, 0x35,
+	0xc7, 0x14, 0x87, 0xfd, 0xb5, 0x34, 0x0a, 0xee, 0x29, 0x0e, 0xc9, 0x33, 0x88, 0x8c, 0x91, 0x74,
+	0x28, 0x24, 0x9a, 0x78, 0xdd, 0x87, 0xb6, 0x93, 0xe5, 0x86, 0x93, 0x2c, 0x3b, 0xfb, 0xca, 0xb1,
+	0xfd, 0xb5, 0xb4, 0x61, 0x8c, 0xf4, 0x67, 0xf2, 0x18, 0xea, 0x66, 0x60, 0xe2, 0x0d, 0x1f, 0xb0,
+	0xbf, 0x12, 0xf0, 0x3a, 0x0b, 0x8d, 0xf5, 0xd7, 0x52, 0xe7, 0x45, 0x3e, 0x82, 0x6d, 0x53, 0x08,
+	0x3a, 0x50, 0x39, 0x13, 0x85, 0x89, 0xeb, 0x47, 0xf5, 0xe3, 0x28, 0x05, 0x53, 0x88, 0xd7, 0x01,
+	0x21, 0xcf, 0x60, 0x7f, 0x86, 0x5a, 0x0c, 0xaf, 0xa9, 0x99, 0x5e, 0xba, 0x55, 0x52, 0x26, 0x2d,
+	0x2d, 0x58, 0x8e, 0xf1, 0x3d, 0xef, 0xbc, 0x17, 0xe8, 0x2c, 0xb0, 0x2f, 0xa5, 0xfd, 0x86, 0xe5,
+	0x48, 0xbe, 0x00, 0x28, 0x99, 0x66, 0x39, 0x5a, 0xd4, 0x26, 0xde, 0xf4, 0xbd, 0x3c, 0x5c, 0xe9,
+	0xc5, 0xc8, 0x37, 0x37, 0x2e, 0xe9, 0x92, 0x3b, 0xf9, 0x04, 0xee, 0x33, 0x59, 0x16, 0xd4, 0x2f,
+	0x94, 0x2b, 0x69, 0xe2, 0x2d, 0x5f, 0xaa, 0xe9, 0xd0, 0x37, 0x15, 0x48, 0x9e, 0xdf, 0xb4, 0xc6,
+	0x51, 0x5b, 0x31, 0x14, 0x9c, 0x59, 0xa4, 0x63, 0x66, 0xc6, 0x71, 0xc3, 0xfb, 0x3f, 0x08, 0x74,
+	0x6f, 0xc1, 0xf6, 0x99, 0x19, 0xbf, 0x23, 0xce, 0x94, 0x13, 0x11, 0x47, 0xef, 0x88, 0xcb, 0xca,
+	0x89, 0x20, 0x5f, 0xc2, 0x43, 0x55, 0x5a, 0xa1, 0x0a, 0x26, 0x29, 0x97, 0x02, 0x0b, 0xbb, 0x9c,
+	0x20, 0x86, 0xa3, 0xda, 0x71, 0x23, 0x3d, 0xa8, 0x5c, 0x7a, 0xde, 0x63, 0x29, 0xc7, 0xab, 0x26,
+	0x6c, 0xbb, 0xfb, 0x0c, 0x17, 0x6c, 0x3a, 0x3f, 0xd4, 0xa0, 0x51, 0x5d, 0x20, 0x39, 0x80, 0x86,
+	0x95, 0xc6, 0xe7, 0xf3, 0x2a, 0x89, 0xd2, 0x2d, 0x2b, 0x8d, 0x8b, 0x26, 0xfb, 0xe0, 0x8e, 0x74,
+	0x82, 0xd7, 0x5e, 0x04, 0x51, 0xba, 0x69, 0xa5, 0xf9, 0x1a, 0xaf, 0x1d, 0xa1, 0x95, 0xb2, 0x94,
+	0xb3, 0xb8, 0x1e, 0x08, 0x67, 0xf6, 0x98, 0xbb, 0x54, 0xc5, 0x4d, 0x49, 0x8d, 0x65, 0xa5, 0x44,
+	0xaf, 0x84, 0x28, 0x05, 0x07, 0x65, 0x1e, 0x21, 0x2d, 0xa8, 0x73, 0x2d, 0xe3, 0x7b, 0x9e, 0x70,
+	0xc7, 0xce, 0xef, 0xeb, 0xf0, 0xc1, 0x45, 0x69, 0xac, 0x46, 0x96, 0xff, 0x7f, 0xd4, 0xdb, 0x82,
+	0xba, 0x29, 0xc4, 0x7c, 0x7a, 0x77, 0xfc, 0x6f, 0xe4, 0xba, 0xf5, 0x6f, 0xe5, 0xda, 0xb8, 0x43,
+	0xae, 0xab, 0xd7, 0xff, 0x5b, 0x0d, 0xa2, 0x9b, 0x81, 0xc8, 0x23, 0x00, 0xcb, 0xf4, 0x08, 0x2d,
+	0x9d, 0x6a, 0x31, 0x57, 0x40, 0x14, 0x90, 0x0b, 0x2d, 0x48, 0x1f, 0x5a, 0x9c, 0x49, 0x49, 0xb9,
+	0xc6, 0x01, 0x16, 0x56, 0x30, 0x59, 0xed, 0xf4, 0xd1, 0xed, 0x2e, 0x7b, 0x4c, 0xca, 0xde, 0xc2,
+	0x29, 0xdd, 0xe5, 0xb7, 0x01, 0xf2, 0x39, 0xc4, 0x4b, 0xa2, 0x35, 0xf3, 0x76, 0xc2, 0x86, 0xc2,
+	0x1e, 0xdb, 0xcb, 0x7c, 0xe6, 0x69, 0xbf, 0x23, 0xf7, 0xd9, 0x30, 0x29, 0x06, 0xcc, 0xe9, 0x9b,
+	0x72, 0x55, 0x58, 0xbc, 0x9a, 0x07, 0x06, 0x85, 0x3d, 0x58, 0xd0, 0xbd, 0xc0, 0xba, 0xb8, 0xce,
+	0x2f, 0x35, 0xd8, 0x5d, 0x69, 0x8b, 0x8c, 0xa1, 0xed, 0x84, 0xb1, 0x34, 0x0f, 0x0d, 0x32, 0x9a,
+	0x8b, 0xec, 0xf4, 0xbd, 0x53, 0x25, 0x4e, 0x2a, 0x0b, 0x3b, 0x0b, 0x02, 0xdc, 0x1b, 0xde, 0x81,
+	0x1e, 0xbe, 0x85, 0xbd, 0xbb, 0xbc, 0xc9, 0xa7, 0xb0, 0x6b, 0xd5, 0x04, 0x0b, 0x2f, 0xd0, 0x30,
+	0x45, 0xd8, 0x7a, 0xd3, 0xc3, 0x2e, 0xc6, 0x4f, 0xdd, 0x86, 0xcd, 0x31, 0xb2, 0x01, 0xea, 0xea,
+	0xe3, 0x0b, 0x56, 0xe7, 0xcf, 0x75, 0x68, 0xde, 0x92, 0x04, 0x41, 0x88, 0x73, 0x51, 0x88, 0x7c,
+	0x9a, 0xdf, 0x28, 0x81, 0xce, 0x50, 0x1b, 0xa1, 0x0a, 0x9f, 0xfa, 0xfe, 0xe9, 0xe3, 0xf7, 0x28,
+	0x2a, 0xa9, 0x84, 0xf2, 0x36, 0x84, 0xa4, 0xed, 0x79, 0xb2, 0x15, 0xdc, 0x97, 0x61, 0x57, 0x77,
+	0x97, 0x59, 0xff, 0x27, 0x65, 0x42, 0xb2, 0xd5, 0x32, 0x1f, 0x43, 0x93, 0x8b, 0x72, 0x8c, 0x9a,
+	0x9a, 0xa9, 0xb0, 0x58, 0x3d, 0x0d, 0x3b, 0x01, 0xcc, 0x3c, 0xe6, 0x7e, 0x34, 0xc8, 0x07, 0x63,
+	0xca, 0xa7, 0x7a, 0x86, 0xee, 0xa3, 0xf5, 0xaf, 0x87, 0x83, 0x7a, 0x1e, 0xe9, 0x64, 0xb0, 0xbb,
+	0x9a, 0x78, 0x07, 0x1a, 0xe7, 0x67, 0x19, 0x7d, 0x79, 0x71, 0xfe, 0x6d, 0x6b, 0x8d, 0x6c, 0xc3,
+	0xd6, 0xf9, 0x59, 0x36, 0x3b, 0xa1, 0x4f, 0x5a, 0xb5, 0x85, 0x71, 0xd2, 0x5a, 0x5f, 0x18, 0xa7,
+	0xad, 0xfa, 0xc2, 0x78, 0xda, 0xda, 0x78, 0xf5, 0xe2, 0xe7, 0x3f, 0x36, 0x6a, 0x3f, 0xfd, 0xfa,
+	0x61, 0xed, 0xbb, 0x27, 0x7f, 0xef, 0xd9, 0x2f, 0x27, 0xa3, 0xf9, 0x3b, 0x7d, 0xb9, 0xe9, 0x77,
+	0xf6, 0xf4, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x35, 0xae, 0x8b, 0x09, 0x31, 0x08, 0x00, 0x00,
 }
 
 func (this *SslConfig) Equal(that interface{}) bool {
@@ -709,6 +780,25 @@ func (this *SslConfig) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.VerifyCertificateHash) != len(that1.VerifyCertificateHash) {
+		return false
+	}
+	for i := range this.VerifyCertificateHash {
+		if this.VerifyCertificateHash[i] != that1.VerifyCertificateHash[i] {
+			return false
+		}
+	}
+	if len(this.VerifyCertificateSpki) != len(that1.VerifyCertificateSpki) {
+		return false
+	}
+	for i := range this.VerifyCertificateSpki {
+		if this.VerifyCertificateSpki[i] != that1.VerifyCertificateSpki[i] {
+			return false
+		}
+	}
+	if this.OptionalClientCertificate != that1.OptionalClientCertificate {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -814,6 +904,12 @@ func (this *SSLFiles) Equal(that interface{}) bool {
 	if this.RootCa != that1.RootCa {
 		return false
 	}
+	if this.OcspStaple != that1.OcspStaple {
+		return false
+	}
+	if this.Crl != that1.Crl {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -861,6 +957,14 @@ func (this *UpstreamSslConfig) Equal(that interface{}) bool {
 	if !this.Parameters.Equal(that1.Parameters) {
 		return false
 	}
+	if len(this.AlpnProtocols) != len(that1.AlpnProtocols) {
+		return false
+	}
+	for i := range this.AlpnProtocols {
+		if this.AlpnProtocols[i] != that1.AlpnProtocols[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
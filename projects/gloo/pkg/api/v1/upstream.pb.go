@@ -14,8 +14,10 @@ import (
 	core1 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/core"
 	aws "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
 	ec2 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws/ec2"
+	ecs "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws/ecs"
 	azure "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/azure"
 	consul "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/consul"
+	dns_srv "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dns_srv"
 	kubernetes "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
 	pipe "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/pipe"
 	static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
@@ -34,7 +36,6 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-//
 // Upstreams represent destination for routing HTTP requests. Upstreams can be compared to
 // [clusters](https://www.envoyproxy.io/docs/envoy/latest/api-v2/api/v2/cds.proto) in Envoy terminology.
 // Each upstream in Gloo has a type. Supported types include `static`, `kubernetes`, `aws`, `consul`, and more.
@@ -71,6 +72,8 @@ type Upstream struct {
 	//	*Upstream_Azure
 	//	*Upstream_Consul
 	//	*Upstream_AwsEc2
+	//	*Upstream_AwsEcs
+	//	*Upstream_DnsSrv
 	UpstreamType         isUpstream_UpstreamType `protobuf_oneof:"upstream_type"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
@@ -127,6 +130,12 @@ type Upstream_Consul struct {
 type Upstream_AwsEc2 struct {
 	AwsEc2 *ec2.UpstreamSpec `protobuf:"bytes,17,opt,name=aws_ec2,json=awsEc2,proto3,oneof" json:"aws_ec2,omitempty"`
 }
+type Upstream_AwsEcs struct {
+	AwsEcs *ecs.UpstreamSpec `protobuf:"bytes,18,opt,name=aws_ecs,json=awsEcs,proto3,oneof" json:"aws_ecs,omitempty"`
+}
+type Upstream_DnsSrv struct {
+	DnsSrv *dns_srv.UpstreamSpec `protobuf:"bytes,19,opt,name=dns_srv,json=dnsSrv,proto3,oneof" json:"dns_srv,omitempty"`
+}
 
 func (*Upstream_Kube) isUpstream_UpstreamType()   {}
 func (*Upstream_Static) isUpstream_UpstreamType() {}
@@ -135,6 +144,8 @@ func (*Upstream_Aws) isUpstream_UpstreamType()    {}
 func (*Upstream_Azure) isUpstream_UpstreamType()  {}
 func (*Upstream_Consul) isUpstream_UpstreamType() {}
 func (*Upstream_AwsEc2) isUpstream_UpstreamType() {}
+func (*Upstream_AwsEcs) isUpstream_UpstreamType() {}
+func (*Upstream_DnsSrv) isUpstream_UpstreamType() {}
 
 func (m *Upstream) GetUpstreamType() isUpstream_UpstreamType {
 	if m != nil {
@@ -262,6 +273,20 @@ func (m *Upstream) GetAwsEc2() *ec2.UpstreamSpec {
 	return nil
 }
 
+func (m *Upstream) GetAwsEcs() *ecs.UpstreamSpec {
+	if x, ok := m.GetUpstreamType().(*Upstream_AwsEcs); ok {
+		return x.AwsEcs
+	}
+	return nil
+}
+
+func (m *Upstream) GetDnsSrv() *dns_srv.UpstreamSpec {
+	if x, ok := m.GetUpstreamType().(*Upstream_DnsSrv); ok {
+		return x.DnsSrv
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Upstream) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -272,6 +297,8 @@ func (*Upstream) XXX_OneofWrappers() []interface{} {
 		(*Upstream_Azure)(nil),
 		(*Upstream_Consul)(nil),
 		(*Upstream_AwsEc2)(nil),
+		(*Upstream_AwsEcs)(nil),
+		(*Upstream_DnsSrv)(nil),
 	}
 }
 
@@ -316,59 +343,61 @@ func init() {
 }
 
 var fileDescriptor_b74df493149f644d = []byte{
-	// 827 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xdf, 0x4e, 0x2b, 0x45,
-	0x1c, 0xc7, 0x4f, 0x0f, 0x7b, 0x38, 0xed, 0x00, 0xd2, 0x8e, 0x44, 0x37, 0x47, 0x85, 0xa6, 0x26,
-	0x1e, 0x24, 0x61, 0x56, 0x4a, 0x8c, 0xa6, 0x06, 0x63, 0x5a, 0x48, 0x48, 0x00, 0x4d, 0x96, 0x78,
-	0xe3, 0xcd, 0x66, 0x3a, 0x1d, 0xda, 0xb1, 0xcb, 0xce, 0x66, 0x67, 0xb6, 0x80, 0x97, 0x3c, 0x8d,
-	0x8f, 0xe0, 0x0b, 0x98, 0xf8, 0x14, 0x5c, 0xf8, 0x06, 0x98, 0x78, 0x6f, 0xe6, 0x5f, 0xe9, 0x1f,
-	0x4a, 0xd7, 0x0b, 0xba, 0x3b, 0x33, 0xdf, 0xef, 0x67, 0x7f, 0xfc, 0x76, 0xe6, 0x9b, 0x05, 0xdf,
-	0xf5, 0x99, 0x1c, 0xe4, 0x5d, 0x44, 0xf8, 0x75, 0x20, 0x78, 0xcc, 0xf7, 0x19, 0x0f, 0xfa, 0x31,
-	0xe7, 0x41, 0x9a, 0xf1, 0x5f, 0x29, 0x91, 0xc2, 0x8c, 0x70, 0xca, 0x82, 0xd1, 0x41, 0x90, 0xa7,
-	0x42, 0x66, 0x14, 0x5f, 0xa3, 0x34, 0xe3, 0x92, 0xc3, 0x75, 0xb5, 0x86, 0x94, 0x0d, 0x31, 0xfe,
-	0x6e, 0xab, 0xcf, 0xfb, 0x5c, 0x2f, 0x04, 0xea, 0xce, 0x68, 0xde, 0x41, 0x7a, 0x2b, 0xcd, 0x24,
-	0xbd, 0x95, 0x76, 0x6e, 0x5b, 0x3f, 0x69, 0xc8, 0xa4, 0xe3, 0x5e, 0x53, 0x89, 0x7b, 0x58, 0x62,
-	0xbb, 0xfe, 0xf9, 0xe2, 0x0a, 0x84, 0x88, 0xad, 0xe8, 0x85, 0x32, 0x09, 0xcb, 0x48, 0xce, 0x64,
-	0xd4, 0xcd, 0x28, 0x1e, 0xd2, 0xcc, 0x1a, 0xf6, 0x17, 0x1b, 0x62, 0x8e, 0x7b, 0x51, 0x17, 0xc7,
-	0x38, 0x21, 0x63, 0xf9, 0xde, 0x0b, 0x7c, 0x9e, 0x24, 0x94, 0x48, 0xc6, 0x13, 0xab, 0x3d, 0x5e,
-	0xa0, 0xa5, 0xb7, 0x92, 0x66, 0x09, 0x8e, 0x03, 0x9a, 0x8c, 0xf8, 0x9d, 0xb1, 0x37, 0x03, 0xc2,
-	0x33, 0x1a, 0x0c, 0x28, 0x8e, 0xe5, 0x20, 0x22, 0x03, 0x4a, 0x86, 0x96, 0xf2, 0xe9, 0x6c, 0x5b,
-	0x84, 0xc4, 0x32, 0x17, 0x76, 0xf5, 0xfc, 0xff, 0x3d, 0x23, 0xce, 0x85, 0xa4, 0x59, 0xc0, 0x73,
-	0x19, 0x33, 0x9a, 0x45, 0x3d, 0x2a, 0xa7, 0x2a, 0x9e, 0x7b, 0x05, 0x6e, 0x6c, 0xd7, 0xbf, 0x5e,
-	0xfc, 0xdf, 0xf3, 0x54, 0x71, 0x84, 0xae, 0x8e, 0x11, 0x7b, 0xb1, 0xb6, 0x83, 0xe5, 0xb6, 0x94,
-	0xa5, 0x54, 0xff, 0x58, 0xcb, 0xd1, 0x72, 0xcb, 0x30, 0xef, 0xd2, 0x2c, 0xa1, 0x92, 0x4e, 0xde,
-	0x2e, 0xdf, 0x06, 0xce, 0x8e, 0x6f, 0xf4, 0x9f, 0x35, 0x1c, 0x16, 0x30, 0xfc, 0x96, 0x67, 0xd4,
-	0xfc, 0x16, 0x6f, 0x07, 0xe1, 0x89, 0xc8, 0x63, 0x7b, 0xb1, 0xb6, 0x6f, 0x8a, 0x15, 0x47, 0x49,
-	0x53, 0x5d, 0x23, 0x4a, 0x9a, 0xd6, 0xf8, 0x7e, 0xa9, 0xd1, 0x08, 0x1b, 0x7f, 0x56, 0x40, 0xf9,
-	0x67, 0x7b, 0x2a, 0xe1, 0x19, 0x58, 0x35, 0x5b, 0xc6, 0x2f, 0xd5, 0x4b, 0xbb, 0x6b, 0xcd, 0x2d,
-	0xa4, 0xb6, 0x9a, 0x3b, 0xa0, 0xe8, 0x52, 0xaf, 0xb5, 0x3f, 0xfb, 0xe3, 0x5f, 0xaf, 0xf4, 0xd7,
-	0xc3, 0xce, 0xab, 0x7f, 0x1e, 0x76, 0x6a, 0x92, 0x0a, 0xd9, 0x63, 0x57, 0x57, 0xad, 0x06, 0xeb,
-	0x27, 0x3c, 0xa3, 0x8d, 0xd0, 0x22, 0xe0, 0xb7, 0xa0, 0xec, 0x8e, 0xa5, 0xff, 0x5a, 0xe3, 0x3e,
-	0x9a, 0xc6, 0x5d, 0xd8, 0xd5, 0xb6, 0xa7, 0x60, 0xe1, 0x58, 0x0d, 0x7f, 0x04, 0xb0, 0xc7, 0x04,
-	0xe1, 0x23, 0x9a, 0xdd, 0x45, 0x63, 0xc6, 0x8a, 0x66, 0xec, 0xa0, 0xc9, 0xcc, 0x40, 0xc7, 0x4e,
-	0xe7, 0x60, 0x61, 0xad, 0x37, 0x3b, 0x05, 0xbf, 0x07, 0x40, 0x88, 0x38, 0x22, 0x3c, 0xb9, 0x62,
-	0x7d, 0xdf, 0x7b, 0x8e, 0xe3, 0x5a, 0x70, 0x29, 0xe2, 0x8e, 0x96, 0x85, 0x15, 0xe1, 0x6e, 0xe1,
-	0x05, 0xa8, 0xce, 0x24, 0x82, 0xf0, 0xdf, 0x68, 0x4a, 0x63, 0x9a, 0xd2, 0x31, 0xaa, 0xb6, 0x11,
-	0x59, 0xd0, 0x26, 0x99, 0x9a, 0x15, 0x30, 0x04, 0x5b, 0x53, 0x79, 0xe1, 0x0a, 0x5b, 0xd5, 0xc8,
-	0xfa, 0x34, 0xf2, 0x9c, 0xe3, 0x5e, 0xdb, 0x0a, 0x2d, 0x10, 0xc6, 0x73, 0x73, 0xf0, 0x0c, 0xd4,
-	0x9e, 0x42, 0xc5, 0x01, 0xdf, 0x6a, 0xe0, 0xf6, 0x4c, 0x8d, 0x63, 0x99, 0xc5, 0x55, 0xc9, 0xcc,
-	0x0c, 0xec, 0x80, 0x8d, 0xc9, 0x74, 0x11, 0x7e, 0xb9, 0xbe, 0xa2, 0x41, 0x3a, 0x21, 0x10, 0x4e,
-	0x19, 0x1a, 0x35, 0xcd, 0xbb, 0x3c, 0xd5, 0xba, 0x8e, 0x92, 0x85, 0xeb, 0x83, 0xa7, 0x81, 0x80,
-	0x97, 0xa0, 0x36, 0x97, 0x1d, 0x7e, 0x45, 0x57, 0xf4, 0xc5, 0x0c, 0xc8, 0x44, 0x0d, 0xfa, 0xc9,
-	0xc8, 0x8f, 0x9d, 0x3a, 0xac, 0xf2, 0x99, 0x19, 0xf8, 0x09, 0xa8, 0xe4, 0x82, 0x46, 0x03, 0x29,
-	0xd3, 0xa6, 0x0f, 0xea, 0xa5, 0xdd, 0x72, 0x58, 0xce, 0x05, 0x3d, 0x55, 0x63, 0xd8, 0x01, 0x9e,
-	0x3a, 0xdd, 0xfe, 0x9a, 0x7e, 0xc8, 0x3e, 0x9a, 0x38, 0xea, 0x6e, 0xcf, 0x3f, 0xff, 0xce, 0x53,
-	0x4a, 0x4e, 0x5f, 0x85, 0xda, 0x0c, 0x3b, 0xe6, 0x08, 0x30, 0xe2, 0xaf, 0x6b, 0xcc, 0x97, 0xc8,
-	0xe6, 0x53, 0x11, 0x84, 0xb5, 0xc2, 0x23, 0xe0, 0xa9, 0x80, 0xf2, 0x37, 0x34, 0xe2, 0x3d, 0xd2,
-	0x69, 0x55, 0xa8, 0x06, 0xa5, 0x84, 0x2d, 0xb0, 0x82, 0x6f, 0x84, 0xff, 0x81, 0x6d, 0x96, 0x8a,
-	0x9e, 0x22, 0x66, 0x65, 0x82, 0x3f, 0x80, 0x37, 0x3a, 0x77, 0xfc, 0x4d, 0xed, 0xde, 0x45, 0x26,
-	0x85, 0x8a, 0xf8, 0x8d, 0x51, 0x75, 0xc0, 0x64, 0x90, 0x5f, 0xb5, 0x1d, 0xb0, 0x91, 0x54, 0xa8,
-	0x03, 0x46, 0x0b, 0x4f, 0xc0, 0x5b, 0x1b, 0x48, 0x7e, 0x4d, 0x53, 0xf6, 0x90, 0x0b, 0xa8, 0x42,
-	0x18, 0x7c, 0x23, 0x4e, 0x48, 0xb3, 0xf5, 0xf1, 0xfd, 0xa3, 0xe7, 0x81, 0xd7, 0xb9, 0xb8, 0x7f,
-	0xf4, 0xd6, 0x60, 0xc5, 0x7d, 0x3e, 0x88, 0xf6, 0x26, 0xd8, 0x70, 0x83, 0x48, 0xde, 0xa5, 0xb4,
-	0xf1, 0x21, 0xa8, 0xcd, 0x65, 0x41, 0xbb, 0xa5, 0x92, 0xea, 0xf7, 0xbf, 0xb7, 0x4b, 0xbf, 0x7c,
-	0x55, 0xec, 0x33, 0x25, 0x1d, 0xf6, 0x6d, 0x4c, 0x76, 0x57, 0x75, 0x3e, 0x1e, 0xfe, 0x17, 0x00,
-	0x00, 0xff, 0xff, 0x57, 0x55, 0x3e, 0x32, 0xe1, 0x08, 0x00, 0x00,
+	// 860 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xc1, 0x4e, 0xdc, 0x46,
+	0x18, 0xc7, 0xb3, 0xc1, 0x21, 0xcb, 0x00, 0x85, 0x9d, 0xa0, 0xd6, 0x4a, 0x5b, 0x40, 0x5b, 0xa9,
+	0xa1, 0x91, 0xb0, 0x9b, 0x8d, 0xaa, 0x56, 0x54, 0xa9, 0xaa, 0x5d, 0x22, 0x21, 0x25, 0x69, 0x25,
+	0xa3, 0x5e, 0x7a, 0xb1, 0x66, 0xc7, 0xc3, 0xee, 0x74, 0x8d, 0xc7, 0xf2, 0x37, 0x5e, 0xa0, 0xc7,
+	0x3c, 0x4d, 0x1f, 0xa1, 0x8f, 0xd0, 0x7b, 0xef, 0x39, 0xf4, 0x0d, 0x52, 0xa9, 0xf7, 0x6a, 0x66,
+	0xbe, 0x01, 0x76, 0x09, 0x60, 0x0e, 0x78, 0xfc, 0xcd, 0xfc, 0xff, 0x3f, 0x7f, 0x8c, 0x67, 0xff,
+	0x32, 0xf9, 0x7e, 0x24, 0xf5, 0xb8, 0x1e, 0x46, 0x5c, 0x1d, 0xc7, 0xa0, 0x72, 0xb5, 0x2b, 0x55,
+	0x3c, 0xca, 0x95, 0x8a, 0xcb, 0x4a, 0xfd, 0x26, 0xb8, 0x06, 0x57, 0xb1, 0x52, 0xc6, 0xd3, 0x67,
+	0x71, 0x5d, 0x82, 0xae, 0x04, 0x3b, 0x8e, 0xca, 0x4a, 0x69, 0x45, 0x57, 0xcc, 0x5a, 0x64, 0x6c,
+	0x91, 0x54, 0x8f, 0x37, 0x46, 0x6a, 0xa4, 0xec, 0x42, 0x6c, 0xee, 0x9c, 0xe6, 0x31, 0x15, 0xa7,
+	0xda, 0x4d, 0x8a, 0x53, 0x8d, 0x73, 0x9b, 0xf6, 0x49, 0x13, 0xa9, 0x3d, 0xf7, 0x58, 0x68, 0x96,
+	0x31, 0xcd, 0x70, 0xfd, 0x8b, 0xeb, 0x3b, 0x00, 0xc8, 0x51, 0x74, 0x43, 0x9b, 0x5c, 0x56, 0xbc,
+	0x96, 0x3a, 0x1d, 0x56, 0x82, 0x4d, 0x44, 0x85, 0x86, 0xdd, 0xeb, 0x0d, 0xb9, 0x62, 0x59, 0x3a,
+	0x64, 0x39, 0x2b, 0xf8, 0xb9, 0xfc, 0xe9, 0x0d, 0x7c, 0x55, 0x14, 0x82, 0x6b, 0xa9, 0x0a, 0xd4,
+	0xee, 0x5f, 0xa3, 0x15, 0xa7, 0x5a, 0x54, 0x05, 0xcb, 0x63, 0x51, 0x4c, 0xd5, 0x99, 0xb3, 0xf7,
+	0x62, 0xae, 0x2a, 0x11, 0x8f, 0x05, 0xcb, 0xf5, 0x38, 0xe5, 0x63, 0xc1, 0x27, 0x48, 0xf9, 0x6c,
+	0x7e, 0x5b, 0x40, 0x33, 0x5d, 0x03, 0xae, 0xbe, 0xbe, 0xdb, 0x33, 0xf2, 0x1a, 0xb4, 0xa8, 0x62,
+	0x55, 0xeb, 0x5c, 0x8a, 0x2a, 0xcd, 0x84, 0x9e, 0xe9, 0xf8, 0xca, 0x2b, 0xf0, 0x35, 0xae, 0x7f,
+	0x73, 0xfd, 0x7f, 0xaf, 0x4a, 0xc3, 0x01, 0xdb, 0x9d, 0xe4, 0x38, 0xa0, 0xed, 0xd9, 0xed, 0xb6,
+	0x52, 0x96, 0xc2, 0x5e, 0xd0, 0xf2, 0xe2, 0x76, 0xcb, 0xa4, 0x1e, 0x8a, 0xaa, 0x10, 0x5a, 0x5c,
+	0xbe, 0xbd, 0xfd, 0x18, 0x78, 0x3b, 0x3b, 0xb1, 0x7f, 0x68, 0x78, 0xde, 0xc0, 0xf0, 0x7b, 0x5d,
+	0x09, 0x77, 0x6d, 0xbe, 0x1d, 0x5c, 0x15, 0x50, 0xe7, 0x38, 0xa0, 0xed, 0xdb, 0x66, 0xcd, 0x09,
+	0xde, 0x33, 0x63, 0x2a, 0x78, 0xef, 0xae, 0x46, 0x40, 0x23, 0x34, 0x37, 0x66, 0x05, 0xa4, 0x50,
+	0x4d, 0xfd, 0x88, 0xc6, 0x27, 0xb7, 0x1a, 0x9d, 0xb0, 0xfb, 0x37, 0x21, 0xed, 0x5f, 0x30, 0x07,
+	0xe8, 0x2b, 0xb2, 0xe8, 0x0e, 0x69, 0xd8, 0xda, 0x6e, 0xed, 0x2c, 0xf7, 0x36, 0x22, 0x73, 0xb8,
+	0x7d, 0x24, 0x44, 0x87, 0x76, 0xad, 0xff, 0xf9, 0x9f, 0xff, 0x05, 0xad, 0xbf, 0xde, 0x6d, 0xdd,
+	0xfb, 0xf7, 0xdd, 0x56, 0x47, 0x0b, 0xd0, 0x99, 0x3c, 0x3a, 0xda, 0xeb, 0xca, 0x51, 0xa1, 0x2a,
+	0xd1, 0x4d, 0x10, 0x41, 0xbf, 0x23, 0x6d, 0x1f, 0x04, 0xe1, 0x7d, 0x8b, 0xfb, 0x78, 0x16, 0xf7,
+	0x06, 0x57, 0xfb, 0x81, 0x81, 0x25, 0xe7, 0x6a, 0xfa, 0x13, 0xa1, 0x99, 0x04, 0xae, 0xa6, 0xa2,
+	0x3a, 0x4b, 0xcf, 0x19, 0x0b, 0x96, 0xb1, 0x15, 0x5d, 0x4e, 0xa9, 0x68, 0xdf, 0xeb, 0x3c, 0x2c,
+	0xe9, 0x64, 0xf3, 0x53, 0xf4, 0x07, 0x42, 0x00, 0xf2, 0x94, 0xab, 0xe2, 0x48, 0x8e, 0xc2, 0xe0,
+	0x43, 0x1c, 0xbf, 0x05, 0x87, 0x90, 0x0f, 0xac, 0x2c, 0x59, 0x02, 0x7f, 0x4b, 0xdf, 0x90, 0xf5,
+	0xb9, 0x0c, 0x82, 0xf0, 0x81, 0xa5, 0x74, 0x67, 0x29, 0x03, 0xa7, 0xea, 0x3b, 0x11, 0x82, 0xd6,
+	0xf8, 0xcc, 0x2c, 0xd0, 0x84, 0x6c, 0xcc, 0x24, 0x94, 0x6f, 0x6c, 0xd1, 0x22, 0xb7, 0x67, 0x91,
+	0xaf, 0x15, 0xcb, 0xfa, 0x28, 0x44, 0x20, 0xcd, 0xaf, 0xcc, 0xd1, 0x57, 0xa4, 0x73, 0x11, 0x63,
+	0x1e, 0xf8, 0xd0, 0x02, 0x37, 0xe7, 0x7a, 0x3c, 0x97, 0x21, 0x6e, 0x9d, 0xcf, 0xcd, 0xd0, 0x01,
+	0x59, 0xbd, 0x9c, 0x67, 0x10, 0xb6, 0xb7, 0x17, 0x2c, 0xc8, 0x66, 0x52, 0xc4, 0x4a, 0x19, 0x4d,
+	0x7b, 0xee, 0x5d, 0x1e, 0x58, 0xdd, 0xc0, 0xc8, 0x92, 0x95, 0xf1, 0x45, 0x01, 0xf4, 0x90, 0x74,
+	0xae, 0xa4, 0x55, 0xb8, 0x64, 0x3b, 0xfa, 0x72, 0x0e, 0xe4, 0xc2, 0x2d, 0xfa, 0xd9, 0xc9, 0xf7,
+	0xbd, 0x3a, 0x59, 0x57, 0x73, 0x33, 0xf4, 0x53, 0xb2, 0x54, 0x83, 0x48, 0xc7, 0x5a, 0x97, 0xbd,
+	0x90, 0x6c, 0xb7, 0x76, 0xda, 0x49, 0xbb, 0x06, 0x71, 0x60, 0x6a, 0x3a, 0x20, 0x81, 0xc9, 0x93,
+	0x70, 0xd9, 0x3e, 0x64, 0x37, 0xba, 0x14, 0x2e, 0xfe, 0xcc, 0x7f, 0xf8, 0x9d, 0x97, 0x82, 0x1f,
+	0xdc, 0x4b, 0xac, 0x99, 0x0e, 0xdc, 0x4f, 0x40, 0xf2, 0x70, 0xc5, 0x62, 0xbe, 0x8a, 0x5c, 0xd9,
+	0x08, 0x81, 0x56, 0xfa, 0x82, 0x04, 0x26, 0x12, 0xc3, 0x55, 0x8b, 0x78, 0x12, 0x99, 0xa2, 0x59,
+	0x0f, 0x46, 0x49, 0xf7, 0xc8, 0x02, 0x3b, 0x81, 0xf0, 0x23, 0xdc, 0x2c, 0x13, 0x76, 0x4d, 0xcc,
+	0xc6, 0x44, 0x7f, 0x24, 0x0f, 0x6c, 0xd2, 0x85, 0x6b, 0xd6, 0xbd, 0x13, 0xd9, 0xaa, 0x91, 0xdf,
+	0x19, 0xcd, 0x0e, 0xb8, 0xd4, 0x0b, 0xd7, 0x71, 0x07, 0x5c, 0xd9, 0x6c, 0x07, 0x9c, 0x96, 0xbe,
+	0x24, 0x0f, 0x31, 0x02, 0xc3, 0x8e, 0xa5, 0x3c, 0x8d, 0xb0, 0x6e, 0x86, 0x61, 0x27, 0xf0, 0x92,
+	0xf7, 0x2e, 0x30, 0x10, 0xd2, 0x19, 0x0c, 0xdc, 0x01, 0x03, 0x06, 0x83, 0xf1, 0x18, 0x3e, 0x42,
+	0x0c, 0xd6, 0xcd, 0x30, 0x59, 0x01, 0x87, 0xd5, 0x74, 0xef, 0x93, 0xb7, 0xef, 0x83, 0x80, 0xdc,
+	0xaf, 0xe1, 0xed, 0xfb, 0x60, 0x99, 0x2e, 0xf9, 0xcf, 0x27, 0xe8, 0xaf, 0x91, 0x55, 0x5f, 0xa4,
+	0xfa, 0xac, 0x14, 0xdd, 0x47, 0xa4, 0x73, 0x25, 0x99, 0xfa, 0x7b, 0x26, 0x37, 0xff, 0xf8, 0x67,
+	0xb3, 0xf5, 0xeb, 0xd7, 0xcd, 0x3e, 0xd3, 0xca, 0xc9, 0x08, 0x43, 0x7b, 0xb8, 0x68, 0xd3, 0xfa,
+	0xf9, 0xff, 0x03, 0x00, 0x68, 0xe7, 0x65, 0xda, 0xe1, 0x09, 0x00, 0x00,
 }
 
 func (this *Upstream) Equal(that interface{}) bool {
@@ -607,6 +636,54 @@ func (this *Upstream_AwsEc2) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Upstream_AwsEcs) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Upstream_AwsEcs)
+	if !ok {
+		that2, ok := that.(Upstream_AwsEcs)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.AwsEcs.Equal(that1.AwsEcs) {
+		return false
+	}
+	return true
+}
+func (this *Upstream_DnsSrv) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Upstream_DnsSrv)
+	if !ok {
+		that2, ok := that.(Upstream_DnsSrv)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DnsSrv.Equal(that1.DnsSrv) {
+		return false
+	}
+	return true
+}
 func (this *DiscoveryMetadata) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
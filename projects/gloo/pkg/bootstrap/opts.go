@@ -9,6 +9,8 @@ import (
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
 
+	sdscache "github.com/envoyproxy/go-control-plane/pkg/cache"
+	consulapi "github.com/hashicorp/consul/api"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
@@ -17,6 +19,7 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/server"
 	skkube "github.com/solo-io/solo-kit/pkg/api/v1/resources/common/kubernetes"
 	"google.golang.org/grpc"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -30,7 +33,9 @@ type Opts struct {
 	Secrets           factory.ResourceClientFactory
 	Artifacts         factory.ResourceClientFactory
 	AuthConfigs       factory.ResourceClientFactory
+	ReferencePolicies factory.ResourceClientFactory
 	KubeClient        kubernetes.Interface
+	KubeDynamicClient dynamic.Interface
 	Consul            Consul
 	WatchOpts         clients.WatchOpts
 	DevMode           bool
@@ -41,15 +46,23 @@ type Opts struct {
 }
 
 type Consul struct {
+	// the client built from the consul settings, used to register the proxies if enabled
+	ConsulClient       *consulapi.Client
 	ConsulWatcher      consul.ConsulWatcher
 	DnsServer          string
 	DnsPollingInterval *time.Duration
+	// the Connect certificates of the gloo service, watched if consul service discovery is enabled
+	ConnectCertificates *consul.ConnectCertificates
+	// registers the listeners of the proxies in consul, if enabled
+	RegistrationSyncer v1.ApiSyncer
 }
 
 type ControlPlane struct {
 	*GrpcService
 	SnapshotCache cache.SnapshotCache
 	XDSServer     server.Server
+	// serves gloo secrets to envoy by name, see settings.gloo.secretDiscovery
+	SecretCache sdscache.SnapshotCache
 }
 
 type ValidationServer struct {
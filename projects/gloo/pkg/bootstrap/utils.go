@@ -13,6 +13,10 @@ import (
 	"github.com/solo-io/gloo/pkg/utils/settingsutil"
 	kubeconverters "github.com/solo-io/gloo/projects/gloo/pkg/api/converters/kube"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/aws/secretsource"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/filewatch"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/gitsource"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/secretencryption"
 	"github.com/solo-io/go-utils/kubeutils"
 	"github.com/solo-io/solo-kit/pkg/api/external/kubernetes/service"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
@@ -121,9 +125,17 @@ func ConfigFactoryForSettings(params ConfigFactoryParams, resourceCrd crd.Crd) (
 			RootKey: rootKey,
 		}, nil
 	case *v1.Settings_DirectoryConfigSource:
-		return &factory.FileResourceClientFactory{
+		return &filewatch.ClientFactory{
 			RootDir: filepath.Join(source.DirectoryConfigSource.Directory, resourceCrd.Plural),
 		}, nil
+	case *v1.Settings_GitConfigSource:
+		checkout, err := gitsource.EnsureCheckout(source.GitConfigSource, settings.GetRefreshRate())
+		if err != nil {
+			return nil, err
+		}
+		return &filewatch.ClientFactory{
+			RootDir: filepath.Join(checkout.Dir(), resourceCrd.Plural),
+		}, nil
 	}
 	return nil, errors.Errorf("invalid config source type")
 }
@@ -165,6 +177,33 @@ func SecretFactoryForSettings(ctx context.Context,
 	cfg **rest.Config,
 	clientset *kubernetes.Interface,
 	kubeCoreCache *cache.KubeCoreCache,
+	consulClient *consulapi.Client,
+	vaultClient *vaultapi.Client,
+	pluralName string) (factory.ResourceClientFactory, error) {
+	secretFactory, err := secretFactoryForSource(ctx, settings, sharedCache, cfg, clientset, kubeCoreCache, consulClient, vaultClient, pluralName)
+	if err != nil {
+		return nil, err
+	}
+	if keyFile := settings.GetSecretEncryption().GetKeyFile(); keyFile != "" {
+		key, err := secretencryption.LoadKey(keyFile)
+		if err != nil {
+			return nil, err
+		}
+		return &secretencryption.ClientFactory{
+			Base: secretFactory,
+			Key:  key,
+		}, nil
+	}
+	return secretFactory, nil
+}
+
+func secretFactoryForSource(ctx context.Context,
+	settings *v1.Settings,
+	sharedCache memory.InMemoryResourceCache,
+	cfg **rest.Config,
+	clientset *kubernetes.Interface,
+	kubeCoreCache *cache.KubeCoreCache,
+	consulClient *consulapi.Client,
 	vaultClient *vaultapi.Client,
 	pluralName string) (factory.ResourceClientFactory, error) {
 	if settings.SecretSource == nil {
@@ -203,6 +242,17 @@ func SecretFactoryForSettings(ctx context.Context,
 		return &factory.FileResourceClientFactory{
 			RootDir: filepath.Join(source.DirectorySecretSource.Directory, pluralName),
 		}, nil
+	case *v1.Settings_AwsSecretSource:
+		return secretsource.NewClientFactory(source.AwsSecretSource)
+	case *v1.Settings_ConsulKvSecretSource:
+		rootKey := source.ConsulKvSecretSource.GetRootKey()
+		if rootKey == "" {
+			rootKey = DefaultRootKey
+		}
+		return &factory.ConsulResourceClientFactory{
+			Consul:  consulClient,
+			RootKey: rootKey,
+		}, nil
 	}
 	return nil, errors.Errorf("invalid config source type")
 }
@@ -1,12 +1,109 @@
 package bootstrap
 
 import (
+	"context"
+	"io/ioutil"
+	"time"
+
+	"github.com/gogo/protobuf/types"
 	"github.com/hashicorp/vault/api"
 	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/settingsutil"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+)
+
+const (
+	defaultServiceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	defaultKubernetesAuthMountPath = "kubernetes"
+	defaultAppRoleAuthMountPath    = "approle"
+
+	// the vault secret client stores secrets in the KV version 2 engine mounted here
+	vaultKvMountPath = "secret"
+
+	// the field of the settings status details holding the availability of vault
+	VaultStatusField = "vault"
+)
+
+var (
+	mVaultUp            = stats.Int64("gloo.solo.io/vault/up", "Whether Gloo is authenticated to Vault (1) or not (0)", "1")
+	mVaultTokenRenewals = stats.Int64("gloo.solo.io/vault/token_renewals", "The number of Vault token renewals", "1")
+	mVaultErrors        = stats.Int64("gloo.solo.io/vault/errors", "The number of failed Vault logins and token renewals", "1")
+
+	vaultUpView = &view.View{
+		Name:        "gloo.solo.io/vault/up",
+		Measure:     mVaultUp,
+		Description: "Whether Gloo is authenticated to Vault (1) or not (0)",
+		Aggregation: view.LastValue(),
+	}
+	vaultTokenRenewalsView = &view.View{
+		Name:        "gloo.solo.io/vault/token_renewals",
+		Measure:     mVaultTokenRenewals,
+		Description: "The number of Vault token renewals",
+		Aggregation: view.Count(),
+	}
+	vaultErrorsView = &view.View{
+		Name:        "gloo.solo.io/vault/errors",
+		Measure:     mVaultErrors,
+		Description: "The number of failed Vault logins and token renewals",
+		Aggregation: view.Count(),
+	}
 )
 
-func VaultClientForSettings(vaultSettings *v1.Settings_VaultSecrets) (*api.Client, error) {
+func init() {
+	_ = view.Register(vaultUpView, vaultTokenRenewalsView, vaultErrorsView)
+}
+
+// VaultRetryInterval is the time between attempts to authenticate to vault while it is unavailable. It is also the
+// shortest time a token that cannot be renewed is used before logging in again.
+var VaultRetryInterval = 10 * time.Second
+
+var (
+	NoVaultTokenError = errors.Errorf("token is required for connecting to vault")
+
+	MissingVaultRoleError = func(authMethod string) error {
+		return errors.Errorf("a role is required to log in to vault with %v auth", authMethod)
+	}
+
+	NoVaultAuthError = func(authMethod string) error {
+		return errors.Errorf("vault did not return a token for %v auth", authMethod)
+	}
+
+	UnsupportedKvVersionError = func(version string) error {
+		return errors.Errorf("the vault secret source requires a KV version 2 secrets engine mounted at %v/, found version %v", vaultKvMountPath, version)
+	}
+)
+
+// ReportVaultStatusOnSettings returns a VaultStatusReporter writing the availability of vault to the status of the settings
+func ReportVaultStatusOnSettings(settingsClient v1.SettingsClient, settingsRef core.ResourceRef) VaultStatusReporter {
+	return func(ctx context.Context, err error) {
+		status := "available"
+		if err != nil {
+			status = "unavailable: " + err.Error()
+		}
+		value := &types.Value{Kind: &types.Value_StringValue{StringValue: status}}
+		if err := settingsutil.ReportStatusDetail(ctx, settingsClient, settingsRef, VaultStatusField, value); err != nil {
+			contextutils.LoggerFrom(ctx).Warnf("reporting the vault status on settings %v: %v", settingsRef.Key(), err)
+		}
+	}
+}
+
+// a vaultLogin obtains a new token from vault
+type vaultLogin func(client *api.Client) (*api.Secret, error)
+
+// VaultStatusReporter is notified when gloo authenticates to vault (with a nil error), and when vault becomes
+// unavailable (with the error)
+type VaultStatusReporter func(ctx context.Context, err error)
+
+// VaultClientForSettings returns a client authenticated with the configured auth method.
+// The token of the client is renewed, and replaced by logging in again once it expires, until ctx is cancelled.
+// If vault cannot be authenticated to, it is reported as unavailable and the client is returned anyway, while the
+// authentication is retried in the background. Only invalid settings return an error.
+// Changes of the availability of vault are reported to reportStatus, if not nil.
+func VaultClientForSettings(ctx context.Context, vaultSettings *v1.Settings_VaultSecrets, reportStatus VaultStatusReporter) (*api.Client, error) {
 	cfg := api.DefaultConfig()
 
 	var tlsCfg *api.TLSConfig
@@ -58,11 +155,270 @@ func VaultClientForSettings(vaultSettings *v1.Settings_VaultSecrets) (*api.Clien
 			return nil, err
 		}
 	}
-	token := vaultSettings.GetToken()
-	if token == "" {
-		return nil, errors.Errorf("token is required for connecting to vault")
+	login, err := vaultLoginForSettings(vaultSettings)
+	if err != nil {
+		return nil, err
 	}
-	client.SetToken(token)
+	if login == nil {
+		token := vaultSettings.GetToken()
+		if token == "" {
+			return nil, NoVaultTokenError
+		}
+		client.SetToken(token)
+	}
+
+	ctx = contextutils.WithLogger(ctx, "vault")
+	availability := &vaultAvailability{report: reportStatus}
+	retryInterval := VaultRetryInterval
+
+	// authenticate before returning the client, so that its first requests do not race the login
+	secret, err := authenticateVault(client, login)
+	if err == nil {
+		if err := checkVaultKvVersion(ctx, client); err != nil {
+			availability.down(ctx, err)
+			return nil, err
+		}
+		availability.up(ctx)
+		go manageVaultToken(ctx, client, login, secret, availability, retryInterval)
+		return client, nil
+	}
+
+	// vault may not be reachable yet when gloo starts, which must not stop gloo from serving the other secrets
+	availability.down(ctx, err)
+	go func() {
+		secret, ok := retryVaultAuthentication(ctx, client, login, availability, retryInterval)
+		if !ok {
+			return
+		}
+		manageVaultToken(ctx, client, login, secret, availability, retryInterval)
+	}()
 
 	return client, nil
 }
+
+// retryVaultAuthentication authenticates to vault every retryInterval until it succeeds, and returns false if
+// ctx is cancelled first
+func retryVaultAuthentication(ctx context.Context, client *api.Client, login vaultLogin, availability *vaultAvailability, retryInterval time.Duration) (*api.Secret, bool) {
+	for {
+		if !sleepContext(ctx, retryInterval) {
+			return nil, false
+		}
+		secret, err := authenticateVault(client, login)
+		if err == nil {
+			err = checkVaultKvVersion(ctx, client)
+		}
+		if err != nil {
+			availability.down(ctx, err)
+			continue
+		}
+		availability.up(ctx)
+		return secret, true
+	}
+}
+
+func vaultLoginForSettings(vaultSettings *v1.Settings_VaultSecrets) (vaultLogin, error) {
+	if kubernetesAuth := vaultSettings.GetKubernetesAuth(); kubernetesAuth != nil {
+		if kubernetesAuth.GetRole() == "" {
+			return nil, MissingVaultRoleError("kubernetes")
+		}
+		tokenFile := kubernetesAuth.GetServiceAccountTokenFile()
+		if tokenFile == "" {
+			tokenFile = defaultServiceAccountTokenFile
+		}
+		mountPath := kubernetesAuth.GetMountPath()
+		if mountPath == "" {
+			mountPath = defaultKubernetesAuthMountPath
+		}
+		return func(client *api.Client) (*api.Secret, error) {
+			// the service account token may be rotated, so read it on every login
+			jwt, err := ioutil.ReadFile(tokenFile)
+			if err != nil {
+				return nil, errors.Wrapf(err, "reading service account token")
+			}
+			return vaultLoginWith(client, "kubernetes", mountPath, map[string]interface{}{
+				"role": kubernetesAuth.GetRole(),
+				"jwt":  string(jwt),
+			})
+		}, nil
+	}
+
+	if appRoleAuth := vaultSettings.GetAppRoleAuth(); appRoleAuth != nil {
+		if appRoleAuth.GetRoleId() == "" {
+			return nil, MissingVaultRoleError("approle")
+		}
+		mountPath := appRoleAuth.GetMountPath()
+		if mountPath == "" {
+			mountPath = defaultAppRoleAuthMountPath
+		}
+		return func(client *api.Client) (*api.Secret, error) {
+			return vaultLoginWith(client, "approle", mountPath, map[string]interface{}{
+				"role_id":   appRoleAuth.GetRoleId(),
+				"secret_id": appRoleAuth.GetSecretId(),
+			})
+		}, nil
+	}
+
+	return nil, nil
+}
+
+func vaultLoginWith(client *api.Client, authMethod, mountPath string, data map[string]interface{}) (*api.Secret, error) {
+	secret, err := client.Logical().Write("auth/"+mountPath+"/login", data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "logging in to vault with %v auth", authMethod)
+	}
+	if secret == nil || secret.Auth == nil || secret.Auth.ClientToken == "" {
+		return nil, NoVaultAuthError(authMethod)
+	}
+	client.SetToken(secret.Auth.ClientToken)
+	return secret, nil
+}
+
+// manageVaultToken keeps the token of the client valid, starting from the secret of the initial authentication.
+// Static tokens are renewed until they expire, tokens obtained with login are replaced by logging in again.
+func manageVaultToken(ctx context.Context, client *api.Client, login vaultLogin, secret *api.Secret, availability *vaultAvailability, retryInterval time.Duration) {
+	logger := contextutils.LoggerFrom(ctx)
+	for {
+		var err error
+		if secret != nil && secret.Auth != nil && secret.Auth.Renewable {
+			err = renewVaultToken(ctx, client, secret)
+		} else if login != nil && secret != nil && secret.Auth != nil {
+			// a token that cannot be renewed is replaced shortly before it expires.
+			// vault returns a lease duration of 0 for tokens without a TTL, which must not be replaced in a loop.
+			wait := time.Duration(secret.Auth.LeaseDuration) * time.Second * 2 / 3
+			if wait < retryInterval {
+				wait = retryInterval
+			}
+			if !sleepContext(ctx, wait) {
+				return
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			availability.down(ctx, err)
+		}
+		if login == nil {
+			// a static token cannot be replaced
+			if secret != nil && secret.Auth != nil && secret.Auth.Renewable {
+				logger.Warnf("the vault token can no longer be renewed, configure kubernetesAuth or appRoleAuth to log in again automatically")
+			}
+			return
+		}
+		logger.Infof("the vault token can no longer be renewed, logging in again")
+
+		for {
+			secret, err = login(client)
+			if err == nil {
+				break
+			}
+			availability.down(ctx, err)
+			if !sleepContext(ctx, retryInterval) {
+				return
+			}
+		}
+		availability.up(ctx)
+	}
+}
+
+// authenticateVault logs in with login, or renews the static token of the client.
+// The returned secret is nil for static tokens that cannot be renewed.
+func authenticateVault(client *api.Client, login vaultLogin) (*api.Secret, error) {
+	if login != nil {
+		return login(client)
+	}
+	self, err := client.Auth().Token().LookupSelf()
+	if err != nil {
+		return nil, errors.Wrapf(err, "looking up vault token")
+	}
+	if renewable, _ := self.TokenIsRenewable(); !renewable {
+		return nil, nil
+	}
+	secret, err := client.Auth().Token().RenewSelf(0)
+	if err != nil {
+		return nil, errors.Wrapf(err, "renewing vault token")
+	}
+	return secret, nil
+}
+
+// renewVaultToken blocks until the token can no longer be renewed or ctx is cancelled
+func renewVaultToken(ctx context.Context, client *api.Client, secret *api.Secret) error {
+	watcher, err := client.NewLifetimeWatcher(&api.LifetimeWatcherInput{Secret: secret})
+	if err != nil {
+		return err
+	}
+	go watcher.Start()
+	defer watcher.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-watcher.DoneCh():
+			return err
+		case <-watcher.RenewCh():
+			stats.Record(ctx, mVaultTokenRenewals.M(1))
+			contextutils.LoggerFrom(ctx).Debugf("renewed vault token")
+		}
+	}
+}
+
+// checkVaultKvVersion returns an error if the secrets engine used by the vault secret client is not KV version 2,
+// whose versions are the resource versions of the secrets. The check is skipped if the mount cannot be read.
+func checkVaultKvVersion(ctx context.Context, client *api.Client) error {
+	mount, err := client.Logical().Read("sys/internal/ui/mounts/" + vaultKvMountPath)
+	if err != nil {
+		contextutils.LoggerFrom(ctx).Warnf("skipping the check of the vault secrets engine, reading the mount at %v/ failed: %v", vaultKvMountPath, err)
+		return nil
+	}
+	if mount == nil {
+		return UnsupportedKvVersionError("none")
+	}
+	options, _ := mount.Data["options"].(map[string]interface{})
+	version, _ := options["version"].(string)
+	if version != "2" {
+		if version == "" {
+			version = "1"
+		}
+		return UnsupportedKvVersionError(version)
+	}
+	return nil
+}
+
+// vaultAvailability records the availability of vault in metrics and logs, and reports its changes.
+// only accessed from one goroutine at a time.
+type vaultAvailability struct {
+	report VaultStatusReporter
+	// whether the availability has been reported yet, and its last reported value
+	reported  bool
+	available bool
+}
+
+func (v *vaultAvailability) up(ctx context.Context) {
+	stats.Record(ctx, mVaultUp.M(1))
+	v.set(ctx, true, nil)
+}
+
+func (v *vaultAvailability) down(ctx context.Context, err error) {
+	stats.Record(ctx, mVaultUp.M(0), mVaultErrors.M(1))
+	contextutils.LoggerFrom(ctx).Errorf("vault is unavailable: %v", err)
+	v.set(ctx, false, err)
+}
+
+func (v *vaultAvailability) set(ctx context.Context, available bool, err error) {
+	if v.reported && v.available == available {
+		return
+	}
+	v.reported, v.available = true, available
+	if v.report != nil {
+		v.report(ctx, err)
+	}
+}
+
+func sleepContext(ctx context.Context, duration time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(duration):
+		return true
+	}
+}
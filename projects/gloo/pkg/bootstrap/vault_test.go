@@ -0,0 +1,247 @@
+package bootstrap_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
+	"github.com/solo-io/gloo/test/services"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Vault", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("requires a token without an auth method", func() {
+		_, err := VaultClientForSettings(ctx, &v1.Settings_VaultSecrets{}, nil)
+		Expect(err).To(MatchError(NoVaultTokenError))
+	})
+
+	It("requires a role for kubernetes auth", func() {
+		_, err := VaultClientForSettings(ctx, &v1.Settings_VaultSecrets{
+			KubernetesAuth: &v1.Settings_VaultSecrets_KubernetesAuth{},
+		}, nil)
+		Expect(err).To(MatchError(MissingVaultRoleError("kubernetes")))
+	})
+
+	It("requires a role id for approle auth", func() {
+		_, err := VaultClientForSettings(ctx, &v1.Settings_VaultSecrets{
+			AppRoleAuth: &v1.Settings_VaultSecrets_AppRoleAuth{},
+		}, nil)
+		Expect(err).To(MatchError(MissingVaultRoleError("approle")))
+	})
+
+	It("reports the error of the initial login and keeps the setup running", func() {
+		var reported []error
+		client, err := VaultClientForSettings(ctx, &v1.Settings_VaultSecrets{
+			Address:     "http://127.0.0.1:1",
+			AppRoleAuth: &v1.Settings_VaultSecrets_AppRoleAuth{RoleId: "role"},
+		}, func(ctx context.Context, err error) {
+			reported = append(reported, err)
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client).NotTo(BeNil())
+		Expect(reported).To(HaveLen(1))
+		Expect(reported[0]).To(HaveOccurred())
+	})
+
+	Context("with a fake vault server", func() {
+		var (
+			server        *httptest.Server
+			lock          sync.Mutex
+			loginsAllowed bool
+			logins        int
+			retryInterval time.Duration
+		)
+
+		BeforeEach(func() {
+			retryInterval = VaultRetryInterval
+			VaultRetryInterval = 50 * time.Millisecond
+			loginsAllowed, logins = true, 0
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				lock.Lock()
+				defer lock.Unlock()
+				switch r.URL.Path {
+				case "/v1/auth/approle/login":
+					if !loginsAllowed {
+						w.WriteHeader(http.StatusForbidden)
+						return
+					}
+					logins++
+					// a token without a TTL, which cannot be renewed
+					fmt.Fprintf(w, `{"auth": {"client_token": "token-%d", "renewable": false, "lease_duration": 0}}`, logins)
+				case "/v1/sys/internal/ui/mounts/secret":
+					fmt.Fprint(w, `{"data": {"options": {"version": "2"}}}`)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+		})
+
+		AfterEach(func() {
+			cancel()
+			server.Close()
+			VaultRetryInterval = retryInterval
+		})
+
+		settings := func() *v1.Settings_VaultSecrets {
+			return &v1.Settings_VaultSecrets{
+				Address:     server.URL,
+				AppRoleAuth: &v1.Settings_VaultSecrets_AppRoleAuth{RoleId: "role"},
+			}
+		}
+
+		loginCount := func() int {
+			lock.Lock()
+			defer lock.Unlock()
+			return logins
+		}
+
+		It("logs in once vault becomes available", func() {
+			lock.Lock()
+			loginsAllowed = false
+			lock.Unlock()
+
+			var (
+				reportLock sync.Mutex
+				reported   []error
+			)
+			client, err := VaultClientForSettings(ctx, settings(), func(ctx context.Context, err error) {
+				reportLock.Lock()
+				defer reportLock.Unlock()
+				reported = append(reported, err)
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			lock.Lock()
+			loginsAllowed = true
+			lock.Unlock()
+			Eventually(client.Token).Should(Equal("token-1"))
+			Eventually(func() []error {
+				reportLock.Lock()
+				defer reportLock.Unlock()
+				return append([]error(nil), reported...)
+			}).Should(HaveLen(2))
+			Expect(reported[0]).To(HaveOccurred())
+			Expect(reported[1]).NotTo(HaveOccurred())
+		})
+
+		It("does not log in again in a loop for tokens without a lease duration", func() {
+			client, err := VaultClientForSettings(ctx, settings(), nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.Token()).To(Equal("token-1"))
+
+			time.Sleep(4 * VaultRetryInterval)
+			Expect(loginCount()).To(BeNumerically("<=", 6))
+			Eventually(loginCount).Should(BeNumerically(">", 1))
+		})
+	})
+
+	Context("with a vault dev server", func() {
+		var (
+			vaultFactory  *services.VaultFactory
+			vaultInstance *services.VaultInstance
+		)
+
+		vault := func(args ...string) string {
+			out, err := vaultInstance.Exec(args...)
+			Expect(err).NotTo(HaveOccurred())
+			return strings.TrimSpace(out)
+		}
+
+		BeforeEach(func() {
+			if os.Getenv("RUN_VAULT_TESTS") != "1" {
+				Skip("This test downloads and runs vault and is disabled by default. To enable, set RUN_VAULT_TESTS=1 in your env.")
+			}
+			var err error
+			vaultFactory, err = services.NewVaultFactory()
+			Expect(err).NotTo(HaveOccurred())
+			vaultInstance, err = vaultFactory.NewVaultInstance()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vaultInstance.Run()).NotTo(HaveOccurred())
+
+			os.Setenv("VAULT_ADDR", "http://127.0.0.1:8200")
+			os.Setenv("VAULT_TOKEN", vaultInstance.Token())
+		})
+
+		AfterEach(func() {
+			os.Unsetenv("VAULT_ADDR")
+			os.Unsetenv("VAULT_TOKEN")
+			if vaultInstance != nil {
+				vaultInstance.Clean()
+			}
+			vaultFactory.Clean()
+		})
+
+		It("logs in with approle auth and reads versioned secrets", func() {
+			var reported []error
+			vault("auth", "enable", "approle")
+			vault("policy", "write", "gloo", "-", "path \"secret/*\" { capabilities = [\"create\", \"read\", \"update\", \"delete\", \"list\"] }")
+			vault("write", "auth/approle/role/gloo", "token_policies=gloo", "token_ttl=1m")
+			roleId := vault("read", "-field=role_id", "auth/approle/role/gloo/role-id")
+			secretId := vault("write", "-f", "-field=secret_id", "auth/approle/role/gloo/secret-id")
+
+			client, err := VaultClientForSettings(ctx, &v1.Settings_VaultSecrets{
+				Address:     "http://127.0.0.1:8200",
+				AppRoleAuth: &v1.Settings_VaultSecrets_AppRoleAuth{RoleId: roleId, SecretId: secretId},
+			}, func(ctx context.Context, err error) {
+				reported = append(reported, err)
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.Token()).NotTo(BeEmpty())
+			Expect(client.Token()).NotTo(Equal(vaultInstance.Token()))
+			Expect(reported).To(Equal([]error{nil}))
+
+			secretClient, err := v1.NewSecretClient(&factory.VaultSecretClientFactory{Vault: client, RootKey: DefaultRootKey})
+			Expect(err).NotTo(HaveOccurred())
+			written, err := secretClient.Write(&v1.Secret{
+				Metadata: core.Metadata{Name: "tls", Namespace: "default"},
+				Kind:     &v1.Secret_Tls{Tls: &v1.TlsSecret{CertChain: "cert"}},
+			}, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(written.Metadata.ResourceVersion).To(Equal("1"))
+
+			written.GetTls().CertChain = "rotated"
+			written, err = secretClient.Write(written, clients.WriteOpts{OverwriteExisting: true})
+			Expect(err).NotTo(HaveOccurred())
+			read, err := secretClient.Read("default", "tls", clients.ReadOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(read.Metadata.ResourceVersion).To(Equal("2"))
+			Expect(read.GetTls().GetCertChain()).To(Equal("rotated"))
+		})
+
+		It("rejects a KV version 1 secrets engine", func() {
+			vault("secrets", "disable", "secret")
+			vault("secrets", "enable", "-path=secret", "-version=1", "kv")
+
+			_, err := VaultClientForSettings(ctx, &v1.Settings_VaultSecrets{
+				Address: "http://127.0.0.1:8200",
+				Token:   vaultInstance.Token(),
+			}, nil)
+			Expect(err).To(MatchError(UnsupportedKvVersionError("1")))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package ec2
 
 import (
 	"context"
+	"strconv"
 	"strings"
 
 	"github.com/solo-io/go-utils/contextutils"
@@ -39,9 +40,16 @@ func getLatestEndpoints(ctx context.Context, lister Ec2InstanceLister, secrets v
 		for _, upstream := range credGroup.upstreams {
 			instancesForUpstream := filterInstancesForUpstream(ctx, upstream, credGroup)
 			for _, instance := range instancesForUpstream {
-				if endpoint := upstreamInstanceToEndpoint(ctx, writeNamespace, upstream, instance); endpoint != nil {
-					allEndpoints = append(allEndpoints, endpoint)
+				endpoint := upstreamInstanceToEndpoint(ctx, writeNamespace, upstream, credGroup.credentialSpec.Region(), instance)
+				if endpoint == nil {
+					continue
 				}
+				if upstream.GetAwsEc2().GetUseStatusChecks() {
+					if status, ok := credGroup.statuses[aws.StringValue(instance.InstanceId)]; ok {
+						endpoint.HealthStatus = instanceStatusHealthStatus(status)
+					}
+				}
+				allEndpoints = append(allEndpoints, endpoint)
 			}
 		}
 	}
@@ -59,23 +67,28 @@ type credentialGroup struct {
 	instances []*ec2.Instance
 	// one filter map exists for each instance in order to support client-side filtering
 	filterMaps []FilterMap
+	// the status checks of the running instances, by instance id
+	// only listed if one of the upstreams sets its health from the status checks
+	statuses map[string]*ec2.InstanceStatus
 }
 
 // Initializes the credentialGroups
 // Credential groups are returned as a map to enforce the "one credentialGroup per unique credential" property that is
 // required in order to realize the benefits of batched AWS API calls.
+// Upstreams that span several regions belong to one credential group per region.
 // NOTE: assumes that upstreams are EC2 upstreams
 func getCredGroupsFromUpstreams(upstreams v1.UpstreamList) (map[CredentialKey]*credentialGroup, error) {
 	credGroups := make(map[CredentialKey]*credentialGroup)
 	for _, upstream := range upstreams {
-		cred := NewCredentialSpecFromEc2UpstreamSpec(upstream.GetAwsEc2())
-		key := cred.GetKey()
-		if _, ok := credGroups[key]; ok {
-			credGroups[key].upstreams = append(credGroups[key].upstreams, upstream)
-		} else {
-			credGroups[key] = &credentialGroup{
-				upstreams:      v1.UpstreamList{upstream},
-				credentialSpec: cred,
+		for _, cred := range NewCredentialSpecsFromEc2UpstreamSpec(upstream.GetAwsEc2()) {
+			key := cred.GetKey()
+			if _, ok := credGroups[key]; ok {
+				credGroups[key].upstreams = append(credGroups[key].upstreams, upstream)
+			} else {
+				credGroups[key] = &credentialGroup{
+					upstreams:      v1.UpstreamList{upstream},
+					credentialSpec: cred,
+				}
 			}
 		}
 	}
@@ -86,6 +99,7 @@ func getCredGroupsFromUpstreams(upstreams v1.UpstreamList) (map[CredentialKey]*c
 // - adds the instances for each credentialGroup's credential
 // - adds tag filters for each instance for later use when refining the list of instances that an upstream has
 // permission to describe to the list of instances that the upstream should route to
+// - adds the status checks of the instances, if any of the credentialGroup's upstreams uses them and they can be listed
 func getInstancesForCredentialGroups(ctx context.Context, lister Ec2InstanceLister, secrets v1.SecretList, credGroups map[CredentialKey]*credentialGroup) error {
 	for _, credGroup := range credGroups {
 		instances, err := lister.ListForCredentials(ctx, credGroup.credentialSpec, secrets)
@@ -94,10 +108,34 @@ func getInstancesForCredentialGroups(ctx context.Context, lister Ec2InstanceList
 		}
 		credGroup.instances = instances
 		credGroup.filterMaps = generateFilterMaps(instances)
+
+		if !anyUpstreamUsesStatusChecks(credGroup.upstreams) {
+			continue
+		}
+		statuses, err := lister.ListStatusesForCredentials(ctx, credGroup.credentialSpec, secrets)
+		if err != nil {
+			// the health of the group's endpoints falls back to the state of their instances
+			contextutils.LoggerFrom(ctx).Warnw("unable to list the status checks of ec2 instances, "+
+				"using the instance states instead", zap.Any("credentialSpec", credGroup.credentialSpec), zap.Error(err))
+			continue
+		}
+		credGroup.statuses = make(map[string]*ec2.InstanceStatus)
+		for _, status := range statuses {
+			credGroup.statuses[aws.StringValue(status.InstanceId)] = status
+		}
 	}
 	return nil
 }
 
+func anyUpstreamUsesStatusChecks(upstreams v1.UpstreamList) bool {
+	for _, upstream := range upstreams {
+		if upstream.GetAwsEc2().GetUseStatusChecks() {
+			return true
+		}
+	}
+	return false
+}
+
 // applies filter logic equivalent to the tag filter logic used in AWS's DescribeInstances API
 // NOTE: assumes that upstreams are EC2 upstreams
 func filterInstancesForUpstream(ctx context.Context, upstream *v1.Upstream, credGroup *credentialGroup) []*ec2.Instance {
@@ -123,6 +161,9 @@ func filterInstancesForUpstream(ctx context.Context, upstream *v1.Upstream, cred
 				}
 			}
 		}
+		if matchesAll {
+			matchesAll = matchesInstanceAttributes(upstream.GetAwsEc2(), candidateInstance, fm)
+		}
 		if matchesAll {
 			instances = append(instances, candidateInstance)
 			logger.Debugw("instance for upstream accepted", "upstream", upstream.Metadata.Ref().Key(), "instance-tags", candidateInstance.Tags, "instance-id", candidateInstance.InstanceId)
@@ -133,11 +174,55 @@ func filterInstancesForUpstream(ctx context.Context, upstream *v1.Upstream, cred
 	return instances
 }
 
+// the instance tag AWS adds to the members of an Auto Scaling group
+const autoScalingGroupTagKey = "aws:autoscaling:groupName"
+
+// the EC2 API does not report a lifecycle for on-demand instances
+const onDemandInstanceLifecycle = "on-demand"
+
+// applies the instance state, lifecycle and Auto Scaling group filters of the upstream
+func matchesInstanceAttributes(spec *glooec2.UpstreamSpec, instance *ec2.Instance, fm FilterMap) bool {
+	states := spec.GetInstanceStates()
+	if len(states) == 0 {
+		states = []string{ec2.InstanceStateNameRunning}
+	}
+	if instance.State == nil || !containsString(states, aws.StringValue(instance.State.Name)) {
+		return false
+	}
+
+	if lifecycles := spec.GetInstanceLifecycles(); len(lifecycles) > 0 {
+		lifecycle := aws.StringValue(instance.InstanceLifecycle)
+		if lifecycle == "" {
+			lifecycle = onDemandInstanceLifecycle
+		}
+		if !containsString(lifecycles, lifecycle) {
+			return false
+		}
+	}
+
+	if groups := spec.GetAutoScalingGroups(); len(groups) > 0 {
+		group, ok := fm[awsKeyCase(autoScalingGroupTagKey)]
+		if !ok || !containsString(groups, group) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 // NOTE: assumes that upstreams are EC2 upstreams
-func upstreamInstanceToEndpoint(ctx context.Context, writeNamespace string, upstream *v1.Upstream, instance *ec2.Instance) *v1.Endpoint {
-	ipAddr := instance.PrivateIpAddress
+func upstreamInstanceToEndpoint(ctx context.Context, writeNamespace string, upstream *v1.Upstream, region string, instance *ec2.Instance) *v1.Endpoint {
+	ipAddr, hostname := instance.PrivateIpAddress, instance.PrivateDnsName
 	if upstream.GetAwsEc2().GetPublicIp() {
-		ipAddr = instance.PublicIpAddress
+		ipAddr, hostname = instance.PublicIpAddress, instance.PublicDnsName
 	}
 	if ipAddr == nil {
 		contextutils.LoggerFrom(ctx).Warnw("no ip found for config",
@@ -150,20 +235,43 @@ func upstreamInstanceToEndpoint(ctx context.Context, writeNamespace string, upst
 	if port == 0 {
 		port = DefaultPort
 	}
+	if portTag := upstream.GetAwsEc2().GetPortTag(); portTag != "" {
+		if tagPort, ok := instancePortFromTag(instance, portTag); ok {
+			port = tagPort
+		} else {
+			contextutils.LoggerFrom(ctx).Debugw("no valid port tag found on instance, using the upstream port",
+				zap.Any("upstreamRef", upstream.GetMetadata().Ref()),
+				zap.Any("instanceId", aws.StringValue(instance.InstanceId)),
+				zap.Any("portTag", portTag))
+		}
+	}
 	ref := upstream.Metadata.Ref()
+	// the same private address can be in use in several regions
+	nameSuffix := aws.StringValue(ipAddr)
+	if len(upstreamRegions(upstream.GetAwsEc2())) > 1 {
+		nameSuffix = region + "-" + nameSuffix
+	}
 	// for easier debugging, add the instance id to the xds output
 	instanceInfo := make(map[string]string)
 	instanceInfo[InstanceIdAnnotationKey] = aws.StringValue(instance.InstanceId)
 	endpoint := v1.Endpoint{
-		Upstreams: []*core.ResourceRef{&ref},
-		Address:   aws.StringValue(ipAddr),
-		Port:      port,
+		Upstreams:    []*core.ResourceRef{&ref},
+		Address:      aws.StringValue(ipAddr),
+		Port:         port,
+		Hostname:     aws.StringValue(hostname),
+		HealthStatus: instanceHealthStatus(instance),
 		Metadata: core.Metadata{
-			Name:        generateName(ref, aws.StringValue(ipAddr)),
+			Name:        generateName(ref, nameSuffix),
 			Namespace:   writeNamespace,
 			Annotations: instanceInfo,
 		},
 	}
+	if instance.Placement != nil {
+		endpoint.Locality = &v1.Locality{
+			Region: region,
+			Zone:   aws.StringValue(instance.Placement.AvailabilityZone),
+		}
+	}
 	contextutils.LoggerFrom(ctx).Debugw("instance from upstream",
 		zap.Any("upstream", upstream),
 		zap.Any("instance", instance),
@@ -171,6 +279,58 @@ func upstreamInstanceToEndpoint(ctx context.Context, writeNamespace string, upst
 	return &endpoint
 }
 
+// instanceHealthStatus maps the state of an instance to the health status of its endpoint
+func instanceHealthStatus(instance *ec2.Instance) v1.HealthStatus {
+	if instance.State == nil {
+		return v1.HealthStatus_UNKNOWN
+	}
+	switch aws.StringValue(instance.State.Name) {
+	case ec2.InstanceStateNameRunning:
+		return v1.HealthStatus_HEALTHY
+	case ec2.InstanceStateNameStopping, ec2.InstanceStateNameShuttingDown:
+		return v1.HealthStatus_DRAINING
+	case ec2.InstanceStateNamePending:
+		return v1.HealthStatus_UNKNOWN
+	default:
+		return v1.HealthStatus_UNHEALTHY
+	}
+}
+
+// instanceStatusHealthStatus maps the status checks of a running instance to the health status of its endpoint
+func instanceStatusHealthStatus(status *ec2.InstanceStatus) v1.HealthStatus {
+	var instanceStatus, systemStatus string
+	if status.InstanceStatus != nil {
+		instanceStatus = aws.StringValue(status.InstanceStatus.Status)
+	}
+	if status.SystemStatus != nil {
+		systemStatus = aws.StringValue(status.SystemStatus.Status)
+	}
+	switch {
+	case instanceStatus == ec2.SummaryStatusImpaired || systemStatus == ec2.SummaryStatusImpaired:
+		return v1.HealthStatus_UNHEALTHY
+	case instanceStatus == ec2.SummaryStatusOk && systemStatus == ec2.SummaryStatusOk:
+		return v1.HealthStatus_HEALTHY
+	default:
+		// the checks are initializing or do not have enough data yet
+		return v1.HealthStatus_UNKNOWN
+	}
+}
+
+// instancePortFromTag reads the port of an instance from the value of the tag with the given key
+func instancePortFromTag(instance *ec2.Instance, tagKey string) (uint32, bool) {
+	for _, tag := range instance.Tags {
+		if awsKeyCase(aws.StringValue(tag.Key)) != awsKeyCase(tagKey) {
+			continue
+		}
+		port, err := strconv.ParseUint(strings.TrimSpace(aws.StringValue(tag.Value)), 10, 16)
+		if err != nil || port == 0 {
+			return 0, false
+		}
+		return uint32(port), true
+	}
+	return 0, false
+}
+
 // a FilterMap is created for each EC2 instance so we can efficiently filter the instances associated with a given
 // upstream's filter spec
 // filter maps are generated from tag lists, the keys are the tag keys, the values are the tag values
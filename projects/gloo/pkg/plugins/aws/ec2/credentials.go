@@ -48,6 +48,15 @@ func (cs *CredentialSpec) Clone() *CredentialSpec {
 	}
 }
 
+// NewCredentialSpec returns the credential spec for the given secret, region and role
+func NewCredentialSpec(secretRef *core.ResourceRef, region, roleArn string) *CredentialSpec {
+	return &CredentialSpec{
+		secretRef: secretRef,
+		region:    region,
+		roleArn:   roleArn,
+	}
+}
+
 func NewCredentialSpecFromEc2UpstreamSpec(spec *glooec2.UpstreamSpec) *CredentialSpec {
 	return &CredentialSpec{
 		secretRef: spec.SecretRef,
@@ -56,6 +65,37 @@ func NewCredentialSpecFromEc2UpstreamSpec(spec *glooec2.UpstreamSpec) *Credentia
 	}
 }
 
+// NewCredentialSpecsFromEc2UpstreamSpec returns a credential spec for each region the upstream selects instances in
+func NewCredentialSpecsFromEc2UpstreamSpec(spec *glooec2.UpstreamSpec) []*CredentialSpec {
+	var specs []*CredentialSpec
+	for _, region := range upstreamRegions(spec) {
+		specs = append(specs, &CredentialSpec{
+			secretRef: spec.SecretRef,
+			region:    region,
+			roleArn:   spec.GetRoleArn(),
+		})
+	}
+	return specs
+}
+
+// the regions of an upstream are its region followed by its additional regions, without duplicates
+func upstreamRegions(spec *glooec2.UpstreamSpec) []string {
+	var regions []string
+	seen := make(map[string]bool)
+	for _, region := range append([]string{spec.GetRegion()}, spec.GetRegions()...) {
+		if seen[region] {
+			continue
+		}
+		// an empty region lets the aws session pick the region from the environment, which only makes sense alone
+		if region == "" && len(spec.GetRegions()) > 0 {
+			continue
+		}
+		seen[region] = true
+		regions = append(regions, region)
+	}
+	return regions
+}
+
 type CredentialKey struct {
 	secretRef string
 	region    string
@@ -85,7 +85,7 @@ var _ = Describe("Plugin", func() {
 		privateIp := "5.5.5.5"
 		writeNamespace := "default"
 		DescribeTable("convert to endpoints", func(input *v1.Upstream, instance *ec2.Instance, expected *v1.Endpoint) {
-			out := upstreamInstanceToEndpoint(context.TODO(), writeNamespace, input, instance)
+			out := upstreamInstanceToEndpoint(context.TODO(), writeNamespace, input, "us-east-1", instance)
 			Expect(out).To(Equal(expected))
 			testutils.ExpectEqualProtoMessages(out, expected)
 		},
@@ -181,6 +181,39 @@ var _ = Describe("Plugin", func() {
 						Annotations: map[string]string{InstanceIdAnnotationKey: "id1"},
 					},
 				}),
+			Entry("should use the instance state and dns name", &v1.Upstream{
+				UpstreamType: &v1.Upstream_AwsEc2{
+					AwsEc2: &glooec2.UpstreamSpec{
+						Region:   "us-east-1",
+						PublicIp: true,
+						Port:     77,
+					},
+				},
+				Metadata: core.Metadata{
+					Name:      "ex1",
+					Namespace: "default",
+				},
+			},
+				&ec2.Instance{
+					InstanceId:       aws.String("id1"),
+					PublicIpAddress:  aws.String(pubIp),
+					PublicDnsName:    aws.String("ec2-1-2-3-4.compute-1.amazonaws.com"),
+					PrivateIpAddress: aws.String(privateIp),
+					PrivateDnsName:   aws.String("ip-5-5-5-5.ec2.internal"),
+					State:            &ec2.InstanceState{Name: aws.String(ec2.InstanceStateNameStopping)},
+				},
+				&v1.Endpoint{
+					Upstreams:    []*core.ResourceRef{{"ex1", "default"}},
+					Address:      pubIp,
+					Port:         77,
+					Hostname:     "ec2-1-2-3-4.compute-1.amazonaws.com",
+					HealthStatus: v1.HealthStatus_DRAINING,
+					Metadata: core.Metadata{
+						Name:        "ec2-name-ex1-namespace-default--1-2-3-4",
+						Namespace:   writeNamespace,
+						Annotations: map[string]string{InstanceIdAnnotationKey: "id1"},
+					},
+				}),
 			Entry("should return nil if no ips are available for the given config", &v1.Upstream{
 				UpstreamType: &v1.Upstream_AwsEc2{
 					AwsEc2: &glooec2.UpstreamSpec{
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	glooec2 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws/ec2"
@@ -15,6 +16,17 @@ import (
 )
 
 func GetEc2Client(cred *CredentialSpec, secrets v1.SecretList) (*ec2.EC2, error) {
+	sess, err := GetAwsSessionForCredentials(cred, secrets)
+	if err != nil {
+		return nil, err
+	}
+	return ec2.New(sess), nil
+}
+
+// GetAwsSessionForCredentials creates an AWS session in the region of the credentials, from the secret of the
+// credentials (or the environment), which assumes the role of the credentials if they have one.
+// It is shared by the plugins for the AWS services that use the same credentials as EC2, such as ECS.
+func GetAwsSessionForCredentials(cred *CredentialSpec, secrets v1.SecretList) (*session.Session, error) {
 	regionConfig := &aws.Config{Region: aws.String(cred.Region())}
 	secretRef := cred.SecretRef()
 	sess, err := aws2.GetAwsSession(secretRef, secrets, regionConfig)
@@ -26,10 +38,9 @@ func GetEc2Client(cred *CredentialSpec, secrets v1.SecretList) (*ec2.EC2, error)
 	}
 	if cred.Arn() != "" {
 		cred := stscreds.NewCredentials(sess, cred.Arn())
-		config := &aws.Config{Credentials: cred}
-		return ec2.New(sess, config), nil
+		return sess.Copy(&aws.Config{Credentials: cred}), nil
 	}
-	return ec2.New(sess), nil
+	return sess, nil
 }
 
 func GetInstancesFromDescription(desc *ec2.DescribeInstancesOutput) []*ec2.Instance {
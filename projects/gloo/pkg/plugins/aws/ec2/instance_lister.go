@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/go-utils/contextutils"
 	"go.uber.org/zap"
@@ -16,33 +17,53 @@ import (
 // This allows us to easily mock the API in our tests.
 type Ec2InstanceLister interface {
 	ListForCredentials(ctx context.Context, cred *CredentialSpec, secrets v1.SecretList) ([]*ec2.Instance, error)
+	ListStatusesForCredentials(ctx context.Context, cred *CredentialSpec, secrets v1.SecretList) ([]*ec2.InstanceStatus, error)
 }
 
 type ec2InstanceLister struct {
+	// creates the client for the given credentials, tests replace it with a fake EC2 API
+	newClient func(cred *CredentialSpec, secrets v1.SecretList) (ec2iface.EC2API, error)
 }
 
 func NewEc2InstanceLister() *ec2InstanceLister {
-	return &ec2InstanceLister{}
+	return &ec2InstanceLister{
+		newClient: func(cred *CredentialSpec, secrets v1.SecretList) (ec2iface.EC2API, error) {
+			svc, err := GetEc2Client(cred, secrets)
+			if err != nil {
+				return nil, err
+			}
+			return svc, nil
+		},
+	}
 }
 
 var _ Ec2InstanceLister = &ec2InstanceLister{}
 
+// the instances in every state but terminated are listed, upstreams select the states they route to
+var listedInstanceStates = []string{
+	ec2.InstanceStateNamePending,
+	ec2.InstanceStateNameRunning,
+	ec2.InstanceStateNameShuttingDown,
+	ec2.InstanceStateNameStopping,
+	ec2.InstanceStateNameStopped,
+}
+
 func (c *ec2InstanceLister) ListForCredentials(ctx context.Context, cred *CredentialSpec, secrets v1.SecretList) ([]*ec2.Instance, error) {
-	svc, err := GetEc2Client(cred, secrets)
+	svc, err := c.newClient(cred, secrets)
 	if err != nil {
 		return nil, GetClientError(err)
 	}
 	return c.ListWithClient(ctx, svc)
 }
 
-func (c *ec2InstanceLister) ListWithClient(ctx context.Context, svc *ec2.EC2) ([]*ec2.Instance, error) {
+func (c *ec2InstanceLister) ListWithClient(ctx context.Context, svc ec2iface.EC2API) ([]*ec2.Instance, error) {
 
 	var results []*ec2.DescribeInstancesOutput
-	// pass a filter to only get running instances.
+	// pass a filter to skip terminated instances.
 	input := &ec2.DescribeInstancesInput{
 		Filters: []*ec2.Filter{
 			{Name: aws.String("instance-state-name"),
-				Values: []*string{aws.String("running")}},
+				Values: aws.StringSlice(listedInstanceStates)},
 		},
 	}
 	err := svc.DescribeInstancesPagesWithContext(ctx, input, func(r *ec2.DescribeInstancesOutput, more bool) bool {
@@ -62,6 +83,29 @@ func (c *ec2InstanceLister) ListWithClient(ctx context.Context, svc *ec2.EC2) ([
 	return result, nil
 }
 
+func (c *ec2InstanceLister) ListStatusesForCredentials(ctx context.Context, cred *CredentialSpec, secrets v1.SecretList) ([]*ec2.InstanceStatus, error) {
+	svc, err := c.newClient(cred, secrets)
+	if err != nil {
+		return nil, GetClientError(err)
+	}
+	return c.ListStatusesWithClient(ctx, svc)
+}
+
+// EC2 only reports the status checks of running instances
+func (c *ec2InstanceLister) ListStatusesWithClient(ctx context.Context, svc ec2iface.EC2API) ([]*ec2.InstanceStatus, error) {
+	var result []*ec2.InstanceStatus
+	err := svc.DescribeInstanceStatusPagesWithContext(ctx, &ec2.DescribeInstanceStatusInput{}, func(r *ec2.DescribeInstanceStatusOutput, more bool) bool {
+		result = append(result, r.InstanceStatuses...)
+		return true
+	})
+	if err != nil {
+		return nil, DescribeInstanceStatusError(err)
+	}
+
+	contextutils.LoggerFrom(ctx).Debugw("ec2Upstream status result", zap.Any("value", result))
+	return result, nil
+}
+
 var (
 	GetClientError = func(err error) error {
 		return eris.Wrapf(err, "unable to get aws client")
@@ -70,4 +114,8 @@ var (
 	DescribeInstancesError = func(err error) error {
 		return eris.Wrapf(err, "unable to describe instances")
 	}
+
+	DescribeInstanceStatusError = func(err error) error {
+		return eris.Wrapf(err, "unable to describe instance status")
+	}
 )
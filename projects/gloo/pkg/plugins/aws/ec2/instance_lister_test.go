@@ -0,0 +1,296 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooec2 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws/ec2"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Instance lister", func() {
+
+	var (
+		ctx       context.Context
+		fakeApis  map[string]*fakeEc2Api
+		lister    *ec2InstanceLister
+		upstream  *v1.Upstream
+		namespace = "default"
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fakeApis = map[string]*fakeEc2Api{
+			"us-east-1": {
+				instances: []*ec2.Instance{
+					fakeInstance("i-running", "10.0.0.1", ec2.InstanceStateNameRunning),
+					fakeInstance("i-stopping", "10.0.0.2", ec2.InstanceStateNameStopping),
+					fakeInstance("i-terminated", "10.0.0.3", ec2.InstanceStateNameTerminated),
+				},
+			},
+			"eu-west-1": {
+				instances: []*ec2.Instance{
+					fakeInstance("i-europe", "10.0.0.1", ec2.InstanceStateNameRunning),
+				},
+			},
+		}
+		lister = &ec2InstanceLister{
+			newClient: func(cred *CredentialSpec, secrets v1.SecretList) (ec2iface.EC2API, error) {
+				return fakeApis[cred.Region()], nil
+			},
+		}
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{Name: "us", Namespace: namespace},
+			UpstreamType: &v1.Upstream_AwsEc2{
+				AwsEc2: &glooec2.UpstreamSpec{
+					Region: "us-east-1",
+					Port:   8080,
+				},
+			},
+		}
+	})
+
+	endpointsByInstance := func(upstreams ...*v1.Upstream) map[string]*v1.Endpoint {
+		endpoints, err := getLatestEndpoints(ctx, lister, nil, namespace, upstreams)
+		Expect(err).NotTo(HaveOccurred())
+		result := make(map[string]*v1.Endpoint)
+		for _, endpoint := range endpoints {
+			result[endpoint.Metadata.Annotations[InstanceIdAnnotationKey]] = endpoint
+		}
+		return result
+	}
+
+	instanceIds := func(endpoints map[string]*v1.Endpoint) []string {
+		var ids []string
+		for id := range endpoints {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		return ids
+	}
+
+	It("lists the instances of every page, skipping terminated instances", func() {
+		fakeApis["us-east-1"].pageSize = 1
+
+		instances, err := lister.ListForCredentials(ctx, NewCredentialSpecFromEc2UpstreamSpec(upstream.GetAwsEc2()), nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		var ids []string
+		for _, instance := range instances {
+			ids = append(ids, aws.StringValue(instance.InstanceId))
+		}
+		Expect(ids).To(Equal([]string{"i-running", "i-stopping"}))
+	})
+
+	It("only selects running instances by default", func() {
+		endpoints := endpointsByInstance(upstream)
+		Expect(instanceIds(endpoints)).To(Equal([]string{"i-running"}))
+		Expect(endpoints["i-running"].HealthStatus).To(Equal(v1.HealthStatus_HEALTHY))
+	})
+
+	It("selects instances in the configured states", func() {
+		upstream.GetAwsEc2().InstanceStates = []string{ec2.InstanceStateNameRunning, ec2.InstanceStateNameStopping}
+
+		endpoints := endpointsByInstance(upstream)
+		Expect(instanceIds(endpoints)).To(Equal([]string{"i-running", "i-stopping"}))
+		Expect(endpoints["i-stopping"].HealthStatus).To(Equal(v1.HealthStatus_DRAINING))
+	})
+
+	It("lists the instances of every region once", func() {
+		upstream.GetAwsEc2().Regions = []string{"eu-west-1", "us-east-1"}
+		other := &v1.Upstream{
+			Metadata: core.Metadata{Name: "eu", Namespace: namespace},
+			UpstreamType: &v1.Upstream_AwsEc2{
+				AwsEc2: &glooec2.UpstreamSpec{Region: "eu-west-1"},
+			},
+		}
+
+		endpoints, err := getLatestEndpoints(ctx, lister, nil, namespace, v1.UpstreamList{upstream, other})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpoints).To(HaveLen(3))
+		Expect(fakeApis["us-east-1"].describeInstancesCalls).To(Equal(1))
+		Expect(fakeApis["eu-west-1"].describeInstancesCalls).To(Equal(1))
+
+		usEndpoints := endpointsByInstance(upstream)
+		Expect(instanceIds(usEndpoints)).To(Equal([]string{"i-europe", "i-running"}))
+		// both instances have the same address, the region tells their endpoints apart
+		Expect(usEndpoints["i-europe"].Metadata.Name).To(Equal("ec2-name-us-namespace-default--eu-west-1-10-0-0-1"))
+		Expect(usEndpoints["i-europe"].Locality.Region).To(Equal("eu-west-1"))
+		Expect(usEndpoints["i-running"].Metadata.Name).To(Equal("ec2-name-us-namespace-default--us-east-1-10-0-0-1"))
+		Expect(usEndpoints["i-running"].Locality.Region).To(Equal("us-east-1"))
+	})
+
+	It("selects instances by lifecycle", func() {
+		spot := fakeInstance("i-spot", "10.0.0.4", ec2.InstanceStateNameRunning)
+		spot.InstanceLifecycle = aws.String(ec2.InstanceLifecycleTypeSpot)
+		fakeApis["us-east-1"].instances = append(fakeApis["us-east-1"].instances, spot)
+
+		upstream.GetAwsEc2().InstanceLifecycles = []string{"spot"}
+		Expect(instanceIds(endpointsByInstance(upstream))).To(Equal([]string{"i-spot"}))
+
+		upstream.GetAwsEc2().InstanceLifecycles = []string{"on-demand"}
+		Expect(instanceIds(endpointsByInstance(upstream))).To(Equal([]string{"i-running"}))
+	})
+
+	It("selects instances by Auto Scaling group", func() {
+		member := fakeInstance("i-member", "10.0.0.4", ec2.InstanceStateNameRunning)
+		member.Tags = append(member.Tags, &ec2.Tag{Key: aws.String("aws:autoscaling:groupName"), Value: aws.String("web")})
+		fakeApis["us-east-1"].instances = append(fakeApis["us-east-1"].instances, member)
+
+		upstream.GetAwsEc2().AutoScalingGroups = []string{"web"}
+		Expect(instanceIds(endpointsByInstance(upstream))).To(Equal([]string{"i-member"}))
+	})
+
+	It("reads the port from the instance tag", func() {
+		tagged := fakeInstance("i-tagged", "10.0.0.4", ec2.InstanceStateNameRunning)
+		tagged.Tags = append(tagged.Tags, &ec2.Tag{Key: aws.String("Port"), Value: aws.String("9090")})
+		invalid := fakeInstance("i-invalid", "10.0.0.5", ec2.InstanceStateNameRunning)
+		invalid.Tags = append(invalid.Tags, &ec2.Tag{Key: aws.String("port"), Value: aws.String("http")})
+		fakeApis["us-east-1"].instances = append(fakeApis["us-east-1"].instances, tagged, invalid)
+
+		upstream.GetAwsEc2().PortTag = "port"
+		endpoints := endpointsByInstance(upstream)
+		Expect(endpoints["i-tagged"].Port).To(BeEquivalentTo(9090))
+		Expect(endpoints["i-invalid"].Port).To(BeEquivalentTo(8080))
+		Expect(endpoints["i-running"].Port).To(BeEquivalentTo(8080))
+	})
+
+	It("sets the health of endpoints from the status checks", func() {
+		impaired := fakeInstance("i-impaired", "10.0.0.4", ec2.InstanceStateNameRunning)
+		initializing := fakeInstance("i-initializing", "10.0.0.5", ec2.InstanceStateNameRunning)
+		fakeApis["us-east-1"].instances = append(fakeApis["us-east-1"].instances, impaired, initializing)
+		fakeApis["us-east-1"].statuses = []*ec2.InstanceStatus{
+			fakeInstanceStatus("i-running", ec2.SummaryStatusOk, ec2.SummaryStatusOk),
+			fakeInstanceStatus("i-impaired", ec2.SummaryStatusOk, ec2.SummaryStatusImpaired),
+			fakeInstanceStatus("i-initializing", ec2.SummaryStatusInitializing, ec2.SummaryStatusOk),
+		}
+
+		upstream.GetAwsEc2().UseStatusChecks = true
+		endpoints := endpointsByInstance(upstream)
+		Expect(endpoints["i-running"].HealthStatus).To(Equal(v1.HealthStatus_HEALTHY))
+		Expect(endpoints["i-impaired"].HealthStatus).To(Equal(v1.HealthStatus_UNHEALTHY))
+		Expect(endpoints["i-initializing"].HealthStatus).To(Equal(v1.HealthStatus_UNKNOWN))
+	})
+
+	It("only describes the status of instances when an upstream uses the status checks", func() {
+		endpointsByInstance(upstream)
+		Expect(fakeApis["us-east-1"].describeInstanceStatusCalls).To(Equal(0))
+
+		upstream.GetAwsEc2().UseStatusChecks = true
+		endpointsByInstance(upstream)
+		Expect(fakeApis["us-east-1"].describeInstanceStatusCalls).To(Equal(1))
+	})
+
+	It("falls back to the instance states of the credentials whose status checks cannot be listed", func() {
+		fakeApis["us-east-1"].statusErr = errors.New("access denied")
+		fakeApis["eu-west-1"].statuses = []*ec2.InstanceStatus{
+			fakeInstanceStatus("i-europe", ec2.SummaryStatusOk, ec2.SummaryStatusImpaired),
+		}
+		upstream.GetAwsEc2().UseStatusChecks = true
+		other := &v1.Upstream{
+			Metadata: core.Metadata{Name: "eu", Namespace: namespace},
+			UpstreamType: &v1.Upstream_AwsEc2{
+				AwsEc2: &glooec2.UpstreamSpec{
+					Region:          "eu-west-1",
+					Port:            8080,
+					UseStatusChecks: true,
+				},
+			},
+		}
+
+		endpoints := endpointsByInstance(upstream, other)
+		Expect(instanceIds(endpoints)).To(Equal([]string{"i-europe", "i-running"}))
+		Expect(endpoints["i-running"].HealthStatus).To(Equal(v1.HealthStatus_HEALTHY))
+		Expect(endpoints["i-europe"].HealthStatus).To(Equal(v1.HealthStatus_UNHEALTHY))
+	})
+})
+
+func fakeInstance(id, privateIp, state string) *ec2.Instance {
+	return &ec2.Instance{
+		InstanceId:       aws.String(id),
+		PrivateIpAddress: aws.String(privateIp),
+		State:            &ec2.InstanceState{Name: aws.String(state)},
+		Placement:        &ec2.Placement{},
+	}
+}
+
+func fakeInstanceStatus(id, instanceStatus, systemStatus string) *ec2.InstanceStatus {
+	return &ec2.InstanceStatus{
+		InstanceId:     aws.String(id),
+		InstanceStatus: &ec2.InstanceStatusSummary{Status: aws.String(instanceStatus)},
+		SystemStatus:   &ec2.InstanceStatusSummary{Status: aws.String(systemStatus)},
+	}
+}
+
+// fakeEc2Api serves instances and their statuses like the EC2 API, only the calls used by the lister are implemented
+type fakeEc2Api struct {
+	ec2iface.EC2API
+
+	instances []*ec2.Instance
+	statuses  []*ec2.InstanceStatus
+	// returned when describing the status of the instances, if set
+	statusErr error
+	// the number of instances per page, all instances are returned in one page if not set
+	pageSize int
+
+	describeInstancesCalls      int
+	describeInstanceStatusCalls int
+}
+
+func (f *fakeEc2Api) DescribeInstancesPagesWithContext(ctx aws.Context, input *ec2.DescribeInstancesInput, fn func(*ec2.DescribeInstancesOutput, bool) bool, opts ...request.Option) error {
+	f.describeInstancesCalls++
+
+	var instances []*ec2.Instance
+	for _, instance := range f.instances {
+		if matchesStateFilters(instance, input.Filters) {
+			instances = append(instances, instance)
+		}
+	}
+
+	pageSize := f.pageSize
+	if pageSize == 0 {
+		pageSize = len(instances)
+	}
+	for start := 0; start < len(instances); start += pageSize {
+		end := start + pageSize
+		if end > len(instances) {
+			end = len(instances)
+		}
+		page := &ec2.DescribeInstancesOutput{
+			Reservations: []*ec2.Reservation{{Instances: instances[start:end]}},
+		}
+		if !fn(page, end == len(instances)) {
+			break
+		}
+	}
+	return nil
+}
+
+func (f *fakeEc2Api) DescribeInstanceStatusPagesWithContext(ctx aws.Context, input *ec2.DescribeInstanceStatusInput, fn func(*ec2.DescribeInstanceStatusOutput, bool) bool, opts ...request.Option) error {
+	f.describeInstanceStatusCalls++
+	if f.statusErr != nil {
+		return f.statusErr
+	}
+	fn(&ec2.DescribeInstanceStatusOutput{InstanceStatuses: f.statuses}, true)
+	return nil
+}
+
+func matchesStateFilters(instance *ec2.Instance, filters []*ec2.Filter) bool {
+	for _, filter := range filters {
+		if aws.StringValue(filter.Name) != "instance-state-name" {
+			continue
+		}
+		if !containsString(aws.StringValueSlice(filter.Values), aws.StringValue(instance.State.Name)) {
+			return false
+		}
+	}
+	return true
+}
@@ -47,9 +47,10 @@ var _ = Describe("polling", func() {
 		epw = testEndpointsWatcher(ctx, writeNamespace, upstreams, secretClient, refreshRate, responses)
 		ref1 := testUpstream1.Metadata.Ref()
 		matchPollResponse(epw, v1.EndpointList{{
-			Upstreams: []*core.ResourceRef{&ref1},
-			Address:   testPrivateIp1,
-			Port:      testPort1,
+			Upstreams:    []*core.ResourceRef{&ref1},
+			Address:      testPrivateIp1,
+			Port:         testPort1,
+			HealthStatus: v1.HealthStatus_HEALTHY,
 			Metadata: core.Metadata{
 				Name:        "ec2-name-u1-namespace-default--111-111-111-111",
 				Namespace:   "default",
@@ -62,9 +63,10 @@ var _ = Describe("polling", func() {
 		epw = testEndpointsWatcher(ctx, writeNamespace, upstreams, secretClient, refreshRate, responses)
 		ref2 := testUpstream2.Metadata.Ref()
 		matchPollResponse(epw, v1.EndpointList{{
-			Upstreams: []*core.ResourceRef{&ref2},
-			Address:   testPublicIp1,
-			Port:      testPort1,
+			Upstreams:    []*core.ResourceRef{&ref2},
+			Address:      testPublicIp1,
+			Port:         testPort1,
+			HealthStatus: v1.HealthStatus_HEALTHY,
 			Metadata: core.Metadata{
 				Name:        "ec2-name-u2-namespace-default--222-222-222-222",
 				Namespace:   "default",
@@ -144,12 +146,16 @@ func (m *mockEc2InstanceLister) ListForCredentials(ctx context.Context, cred *Cr
 	return v, nil
 }
 
+func (m *mockEc2InstanceLister) ListStatusesForCredentials(ctx context.Context, cred *CredentialSpec, secrets v1.SecretList) ([]*ec2.InstanceStatus, error) {
+	return nil, nil
+}
+
 func getSecretClient(ctx context.Context) v1.SecretClient {
 	config := &rest.Config{}
 	mc := memory.NewInMemoryResourceCache()
 	var kubeCoreCache corecache.KubeCoreCache
 	settings := &v1.Settings{}
-	secretFactory, err := bootstrap.SecretFactoryForSettings(ctx, settings, mc, &config, nil, &kubeCoreCache, nil, v1.SecretCrd.Plural)
+	secretFactory, err := bootstrap.SecretFactoryForSettings(ctx, settings, mc, &config, nil, &kubeCoreCache, nil, nil, v1.SecretCrd.Plural)
 	Expect(err).NotTo(HaveOccurred())
 	secretClient, err := v1.NewSecretClient(secretFactory)
 	Expect(err).NotTo(HaveOccurred())
@@ -175,6 +181,7 @@ func getMockListerResponses() mockListerResponses {
 			Value: aws.String("any old value"),
 		}},
 		VpcId: aws.String("id1"),
+		State: &ec2.InstanceState{Name: aws.String(ec2.InstanceStateNameRunning)},
 	}}
 	ec2Upstream2 := &glooec2.UpstreamSpec{
 		Region:    region1,
@@ -191,6 +198,7 @@ func getMockListerResponses() mockListerResponses {
 			Value: aws.String("v2"),
 		}},
 		VpcId: aws.String("id2"),
+		State: &ec2.InstanceState{Name: aws.String(ec2.InstanceStateNameRunning)},
 	}}
 	return resp
 }
@@ -0,0 +1,14 @@
+package ecs
+
+import (
+	"testing"
+
+	"github.com/solo-io/go-utils/testutils"
+
+	. "github.com/onsi/ginkgo"
+)
+
+func TestEcs(t *testing.T) {
+	testutils.RegisterCommonFailHandlers()
+	RunSpecs(t, "ECS Suite")
+}
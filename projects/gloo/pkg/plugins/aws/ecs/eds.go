@@ -0,0 +1,133 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/solo-io/gloo/pkg/utils/settingsutil"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/kubeutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDS API
+// start the EDS watch which sends a new list of endpoints on any change
+func (p *plugin) WatchEndpoints(writeNamespace string, unfilteredUpstreams v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
+	contextutils.LoggerFrom(opts.Ctx).Debugw("calling WatchEndpoints on ECS")
+	var ecsUpstreams v1.UpstreamList
+	for _, upstream := range unfilteredUpstreams {
+		if _, ok := upstream.GetUpstreamType().(*v1.Upstream_AwsEcs); ok {
+			ecsUpstreams = append(ecsUpstreams, upstream)
+		}
+	}
+	return newEndpointsWatcher(opts.Ctx, writeNamespace, ecsUpstreams, p.secretClient, opts.RefreshRate).poll()
+}
+
+type edsWatcher struct {
+	upstreams        v1.UpstreamList
+	watchContext     context.Context
+	secretClient     v1.SecretClient
+	refreshRate      time.Duration
+	writeNamespace   string
+	taskLister       TaskLister
+	secretNamespaces []string
+}
+
+func newEndpointsWatcher(watchCtx context.Context, writeNamespace string, upstreams v1.UpstreamList, secretClient v1.SecretClient, parentRefreshRate time.Duration) *edsWatcher {
+	var namespaces []string
+
+	// We either watch all namespaces, or list the secrets of the namespaces the upstreams refer to
+	settings := settingsutil.FromContext(watchCtx)
+	if settingsutil.IsAllNamespacesFromSettings(settings) {
+		namespaces = []string{metav1.NamespaceAll}
+	} else {
+		nsSet := map[string]bool{}
+		for _, upstream := range upstreams {
+			if secretRef := upstream.GetAwsEcs().GetSecretRef(); secretRef != nil {
+				nsSet[secretRef.Namespace] = true
+			}
+		}
+		for ns := range nsSet {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	return &edsWatcher{
+		upstreams:        upstreams,
+		watchContext:     watchCtx,
+		secretClient:     secretClient,
+		refreshRate:      getRefreshRate(parentRefreshRate),
+		writeNamespace:   writeNamespace,
+		taskLister:       NewTaskLister(),
+		secretNamespaces: namespaces,
+	}
+}
+
+// as with EC2, we are calling AWS during our watches, so we set a minimum refresh rate of thirty seconds to avoid
+// ratelimit concerns
+const minRefreshRate = 30 * time.Second
+
+func getRefreshRate(parentRefreshRate time.Duration) time.Duration {
+	if parentRefreshRate < minRefreshRate {
+		return minRefreshRate
+	}
+	return parentRefreshRate
+}
+
+func (c *edsWatcher) updateEndpointsList(endpointsChan chan v1.EndpointList, errs chan error) {
+	var secrets v1.SecretList
+	for _, ns := range c.secretNamespaces {
+		nsSecrets, err := c.secretClient.List(ns, clients.ListOpts{Ctx: c.watchContext})
+		if err != nil {
+			errs <- err
+			return
+		}
+		secrets = append(secrets, nsSecrets...)
+	}
+
+	allEndpoints, err := getLatestEndpoints(c.watchContext, c.taskLister, secrets, c.writeNamespace, c.upstreams)
+	if err != nil {
+		errs <- err
+		return
+	}
+	select {
+	case <-c.watchContext.Done():
+		return
+	case endpointsChan <- allEndpoints:
+	}
+}
+
+func (c *edsWatcher) poll() (<-chan v1.EndpointList, <-chan error, error) {
+	endpointsChan := make(chan v1.EndpointList)
+	errs := make(chan error)
+	go func() {
+		defer close(endpointsChan)
+		defer close(errs)
+
+		c.updateEndpointsList(endpointsChan, errs)
+		ticker := time.NewTicker(c.refreshRate)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case _, ok := <-ticker.C:
+				if !ok {
+					return
+				}
+				c.updateEndpointsList(endpointsChan, errs)
+			case <-c.watchContext.Done():
+				return
+			}
+		}
+	}()
+	return endpointsChan, errs, nil
+}
+
+const ecsEndpointNamePrefix = "ecs"
+
+func generateName(upstreamRef core.ResourceRef, address string) string {
+	return kubeutils.SanitizeNameV2(fmt.Sprintf("%v-%v-%v", ecsEndpointNamePrefix, upstreamRef.String(), address))
+}
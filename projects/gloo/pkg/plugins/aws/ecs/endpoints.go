@@ -0,0 +1,214 @@
+package ecs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws/ec2"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"go.uber.org/zap"
+)
+
+const TaskArnAnnotationKey = "taskArn"
+
+// the status of the tasks that can receive traffic
+const taskStatusRunning = "RUNNING"
+
+// the network interface attachment of tasks that use the awsvpc network mode (such as all Fargate tasks)
+const (
+	networkInterfaceAttachmentType     = "ElasticNetworkInterface"
+	networkInterfacePrivateIpv4Address = "privateIPv4Address"
+)
+
+// serviceKey identifies the tasks of a service, as seen with a set of credentials
+type serviceKey struct {
+	credentials ec2.CredentialKey
+	cluster     string
+	serviceName string
+}
+
+// serviceGroup exists to list the tasks of a service once for all the upstreams that route to it
+type serviceGroup struct {
+	credentialSpec *ec2.CredentialSpec
+	cluster        string
+	serviceName    string
+	// all the upstreams that share the credentials, cluster and service
+	upstreams v1.UpstreamList
+}
+
+// getLatestEndpoints lists the tasks of the services of the upstreams, and returns an endpoint for the container of
+// each running task.
+// NOTE: MUST filter the upstreamList to ONLY ECS upstreams before calling this function
+func getLatestEndpoints(ctx context.Context, lister TaskLister, secrets v1.SecretList, writeNamespace string, upstreamList v1.UpstreamList) (v1.EndpointList, error) {
+	var allEndpoints v1.EndpointList
+	for _, group := range getServiceGroupsFromUpstreams(upstreamList) {
+		tasks, err := lister.ListForService(ctx, group.credentialSpec, group.cluster, group.serviceName, secrets)
+		if err != nil {
+			return nil, err
+		}
+		for _, upstream := range group.upstreams {
+			for _, task := range tasks.Tasks {
+				endpoint := upstreamTaskToEndpoint(ctx, writeNamespace, upstream, tasks.TaskDefinitions, task)
+				if endpoint == nil {
+					continue
+				}
+				allEndpoints = append(allEndpoints, endpoint)
+			}
+		}
+	}
+	return allEndpoints, nil
+}
+
+// groups the upstreams by credentials, cluster and service, preserving the order of the upstreams
+// NOTE: assumes that upstreams are ECS upstreams
+func getServiceGroupsFromUpstreams(upstreams v1.UpstreamList) []*serviceGroup {
+	var groups []*serviceGroup
+	groupsByKey := make(map[serviceKey]*serviceGroup)
+	for _, upstream := range upstreams {
+		spec := upstream.GetAwsEcs()
+		cred := ec2.NewCredentialSpec(spec.GetSecretRef(), spec.GetRegion(), spec.GetRoleArn())
+		key := serviceKey{
+			credentials: cred.GetKey(),
+			cluster:     spec.GetCluster(),
+			serviceName: spec.GetServiceName(),
+		}
+		if group, ok := groupsByKey[key]; ok {
+			group.upstreams = append(group.upstreams, upstream)
+			continue
+		}
+		group := &serviceGroup{
+			credentialSpec: cred,
+			cluster:        spec.GetCluster(),
+			serviceName:    spec.GetServiceName(),
+			upstreams:      v1.UpstreamList{upstream},
+		}
+		groupsByKey[key] = group
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+// NOTE: assumes that upstreams are ECS upstreams
+func upstreamTaskToEndpoint(ctx context.Context, writeNamespace string, upstream *v1.Upstream, taskDefinitions map[string]*ecs.TaskDefinition, task *ecs.Task) *v1.Endpoint {
+	logger := contextutils.LoggerFrom(ctx)
+	spec := upstream.GetAwsEcs()
+	if aws.StringValue(task.LastStatus) != taskStatusRunning {
+		logger.Debugw("task is not running, skipping",
+			zap.Any("upstreamRef", upstream.GetMetadata().Ref()),
+			zap.Any("taskArn", aws.StringValue(task.TaskArn)),
+			zap.Any("lastStatus", aws.StringValue(task.LastStatus)))
+		return nil
+	}
+	container := taskContainer(task, spec.GetContainerName())
+	if container == nil {
+		logger.Warnw("no matching container found in task",
+			zap.Any("upstreamRef", upstream.GetMetadata().Ref()),
+			zap.Any("taskArn", aws.StringValue(task.TaskArn)),
+			zap.Any("containerName", spec.GetContainerName()))
+		return nil
+	}
+	address := containerAddress(task, container)
+	if address == "" {
+		logger.Warnw("no ip found for task, only tasks that use the awsvpc network mode are supported",
+			zap.Any("upstreamRef", upstream.GetMetadata().Ref()),
+			zap.Any("taskArn", aws.StringValue(task.TaskArn)))
+		return nil
+	}
+	port := spec.GetPort()
+	if port == 0 {
+		port = containerPort(taskDefinitions[aws.StringValue(task.TaskDefinitionArn)], aws.StringValue(container.Name))
+	}
+	if port == 0 {
+		logger.Warnw("no port found for container, set the port on the upstream or a port mapping on the container",
+			zap.Any("upstreamRef", upstream.GetMetadata().Ref()),
+			zap.Any("taskArn", aws.StringValue(task.TaskArn)),
+			zap.Any("containerName", aws.StringValue(container.Name)))
+		return nil
+	}
+	ref := upstream.Metadata.Ref()
+	endpoint := &v1.Endpoint{
+		Upstreams:    []*core.ResourceRef{&ref},
+		Address:      address,
+		Port:         port,
+		HealthStatus: containerHealthStatus(container),
+		Locality: &v1.Locality{
+			Region: spec.GetRegion(),
+			Zone:   aws.StringValue(task.AvailabilityZone),
+		},
+		Metadata: core.Metadata{
+			Name:      generateName(ref, address),
+			Namespace: writeNamespace,
+			// for easier debugging, add the task arn to the xds output
+			Annotations: map[string]string{TaskArnAnnotationKey: aws.StringValue(task.TaskArn)},
+		},
+	}
+	logger.Debugw("task from upstream",
+		zap.Any("upstream", upstream),
+		zap.Any("task", task),
+		zap.Any("endpoint", endpoint))
+	return endpoint
+}
+
+// taskContainer returns the container of the task with the given name, or the first container if no name is given
+func taskContainer(task *ecs.Task, name string) *ecs.Container {
+	for _, container := range task.Containers {
+		if name == "" || aws.StringValue(container.Name) == name {
+			return container
+		}
+	}
+	return nil
+}
+
+// the address of a container is the private address of the network interface of its task
+func containerAddress(task *ecs.Task, container *ecs.Container) string {
+	for _, networkInterface := range container.NetworkInterfaces {
+		if address := aws.StringValue(networkInterface.PrivateIpv4Address); address != "" {
+			return address
+		}
+	}
+	for _, attachment := range task.Attachments {
+		if aws.StringValue(attachment.Type) != networkInterfaceAttachmentType {
+			continue
+		}
+		for _, detail := range attachment.Details {
+			if aws.StringValue(detail.Name) == networkInterfacePrivateIpv4Address {
+				return aws.StringValue(detail.Value)
+			}
+		}
+	}
+	return ""
+}
+
+// containerPort returns the first container port of the port mappings of the container in the task definition
+func containerPort(taskDefinition *ecs.TaskDefinition, containerName string) uint32 {
+	if taskDefinition == nil {
+		return 0
+	}
+	for _, definition := range taskDefinition.ContainerDefinitions {
+		if aws.StringValue(definition.Name) != containerName {
+			continue
+		}
+		for _, mapping := range definition.PortMappings {
+			if port := aws.Int64Value(mapping.ContainerPort); port > 0 {
+				return uint32(port)
+			}
+		}
+	}
+	return 0
+}
+
+// containerHealthStatus maps the health of a container, as reported by its ECS health check, to the health status of
+// its endpoint. Containers without a health check have an unknown health status.
+func containerHealthStatus(container *ecs.Container) v1.HealthStatus {
+	switch aws.StringValue(container.HealthStatus) {
+	case ecs.HealthStatusHealthy:
+		return v1.HealthStatus_HEALTHY
+	case ecs.HealthStatusUnhealthy:
+		return v1.HealthStatus_UNHEALTHY
+	default:
+		return v1.HealthStatus_UNKNOWN
+	}
+}
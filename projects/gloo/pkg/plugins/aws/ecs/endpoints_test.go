@@ -0,0 +1,182 @@
+package ecs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	"github.com/gogo/protobuf/proto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooecs "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws/ecs"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws/ec2"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("ECS endpoints", func() {
+
+	var (
+		ctx      context.Context
+		lister   *mockTaskLister
+		upstream *v1.Upstream
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		lister = &mockTaskLister{
+			tasks: map[string]*ServiceTasks{
+				"cluster/service": {
+					Tasks: []*ecs.Task{
+						fakeTask("task-1", "10.0.0.1", taskStatusRunning, ecs.HealthStatusHealthy),
+						fakeTask("task-2", "10.0.0.2", taskStatusRunning, ecs.HealthStatusUnhealthy),
+						fakeTask("task-3", "10.0.0.3", "PROVISIONING", ecs.HealthStatusUnknown),
+					},
+					TaskDefinitions: map[string]*ecs.TaskDefinition{
+						"td": {
+							ContainerDefinitions: []*ecs.ContainerDefinition{
+								{Name: aws.String("sidecar")},
+								{
+									Name:         aws.String("app"),
+									PortMappings: []*ecs.PortMapping{{ContainerPort: aws.Int64(8080)}},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{Name: "u1", Namespace: "default"},
+			UpstreamType: &v1.Upstream_AwsEcs{
+				AwsEcs: &glooecs.UpstreamSpec{
+					Region:        "us-east-1",
+					Cluster:       "cluster",
+					ServiceName:   "service",
+					ContainerName: "app",
+				},
+			},
+		}
+	})
+
+	It("creates an endpoint for the container of each running task", func() {
+		endpoints, err := getLatestEndpoints(ctx, lister, nil, "gloo-system", v1.UpstreamList{upstream})
+		Expect(err).NotTo(HaveOccurred())
+
+		ref := upstream.Metadata.Ref()
+		Expect(endpoints).To(Equal(v1.EndpointList{
+			{
+				Upstreams:    []*core.ResourceRef{&ref},
+				Address:      "10.0.0.1",
+				Port:         8080,
+				HealthStatus: v1.HealthStatus_HEALTHY,
+				Locality:     &v1.Locality{Region: "us-east-1", Zone: "us-east-1a"},
+				Metadata: core.Metadata{
+					Name:        "ecs-name-u1-namespace-default--10-0-0-1",
+					Namespace:   "gloo-system",
+					Annotations: map[string]string{TaskArnAnnotationKey: "task-1"},
+				},
+			},
+			{
+				Upstreams:    []*core.ResourceRef{&ref},
+				Address:      "10.0.0.2",
+				Port:         8080,
+				HealthStatus: v1.HealthStatus_UNHEALTHY,
+				Locality:     &v1.Locality{Region: "us-east-1", Zone: "us-east-1a"},
+				Metadata: core.Metadata{
+					Name:        "ecs-name-u1-namespace-default--10-0-0-2",
+					Namespace:   "gloo-system",
+					Annotations: map[string]string{TaskArnAnnotationKey: "task-2"},
+				},
+			},
+		}))
+	})
+
+	It("uses the port of the upstream", func() {
+		upstream.GetAwsEcs().Port = 9090
+		endpoints, err := getLatestEndpoints(ctx, lister, nil, "gloo-system", v1.UpstreamList{upstream})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpoints).To(HaveLen(2))
+		Expect(endpoints[0].Port).To(Equal(uint32(9090)))
+	})
+
+	It("skips containers without a port", func() {
+		upstream.GetAwsEcs().ContainerName = "sidecar"
+		endpoints, err := getLatestEndpoints(ctx, lister, nil, "gloo-system", v1.UpstreamList{upstream})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpoints).To(BeEmpty())
+	})
+
+	It("reads the address of the task from its network interface attachment", func() {
+		task := lister.tasks["cluster/service"].Tasks[0]
+		task.Containers[1].NetworkInterfaces = nil
+		task.Attachments = []*ecs.Attachment{{
+			Type: aws.String(networkInterfaceAttachmentType),
+			Details: []*ecs.KeyValuePair{
+				{Name: aws.String("subnetId"), Value: aws.String("subnet-1")},
+				{Name: aws.String(networkInterfacePrivateIpv4Address), Value: aws.String("10.0.1.1")},
+			},
+		}}
+		endpoints, err := getLatestEndpoints(ctx, lister, nil, "gloo-system", v1.UpstreamList{upstream})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpoints[0].Address).To(Equal("10.0.1.1"))
+	})
+
+	It("lists the tasks of a service once for the upstreams that share it", func() {
+		upstream2 := proto.Clone(upstream).(*v1.Upstream)
+		upstream2.Metadata.Name = "u2"
+		endpoints, err := getLatestEndpoints(ctx, lister, nil, "gloo-system", v1.UpstreamList{upstream, upstream2})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpoints).To(HaveLen(4))
+		Expect(lister.calls).To(Equal(1))
+	})
+
+	It("configures the cluster to use EDS", func() {
+		out := &envoyapi.Cluster{}
+		err := NewPlugin(nil).ProcessUpstream(plugins.Params{}, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetType()).To(Equal(envoyapi.Cluster_EDS))
+	})
+
+	It("rejects upstreams without a service name", func() {
+		upstream.GetAwsEcs().ServiceName = ""
+		err := NewPlugin(nil).ProcessUpstream(plugins.Params{}, upstream, &envoyapi.Cluster{})
+		Expect(err).To(MatchError(MissingServiceNameError()))
+	})
+})
+
+func fakeTask(arn, address, status, health string) *ecs.Task {
+	return &ecs.Task{
+		TaskArn:           aws.String(arn),
+		TaskDefinitionArn: aws.String("td"),
+		LastStatus:        aws.String(status),
+		AvailabilityZone:  aws.String("us-east-1a"),
+		Containers: []*ecs.Container{
+			{
+				Name:              aws.String("sidecar"),
+				NetworkInterfaces: []*ecs.NetworkInterface{{PrivateIpv4Address: aws.String(address)}},
+			},
+			{
+				Name:              aws.String("app"),
+				HealthStatus:      aws.String(health),
+				NetworkInterfaces: []*ecs.NetworkInterface{{PrivateIpv4Address: aws.String(address)}},
+			},
+		},
+	}
+}
+
+type mockTaskLister struct {
+	// the tasks of each service, by cluster/service
+	tasks map[string]*ServiceTasks
+	calls int
+}
+
+func (m *mockTaskLister) ListForService(ctx context.Context, cred *ec2.CredentialSpec, cluster, serviceName string, secrets v1.SecretList) (*ServiceTasks, error) {
+	m.calls++
+	if tasks, ok := m.tasks[cluster+"/"+serviceName]; ok {
+		return tasks, nil
+	}
+	return &ServiceTasks{}, nil
+}
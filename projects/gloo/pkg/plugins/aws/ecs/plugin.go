@@ -0,0 +1,113 @@
+package ecs
+
+import (
+	"reflect"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+)
+
+/*
+Steps:
+- User creates an ECS upstream
+  - names the cluster, service and container that should be made into Endpoints
+- Discovery lists the running tasks of the service with ListTasks and DescribeTasks
+- Gloo plugin creates an endpoint for the container of each task
+*/
+
+type plugin struct {
+	secretClient v1.SecretClient
+
+	// as with the EC2 plugin, the secret client is created with the plugin, since our EDS poll can begin before Init
+	// is called. Errors from creating the secret client are returned by Init.
+	constructorErr error
+}
+
+// checks to ensure interfaces are implemented
+var _ plugins.Plugin = new(plugin)
+var _ plugins.UpstreamPlugin = new(plugin)
+var _ discovery.DiscoveryPlugin = new(plugin)
+
+func NewPlugin(secretFactory factory.ResourceClientFactory) *plugin {
+	p := &plugin{}
+	var err error
+	if secretFactory == nil {
+		p.constructorErr = ConstructorInputError("secret")
+		return p
+	}
+	p.secretClient, err = v1.NewSecretClient(secretFactory)
+	if err != nil {
+		p.constructorErr = ConstructorGetClientError("secret", err)
+		return p
+	}
+	if err := p.secretClient.Register(); err != nil {
+		p.constructorErr = ConstructorRegisterClientError("secret", err)
+		return p
+	}
+	return p
+}
+
+func (p *plugin) Init(params plugins.InitParams) error {
+	return p.constructorErr
+}
+
+// we do not need to update any fields, just check that the input is valid
+func (p *plugin) UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
+	originalSpec, ok := original.UpstreamType.(*v1.Upstream_AwsEcs)
+	if !ok {
+		return false, WrongUpstreamTypeError(original)
+	}
+	desiredSpec, ok := desired.UpstreamType.(*v1.Upstream_AwsEcs)
+	if !ok {
+		return false, WrongUpstreamTypeError(desired)
+	}
+	if !originalSpec.Equal(desiredSpec) {
+		return false, UpstreamDeltaError()
+	}
+	return false, nil
+}
+
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
+	spec, ok := in.UpstreamType.(*v1.Upstream_AwsEcs)
+	if !ok {
+		return nil
+	}
+	if spec.AwsEcs.GetServiceName() == "" {
+		return MissingServiceNameError()
+	}
+
+	// the endpoints come from EDS
+	xds.SetEdsOnCluster(out)
+	return nil
+}
+
+var (
+	ConstructorInputError = func(factoryType string) error {
+		return eris.Errorf("must provide %v factory for ECS plugin", factoryType)
+	}
+
+	ConstructorGetClientError = func(name string, err error) error {
+		return eris.Wrapf(err, "unable to get %v client for ECS plugin", name)
+	}
+
+	ConstructorRegisterClientError = func(name string, err error) error {
+		return eris.Wrapf(err, "unable to register %v client for ECS plugin", name)
+	}
+
+	WrongUpstreamTypeError = func(upstream *v1.Upstream) error {
+		return eris.Errorf("internal error: expected *v1.Upstream_AwsEcs, got %v", reflect.TypeOf(upstream.UpstreamType).Name())
+	}
+
+	UpstreamDeltaError = func() error {
+		return eris.New("expected no difference between *v1.Upstream_AwsEcs upstreams")
+	}
+
+	MissingServiceNameError = func() error {
+		return eris.New("ECS upstreams must specify the name of an ECS service")
+	}
+)
@@ -0,0 +1,131 @@
+package ecs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws/ec2"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
+)
+
+// DescribeTasks accepts at most 100 tasks per call
+const describeTasksBatchSize = 100
+
+// ServiceTasks are the running tasks of an ECS service, with the task definitions they were started from
+type ServiceTasks struct {
+	Tasks []*ecs.Task
+	// the task definitions of the tasks, by task definition ARN
+	TaskDefinitions map[string]*ecs.TaskDefinition
+}
+
+// TaskLister is a simple interface for calling the AWS API.
+// This allows us to easily mock the API in our tests.
+type TaskLister interface {
+	ListForService(ctx context.Context, cred *ec2.CredentialSpec, cluster, serviceName string, secrets v1.SecretList) (*ServiceTasks, error)
+}
+
+type taskLister struct {
+	// creates the client for the given credentials, tests replace it with a fake ECS API
+	newClient func(cred *ec2.CredentialSpec, secrets v1.SecretList) (ecsiface.ECSAPI, error)
+}
+
+func NewTaskLister() *taskLister {
+	return &taskLister{
+		newClient: func(cred *ec2.CredentialSpec, secrets v1.SecretList) (ecsiface.ECSAPI, error) {
+			svc, err := GetEcsClient(cred, secrets)
+			if err != nil {
+				return nil, err
+			}
+			return svc, nil
+		},
+	}
+}
+
+var _ TaskLister = &taskLister{}
+
+// GetEcsClient creates an ECS client with the same credential, role and secret handling as the EC2 client
+func GetEcsClient(cred *ec2.CredentialSpec, secrets v1.SecretList) (*ecs.ECS, error) {
+	sess, err := ec2.GetAwsSessionForCredentials(cred, secrets)
+	if err != nil {
+		return nil, err
+	}
+	return ecs.New(sess), nil
+}
+
+func (l *taskLister) ListForService(ctx context.Context, cred *ec2.CredentialSpec, cluster, serviceName string, secrets v1.SecretList) (*ServiceTasks, error) {
+	svc, err := l.newClient(cred, secrets)
+	if err != nil {
+		return nil, ec2.GetClientError(err)
+	}
+	return l.ListWithClient(ctx, svc, cluster, serviceName)
+}
+
+func (l *taskLister) ListWithClient(ctx context.Context, svc ecsiface.ECSAPI, cluster, serviceName string) (*ServiceTasks, error) {
+	var taskArns []*string
+	listInput := &ecs.ListTasksInput{
+		ServiceName:   aws.String(serviceName),
+		DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+	}
+	if cluster != "" {
+		listInput.Cluster = aws.String(cluster)
+	}
+	err := svc.ListTasksPagesWithContext(ctx, listInput, func(r *ecs.ListTasksOutput, more bool) bool {
+		taskArns = append(taskArns, r.TaskArns...)
+		return true
+	})
+	if err != nil {
+		return nil, ListTasksError(err, serviceName)
+	}
+
+	result := &ServiceTasks{TaskDefinitions: make(map[string]*ecs.TaskDefinition)}
+	for start := 0; start < len(taskArns); start += describeTasksBatchSize {
+		end := start + describeTasksBatchSize
+		if end > len(taskArns) {
+			end = len(taskArns)
+		}
+		describeInput := &ecs.DescribeTasksInput{
+			Cluster: listInput.Cluster,
+			Tasks:   taskArns[start:end],
+		}
+		out, err := svc.DescribeTasksWithContext(ctx, describeInput)
+		if err != nil {
+			return nil, DescribeTasksError(err, serviceName)
+		}
+		result.Tasks = append(result.Tasks, out.Tasks...)
+	}
+
+	// the tasks of a service share a few task definitions (usually one, or two during a deployment)
+	for _, task := range result.Tasks {
+		arn := aws.StringValue(task.TaskDefinitionArn)
+		if _, ok := result.TaskDefinitions[arn]; ok || arn == "" {
+			continue
+		}
+		out, err := svc.DescribeTaskDefinitionWithContext(ctx, &ecs.DescribeTaskDefinitionInput{TaskDefinition: aws.String(arn)})
+		if err != nil {
+			return nil, DescribeTaskDefinitionError(err, arn)
+		}
+		result.TaskDefinitions[arn] = out.TaskDefinition
+	}
+
+	contextutils.LoggerFrom(ctx).Debugw("ecsUpstream result", zap.Any("value", result))
+	return result, nil
+}
+
+var (
+	ListTasksError = func(err error, serviceName string) error {
+		return eris.Wrapf(err, "unable to list the tasks of service %v", serviceName)
+	}
+
+	DescribeTasksError = func(err error, serviceName string) error {
+		return eris.Wrapf(err, "unable to describe the tasks of service %v", serviceName)
+	}
+
+	DescribeTaskDefinitionError = func(err error, taskDefinitionArn string) error {
+		return eris.Wrapf(err, "unable to describe task definition %v", taskDefinitionArn)
+	}
+)
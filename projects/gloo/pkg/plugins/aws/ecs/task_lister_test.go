@@ -0,0 +1,138 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws/ec2"
+)
+
+var _ = Describe("task lister", func() {
+
+	var (
+		ctx    context.Context
+		api    *fakeEcsApi
+		lister *taskLister
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		api = &fakeEcsApi{
+			pageSize: 2,
+			taskDefinitions: map[string]*ecs.TaskDefinition{
+				"td-1": {TaskDefinitionArn: aws.String("td-1")},
+				"td-2": {TaskDefinitionArn: aws.String("td-2")},
+			},
+		}
+		lister = &taskLister{
+			newClient: func(cred *ec2.CredentialSpec, secrets v1.SecretList) (ecsiface.ECSAPI, error) {
+				return api, nil
+			},
+		}
+	})
+
+	It("lists the running tasks of the service in every page, with their task definitions", func() {
+		for i := 0; i < 5; i++ {
+			api.tasks = append(api.tasks, &ecs.Task{
+				TaskArn:           aws.String(fmt.Sprintf("task-%v", i)),
+				TaskDefinitionArn: aws.String(fmt.Sprintf("td-%v", i%2+1)),
+			})
+		}
+
+		tasks, err := lister.ListForService(ctx, ec2.NewCredentialSpec(nil, "us-east-1", ""), "cluster", "service", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tasks.Tasks).To(HaveLen(5))
+		Expect(tasks.TaskDefinitions).To(HaveLen(2))
+		Expect(tasks.TaskDefinitions).To(HaveKey("td-1"))
+		Expect(tasks.TaskDefinitions).To(HaveKey("td-2"))
+
+		Expect(api.listInputs).To(HaveLen(1))
+		Expect(aws.StringValue(api.listInputs[0].Cluster)).To(Equal("cluster"))
+		Expect(aws.StringValue(api.listInputs[0].ServiceName)).To(Equal("service"))
+		Expect(aws.StringValue(api.listInputs[0].DesiredStatus)).To(Equal(ecs.DesiredStatusRunning))
+		// each task definition is described once
+		Expect(api.describedTaskDefinitions).To(ConsistOf("td-1", "td-2"))
+	})
+
+	It("describes the tasks in batches", func() {
+		for i := 0; i < 250; i++ {
+			api.tasks = append(api.tasks, &ecs.Task{TaskArn: aws.String(fmt.Sprintf("task-%v", i))})
+		}
+		api.pageSize = 100
+
+		tasks, err := lister.ListForService(ctx, ec2.NewCredentialSpec(nil, "us-east-1", ""), "", "service", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tasks.Tasks).To(HaveLen(250))
+		Expect(api.describeBatchSizes).To(Equal([]int{100, 100, 50}))
+		// an empty cluster lets ECS use the default cluster
+		Expect(api.listInputs[0].Cluster).To(BeNil())
+	})
+
+	It("does not call DescribeTasks for a service without tasks", func() {
+		tasks, err := lister.ListForService(ctx, ec2.NewCredentialSpec(nil, "us-east-1", ""), "cluster", "service", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tasks.Tasks).To(BeEmpty())
+		Expect(api.describeBatchSizes).To(BeEmpty())
+	})
+})
+
+// fakeEcsApi serves the tasks it holds, in pages
+type fakeEcsApi struct {
+	ecsiface.ECSAPI
+
+	pageSize        int
+	tasks           []*ecs.Task
+	taskDefinitions map[string]*ecs.TaskDefinition
+
+	listInputs               []*ecs.ListTasksInput
+	describeBatchSizes       []int
+	describedTaskDefinitions []string
+}
+
+func (f *fakeEcsApi) ListTasksPagesWithContext(ctx aws.Context, input *ecs.ListTasksInput, fn func(*ecs.ListTasksOutput, bool) bool, opts ...request.Option) error {
+	f.listInputs = append(f.listInputs, input)
+	for start := 0; start < len(f.tasks); start += f.pageSize {
+		end := start + f.pageSize
+		if end > len(f.tasks) {
+			end = len(f.tasks)
+		}
+		page := &ecs.ListTasksOutput{}
+		for _, task := range f.tasks[start:end] {
+			page.TaskArns = append(page.TaskArns, task.TaskArn)
+		}
+		if !fn(page, end == len(f.tasks)) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (f *fakeEcsApi) DescribeTasksWithContext(ctx aws.Context, input *ecs.DescribeTasksInput, opts ...request.Option) (*ecs.DescribeTasksOutput, error) {
+	f.describeBatchSizes = append(f.describeBatchSizes, len(input.Tasks))
+	out := &ecs.DescribeTasksOutput{}
+	for _, arn := range input.Tasks {
+		for _, task := range f.tasks {
+			if aws.StringValue(task.TaskArn) == aws.StringValue(arn) {
+				out.Tasks = append(out.Tasks, task)
+			}
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeEcsApi) DescribeTaskDefinitionWithContext(ctx aws.Context, input *ecs.DescribeTaskDefinitionInput, opts ...request.Option) (*ecs.DescribeTaskDefinitionOutput, error) {
+	arn := aws.StringValue(input.TaskDefinition)
+	f.describedTaskDefinitions = append(f.describedTaskDefinitions, arn)
+	taskDefinition, ok := f.taskDefinitions[arn]
+	if !ok {
+		return nil, fmt.Errorf("task definition %v not found", arn)
+	}
+	return &ecs.DescribeTaskDefinitionOutput{TaskDefinition: taskDefinition}, nil
+}
@@ -0,0 +1,13 @@
+package ecs
+
+import (
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+)
+
+// ECS upstreams are created by the user, not discovered
+// This is just needed to satisfy the DiscoveryPlugin interface
+func (p *plugin) DiscoverUpstreams(watchNamespaces []string, writeNamespace string, opts clients.WatchOpts, discOpts discovery.Opts) (chan v1.UpstreamList, chan error, error) {
+	return nil, nil, nil
+}
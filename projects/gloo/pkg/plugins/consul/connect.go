@@ -0,0 +1,100 @@
+package consul
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	consulplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/consul"
+)
+
+// DefaultConnectServiceName is the service Gloo is registered as in Consul Connect, unless configured otherwise in the settings
+const DefaultConnectServiceName = "gloo"
+
+var (
+	ConnectSslConfigErr = eris.New("Connect-enabled consul upstreams use certificates issued by Consul and cannot have an ssl config")
+
+	ConnectCARootsErr = func(err error) error {
+		return eris.Wrapf(err, "reading the Connect CA roots from the Consul agent")
+	}
+
+	ConnectCALeafErr = func(service string, err error) error {
+		return eris.Wrapf(err, "reading the Connect leaf certificate for service %v from the Consul agent", service)
+	}
+
+	ConnectCertificatesNotWatchedErr = eris.New("Connect-enabled consul upstreams require consul service discovery to be configured in the settings")
+)
+
+// ConnectServiceName returns the service Gloo is registered as in Consul Connect
+func ConnectServiceName(serviceDiscovery *v1.Settings_ConsulConfiguration_ServiceDiscoveryOptions) string {
+	if name := serviceDiscovery.GetConnectServiceName(); name != "" {
+		return name
+	}
+	return DefaultConnectServiceName
+}
+
+// connectTlsContext builds the tls context for connecting to the Connect proxies of a consul service.
+// Gloo presents the leaf certificate of its own Connect service, and only trusts proxies that present
+// a certificate signed by the Connect CA for the destination service.
+// The certificates are watched with blocking queries, and Gloo translates again when consul rotates them.
+func (p *plugin) connectTlsContext(spec *consulplugin.UpstreamSpec) (*envoyauth.UpstreamTlsContext, error) {
+	if p.connectCertificates == nil {
+		return nil, ConnectCertificatesNotWatchedErr
+	}
+	roots, err := p.connectCertificates.Roots()
+	if err != nil {
+		return nil, ConnectCARootsErr(err)
+	}
+	leaf, err := p.connectCertificates.Leaf()
+	if err != nil {
+		return nil, ConnectCALeafErr(p.connectCertificates.Service(), err)
+	}
+
+	// trust every root, not just the active one, so connections keep working while the CA is rotated
+	var trustedCa []string
+	for _, root := range roots.Roots {
+		trustedCa = append(trustedCa, strings.TrimSpace(root.RootCertPEM))
+	}
+
+	return &envoyauth.UpstreamTlsContext{
+		CommonTlsContext: &envoyauth.CommonTlsContext{
+			TlsCertificates: []*envoyauth.TlsCertificate{{
+				CertificateChain: inlineDataSource(leaf.CertPEM),
+				PrivateKey:       inlineDataSource(leaf.PrivateKeyPEM),
+			}},
+			ValidationContextType: &envoyauth.CommonTlsContext_ValidationContext{
+				ValidationContext: &envoyauth.CertificateValidationContext{
+					TrustedCa: inlineDataSource(strings.Join(trustedCa, "\n")),
+					MatchSubjectAltNames: []*envoymatcher.StringMatcher{{
+						MatchPattern: &envoymatcher.StringMatcher_SafeRegex{
+							SafeRegex: &envoymatcher.RegexMatcher{
+								EngineType: &envoymatcher.RegexMatcher_GoogleRe2{GoogleRe2: &envoymatcher.RegexMatcher_GoogleRE2{}},
+								Regex:      connectServiceIdRegex(roots.TrustDomain, spec.GetServiceName()),
+							},
+						},
+					}},
+				},
+			},
+		},
+	}, nil
+}
+
+// connectServiceIdRegex matches the SPIFFE ID Consul puts in the certificates of a service, in any data center.
+// Consul Enterprise puts the namespace of the service, and from 1.11 its admin partition, in the ID, so any of them
+// are accepted.
+func connectServiceIdRegex(trustDomain, service string) string {
+	return fmt.Sprintf("^spiffe://%s(/ap/[^/]+)?/ns/[^/]+/dc/[^/]+/svc/%s$", regexp.QuoteMeta(trustDomain), regexp.QuoteMeta(service))
+}
+
+func inlineDataSource(s string) *envoycore.DataSource {
+	return &envoycore.DataSource{
+		Specifier: &envoycore.DataSource_InlineString{
+			InlineString: s,
+		},
+	}
+}
@@ -11,6 +11,10 @@ import (
 //go:generate gofmt -w ./mocks/
 //go:generate goimports -w ./mocks/
 
+// DnsResolver resolves the host names of consul services to their addresses.
+// It uses the go resolver, which is enough as the addresses are resolved again every DefaultDnsPollingInterval.
+// The dnssrv plugin has its own resolver (dnssrv.DnsSrvResolver), as it needs SRV records and the TTL of the
+// records to know when to resolve them again, which the go resolver does not return.
 type DnsResolver interface {
 	Resolve(ctx context.Context, address string) ([]net.IPAddr, error)
 }
@@ -22,6 +22,7 @@ import (
 
 	"github.com/solo-io/gloo/pkg/utils"
 
+	"github.com/gogo/protobuf/types"
 	consulapi "github.com/hashicorp/consul/api"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/go-utils/errutils"
@@ -37,15 +38,23 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 
 	// Filter out non-consul upstreams
 	trackedServiceToUpstreams := make(map[string][]*v1.Upstream)
+	connectServices := make(map[string]bool)
 	var previousSpecs []*consulapi.CatalogService
 	var previousHash uint64
 	for _, us := range upstreamsToTrack {
 		if consulUsSpec := us.GetConsul(); consulUsSpec != nil {
 			// We generate one upstream for every Consul service name, so this should never happen.
 			trackedServiceToUpstreams[consulUsSpec.ServiceName] = append(trackedServiceToUpstreams[consulUsSpec.ServiceName], us)
+			if consulUsSpec.ConnectEnabled {
+				connectServices[consulUsSpec.ServiceName] = true
+			}
 		}
 	}
 
+	if p.useHealthChecks {
+		return p.watchEndpointsWithHealthChecks(writeNamespace, trackedServiceToUpstreams, connectServices, opts)
+	}
+
 	dataCenters, err := p.client.DataCenters()
 	if err != nil {
 		return nil, nil, err
@@ -96,7 +105,7 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 				ctx, newCancel := context.WithCancel(opts.Ctx)
 				cancel = newCancel
 
-				specs := refreshSpecs(ctx, p.client, serviceMeta, errChan)
+				specs := refreshSpecs(ctx, p.client, serviceMeta, connectServices, errChan)
 				endpoints := buildEndpointsFromSpecs(opts.Ctx, writeNamespace, p.resolver, specs, trackedServiceToUpstreams)
 
 				previousHash = hashutils.MustHash(endpoints)
@@ -129,7 +138,7 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 	return endpointsChan, errChan, nil
 }
 
-func refreshSpecs(ctx context.Context, client consul.ConsulWatcher, serviceMeta []*consul.ServiceMeta, errChan chan error) []*consulapi.CatalogService {
+func refreshSpecs(ctx context.Context, client consul.ConsulWatcher, serviceMeta []*consul.ServiceMeta, connectServices map[string]bool, errChan chan error) []*consulapi.CatalogService {
 	logger := contextutils.LoggerFrom(contextutils.WithLogger(ctx, "consul_eds"))
 
 	specs := newSpecCollector()
@@ -147,7 +156,12 @@ func refreshSpecs(ctx context.Context, client consul.ConsulWatcher, serviceMeta
 			eg.Go(func() error {
 				queryOpts := &consulapi.QueryOptions{Datacenter: dcName, RequireConsistent: true}
 
-				services, _, err := client.Service(svc.Name, "", queryOpts.WithContext(ctx))
+				query := client.Service
+				if connectServices[svc.Name] {
+					// the endpoints of connect-enabled upstreams are the Connect proxies of the service
+					query = client.Connect
+				}
+				services, _, err := query(svc.Name, "", queryOpts.WithContext(ctx))
 				if err != nil {
 					return err
 				}
@@ -176,7 +190,7 @@ func refreshSpecs(ctx context.Context, client consul.ConsulWatcher, serviceMeta
 func buildEndpointsFromSpecs(ctx context.Context, writeNamespace string, resolver DnsResolver, specs []*consulapi.CatalogService, trackedServiceToUpstreams map[string][]*v1.Upstream) v1.EndpointList {
 	var endpoints v1.EndpointList
 	for _, spec := range specs {
-		if upstreams, ok := trackedServiceToUpstreams[spec.ServiceName]; ok {
+		if upstreams, ok := trackedServiceToUpstreams[destinationServiceName(spec)]; ok {
 			// TODO if buildEndpoints fails temporarily due to dns failure, we will remove it from eds.
 			// tracking issue: https://github.com/solo-io/gloo/issues/2576
 			if eps, err := buildEndpoints(ctx, writeNamespace, resolver, spec, upstreams); err != nil {
@@ -262,7 +276,12 @@ func buildEndpoints(ctx context.Context, namespace string, resolver DnsResolver,
 
 	var endpoints []*v1.Endpoint
 	for _, ipAddr := range ipAddresses {
-		endpoints = append(endpoints, buildEndpoint(namespace, ipAddr, service, upstreams))
+		endpoint := buildEndpoint(namespace, ipAddr, service, upstreams)
+		if ipAddr != address {
+			// remember the hostname the address was resolved from
+			endpoint.Hostname = address
+		}
+		endpoints = append(endpoints, endpoint)
 	}
 	return endpoints, nil
 }
@@ -293,8 +312,9 @@ func getIpAddresses(ctx context.Context, address string, resolver DnsResolver) (
 	return ipAddresses, nil
 }
 
+// the catalog does not report health checks, so the health status of the endpoint is left unknown
 func buildEndpoint(namespace, address string, service *consulapi.CatalogService, upstreams []*v1.Upstream) *v1.Endpoint {
-	return &v1.Endpoint{
+	endpoint := &v1.Endpoint{
 		Metadata: core.Metadata{
 			Namespace:       namespace,
 			Name:            buildEndpointName(address, service),
@@ -304,7 +324,23 @@ func buildEndpoint(namespace, address string, service *consulapi.CatalogService,
 		Upstreams: toResourceRefs(upstreams, service.ServiceTags),
 		Address:   address,
 		Port:      uint32(service.ServicePort),
+		Locality: &v1.Locality{
+			Region: service.Datacenter,
+		},
+	}
+	if service.ServiceWeights.Passing > 0 {
+		endpoint.LoadBalancingWeight = &types.UInt32Value{Value: uint32(service.ServiceWeights.Passing)}
+	}
+	return endpoint
+}
+
+// destinationServiceName returns the service a Connect proxy instance forwards to,
+// or the name of the service for any other instance
+func destinationServiceName(service *consulapi.CatalogService) string {
+	if service.ServiceProxy != nil && service.ServiceProxy.DestinationServiceName != "" {
+		return service.ServiceProxy.DestinationServiceName
 	}
+	return service.ServiceName
 }
 
 func buildEndpointName(address string, service *consulapi.CatalogService) string {
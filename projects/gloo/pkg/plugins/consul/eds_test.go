@@ -16,6 +16,7 @@ import (
 
 	. "github.com/solo-io/gloo/projects/gloo/constants"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
 	consulapi "github.com/hashicorp/consul/api"
 	. "github.com/onsi/ginkgo"
@@ -109,7 +110,7 @@ var _ = Describe("Consul EDS", func() {
 				}).Times(3) // once for each datacenter
 
 			expectedEndpointsFirstAttempt = v1.EndpointList{
-				createExpectedEndpoint(buildEndpointName("2.1.0.10", testService), svc1, "2.1.0.10", "100", writeNamespace, 3456, map[string]string{
+				createExpectedEndpoint(buildEndpointName("2.1.0.10", testService), svc1, "2.1.0.10", dc2, "100", writeNamespace, 3456, map[string]string{
 					ConsulTagKeyPrefix + primary:    yes,
 					ConsulTagKeyPrefix + secondary:  yes,
 					ConsulTagKeyPrefix + canary:     yes,
@@ -120,7 +121,7 @@ var _ = Describe("Consul EDS", func() {
 			}
 
 			expectedEndpointsSecondAttempt = v1.EndpointList{
-				createExpectedEndpoint(buildEndpointName("2.1.0.11", testService), svc1, "2.1.0.11", "100", writeNamespace, 3456, map[string]string{
+				createExpectedEndpoint(buildEndpointName("2.1.0.11", testService), svc1, "2.1.0.11", dc2, "100", writeNamespace, 3456, map[string]string{
 					ConsulTagKeyPrefix + primary:    yes,
 					ConsulTagKeyPrefix + secondary:  yes,
 					ConsulTagKeyPrefix + canary:     yes,
@@ -129,6 +130,9 @@ var _ = Describe("Consul EDS", func() {
 					ConsulDataCenterKeyPrefix + dc3: no,
 				}),
 			}
+			for _, ep := range append(expectedEndpointsFirstAttempt, expectedEndpointsSecondAttempt...) {
+				ep.Hostname = buildHostname(svc1, dc2)
+			}
 		})
 
 		AfterEach(func() {
@@ -158,7 +162,7 @@ var _ = Describe("Consul EDS", func() {
 				fmt.Fprint(GinkgoWriter, "Updated resolve called.")
 			}).Return(updatedIps, nil).Times(2)
 
-			eds := NewPlugin(consulWatcherMock, mockDnsResolver, nil)
+			eds := NewPlugin(consulWatcherMock, mockDnsResolver, nil, nil, nil)
 
 			endpointsChan, errorChan, err := eds.WatchEndpoints(writeNamespace, upstreamsToTrack, clients.WatchOpts{Ctx: ctx})
 
@@ -324,7 +328,7 @@ var _ = Describe("Consul EDS", func() {
 
 			expectedEndpointsFirstAttempt = v1.EndpointList{
 				// 5 endpoints for service 1
-				createExpectedEndpoint("1-1-0-1-svc-1-a-1234", "svc-1,svc-1primary", "1.1.0.1", "100", writeNamespace, 1234, map[string]string{
+				createExpectedEndpoint("1-1-0-1-svc-1-a-1234", "svc-1,svc-1primary", "1.1.0.1", dc1, "100", writeNamespace, 1234, map[string]string{
 					ConsulTagKeyPrefix + primary:    yes,
 					ConsulTagKeyPrefix + secondary:  no,
 					ConsulTagKeyPrefix + canary:     no,
@@ -332,7 +336,7 @@ var _ = Describe("Consul EDS", func() {
 					ConsulDataCenterKeyPrefix + dc2: no,
 					ConsulDataCenterKeyPrefix + dc3: no,
 				}),
-				createExpectedEndpoint("1-1-0-2-svc-1-b-1234", "svc-1,svc-1primary", "1.1.0.2", "100", writeNamespace, 1234, map[string]string{
+				createExpectedEndpoint("1-1-0-2-svc-1-b-1234", "svc-1,svc-1primary", "1.1.0.2", dc1, "100", writeNamespace, 1234, map[string]string{
 					ConsulTagKeyPrefix + primary:    yes,
 					ConsulTagKeyPrefix + secondary:  no,
 					ConsulTagKeyPrefix + canary:     no,
@@ -340,7 +344,7 @@ var _ = Describe("Consul EDS", func() {
 					ConsulDataCenterKeyPrefix + dc2: no,
 					ConsulDataCenterKeyPrefix + dc3: no,
 				}),
-				createExpectedEndpoint("2-1-0-10-svc-1-c-3456", "svc-1,svc-1secondary", "2.1.0.10", "100", writeNamespace, 3456, map[string]string{
+				createExpectedEndpoint("2-1-0-10-svc-1-c-3456", "svc-1,svc-1secondary", "2.1.0.10", dc2, "100", writeNamespace, 3456, map[string]string{
 					ConsulTagKeyPrefix + primary:    no,
 					ConsulTagKeyPrefix + secondary:  yes,
 					ConsulTagKeyPrefix + canary:     no,
@@ -348,7 +352,7 @@ var _ = Describe("Consul EDS", func() {
 					ConsulDataCenterKeyPrefix + dc2: yes,
 					ConsulDataCenterKeyPrefix + dc3: no,
 				}),
-				createExpectedEndpoint("2-1-0-11-svc-1-d-4567", "svc-1,svc-1secondary", "2.1.0.11", "100", writeNamespace, 4567, map[string]string{
+				createExpectedEndpoint("2-1-0-11-svc-1-d-4567", "svc-1,svc-1secondary", "2.1.0.11", dc2, "100", writeNamespace, 4567, map[string]string{
 					ConsulTagKeyPrefix + primary:    no,
 					ConsulTagKeyPrefix + secondary:  yes,
 					ConsulTagKeyPrefix + canary:     no,
@@ -356,7 +360,7 @@ var _ = Describe("Consul EDS", func() {
 					ConsulDataCenterKeyPrefix + dc2: yes,
 					ConsulDataCenterKeyPrefix + dc3: no,
 				}),
-				createExpectedEndpoint("3-1-0-99-svc-1-e-9999", "svc-1,svc-1secondary,svc-1canary", "3.1.0.99", "100", writeNamespace, 9999, map[string]string{
+				createExpectedEndpoint("3-1-0-99-svc-1-e-9999", "svc-1,svc-1secondary,svc-1canary", "3.1.0.99", dc3, "100", writeNamespace, 9999, map[string]string{
 					ConsulTagKeyPrefix + primary:    no,
 					ConsulTagKeyPrefix + secondary:  yes,
 					ConsulTagKeyPrefix + canary:     yes,
@@ -366,25 +370,25 @@ var _ = Describe("Consul EDS", func() {
 				}),
 
 				// 4 endpoints for service 2
-				createExpectedEndpoint("1-2-0-1-svc-2-a2-8080", "svc-2primary", "1.2.0.1", "100", writeNamespace, 8080, map[string]string{
+				createExpectedEndpoint("1-2-0-1-svc-2-a2-8080", "svc-2primary", "1.2.0.1", dc1, "100", writeNamespace, 8080, map[string]string{
 					ConsulTagKeyPrefix + primary:    yes,
 					ConsulTagKeyPrefix + secondary:  no,
 					ConsulDataCenterKeyPrefix + dc1: yes,
 					ConsulDataCenterKeyPrefix + dc2: no,
 				}),
-				createExpectedEndpoint("1-2-0-2-svc-2-b2-8080", "svc-2primary", "1.2.0.2", "100", writeNamespace, 8080, map[string]string{
+				createExpectedEndpoint("1-2-0-2-svc-2-b2-8080", "svc-2primary", "1.2.0.2", dc1, "100", writeNamespace, 8080, map[string]string{
 					ConsulTagKeyPrefix + primary:    yes,
 					ConsulTagKeyPrefix + secondary:  no,
 					ConsulDataCenterKeyPrefix + dc1: yes,
 					ConsulDataCenterKeyPrefix + dc2: no,
 				}),
-				createExpectedEndpoint("2-2-0-10-svc-2-c2-8088", "svc-2secondary", "2.2.0.10", "100", writeNamespace, 8088, map[string]string{
+				createExpectedEndpoint("2-2-0-10-svc-2-c2-8088", "svc-2secondary", "2.2.0.10", dc2, "100", writeNamespace, 8088, map[string]string{
 					ConsulTagKeyPrefix + primary:    no,
 					ConsulTagKeyPrefix + secondary:  yes,
 					ConsulDataCenterKeyPrefix + dc1: no,
 					ConsulDataCenterKeyPrefix + dc2: yes,
 				}),
-				createExpectedEndpoint("2-2-0-11-svc-2-d2-8088", "svc-2secondary", "2.2.0.11", "100", writeNamespace, 8088, map[string]string{
+				createExpectedEndpoint("2-2-0-11-svc-2-d2-8088", "svc-2secondary", "2.2.0.11", dc2, "100", writeNamespace, 8088, map[string]string{
 					ConsulTagKeyPrefix + primary:    no,
 					ConsulTagKeyPrefix + secondary:  yes,
 					ConsulDataCenterKeyPrefix + dc1: no,
@@ -399,7 +403,7 @@ var _ = Describe("Consul EDS", func() {
 
 			expectedEndpointsSecondAttempt = append(
 				expectedEndpointsFirstAttempt.Clone(),
-				createExpectedEndpoint("3-1-0-3-svc-1-e1-1234", "svc-1,svc-1canary", "3.1.0.3", "100", writeNamespace, 1234, map[string]string{
+				createExpectedEndpoint("3-1-0-3-svc-1-e1-1234", "svc-1,svc-1canary", "3.1.0.3", dc3, "100", writeNamespace, 1234, map[string]string{
 					ConsulTagKeyPrefix + primary:    no,
 					ConsulTagKeyPrefix + secondary:  no,
 					ConsulTagKeyPrefix + canary:     yes,
@@ -425,7 +429,7 @@ var _ = Describe("Consul EDS", func() {
 		})
 
 		It("works as expected", func() {
-			eds := NewPlugin(consulWatcherMock, nil, nil)
+			eds := NewPlugin(consulWatcherMock, nil, nil, nil, nil)
 
 			endpointsChan, errorChan, err := eds.WatchEndpoints(writeNamespace, upstreamsToTrack, clients.WatchOpts{Ctx: ctx})
 
@@ -615,6 +619,89 @@ var _ = Describe("Consul EDS", func() {
 			Expect(endpontNames).To(HaveLen(len(svcs) + (len(twoIps) - 1)))
 		})
 	})
+	Describe("endpoints watch with health checks", func() {
+
+		var (
+			ctx               context.Context
+			cancel            context.CancelFunc
+			consulWatcherMock *mock_consul.MockConsulWatcher
+
+			dc1         = "dc-1"
+			dc2         = "dc-2"
+			dataCenters = []string{dc1, dc2}
+
+			svc1 = "svc-1"
+
+			serviceMetaProducer chan []*consul.ServiceMeta
+			errorProducer       chan error
+			dc1Health           chan []*consulapi.ServiceEntry
+			dc2Health           chan []*consulapi.ServiceEntry
+		)
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+
+			serviceMetaProducer = make(chan []*consul.ServiceMeta)
+			errorProducer = make(chan error)
+			dc1Health = make(chan []*consulapi.ServiceEntry)
+			dc2Health = make(chan []*consulapi.ServiceEntry)
+
+			consulWatcherMock = mock_consul.NewMockConsulWatcher(ctrl)
+			consulWatcherMock.EXPECT().DataCenters().Return(dataCenters, nil).Times(1)
+			consulWatcherMock.EXPECT().WatchServices(gomock.Any(), dataCenters).Return(serviceMetaProducer, errorProducer).Times(1)
+			consulWatcherMock.EXPECT().WatchServiceHealth(gomock.Any(), svc1, dc1, false).Return(dc1Health, make(chan error)).Times(1)
+			consulWatcherMock.EXPECT().WatchServiceHealth(gomock.Any(), svc1, dc2, false).Return(dc2Health, make(chan error)).Times(1)
+		})
+
+		AfterEach(func() {
+			cancel()
+			close(serviceMetaProducer)
+			close(errorProducer)
+		})
+
+		It("sets the health status of the endpoints from the health checks of their instances", func() {
+			plug := NewPlugin(consulWatcherMock, nil, nil, &v1.Settings_ConsulConfiguration_ServiceDiscoveryOptions{UseHealthChecks: true}, nil)
+
+			upstreams := v1.UpstreamList{createTestUpstream(svc1, svc1, nil, dataCenters)}
+			endpointsChan, errChan, err := plug.WatchEndpoints(writeNamespace, upstreams, clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+
+			serviceMetaProducer <- []*consul.ServiceMeta{{Name: svc1, DataCenters: dataCenters}}
+			Eventually(endpointsChan).Should(Receive(BeEmpty()))
+
+			dc1Health <- []*consulapi.ServiceEntry{
+				createTestServiceEntry("1.1.1.1", dc1, svc1, "a", consulapi.HealthPassing),
+				createTestServiceEntry("1.1.1.2", dc1, svc1, "b", consulapi.HealthWarning),
+			}
+			dc2Health <- []*consulapi.ServiceEntry{
+				createTestServiceEntry("2.2.2.1", dc2, svc1, "c", consulapi.HealthCritical),
+				createTestServiceEntry("2.2.2.2", dc2, svc1, "d", consulapi.HealthMaint),
+			}
+
+			var endpoints v1.EndpointList
+			Eventually(endpointsChan).Should(Receive(&endpoints))
+			if len(endpoints) < 4 {
+				// the updates from the two data centers may have been published separately
+				Eventually(endpointsChan).Should(Receive(&endpoints))
+			}
+			Expect(endpoints).To(HaveLen(4))
+
+			byAddress := make(map[string]*v1.Endpoint)
+			for _, ep := range endpoints {
+				byAddress[ep.Address] = ep
+			}
+			Expect(byAddress["1.1.1.1"].HealthStatus).To(Equal(v1.HealthStatus_HEALTHY))
+			Expect(byAddress["1.1.1.1"].LoadBalancingWeight).To(Equal(&types.UInt32Value{Value: 3}))
+			Expect(byAddress["1.1.1.2"].HealthStatus).To(Equal(v1.HealthStatus_HEALTHY))
+			Expect(byAddress["1.1.1.2"].LoadBalancingWeight).To(Equal(&types.UInt32Value{Value: 1}))
+			Expect(byAddress["2.2.2.1"].HealthStatus).To(Equal(v1.HealthStatus_UNHEALTHY))
+			Expect(byAddress["2.2.2.2"].HealthStatus).To(Equal(v1.HealthStatus_DRAINING))
+			Expect(byAddress["2.2.2.2"].Locality).To(Equal(&v1.Locality{Region: dc2}))
+
+			Consistently(errChan).ShouldNot(Receive())
+		})
+	})
+
 	Describe("unit tests", func() {
 
 		It("generates the correct endpoint for a given Consul service", func() {
@@ -626,6 +713,10 @@ var _ = Describe("Consul EDS", func() {
 				Datacenter:  "dc-1",
 				ServiceTags: []string{"tag-1", "tag-3", "http"},
 				ModifyIndex: 9876,
+				ServiceWeights: consulapi.Weights{
+					Passing: 3,
+					Warning: 1,
+				},
 			}
 			upstream := createTestFilteredUpstream("my-svc", "my-svc", []string{"tag-1", "tag-3"}, []string{"http"}, []string{"dc-1", "dc-2"})
 			// add another upstream so to test that tag2 is in the labels.
@@ -647,9 +738,11 @@ var _ = Describe("Consul EDS", func() {
 					},
 					ResourceVersion: "9876",
 				},
-				Upstreams: []*core.ResourceRef{utils.ResourceRefPtr(upstream.Metadata.Ref())},
-				Address:   "127.0.0.1",
-				Port:      1234,
+				Upstreams:           []*core.ResourceRef{utils.ResourceRefPtr(upstream.Metadata.Ref())},
+				Address:             "127.0.0.1",
+				Port:                1234,
+				Locality:            &v1.Locality{Region: "dc-1"},
+				LoadBalancingWeight: &types.UInt32Value{Value: 3},
 			}))
 		})
 	})
@@ -687,7 +780,33 @@ func createTestService(address, dc, name, id string, tags []string, port int, la
 	}
 }
 
-func createExpectedEndpoint(name, usname, address, version, ns string, port uint32, labels map[string]string) *v1.Endpoint {
+func createTestServiceEntry(address, dc, name, id string, status string) *consulapi.ServiceEntry {
+	serviceCheck := &consulapi.HealthCheck{Node: "node-" + id, CheckID: "service:" + id, ServiceID: id, Status: status}
+	if status == consulapi.HealthMaint {
+		// maintenance mode is reported as a critical check with a reserved id
+		serviceCheck.CheckID = consulapi.ServiceMaintPrefix + id
+		serviceCheck.Status = consulapi.HealthCritical
+	}
+	return &consulapi.ServiceEntry{
+		Node: &consulapi.Node{
+			Node:       "node-" + id,
+			Address:    address,
+			Datacenter: dc,
+		},
+		Service: &consulapi.AgentService{
+			ID:      id,
+			Service: name,
+			Port:    1234,
+			Weights: consulapi.AgentWeights{Passing: 3, Warning: 1},
+		},
+		Checks: consulapi.HealthChecks{
+			{Node: "node-" + id, CheckID: "serfHealth", Status: consulapi.HealthPassing},
+			serviceCheck,
+		},
+	}
+}
+
+func createExpectedEndpoint(name, usname, address, dc, version, ns string, port uint32, labels map[string]string) *v1.Endpoint {
 	ep := &v1.Endpoint{
 		Metadata: core.Metadata{
 			Namespace:       ns,
@@ -696,8 +815,9 @@ func createExpectedEndpoint(name, usname, address, version, ns string, port uint
 			ResourceVersion: version,
 		},
 
-		Address: address,
-		Port:    port,
+		Address:  address,
+		Port:     port,
+		Locality: &v1.Locality{Region: dc},
 	}
 
 	for _, svc := range strings.Split(usname, ",") {
@@ -0,0 +1,94 @@
+package consul
+
+import (
+	"context"
+	"sort"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+var DataCentersErr = func(err error) error {
+	return eris.Wrapf(err, "listing the consul data centers to assign failover priorities")
+}
+
+// initFailover ranks the data centers once per translation, rather than for every upstream. If consul cannot list
+// its data centers, the data centers it listed last are used, so that a transient error does not fail the upstreams.
+func (p *plugin) initFailover(ctx context.Context) {
+	p.dataCenterRanks = nil
+	if p.dataCenterFailover == nil || p.client == nil {
+		return
+	}
+
+	// the consul api lists data centers nearest first, starting with the one of the agent
+	dataCenters, err := p.client.DataCenters()
+	if err != nil {
+		contextutils.LoggerFrom(ctx).Warnf("%v, using the last known data centers: %v", DataCentersErr(err), p.knownDataCenters)
+	} else {
+		p.knownDataCenters = dataCenters
+	}
+	p.dataCenterRanks = failoverRanks(p.localDataCenter, p.dataCenterFailover.GetDataCenters(), p.knownDataCenters)
+}
+
+// ProcessEndpoints assigns an Envoy priority to the endpoints of a consul upstream based on their data center.
+// Envoy requires priorities to be contiguous, so only the data centers the upstream has endpoints in are ranked.
+func (p *plugin) ProcessEndpoints(params plugins.Params, in *v1.Upstream, out *envoyapi.ClusterLoadAssignment) error {
+	if in.GetConsul() == nil || p.dataCenterRanks == nil {
+		return nil
+	}
+	rank := p.dataCenterRanks
+
+	// endpoints in a data center consul does not know about anymore go last
+	dataCenterRank := func(dataCenter string) int {
+		if r, ok := rank[dataCenter]; ok {
+			return r
+		}
+		return len(rank)
+	}
+
+	var ranks []int
+	seen := make(map[int]bool)
+	for _, localityEndpoints := range out.Endpoints {
+		r := dataCenterRank(localityEndpoints.GetLocality().GetRegion())
+		if !seen[r] {
+			seen[r] = true
+			ranks = append(ranks, r)
+		}
+	}
+	sort.Ints(ranks)
+	priorities := make(map[int]uint32, len(ranks))
+	for priority, r := range ranks {
+		priorities[r] = uint32(priority)
+	}
+
+	for _, localityEndpoints := range out.Endpoints {
+		localityEndpoints.Priority = priorities[dataCenterRank(localityEndpoints.GetLocality().GetRegion())]
+	}
+	return nil
+}
+
+// failoverRanks orders data centers for failover: the local data center first, then the preferred data centers,
+// then the remaining known data centers in the order consul lists them.
+func failoverRanks(localDataCenter string, preferred, known []string) map[string]int {
+	if localDataCenter == "" && len(known) > 0 {
+		localDataCenter = known[0]
+	}
+
+	rank := make(map[string]int)
+	add := func(dataCenter string) {
+		if _, ok := rank[dataCenter]; !ok && dataCenter != "" {
+			rank[dataCenter] = len(rank)
+		}
+	}
+	add(localDataCenter)
+	for _, dataCenter := range preferred {
+		add(dataCenter)
+	}
+	for _, dataCenter := range known {
+		add(dataCenter)
+	}
+	return rank
+}
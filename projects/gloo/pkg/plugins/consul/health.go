@@ -0,0 +1,246 @@
+package consul
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	consulapi "github.com/hashicorp/consul/api"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/errutils"
+	"github.com/solo-io/go-utils/hashutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+)
+
+// identifies the instances of a service registered in a data center
+type serviceInDataCenter struct {
+	service    string
+	dataCenter string
+}
+
+type serviceHealthUpdate struct {
+	key     serviceInDataCenter
+	entries []*consulapi.ServiceEntry
+}
+
+// Discovers endpoints with the Consul health API instead of the catalog.
+// The service metadata watch tells which data centers each tracked service is registered in; a blocking query
+// is then run on the health API for every service and data center. Whenever the instances of a service or their
+// health checks change, the endpoints are rebuilt and sent on the returned channel.
+func (p *plugin) watchEndpointsWithHealthChecks(writeNamespace string, trackedServiceToUpstreams map[string][]*v1.Upstream, connectServices map[string]bool, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
+
+	dataCenters, err := p.client.DataCenters()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serviceMetaChan, servicesWatchErrChan := p.client.WatchServices(opts.Ctx, dataCenters)
+
+	errChan := make(chan error)
+	// tracks the goroutines that write to errChan, so it is only closed once they are done
+	var errWriters sync.WaitGroup
+	errWriters.Add(1)
+	go func() {
+		defer errWriters.Done()
+		errutils.AggregateErrs(opts.Ctx, errChan, servicesWatchErrChan, "consul eds")
+	}()
+
+	endpointsChan := make(chan v1.EndpointList)
+	go func() {
+		defer close(endpointsChan)
+		defer func() {
+			errWriters.Wait()
+			close(errChan)
+		}()
+
+		watches := make(map[serviceInDataCenter]context.CancelFunc)
+		defer func() {
+			for _, cancel := range watches {
+				cancel()
+			}
+		}()
+		entries := make(map[serviceInDataCenter][]*consulapi.ServiceEntry)
+		updates := make(chan serviceHealthUpdate)
+
+		startWatch := func(key serviceInDataCenter) {
+			ctx, cancel := context.WithCancel(opts.Ctx)
+			watches[key] = cancel
+			entriesChan, watchErrChan := p.client.WatchServiceHealth(ctx, key.service, key.dataCenter, connectServices[key.service])
+
+			errWriters.Add(2)
+			go func() {
+				defer errWriters.Done()
+				errutils.AggregateErrs(ctx, errChan, watchErrChan, "consul eds: "+key.service)
+			}()
+			go func() {
+				defer errWriters.Done()
+				for {
+					select {
+					case serviceEntries, ok := <-entriesChan:
+						if !ok {
+							return
+						}
+						select {
+						case updates <- serviceHealthUpdate{key: key, entries: serviceEntries}:
+						case <-ctx.Done():
+							return
+						}
+					case <-ctx.Done():
+						return
+					}
+				}
+			}()
+		}
+
+		var (
+			published    bool
+			previousHash uint64
+		)
+		publishEndpoints := func() bool {
+			endpoints := buildEndpointsFromHealthEntries(opts.Ctx, writeNamespace, p.resolver, entries, trackedServiceToUpstreams)
+			currentHash := hashutils.MustHash(endpoints)
+			if published && previousHash == currentHash {
+				return true
+			}
+			select {
+			case <-opts.Ctx.Done():
+				return false
+			case endpointsChan <- endpoints:
+			}
+			published = true
+			previousHash = currentHash
+			return true
+		}
+
+		timer := time.NewTicker(p.dnsPollingInterval)
+		defer timer.Stop()
+
+		for {
+			select {
+			case serviceMeta, ok := <-serviceMetaChan:
+				if !ok {
+					return
+				}
+
+				wanted := make(map[serviceInDataCenter]bool)
+				for _, service := range serviceMeta {
+					if _, ok := trackedServiceToUpstreams[service.Name]; !ok {
+						continue
+					}
+					for _, dataCenter := range service.DataCenters {
+						wanted[serviceInDataCenter{service: service.Name, dataCenter: dataCenter}] = true
+					}
+				}
+				for key, cancel := range watches {
+					if !wanted[key] {
+						cancel()
+						delete(watches, key)
+						delete(entries, key)
+					}
+				}
+				for key := range wanted {
+					if _, ok := watches[key]; !ok {
+						startWatch(key)
+					}
+				}
+
+				if !publishEndpoints() {
+					return
+				}
+
+			case update := <-updates:
+				if _, ok := watches[update.key]; !ok {
+					// the watch was stopped after it sent this update
+					continue
+				}
+				entries[update.key] = update.entries
+				if !publishEndpoints() {
+					return
+				}
+
+			case <-timer.C:
+				// Poll to ensure any DNS updates get picked up in endpoints for EDS
+				if !publishEndpoints() {
+					return
+				}
+
+			case <-opts.Ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return endpointsChan, errChan, nil
+}
+
+func buildEndpointsFromHealthEntries(ctx context.Context, writeNamespace string, resolver DnsResolver, entries map[serviceInDataCenter][]*consulapi.ServiceEntry, trackedServiceToUpstreams map[string][]*v1.Upstream) v1.EndpointList {
+	var endpoints v1.EndpointList
+	for _, serviceEntries := range entries {
+		for _, entry := range serviceEntries {
+			spec := catalogServiceFromEntry(entry)
+			upstreams, ok := trackedServiceToUpstreams[destinationServiceName(spec)]
+			if !ok {
+				continue
+			}
+			eps, err := buildEndpoints(ctx, writeNamespace, resolver, spec, upstreams)
+			if err != nil {
+				contextutils.LoggerFrom(ctx).Warnf("consul eds plugin encountered error resolving DNS for consul service %v", spec, err)
+				continue
+			}
+			for _, ep := range eps {
+				applyHealthChecks(ep, entry)
+			}
+			endpoints = append(endpoints, eps...)
+		}
+	}
+
+	// Sort by name in ascending order for idempotency
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		return endpoints[i].Metadata.Name < endpoints[j].Metadata.Name
+	})
+	return endpoints
+}
+
+// the health API returns the same information as the catalog, grouped by node and service
+func catalogServiceFromEntry(entry *consulapi.ServiceEntry) *consulapi.CatalogService {
+	return &consulapi.CatalogService{
+		ID:             entry.Node.ID,
+		Node:           entry.Node.Node,
+		Address:        entry.Node.Address,
+		Datacenter:     entry.Node.Datacenter,
+		ServiceID:      entry.Service.ID,
+		ServiceName:    entry.Service.Service,
+		ServiceAddress: entry.Service.Address,
+		ServiceTags:    entry.Service.Tags,
+		ServiceMeta:    entry.Service.Meta,
+		ServicePort:    entry.Service.Port,
+		ServiceWeights: consulapi.Weights{
+			Passing: entry.Service.Weights.Passing,
+			Warning: entry.Service.Weights.Warning,
+		},
+		ServiceProxy: entry.Service.Proxy,
+		Checks:       entry.Checks,
+		ModifyIndex:  entry.Service.ModifyIndex,
+	}
+}
+
+// applyHealthChecks sets the health status of an endpoint from the health checks of its service instance.
+// Like Consul DNS, instances with warning checks keep receiving traffic, with their warning weight.
+func applyHealthChecks(endpoint *v1.Endpoint, entry *consulapi.ServiceEntry) {
+	switch entry.Checks.AggregatedStatus() {
+	case consulapi.HealthPassing:
+		endpoint.HealthStatus = v1.HealthStatus_HEALTHY
+	case consulapi.HealthWarning:
+		endpoint.HealthStatus = v1.HealthStatus_HEALTHY
+		if weight := entry.Service.Weights.Warning; weight > 0 {
+			endpoint.LoadBalancingWeight = &types.UInt32Value{Value: uint32(weight)}
+		}
+	case consulapi.HealthMaint:
+		endpoint.HealthStatus = v1.HealthStatus_DRAINING
+	default:
+		endpoint.HealthStatus = v1.HealthStatus_UNHEALTHY
+	}
+}
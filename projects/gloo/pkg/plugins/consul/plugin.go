@@ -14,12 +14,15 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
 
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 )
 
 var _ discovery.DiscoveryPlugin = new(plugin)
+var _ plugins.EndpointPlugin = new(plugin)
 
 var (
 	DefaultDnsAddress         = "127.0.0.1:8600"
@@ -27,9 +30,19 @@ var (
 )
 
 type plugin struct {
-	client             consul.ConsulWatcher
-	resolver           DnsResolver
-	dnsPollingInterval time.Duration
+	client              consul.ConsulWatcher
+	resolver            DnsResolver
+	dnsPollingInterval  time.Duration
+	useHealthChecks     bool
+	connectCertificates *consul.ConnectCertificates
+
+	// read from the settings at every translation
+	localDataCenter    string
+	dataCenterFailover *v1.Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover
+	// the failover rank of each data center, computed at every translation
+	dataCenterRanks map[string]int
+	// the data centers consul listed last
+	knownDataCenters []string
 }
 
 func (p *plugin) Resolve(u *v1.Upstream) (*url.URL, error) {
@@ -74,20 +87,29 @@ func (p *plugin) Resolve(u *v1.Upstream) (*url.URL, error) {
 	return nil, eris.Errorf("service with name %s and tags %v not found", spec.ServiceName, spec.InstanceTags)
 }
 
-func NewPlugin(client consul.ConsulWatcher, resolver DnsResolver, dnsPollingInterval *time.Duration) *plugin {
+func NewPlugin(client consul.ConsulWatcher, resolver DnsResolver, dnsPollingInterval *time.Duration, serviceDiscovery *v1.Settings_ConsulConfiguration_ServiceDiscoveryOptions, connectCertificates *consul.ConnectCertificates) *plugin {
 	pollingInterval := DefaultDnsPollingInterval
 	if dnsPollingInterval != nil {
 		pollingInterval = *dnsPollingInterval
 	}
-	return &plugin{client: client, resolver: resolver, dnsPollingInterval: pollingInterval}
+	return &plugin{
+		client:              client,
+		resolver:            resolver,
+		dnsPollingInterval:  pollingInterval,
+		useHealthChecks:     serviceDiscovery.GetUseHealthChecks(),
+		connectCertificates: connectCertificates,
+	}
 }
 
 func (p *plugin) Init(params plugins.InitParams) error {
+	p.localDataCenter = params.Settings.GetConsul().GetDatacenter()
+	p.dataCenterFailover = params.Settings.GetConsul().GetServiceDiscovery().GetDataCenterFailover()
+	p.initFailover(params.Ctx)
 	return nil
 }
 
 func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
-	_, ok := in.UpstreamType.(*v1.Upstream_Consul)
+	consulSpec, ok := in.UpstreamType.(*v1.Upstream_Consul)
 	if !ok {
 		return nil
 	}
@@ -95,6 +117,20 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	// consul upstreams use EDS
 	xds.SetEdsOnCluster(out)
 
+	if consulSpec.Consul.GetConnectEnabled() {
+		if in.SslConfig != nil {
+			return ConnectSslConfigErr
+		}
+		tlsContext, err := p.connectTlsContext(consulSpec.Consul)
+		if err != nil {
+			return err
+		}
+		out.TransportSocket = &envoycore.TransportSocket{
+			Name:       pluginutils.TlsTransportSocket,
+			ConfigType: &envoycore.TransportSocket_TypedConfig{TypedConfig: pluginutils.MustMessageToAny(tlsContext)},
+		}
+	}
+
 	return nil
 }
 
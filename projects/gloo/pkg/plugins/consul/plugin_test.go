@@ -1,8 +1,19 @@
 package consul
 
 import (
+	"context"
 	"net"
 	"net/url"
+	"regexp"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyendpoint "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
+	"github.com/golang/protobuf/ptypes"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 
 	mock_consul2 "github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul/mocks"
 
@@ -10,6 +21,8 @@ import (
 	consulapi "github.com/hashicorp/consul/api"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
 	mock_consul "github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul/mocks"
 )
 
@@ -31,7 +44,7 @@ var _ = Describe("Resolve", func() {
 	})
 
 	It("can resolve consul service addresses that are IPs", func() {
-		plug := NewPlugin(consulWatcherMock, nil, nil)
+		plug := NewPlugin(consulWatcherMock, nil, nil, nil, nil)
 
 		svcName := "my-svc"
 		tag := "tag"
@@ -68,7 +81,7 @@ var _ = Describe("Resolve", func() {
 		mockDnsResolver := mock_consul2.NewMockDnsResolver(ctrl)
 		mockDnsResolver.EXPECT().Resolve(gomock.Any(), "test.service.consul").Return(ips, nil).Times(1)
 
-		plug := NewPlugin(consulWatcherMock, mockDnsResolver, nil)
+		plug := NewPlugin(consulWatcherMock, mockDnsResolver, nil, nil, nil)
 
 		svcName := "my-svc"
 		tag := "tag"
@@ -98,7 +111,7 @@ var _ = Describe("Resolve", func() {
 
 	It("can resolve consul service addresses in an unfiltered upstream", func() {
 
-		plug := NewPlugin(consulWatcherMock, nil, nil)
+		plug := NewPlugin(consulWatcherMock, nil, nil, nil, nil)
 
 		svcName := "my-svc"
 		dc := "dc1"
@@ -120,3 +133,256 @@ var _ = Describe("Resolve", func() {
 		Expect(u).To(Equal(&url.URL{Scheme: "http", Host: "5.6.7.8:1234"}))
 	})
 })
+
+var _ = Describe("ProcessUpstream", func() {
+	var (
+		ctx               context.Context
+		cancel            context.CancelFunc
+		ctrl              *gomock.Controller
+		consulWatcherMock *mock_consul.MockConsulWatcher
+		upstream          *v1.Upstream
+		out               *envoyapi.Cluster
+	)
+
+	// the certificates are watched with blocking queries after the first read, which block until the test is done
+	blockingQueries := func() {
+		consulWatcherMock.EXPECT().ConnectCARoots(gomock.Any()).DoAndReturn(func(q *consulapi.QueryOptions) (*consulapi.CARootList, *consulapi.QueryMeta, error) {
+			<-q.Context().Done()
+			return nil, nil, q.Context().Err()
+		}).AnyTimes()
+		consulWatcherMock.EXPECT().ConnectCALeaf(gomock.Any(), gomock.Any()).DoAndReturn(func(service string, q *consulapi.QueryOptions) (*consulapi.LeafCert, *consulapi.QueryMeta, error) {
+			<-q.Context().Done()
+			return nil, nil, q.Context().Err()
+		}).AnyTimes()
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		ctrl = gomock.NewController(T)
+		consulWatcherMock = mock_consul.NewMockConsulWatcher(ctrl)
+
+		upstream = createTestUpstream("my-svc", "my-svc", nil, []string{"dc1"})
+		upstream.GetConsul().ConnectEnabled = true
+		out = &envoyapi.Cluster{}
+	})
+
+	AfterEach(func() {
+		cancel()
+		ctrl.Finish()
+	})
+
+	It("uses the Connect certificates of the gloo service for connect-enabled upstreams", func() {
+		consulWatcherMock.EXPECT().ConnectCARoots(gomock.Any()).Return(&consulapi.CARootList{
+			TrustDomain: "11111111-2222-3333-4444-555555555555.consul",
+			Roots: []*consulapi.CARoot{
+				{RootCertPEM: "root-1\n"},
+				{RootCertPEM: "root-2\n"},
+			},
+		}, &consulapi.QueryMeta{LastIndex: 1}, nil)
+		consulWatcherMock.EXPECT().ConnectCALeaf("gloo", gomock.Any()).Return(&consulapi.LeafCert{
+			CertPEM:       "leaf-cert",
+			PrivateKeyPEM: "leaf-key",
+		}, &consulapi.QueryMeta{LastIndex: 1}, nil)
+		blockingQueries()
+
+		certificates := consul.NewConnectCertificates(ctx, consulWatcherMock, ConnectServiceName(nil))
+		plug := NewPlugin(consulWatcherMock, nil, nil, nil, certificates)
+		err := plug.ProcessUpstream(plugins.Params{}, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(out.TransportSocket.Name).To(Equal(pluginutils.TlsTransportSocket))
+		var tlsContext envoyauth.UpstreamTlsContext
+		err = ptypes.UnmarshalAny(out.TransportSocket.GetTypedConfig(), &tlsContext)
+		Expect(err).NotTo(HaveOccurred())
+
+		commonTlsContext := tlsContext.GetCommonTlsContext()
+		Expect(commonTlsContext.GetTlsCertificates()).To(HaveLen(1))
+		Expect(commonTlsContext.GetTlsCertificates()[0].GetCertificateChain().GetInlineString()).To(Equal("leaf-cert"))
+		Expect(commonTlsContext.GetTlsCertificates()[0].GetPrivateKey().GetInlineString()).To(Equal("leaf-key"))
+
+		validationContext := commonTlsContext.GetValidationContext()
+		Expect(validationContext.GetTrustedCa().GetInlineString()).To(Equal("root-1\nroot-2"))
+		Expect(validationContext.GetMatchSubjectAltNames()).To(HaveLen(1))
+		Expect(validationContext.GetMatchSubjectAltNames()[0].GetSafeRegex().GetRegex()).To(
+			Equal(`^spiffe://11111111-2222-3333-4444-555555555555\.consul(/ap/[^/]+)?/ns/[^/]+/dc/[^/]+/svc/my-svc$`))
+	})
+
+	It("matches the SPIFFE IDs of services in any namespace and partition", func() {
+		regex := regexp.MustCompile(connectServiceIdRegex("trust.consul", "my-svc"))
+		Expect(regex.MatchString("spiffe://trust.consul/ns/default/dc/dc1/svc/my-svc")).To(BeTrue())
+		Expect(regex.MatchString("spiffe://trust.consul/ns/team-a/dc/dc1/svc/my-svc")).To(BeTrue())
+		Expect(regex.MatchString("spiffe://trust.consul/ap/part-1/ns/team-a/dc/dc1/svc/my-svc")).To(BeTrue())
+		Expect(regex.MatchString("spiffe://trust.consul/ns/default/dc/dc1/svc/other-svc")).To(BeFalse())
+		Expect(regex.MatchString("spiffe://other.consul/ns/default/dc/dc1/svc/my-svc")).To(BeFalse())
+	})
+
+	It("uses the configured Connect service name", func() {
+		Expect(ConnectServiceName(&v1.Settings_ConsulConfiguration_ServiceDiscoveryOptions{ConnectServiceName: "ingress"})).To(Equal("ingress"))
+		Expect(ConnectServiceName(&v1.Settings_ConsulConfiguration_ServiceDiscoveryOptions{})).To(Equal(DefaultConnectServiceName))
+	})
+
+	It("reads the certificates from consul once", func() {
+		consulWatcherMock.EXPECT().ConnectCARoots(gomock.Any()).Return(&consulapi.CARootList{}, &consulapi.QueryMeta{LastIndex: 1}, nil)
+		consulWatcherMock.EXPECT().ConnectCALeaf("gloo", gomock.Any()).Return(&consulapi.LeafCert{}, &consulapi.QueryMeta{LastIndex: 1}, nil)
+		blockingQueries()
+
+		plug := NewPlugin(consulWatcherMock, nil, nil, nil, consul.NewConnectCertificates(ctx, consulWatcherMock, "gloo"))
+		for i := 0; i < 3; i++ {
+			Expect(plug.ProcessUpstream(plugins.Params{}, upstream, &envoyapi.Cluster{})).NotTo(HaveOccurred())
+		}
+	})
+
+	It("rejects connect-enabled upstreams when the certificates are not watched", func() {
+		plug := NewPlugin(consulWatcherMock, nil, nil, nil, nil)
+		err := plug.ProcessUpstream(plugins.Params{}, upstream, out)
+		Expect(err).To(MatchError(ConnectCertificatesNotWatchedErr))
+	})
+
+	It("rejects connect-enabled upstreams with an ssl config", func() {
+		upstream.SslConfig = &v1.UpstreamSslConfig{Sni: "my-svc"}
+
+		plug := NewPlugin(consulWatcherMock, nil, nil, nil, nil)
+		err := plug.ProcessUpstream(plugins.Params{}, upstream, out)
+		Expect(err).To(MatchError(ConnectSslConfigErr))
+	})
+
+	It("does not set a transport socket for other upstreams", func() {
+		upstream.GetConsul().ConnectEnabled = false
+
+		plug := NewPlugin(consulWatcherMock, nil, nil, nil, nil)
+		err := plug.ProcessUpstream(plugins.Params{}, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.TransportSocket).To(BeNil())
+	})
+})
+
+var _ = Describe("ProcessEndpoints", func() {
+	var (
+		ctrl              *gomock.Controller
+		consulWatcherMock *mock_consul.MockConsulWatcher
+		upstream          *v1.Upstream
+		settings          *v1.Settings
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(T)
+		consulWatcherMock = mock_consul.NewMockConsulWatcher(ctrl)
+
+		upstream = createTestUpstream("my-svc", "my-svc", nil, nil)
+		settings = &v1.Settings{
+			Consul: &v1.Settings_ConsulConfiguration{
+				ServiceDiscovery: &v1.Settings_ConsulConfiguration_ServiceDiscoveryOptions{
+					DataCenterFailover: &v1.Settings_ConsulConfiguration_ServiceDiscoveryOptions_DataCenterFailover{
+						DataCenters: []string{"dc3", "dc2"},
+					},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	loadAssignment := func(dataCenters ...string) *envoyapi.ClusterLoadAssignment {
+		out := &envoyapi.ClusterLoadAssignment{}
+		for _, dc := range dataCenters {
+			out.Endpoints = append(out.Endpoints, &envoyendpoint.LocalityLbEndpoints{
+				Locality: &envoycore.Locality{Region: dc},
+			})
+		}
+		return out
+	}
+
+	priorities := func(out *envoyapi.ClusterLoadAssignment) map[string]uint32 {
+		result := make(map[string]uint32)
+		for _, localityEndpoints := range out.Endpoints {
+			result[localityEndpoints.Locality.Region] = localityEndpoints.Priority
+		}
+		return result
+	}
+
+	It("prioritizes the local data center, then the preferred ones, then the nearest ones", func() {
+		consulWatcherMock.EXPECT().DataCenters().Return([]string{"dc1", "dc4", "dc2", "dc3", "dc5"}, nil)
+
+		plug := NewPlugin(consulWatcherMock, nil, nil, nil, nil)
+		Expect(plug.Init(plugins.InitParams{Settings: settings})).NotTo(HaveOccurred())
+
+		out := loadAssignment("dc1", "dc2", "dc3", "dc4", "dc5", "unknown")
+		err := plug.ProcessEndpoints(plugins.Params{}, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(priorities(out)).To(Equal(map[string]uint32{
+			"dc1":     0,
+			"dc3":     1,
+			"dc2":     2,
+			"dc4":     3,
+			"dc5":     4,
+			"unknown": 5,
+		}))
+	})
+
+	It("uses the configured local data center and keeps priorities contiguous", func() {
+		consulWatcherMock.EXPECT().DataCenters().Return([]string{"dc1", "dc2", "dc3"}, nil)
+		settings.Consul.Datacenter = "dc2"
+
+		plug := NewPlugin(consulWatcherMock, nil, nil, nil, nil)
+		Expect(plug.Init(plugins.InitParams{Settings: settings})).NotTo(HaveOccurred())
+
+		out := loadAssignment("dc1", "dc2")
+		err := plug.ProcessEndpoints(plugins.Params{}, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(priorities(out)).To(Equal(map[string]uint32{
+			"dc2": 0,
+			"dc1": 1,
+		}))
+	})
+
+	It("lists the data centers once per translation", func() {
+		consulWatcherMock.EXPECT().DataCenters().Return([]string{"dc1", "dc2", "dc3"}, nil).Times(1)
+
+		plug := NewPlugin(consulWatcherMock, nil, nil, nil, nil)
+		Expect(plug.Init(plugins.InitParams{Settings: settings})).NotTo(HaveOccurred())
+
+		for i := 0; i < 3; i++ {
+			out := loadAssignment("dc1", "dc3")
+			Expect(plug.ProcessEndpoints(plugins.Params{}, upstream, out)).NotTo(HaveOccurred())
+			Expect(priorities(out)).To(Equal(map[string]uint32{
+				"dc1": 0,
+				"dc3": 1,
+			}))
+		}
+	})
+
+	It("uses the last known data centers when consul cannot list them", func() {
+		gomock.InOrder(
+			consulWatcherMock.EXPECT().DataCenters().Return([]string{"dc1", "dc4", "dc5"}, nil),
+			consulWatcherMock.EXPECT().DataCenters().Return(nil, eris.New("consul unavailable")),
+		)
+
+		plug := NewPlugin(consulWatcherMock, nil, nil, nil, nil)
+		Expect(plug.Init(plugins.InitParams{Ctx: context.Background(), Settings: settings})).NotTo(HaveOccurred())
+		Expect(plug.Init(plugins.InitParams{Ctx: context.Background(), Settings: settings})).NotTo(HaveOccurred())
+
+		out := loadAssignment("dc5", "dc4", "dc1")
+		err := plug.ProcessEndpoints(plugins.Params{}, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(priorities(out)).To(Equal(map[string]uint32{
+			"dc1": 0,
+			"dc4": 1,
+			"dc5": 2,
+		}))
+	})
+
+	It("does not assign priorities when failover is not configured", func() {
+		plug := NewPlugin(consulWatcherMock, nil, nil, nil, nil)
+		Expect(plug.Init(plugins.InitParams{Settings: &v1.Settings{}})).NotTo(HaveOccurred())
+
+		out := loadAssignment("dc1", "dc2")
+		err := plug.ProcessEndpoints(plugins.Params{}, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(priorities(out)).To(Equal(map[string]uint32{
+			"dc1": 0,
+			"dc2": 0,
+		}))
+	})
+})
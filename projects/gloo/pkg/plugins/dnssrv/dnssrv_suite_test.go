@@ -0,0 +1,14 @@
+package dnssrv
+
+import (
+	"testing"
+
+	"github.com/solo-io/go-utils/testutils"
+
+	. "github.com/onsi/ginkgo"
+)
+
+func TestDnsSrv(t *testing.T) {
+	testutils.RegisterCommonFailHandlers()
+	RunSpecs(t, "DNS SRV Suite")
+}
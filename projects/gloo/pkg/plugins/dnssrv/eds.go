@@ -0,0 +1,229 @@
+package dnssrv
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dns_srv"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/kubeutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const (
+	DefaultRefreshInterval = 30 * time.Second
+	DefaultMinTtl          = 5 * time.Second
+)
+
+// EDS API
+// start the EDS watch which sends a new list of endpoints on any change
+func (p *plugin) WatchEndpoints(writeNamespace string, unfilteredUpstreams v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
+	contextutils.LoggerFrom(opts.Ctx).Debugw("calling WatchEndpoints on DNS SRV")
+	var srvUpstreams v1.UpstreamList
+	for _, upstream := range unfilteredUpstreams {
+		if _, ok := upstream.GetUpstreamType().(*v1.Upstream_DnsSrv); ok {
+			srvUpstreams = append(srvUpstreams, upstream)
+		}
+	}
+	return newEndpointsWatcher(opts.Ctx, writeNamespace, srvUpstreams, p.resolver).poll()
+}
+
+type edsWatcher struct {
+	upstreams      v1.UpstreamList
+	watchContext   context.Context
+	writeNamespace string
+	resolver       SrvResolver
+}
+
+func newEndpointsWatcher(watchCtx context.Context, writeNamespace string, upstreams v1.UpstreamList, resolver SrvResolver) *edsWatcher {
+	return &edsWatcher{
+		upstreams:      upstreams,
+		watchContext:   watchCtx,
+		writeNamespace: writeNamespace,
+		resolver:       resolver,
+	}
+}
+
+type upstreamEndpoints struct {
+	upstreamKey string
+	endpoints   v1.EndpointList
+}
+
+// each upstream is resolved on its own schedule, the endpoints of all the upstreams are sent whenever those of an
+// upstream change, once every upstream has been resolved
+func (c *edsWatcher) poll() (<-chan v1.EndpointList, <-chan error, error) {
+	endpointsChan := make(chan v1.EndpointList)
+	errs := make(chan error)
+	updates := make(chan upstreamEndpoints)
+
+	var wg sync.WaitGroup
+	for _, upstream := range c.upstreams {
+		wg.Add(1)
+		go func(upstream *v1.Upstream) {
+			defer wg.Done()
+			c.watchUpstream(upstream, updates, errs)
+		}(upstream)
+	}
+
+	go func() {
+		defer close(endpointsChan)
+		defer close(errs)
+		// the upstream watches may still send errors until they are done
+		defer wg.Wait()
+
+		endpointsByUpstream := make(map[string]v1.EndpointList)
+		send := func() bool {
+			var allEndpoints v1.EndpointList
+			for _, upstream := range c.upstreams {
+				allEndpoints = append(allEndpoints, endpointsByUpstream[upstream.GetMetadata().Ref().Key()]...)
+			}
+			select {
+			case <-c.watchContext.Done():
+				return false
+			case endpointsChan <- allEndpoints:
+				return true
+			}
+		}
+
+		if len(c.upstreams) == 0 && !send() {
+			return
+		}
+		for {
+			select {
+			case <-c.watchContext.Done():
+				return
+			case update := <-updates:
+				endpointsByUpstream[update.upstreamKey] = update.endpoints
+				if len(endpointsByUpstream) == len(c.upstreams) && !send() {
+					return
+				}
+			}
+		}
+	}()
+	return endpointsChan, errs, nil
+}
+
+// watchUpstream resolves the records of an upstream until the watch context is done.
+// When resolving fails, the upstream keeps the endpoints of the last successful resolution.
+func (c *edsWatcher) watchUpstream(upstream *v1.Upstream, updates chan<- upstreamEndpoints, errs chan<- error) {
+	spec := upstream.GetDnsSrv()
+	upstreamKey := upstream.GetMetadata().Ref().Key()
+	var previous v1.EndpointList
+	first := true
+	for {
+		targets, ttl, err := c.resolver.Resolve(c.watchContext, spec.GetDnsServer(), spec.GetName())
+		endpoints := previous
+		if err != nil {
+			select {
+			case <-c.watchContext.Done():
+				return
+			case errs <- ResolveErr(err, upstream):
+			}
+		} else {
+			endpoints = targetsToEndpoints(c.writeNamespace, upstream, targets)
+		}
+
+		if first || !endpointListsEqual(previous, endpoints) {
+			select {
+			case <-c.watchContext.Done():
+				return
+			case updates <- upstreamEndpoints{upstreamKey: upstreamKey, endpoints: endpoints}:
+			}
+			previous = endpoints
+			first = false
+		}
+
+		timer := time.NewTimer(nextResolution(spec, ttl, err == nil))
+		select {
+		case <-c.watchContext.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// nextResolution returns the time until the records of an upstream are resolved again: the refresh interval, or the
+// TTL of the records if the upstream respects TTLs and they expire first
+func nextResolution(spec *dns_srv.UpstreamSpec, ttl time.Duration, resolved bool) time.Duration {
+	interval := durationOrDefault(spec.GetRefreshInterval(), DefaultRefreshInterval)
+	if !resolved || !spec.GetRespectTtl() || ttl >= interval {
+		return interval
+	}
+	if minTtl := durationOrDefault(spec.GetMinTtl(), DefaultMinTtl); ttl < minTtl {
+		return minTtl
+	}
+	return ttl
+}
+
+func durationOrDefault(duration *types.Duration, defaultDuration time.Duration) time.Duration {
+	if duration == nil {
+		return defaultDuration
+	}
+	d, err := types.DurationFromProto(duration)
+	if err != nil || d <= 0 {
+		return defaultDuration
+	}
+	return d
+}
+
+func targetsToEndpoints(writeNamespace string, upstream *v1.Upstream, targets []SrvTarget) v1.EndpointList {
+	ref := upstream.GetMetadata().Ref()
+	var endpoints v1.EndpointList
+	for _, target := range targets {
+		// a weight of 0 makes a target unlikely to be chosen, but envoy requires weights of at least 1
+		weight := uint32(target.Weight)
+		if weight == 0 {
+			weight = 1
+		}
+		for _, address := range target.Addresses {
+			endpoints = append(endpoints, &v1.Endpoint{
+				Upstreams:           []*core.ResourceRef{&ref},
+				Address:             address.String(),
+				Port:                uint32(target.Port),
+				Hostname:            target.Host,
+				Priority:            uint32(target.Priority),
+				LoadBalancingWeight: &types.UInt32Value{Value: weight},
+				Metadata: core.Metadata{
+					Name:      generateName(ref, address.String(), target.Port),
+					Namespace: writeNamespace,
+				},
+			})
+		}
+	}
+	// DNS servers may rotate the order of the records
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		return endpoints[i].GetMetadata().Name < endpoints[j].GetMetadata().Name
+	})
+	return endpoints
+}
+
+func endpointListsEqual(a, b v1.EndpointList) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+const srvEndpointNamePrefix = "srv"
+
+// several records can point to the same address, with different ports
+func generateName(upstreamRef core.ResourceRef, address string, port uint16) string {
+	return kubeutils.SanitizeNameV2(fmt.Sprintf("%v-%v-%v-%d", srvEndpointNamePrefix, upstreamRef.String(), address, port))
+}
+
+var ResolveErr = func(err error, upstream *v1.Upstream) error {
+	return eris.Wrapf(err, "resolving the SRV records of upstream %v", upstream.GetMetadata().Ref().Key())
+}
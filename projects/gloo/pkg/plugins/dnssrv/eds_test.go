@@ -0,0 +1,186 @@
+package dnssrv
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dns_srv"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("DNS SRV endpoints", func() {
+
+	var (
+		ctx      context.Context
+		cancel   context.CancelFunc
+		resolver *fakeSrvResolver
+		upstream *v1.Upstream
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		resolver = &fakeSrvResolver{}
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{Name: "u1", Namespace: "default"},
+			UpstreamType: &v1.Upstream_DnsSrv{
+				DnsSrv: &dns_srv.UpstreamSpec{
+					Name:            "_http._tcp.example.com",
+					DnsServer:       "10.0.0.53:53",
+					RefreshInterval: &types.Duration{Nanos: int32(10 * time.Millisecond)},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("creates an endpoint for each address of each target", func() {
+		resolver.setTargets([]SrvTarget{
+			{Host: "b.example.com", Port: 9090, Priority: 20, Weight: 0, Addresses: []net.IP{net.ParseIP("10.0.0.3")}},
+			{Host: "a.example.com", Port: 8080, Priority: 10, Weight: 5, Addresses: []net.IP{net.ParseIP("10.0.0.1"), net.ParseIP("10.0.0.2")}},
+		})
+
+		endpoints, errs, err := NewPlugin(resolver).WatchEndpoints("gloo-system", v1.UpstreamList{upstream}, clients.WatchOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		var list v1.EndpointList
+		Eventually(endpoints).Should(Receive(&list))
+		Consistently(errs).ShouldNot(Receive())
+
+		ref := upstream.Metadata.Ref()
+		Expect(list).To(Equal(v1.EndpointList{
+			{
+				Upstreams:           []*core.ResourceRef{&ref},
+				Address:             "10.0.0.1",
+				Port:                8080,
+				Hostname:            "a.example.com",
+				Priority:            10,
+				LoadBalancingWeight: &types.UInt32Value{Value: 5},
+				Metadata:            core.Metadata{Name: "srv-name-u1-namespace-default--10-0-0-1-8080", Namespace: "gloo-system"},
+			},
+			{
+				Upstreams:           []*core.ResourceRef{&ref},
+				Address:             "10.0.0.2",
+				Port:                8080,
+				Hostname:            "a.example.com",
+				Priority:            10,
+				LoadBalancingWeight: &types.UInt32Value{Value: 5},
+				Metadata:            core.Metadata{Name: "srv-name-u1-namespace-default--10-0-0-2-8080", Namespace: "gloo-system"},
+			},
+			{
+				Upstreams:           []*core.ResourceRef{&ref},
+				Address:             "10.0.0.3",
+				Port:                9090,
+				Hostname:            "b.example.com",
+				Priority:            20,
+				LoadBalancingWeight: &types.UInt32Value{Value: 1},
+				Metadata:            core.Metadata{Name: "srv-name-u1-namespace-default--10-0-0-3-9090", Namespace: "gloo-system"},
+			},
+		}))
+		Expect(resolver.lastQuery()).To(Equal("10.0.0.53:53/_http._tcp.example.com"))
+	})
+
+	It("sends the endpoints again only when they change", func() {
+		resolver.setTargets([]SrvTarget{{Host: "a.example.com", Port: 8080, Addresses: []net.IP{net.ParseIP("10.0.0.1")}}})
+
+		endpoints, _, err := NewPlugin(resolver).WatchEndpoints("gloo-system", v1.UpstreamList{upstream}, clients.WatchOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(endpoints).Should(Receive(HaveLen(1)))
+		Consistently(endpoints, 100*time.Millisecond).ShouldNot(Receive())
+
+		resolver.setTargets(nil)
+		Eventually(endpoints).Should(Receive(BeEmpty()))
+	})
+
+	It("keeps the endpoints of the last successful resolution when resolving fails", func() {
+		resolver.setTargets([]SrvTarget{{Host: "a.example.com", Port: 8080, Addresses: []net.IP{net.ParseIP("10.0.0.1")}}})
+
+		endpoints, errs, err := NewPlugin(resolver).WatchEndpoints("gloo-system", v1.UpstreamList{upstream}, clients.WatchOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(endpoints).Should(Receive(HaveLen(1)))
+
+		resolver.setErr(eris.New("timeout"))
+		Eventually(errs).Should(Receive(MatchError(ContainSubstring("resolving the SRV records of upstream default.u1: timeout"))))
+		Consistently(endpoints, 100*time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("sends an empty list when there are no DNS SRV upstreams", func() {
+		endpoints, _, err := NewPlugin(resolver).WatchEndpoints("gloo-system", v1.UpstreamList{{Metadata: core.Metadata{Name: "static"}}}, clients.WatchOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(endpoints).Should(Receive(BeEmpty()))
+	})
+
+	It("resolves the records again when their TTL expires, if the upstream respects TTLs", func() {
+		spec := &dns_srv.UpstreamSpec{RefreshInterval: &types.Duration{Seconds: 60}}
+		Expect(nextResolution(spec, 20*time.Second, true)).To(Equal(time.Minute))
+
+		spec.RespectTtl = true
+		Expect(nextResolution(spec, 20*time.Second, true)).To(Equal(20 * time.Second))
+		Expect(nextResolution(spec, 2*time.Minute, true)).To(Equal(time.Minute))
+		// records with very short TTLs are resolved at most every min TTL
+		Expect(nextResolution(spec, time.Second, true)).To(Equal(DefaultMinTtl))
+		spec.MinTtl = &types.Duration{Seconds: 15}
+		Expect(nextResolution(spec, time.Second, true)).To(Equal(15 * time.Second))
+		// failed resolutions are retried at the refresh interval
+		Expect(nextResolution(spec, 0, false)).To(Equal(time.Minute))
+
+		Expect(nextResolution(&dns_srv.UpstreamSpec{}, 0, true)).To(Equal(DefaultRefreshInterval))
+	})
+
+	It("configures the cluster to use EDS", func() {
+		out := &envoyapi.Cluster{}
+		err := NewPlugin(resolver).ProcessUpstream(plugins.Params{}, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetType()).To(Equal(envoyapi.Cluster_EDS))
+	})
+
+	It("rejects upstreams without a name", func() {
+		upstream.GetDnsSrv().Name = ""
+		err := NewPlugin(resolver).ProcessUpstream(plugins.Params{}, upstream, &envoyapi.Cluster{})
+		Expect(err).To(MatchError(MissingNameError()))
+	})
+})
+
+type fakeSrvResolver struct {
+	lock    sync.Mutex
+	targets []SrvTarget
+	err     error
+	queries []string
+}
+
+func (r *fakeSrvResolver) Resolve(ctx context.Context, dnsServer, name string) ([]SrvTarget, time.Duration, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.queries = append(r.queries, dnsServer+"/"+name)
+	return r.targets, time.Minute, r.err
+}
+
+func (r *fakeSrvResolver) setTargets(targets []SrvTarget) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.targets = targets
+}
+
+func (r *fakeSrvResolver) setErr(err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.err = err
+}
+
+func (r *fakeSrvResolver) lastQuery() string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.queries[len(r.queries)-1]
+}
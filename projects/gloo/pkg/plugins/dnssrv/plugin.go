@@ -0,0 +1,88 @@
+package dnssrv
+
+import (
+	"reflect"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+)
+
+/*
+Steps:
+- User creates a DNS SRV upstream
+  - names the SRV records whose targets should be made into Endpoints
+- Discovery resolves the records periodically, and before their TTL expires if the upstream respects TTLs
+- Gloo plugin creates an endpoint for each address of each target, with the port, weight and priority of its record
+*/
+
+type plugin struct {
+	resolver SrvResolver
+}
+
+// checks to ensure interfaces are implemented
+var _ plugins.Plugin = new(plugin)
+var _ plugins.UpstreamPlugin = new(plugin)
+var _ discovery.DiscoveryPlugin = new(plugin)
+
+func NewPlugin(resolver SrvResolver) *plugin {
+	return &plugin{resolver: resolver}
+}
+
+func (p *plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+// DNS SRV upstreams are created by the user, not discovered
+// This is just needed to satisfy the DiscoveryPlugin interface
+func (p *plugin) DiscoverUpstreams(watchNamespaces []string, writeNamespace string, opts clients.WatchOpts, discOpts discovery.Opts) (chan v1.UpstreamList, chan error, error) {
+	return nil, nil, nil
+}
+
+// we do not need to update any fields, just check that the input is valid
+func (p *plugin) UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
+	originalSpec, ok := original.UpstreamType.(*v1.Upstream_DnsSrv)
+	if !ok {
+		return false, WrongUpstreamTypeError(original)
+	}
+	desiredSpec, ok := desired.UpstreamType.(*v1.Upstream_DnsSrv)
+	if !ok {
+		return false, WrongUpstreamTypeError(desired)
+	}
+	if !originalSpec.Equal(desiredSpec) {
+		return false, UpstreamDeltaError()
+	}
+	return false, nil
+}
+
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
+	spec, ok := in.UpstreamType.(*v1.Upstream_DnsSrv)
+	if !ok {
+		return nil
+	}
+	if spec.DnsSrv.GetName() == "" {
+		return MissingNameError()
+	}
+
+	// the endpoints come from EDS
+	xds.SetEdsOnCluster(out)
+	return nil
+}
+
+var (
+	WrongUpstreamTypeError = func(upstream *v1.Upstream) error {
+		return eris.Errorf("internal error: expected *v1.Upstream_DnsSrv, got %v", reflect.TypeOf(upstream.UpstreamType).Name())
+	}
+
+	UpstreamDeltaError = func() error {
+		return eris.New("expected no difference between *v1.Upstream_DnsSrv upstreams")
+	}
+
+	MissingNameError = func() error {
+		return eris.New("DNS SRV upstreams must specify the name of the SRV records")
+	}
+)
@@ -0,0 +1,320 @@
+package dnssrv
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// SrvTarget is the target of a SRV record, with the addresses the target resolved to
+type SrvTarget struct {
+	// the target host, without the trailing dot
+	Host      string
+	Port      uint16
+	Priority  uint16
+	Weight    uint16
+	Addresses []net.IP
+}
+
+// SrvResolver resolves SRV records, along with the addresses of their targets.
+// Resolve also returns the lowest TTL of the records it used, so that callers know when to resolve them again.
+type SrvResolver interface {
+	Resolve(ctx context.Context, dnsServer, name string) ([]SrvTarget, time.Duration, error)
+}
+
+const (
+	defaultDnsPort   = "53"
+	resolvConfPath   = "/etc/resolv.conf"
+	exchangeTimeout  = 5 * time.Second
+	maxUdpPacketSize = 4096
+)
+
+var (
+	QueryErr = func(err error, name string) error {
+		return eris.Wrapf(err, "querying the DNS records of %v", name)
+	}
+
+	ResponseCodeErr = func(name string, rcode dnsmessage.RCode) error {
+		return eris.Errorf("DNS server answered the query for %v with %v", name, rcode)
+	}
+
+	NoDnsServersErr = eris.Errorf("no DNS servers found in %v", resolvConfPath)
+)
+
+// DnsSrvResolver queries DNS servers directly rather than with the go resolver, which hides the TTL of the records.
+type DnsSrvResolver struct {
+	// the name servers used when the upstream does not set a DNS server. read from /etc/resolv.conf when empty.
+	DefaultServers []string
+}
+
+var _ SrvResolver = &DnsSrvResolver{}
+
+func (r *DnsSrvResolver) Resolve(ctx context.Context, dnsServer, name string) ([]SrvTarget, time.Duration, error) {
+	servers := r.DefaultServers
+	if dnsServer != "" {
+		servers = []string{dnsServer}
+	}
+	if len(servers) == 0 {
+		var err error
+		if servers, err = resolvConfServers(resolvConfPath); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// the servers are tried in order, like the go resolver does
+	var lastErr error
+	for _, server := range servers {
+		targets, ttl, err := resolveWithServer(ctx, withDefaultPort(server), name)
+		if err == nil {
+			return targets, ttl, nil
+		}
+		lastErr = err
+	}
+	return nil, 0, lastErr
+}
+
+func resolveWithServer(ctx context.Context, server, name string) ([]SrvTarget, time.Duration, error) {
+	response, err := exchange(ctx, server, fqdn(name), dnsmessage.TypeSRV)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ttl := &minTtl{}
+	var targets []SrvTarget
+	for _, answer := range response.Answers {
+		srv, ok := answer.Body.(*dnsmessage.SRVResource)
+		if !ok {
+			continue
+		}
+		ttl.add(answer.Header.TTL)
+		targets = append(targets, SrvTarget{
+			Host:     strings.TrimSuffix(srv.Target.String(), "."),
+			Port:     srv.Port,
+			Priority: srv.Priority,
+			Weight:   srv.Weight,
+		})
+	}
+
+	// servers usually send the addresses of the targets along with the SRV records
+	additionalAddresses := addressesByName(response.Additionals, ttl)
+	for i := range targets {
+		target := &targets[i]
+		if addresses, ok := additionalAddresses[strings.ToLower(target.Host)]; ok {
+			target.Addresses = addresses
+			continue
+		}
+		for _, qtype := range []dnsmessage.Type{dnsmessage.TypeA, dnsmessage.TypeAAAA} {
+			response, err := exchange(ctx, server, fqdn(target.Host), qtype)
+			if err != nil {
+				return nil, 0, err
+			}
+			target.Addresses = append(target.Addresses, addressesByName(response.Answers, ttl)[strings.ToLower(target.Host)]...)
+		}
+	}
+	return targets, ttl.duration(), nil
+}
+
+// addressesByName collects the A and AAAA records, by lowercase host name
+func addressesByName(resources []dnsmessage.Resource, ttl *minTtl) map[string][]net.IP {
+	addresses := make(map[string][]net.IP)
+	for _, resource := range resources {
+		var ip net.IP
+		switch body := resource.Body.(type) {
+		case *dnsmessage.AResource:
+			ip = net.IP(body.A[:])
+		case *dnsmessage.AAAAResource:
+			ip = net.IP(body.AAAA[:])
+		default:
+			continue
+		}
+		ttl.add(resource.Header.TTL)
+		host := strings.ToLower(strings.TrimSuffix(resource.Header.Name.String(), "."))
+		addresses[host] = append(addresses[host], ip)
+	}
+	return addresses
+}
+
+// exchange sends a query to the server over UDP, and again over TCP if the response was truncated.
+// A name that does not exist is not an error, its response has no answers.
+func exchange(ctx context.Context, server, name string, qtype dnsmessage.Type) (*dnsmessage.Message, error) {
+	queryName, err := dnsmessage.NewName(name)
+	if err != nil {
+		return nil, QueryErr(err, name)
+	}
+	// an unpredictable ID makes forged responses harder to pass off as answers to the query
+	id, err := queryId()
+	if err != nil {
+		return nil, QueryErr(err, name)
+	}
+	query := dnsmessage.Message{
+		Header: dnsmessage.Header{
+			ID:               id,
+			RecursionDesired: true,
+		},
+		Questions: []dnsmessage.Question{{
+			Name:  queryName,
+			Type:  qtype,
+			Class: dnsmessage.ClassINET,
+		}},
+	}
+	packed, err := query.Pack()
+	if err != nil {
+		return nil, QueryErr(err, name)
+	}
+
+	response, err := exchangeOver(ctx, "udp", server, packed)
+	if err == nil && response.Header.Truncated {
+		response, err = exchangeOver(ctx, "tcp", server, packed)
+	}
+	if err != nil {
+		return nil, QueryErr(err, name)
+	}
+	if response.Header.ID != query.Header.ID || !sameQuestions(query.Questions, response.Questions) {
+		return nil, QueryErr(eris.New("the DNS response does not match the query"), name)
+	}
+	switch response.Header.RCode {
+	case dnsmessage.RCodeSuccess:
+	case dnsmessage.RCodeNameError:
+		response.Answers = nil
+	default:
+		return nil, ResponseCodeErr(name, response.Header.RCode)
+	}
+	return response, nil
+}
+
+func queryId() (uint16, error) {
+	var id [2]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint16(id[:]), nil
+}
+
+// sameQuestions compares the question sections of a query and its response. Names are case-insensitive.
+func sameQuestions(query, response []dnsmessage.Question) bool {
+	if len(query) != len(response) {
+		return false
+	}
+	for i := range query {
+		if query[i].Type != response[i].Type || query[i].Class != response[i].Class ||
+			!strings.EqualFold(query[i].Name.String(), response[i].Name.String()) {
+			return false
+		}
+	}
+	return true
+}
+
+func exchangeOver(ctx context.Context, network, server string, query []byte) (*dnsmessage.Message, error) {
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(ctx, network, server)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	deadline, ok := ctx.Deadline()
+	if !ok || time.Until(deadline) > exchangeTimeout {
+		deadline = time.Now().Add(exchangeTimeout)
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	var packed []byte
+	if network == "tcp" {
+		// messages sent over TCP are prefixed with their length
+		length := make([]byte, 2)
+		binary.BigEndian.PutUint16(length, uint16(len(query)))
+		if _, err := conn.Write(append(length, query...)); err != nil {
+			return nil, err
+		}
+		if _, err := io.ReadFull(conn, length); err != nil {
+			return nil, err
+		}
+		packed = make([]byte, binary.BigEndian.Uint16(length))
+		if _, err := io.ReadFull(conn, packed); err != nil {
+			return nil, err
+		}
+	} else {
+		if _, err := conn.Write(query); err != nil {
+			return nil, err
+		}
+		packed = make([]byte, maxUdpPacketSize)
+		n, err := conn.Read(packed)
+		if err != nil {
+			return nil, err
+		}
+		packed = packed[:n]
+	}
+
+	var response dnsmessage.Message
+	if err := response.Unpack(packed); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// resolvConfServers reads the name servers of a resolv.conf file
+func resolvConfServers(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var servers []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "nameserver" {
+			servers = append(servers, fields[1])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(servers) == 0 {
+		return nil, NoDnsServersErr
+	}
+	return servers, nil
+}
+
+func withDefaultPort(server string) string {
+	if _, _, err := net.SplitHostPort(server); err == nil {
+		return server
+	}
+	return net.JoinHostPort(server, defaultDnsPort)
+}
+
+func fqdn(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+// minTtl tracks the lowest TTL of the records used to resolve a name
+type minTtl struct {
+	seconds uint32
+	set     bool
+}
+
+func (t *minTtl) add(seconds uint32) {
+	if !t.set || seconds < t.seconds {
+		t.seconds = seconds
+		t.set = true
+	}
+}
+
+// the duration is zero if no records were used
+func (t *minTtl) duration() time.Duration {
+	return time.Duration(t.seconds) * time.Second
+}
@@ -0,0 +1,304 @@
+package dnssrv
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+var _ = Describe("DNS SRV resolver", func() {
+
+	var (
+		ctx      context.Context
+		server   *fakeDnsServer
+		resolver *DnsSrvResolver
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		server = newFakeDnsServer()
+		resolver = &DnsSrvResolver{DefaultServers: []string{server.address}}
+	})
+
+	AfterEach(func() {
+		server.close()
+	})
+
+	srvRecord := func(name string, ttl uint32, priority, weight, port uint16, target string) dnsmessage.Resource {
+		return dnsmessage.Resource{
+			Header: dnsmessage.ResourceHeader{Name: dnsmessage.MustNewName(name), Class: dnsmessage.ClassINET, TTL: ttl},
+			Body: &dnsmessage.SRVResource{
+				Priority: priority,
+				Weight:   weight,
+				Port:     port,
+				Target:   dnsmessage.MustNewName(target),
+			},
+		}
+	}
+
+	aRecord := func(name string, ttl uint32, ip string) dnsmessage.Resource {
+		var a [4]byte
+		copy(a[:], net.ParseIP(ip).To4())
+		return dnsmessage.Resource{
+			Header: dnsmessage.ResourceHeader{Name: dnsmessage.MustNewName(name), Class: dnsmessage.ClassINET, TTL: ttl},
+			Body:   &dnsmessage.AResource{A: a},
+		}
+	}
+
+	aaaaRecord := func(name string, ttl uint32, ip string) dnsmessage.Resource {
+		var aaaa [16]byte
+		copy(aaaa[:], net.ParseIP(ip).To16())
+		return dnsmessage.Resource{
+			Header: dnsmessage.ResourceHeader{Name: dnsmessage.MustNewName(name), Class: dnsmessage.ClassINET, TTL: ttl},
+			Body:   &dnsmessage.AAAAResource{AAAA: aaaa},
+		}
+	}
+
+	It("resolves the targets of the records with the additional records, and returns the lowest TTL", func() {
+		server.setResponse("_http._tcp.example.com./SRV", fakeDnsResponse{
+			answers: []dnsmessage.Resource{
+				srvRecord("_http._tcp.example.com.", 60, 10, 5, 8080, "a.example.com."),
+				srvRecord("_http._tcp.example.com.", 120, 20, 0, 9090, "b.example.com."),
+			},
+			additionals: []dnsmessage.Resource{
+				aRecord("a.example.com.", 30, "10.0.0.1"),
+				aRecord("A.example.com.", 30, "10.0.0.2"),
+				aRecord("b.example.com.", 300, "10.0.0.3"),
+			},
+		})
+
+		targets, ttl, err := resolver.Resolve(ctx, "", "_http._tcp.example.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ttl).To(Equal(30 * time.Second))
+		Expect(targets).To(HaveLen(2))
+		Expect(targets[0].Host).To(Equal("a.example.com"))
+		Expect(targets[0].Port).To(BeEquivalentTo(8080))
+		Expect(targets[0].Priority).To(BeEquivalentTo(10))
+		Expect(targets[0].Weight).To(BeEquivalentTo(5))
+		Expect(ipStrings(targets[0].Addresses)).To(Equal([]string{"10.0.0.1", "10.0.0.2"}))
+		Expect(targets[1].Host).To(Equal("b.example.com"))
+		Expect(ipStrings(targets[1].Addresses)).To(Equal([]string{"10.0.0.3"}))
+	})
+
+	It("resolves the addresses of targets without additional records", func() {
+		server.setResponse("_http._tcp.example.com./SRV", fakeDnsResponse{
+			answers: []dnsmessage.Resource{
+				srvRecord("_http._tcp.example.com.", 60, 10, 5, 8080, "a.example.com."),
+			},
+		})
+		server.setResponse("a.example.com./A", fakeDnsResponse{
+			answers: []dnsmessage.Resource{aRecord("a.example.com.", 10, "10.0.0.1")},
+		})
+		server.setResponse("a.example.com./AAAA", fakeDnsResponse{
+			answers: []dnsmessage.Resource{aaaaRecord("a.example.com.", 20, "fd00::1")},
+		})
+
+		targets, ttl, err := resolver.Resolve(ctx, "", "_http._tcp.example.com.")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ttl).To(Equal(10 * time.Second))
+		Expect(targets).To(HaveLen(1))
+		Expect(ipStrings(targets[0].Addresses)).To(Equal([]string{"10.0.0.1", "fd00::1"}))
+	})
+
+	It("queries again over TCP when the UDP response is truncated", func() {
+		server.setResponse("_http._tcp.example.com./SRV", fakeDnsResponse{
+			truncateUdp: true,
+			answers: []dnsmessage.Resource{
+				srvRecord("_http._tcp.example.com.", 60, 10, 5, 8080, "a.example.com."),
+			},
+			additionals: []dnsmessage.Resource{aRecord("a.example.com.", 60, "10.0.0.1")},
+		})
+
+		targets, _, err := resolver.Resolve(ctx, "", "_http._tcp.example.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(targets).To(HaveLen(1))
+		Expect(atomic.LoadInt32(&server.tcpQueries)).To(BeEquivalentTo(1))
+	})
+
+	It("uses the DNS server of the upstream", func() {
+		resolver.DefaultServers = []string{"127.0.0.1:1"}
+		server.setResponse("_http._tcp.example.com./SRV", fakeDnsResponse{})
+
+		_, _, err := resolver.Resolve(ctx, server.address, "_http._tcp.example.com")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("returns no targets for a name that does not exist", func() {
+		targets, ttl, err := resolver.Resolve(ctx, "", "_http._tcp.missing.example.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(targets).To(BeEmpty())
+		Expect(ttl).To(BeZero())
+	})
+
+	It("returns an error when the server fails", func() {
+		server.setResponse("_http._tcp.example.com./SRV", fakeDnsResponse{rcode: dnsmessage.RCodeServerFailure})
+
+		_, _, err := resolver.Resolve(ctx, "", "_http._tcp.example.com")
+		Expect(err).To(MatchError(ContainSubstring("RCodeServerFailure")))
+	})
+
+	It("rejects responses to another question", func() {
+		server.setResponse("_http._tcp.example.com./SRV", fakeDnsResponse{
+			questionName: "_http._tcp.other.example.com.",
+			answers: []dnsmessage.Resource{
+				srvRecord("_http._tcp.other.example.com.", 60, 10, 5, 8080, "a.example.com."),
+			},
+		})
+
+		_, _, err := resolver.Resolve(ctx, "", "_http._tcp.example.com")
+		Expect(err).To(MatchError(ContainSubstring("does not match the query")))
+	})
+
+	It("reads the name servers of resolv.conf", func() {
+		file, err := ioutil.TempFile("", "resolv.conf")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.Remove(file.Name())
+		_, err = file.WriteString("# comment\nsearch example.com\nnameserver 10.0.0.53\nnameserver fd00::53\noptions ndots:5\n")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(file.Close()).NotTo(HaveOccurred())
+
+		servers, err := resolvConfServers(file.Name())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(servers).To(Equal([]string{"10.0.0.53", "fd00::53"}))
+		Expect(withDefaultPort(servers[0])).To(Equal("10.0.0.53:53"))
+		Expect(withDefaultPort(servers[1])).To(Equal("[fd00::53]:53"))
+		Expect(withDefaultPort("10.0.0.53:8600")).To(Equal("10.0.0.53:8600"))
+	})
+})
+
+func ipStrings(ips []net.IP) []string {
+	var s []string
+	for _, ip := range ips {
+		s = append(s, ip.String())
+	}
+	return s
+}
+
+type fakeDnsResponse struct {
+	rcode       dnsmessage.RCode
+	truncateUdp bool
+	// answers a question about this name instead of the queried one, if set
+	questionName string
+	answers      []dnsmessage.Resource
+	additionals  []dnsmessage.Resource
+}
+
+// fakeDnsServer answers queries over UDP and TCP on the same port, from its responses by "name/type".
+// Names without a response do not exist.
+type fakeDnsServer struct {
+	address    string
+	udp        net.PacketConn
+	tcp        net.Listener
+	tcpQueries int32
+
+	lock      sync.Mutex
+	responses map[string]fakeDnsResponse
+}
+
+func newFakeDnsServer() *fakeDnsServer {
+	udp, err := net.ListenPacket("udp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+	tcp, err := net.Listen("tcp", udp.LocalAddr().String())
+	Expect(err).NotTo(HaveOccurred())
+	s := &fakeDnsServer{
+		address:   udp.LocalAddr().String(),
+		udp:       udp,
+		tcp:       tcp,
+		responses: make(map[string]fakeDnsResponse),
+	}
+	go s.serveUdp()
+	go s.serveTcp()
+	return s
+}
+
+func (s *fakeDnsServer) setResponse(key string, response fakeDnsResponse) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.responses[key] = response
+}
+
+func (s *fakeDnsServer) close() {
+	s.udp.Close()
+	s.tcp.Close()
+}
+
+func (s *fakeDnsServer) serveUdp() {
+	defer GinkgoRecover()
+	buf := make([]byte, 512)
+	for {
+		n, addr, err := s.udp.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		s.udp.WriteTo(s.respond(buf[:n], true), addr)
+	}
+}
+
+func (s *fakeDnsServer) serveTcp() {
+	defer GinkgoRecover()
+	for {
+		conn, err := s.tcp.Accept()
+		if err != nil {
+			return
+		}
+		length := make([]byte, 2)
+		if _, err := io.ReadFull(conn, length); err == nil {
+			query := make([]byte, binary.BigEndian.Uint16(length))
+			if _, err := io.ReadFull(conn, query); err == nil {
+				atomic.AddInt32(&s.tcpQueries, 1)
+				response := s.respond(query, false)
+				binary.BigEndian.PutUint16(length, uint16(len(response)))
+				conn.Write(append(length, response...))
+			}
+		}
+		conn.Close()
+	}
+}
+
+func (s *fakeDnsServer) respond(packed []byte, udp bool) []byte {
+	var query dnsmessage.Message
+	Expect(query.Unpack(packed)).NotTo(HaveOccurred())
+	question := query.Questions[0]
+	response := dnsmessage.Message{
+		Header: dnsmessage.Header{
+			ID:       query.Header.ID,
+			Response: true,
+		},
+		Questions: query.Questions,
+	}
+	key := strings.ToLower(question.Name.String()) + "/" + strings.TrimPrefix(question.Type.String(), "Type")
+	s.lock.Lock()
+	canned, ok := s.responses[key]
+	s.lock.Unlock()
+	switch {
+	case !ok:
+		response.Header.RCode = dnsmessage.RCodeNameError
+	case canned.truncateUdp && udp:
+		response.Header.Truncated = true
+	default:
+		if canned.questionName != "" {
+			response.Questions = []dnsmessage.Question{{
+				Name:  dnsmessage.MustNewName(canned.questionName),
+				Type:  question.Type,
+				Class: question.Class,
+			}}
+		}
+		response.Header.RCode = canned.rcode
+		response.Answers = canned.answers
+		response.Additionals = canned.additionals
+	}
+	out, err := response.Pack()
+	Expect(err).NotTo(HaveOccurred())
+	return out
+}
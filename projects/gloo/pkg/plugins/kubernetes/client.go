@@ -9,10 +9,14 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/controller"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/dynamic/dynamiclister"
 	kubeinformers "k8s.io/client-go/informers"
 	kubelisters "k8s.io/client-go/listers/core/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -22,6 +26,12 @@ import (
 
 type KubePluginSharedFactory interface {
 	EndpointsLister(ns string) kubelisters.EndpointsLister
+	// nil when the cluster does not serve EndpointSlices, in which case
+	// the EndpointsLister should be used instead. The EndpointSlices are
+	// of the newest version the cluster serves.
+	EndpointSliceLister(ns string) dynamiclister.Lister
+	// nil when nodes cannot be listed, e.g. when gloo's RBAC is namespaced
+	NodeLister() kubelisters.NodeLister
 	Subscribe() <-chan struct{}
 	Unsubscribe(<-chan struct{})
 }
@@ -29,31 +39,45 @@ type KubePluginSharedFactory interface {
 type KubePluginListers struct {
 	initError error
 
-	endpointsLister map[string]kubelisters.EndpointsLister
+	endpointsLister     map[string]kubelisters.EndpointsLister
+	endpointSliceLister map[string]dynamiclister.Lister
+	nodeLister          kubelisters.NodeLister
 
 	cacheUpdatedWatchers      []chan struct{}
 	cacheUpdatedWatchersMutex sync.Mutex
 }
 
-func getInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string) *KubePluginListers {
+func getInformerFactory(ctx context.Context, client kubernetes.Interface, dynamicClient dynamic.Interface, watchNamespaces []string) *KubePluginListers {
 	if len(watchNamespaces) == 0 {
 		watchNamespaces = []string{metav1.NamespaceAll}
 	}
-	kubePluginSharedFactory := startInformerFactory(ctx, client, watchNamespaces)
+	kubePluginSharedFactory := startInformerFactory(ctx, client, dynamicClient, watchNamespaces)
 	if kubePluginSharedFactory.initError != nil {
 		panic(kubePluginSharedFactory.initError)
 	}
 	return kubePluginSharedFactory
 }
 
-func startInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string) *KubePluginListers {
+func startInformerFactory(ctx context.Context, client kubernetes.Interface, dynamicClient dynamic.Interface, watchNamespaces []string) *KubePluginListers {
 	resyncDuration := 12 * time.Hour
 
 	var informers []cache.SharedIndexInformer
 	k := &KubePluginListers{
-		endpointsLister: map[string]kubelisters.EndpointsLister{},
+		endpointsLister:     map[string]kubelisters.EndpointsLister{},
+		endpointSliceLister: map[string]dynamiclister.Lister{},
+	}
+	var endpointSliceVersion *schema.GroupVersionResource
+	if dynamicClient != nil {
+		endpointSliceVersion = servedEndpointSliceVersion(client)
 	}
 	for _, nsToWatch := range watchNamespaces {
+		if endpointSliceVersion != nil {
+			dynamicInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicClient, resyncDuration, nsToWatch, nil)
+			endpointSliceInformer := dynamicInformerFactory.ForResource(*endpointSliceVersion).Informer()
+			informers = append(informers, endpointSliceInformer)
+			k.endpointSliceLister[nsToWatch] = dynamiclister.New(endpointSliceInformer.GetIndexer(), *endpointSliceVersion)
+			continue
+		}
 		kubeInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(client, resyncDuration, kubeinformers.WithNamespace(nsToWatch))
 		endpointInformer := kubeInformerFactory.Core().V1().Endpoints()
 		informers = append(informers, endpointInformer.Informer())
@@ -76,6 +100,16 @@ func startInformerFactory(ctx context.Context, client kubernetes.Interface, watc
 		syncFuncs = append(syncFuncs, informer.HasSynced)
 	}
 
+	// nodes are only read for the topology labels of the endpoints on them. Their status is updated
+	// periodically, so they are not watched by the controller: the endpoints are not listed again on
+	// every node update, and label changes are picked up with the next change of the endpoints.
+	if nodesListable(client) {
+		nodeInformer := kubeinformers.NewSharedInformerFactory(client, resyncDuration).Core().V1().Nodes()
+		go nodeInformer.Informer().Run(stop)
+		syncFuncs = append(syncFuncs, nodeInformer.Informer().HasSynced)
+		k.nodeLister = nodeInformer.Lister()
+	}
+
 	ok := cache.WaitForCacheSync(stop, syncFuncs...)
 	if !ok && ctx.Err() == nil {
 		// if initError is non-nil, the kube resource client will panic
@@ -85,10 +119,43 @@ func startInformerFactory(ctx context.Context, client kubernetes.Interface, watc
 	return k
 }
 
+// servedEndpointSliceVersion returns the newest version of EndpointSlices the cluster serves,
+// or nil if it does not serve them. Older clusters only have Endpoints.
+func servedEndpointSliceVersion(client kubernetes.Interface) *schema.GroupVersionResource {
+	for _, version := range endpointSliceVersions {
+		resources, err := client.Discovery().ServerResourcesForGroupVersion(version.GroupVersion().String())
+		if err != nil {
+			continue
+		}
+		for _, resource := range resources.APIResources {
+			if resource.Name == version.Resource {
+				version := version
+				return &version
+			}
+		}
+	}
+	return nil
+}
+
+// nodesListable returns whether gloo may list nodes, which are cluster-scoped
+// and thus cannot be read when its RBAC is namespaced.
+func nodesListable(client kubernetes.Interface) bool {
+	_, err := client.CoreV1().Nodes().List(metav1.ListOptions{Limit: 1})
+	return err == nil
+}
+
 func (k *KubePluginListers) EndpointsLister(ns string) kubelisters.EndpointsLister {
 	return k.endpointsLister[ns]
 }
 
+func (k *KubePluginListers) EndpointSliceLister(ns string) dynamiclister.Lister {
+	return k.endpointSliceLister[ns]
+}
+
+func (k *KubePluginListers) NodeLister() kubelisters.NodeLister {
+	return k.nodeLister
+}
+
 func (k *KubePluginListers) Subscribe() <-chan struct{} {
 	k.cacheUpdatedWatchersMutex.Lock()
 	defer k.cacheUpdatedWatchersMutex.Unlock()
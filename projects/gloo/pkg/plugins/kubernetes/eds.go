@@ -15,15 +15,18 @@ import (
 	corecache "github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/errors"
+	"go.uber.org/zap"
 	kubev1 "k8s.io/api/core/v1"
+	discoveryv1alpha1 "k8s.io/api/discovery/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
 
 	kubeFactory := func(namespaces []string) KubePluginSharedFactory {
-		return getInformerFactory(opts.Ctx, p.kube, namespaces)
+		return getInformerFactory(opts.Ctx, p.kube, p.kubeDynamic, namespaces)
 	}
 	watcher, err := newEndpointWatcherForUpstreams(kubeFactory, p.kubeCoreCache, writeNamespace, upstreamsToTrack, opts)
 	if err != nil {
@@ -90,11 +93,21 @@ func newEndpointsWatcher(kubeCoreCache corecache.KubeCoreCache, namespaces []str
 
 func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.EndpointList, error) {
 	var endpointList []*kubev1.Endpoints
+	var endpointSliceList []*endpointSlice
 	var serviceList []*kubev1.Service
 	var podList []*kubev1.Pod
+	var nodeList []*kubev1.Node
 	ctx := contextutils.WithLogger(opts.Ctx, "kubernetes_eds")
 	logger := contextutils.LoggerFrom(ctx)
 
+	if nodeLister := c.kubeShareFactory.NodeLister(); nodeLister != nil {
+		nodes, err := nodeLister.List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		nodeList = nodes
+	}
+
 	for _, ns := range c.namespaces {
 		if c.kubeCoreCache.NamespacedServiceLister(ns) == nil {
 			// this namespace is not watched, ignore it.
@@ -112,13 +125,28 @@ func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.Endp
 		}
 		podList = append(podList, pods...)
 
+		if endpointSliceLister := c.kubeShareFactory.EndpointSliceLister(ns); endpointSliceLister != nil {
+			endpointSlices, err := endpointSliceLister.List(labels.SelectorFromSet(opts.Selector))
+			if err != nil {
+				return nil, err
+			}
+			for _, obj := range endpointSlices {
+				slice, err := endpointSliceFromUnstructured(obj)
+				if err != nil {
+					logger.Warnf("skipping endpoint slice %v.%v: %v", obj.GetNamespace(), obj.GetName(), err)
+					continue
+				}
+				endpointSliceList = append(endpointSliceList, slice)
+			}
+			continue
+		}
 		endpoints, err := c.kubeShareFactory.EndpointsLister(ns).List(labels.SelectorFromSet(opts.Selector))
 		if err != nil {
 			return nil, err
 		}
 		endpointList = append(endpointList, endpoints...)
 	}
-	return filterEndpoints(ctx, writeNamespace, endpointList, serviceList, podList, c.upstreams), nil
+	return filterEndpoints(ctx, writeNamespace, endpointList, endpointSliceList, serviceList, podList, nodeList, c.upstreams), nil
 }
 
 func (c *edsWatcher) watch(writeNamespace string, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
@@ -164,11 +192,16 @@ func (c *edsWatcher) watch(writeNamespace string, opts clients.WatchOpts) (<-cha
 	return endpointsChan, errs, nil
 }
 
-func filterEndpoints(ctx context.Context, writeNamespace string, kubeEndpoints []*kubev1.Endpoints,
-	services []*kubev1.Service, pods []*kubev1.Pod, upstreams map[core.ResourceRef]*kubeplugin.UpstreamSpec) v1.EndpointList {
+func filterEndpoints(ctx context.Context, writeNamespace string, kubeEndpoints []*kubev1.Endpoints, endpointSlices []*endpointSlice,
+	services []*kubev1.Service, pods []*kubev1.Pod, nodes []*kubev1.Node, upstreams map[core.ResourceRef]*kubeplugin.UpstreamSpec) v1.EndpointList {
 	var endpoints v1.EndpointList
 
 	logger := contextutils.LoggerFrom(ctx)
+	podsIndex := newPodIndex(pods)
+	nodesByName := make(map[string]*kubev1.Node, len(nodes))
+	for _, node := range nodes {
+		nodesByName[node.Name] = node
+	}
 
 	type Epkey struct {
 		Address      string
@@ -178,6 +211,7 @@ func filterEndpoints(ctx context.Context, writeNamespace string, kubeEndpoints [
 		UpstreamRef  core.ResourceRef
 	}
 	endpointsMap := make(map[Epkey][]*core.ResourceRef)
+	endpointAddresses := make(map[Epkey]serviceAddress)
 
 	// for each upstream
 	for usRef, spec := range upstreams {
@@ -204,57 +238,63 @@ func filterEndpoints(ctx context.Context, writeNamespace string, kubeEndpoints [
 			continue
 		}
 		// find each matching endpoint
-		for _, eps := range kubeEndpoints {
-			if eps.Namespace != spec.ServiceNamespace || eps.Name != spec.ServiceName {
-				continue
+		addresses := addressesFromEndpoints(logger, usRef, spec, kubeServicePort, singlePortService, kubeEndpoints)
+		addresses = append(addresses, addressesFromEndpointSlices(logger, usRef, spec, kubeServicePort, singlePortService, endpointSlices)...)
+		for _, addr := range addresses {
+			var podName, podNamespace string
+			targetRef := addr.targetRef
+			if targetRef != nil {
+				if targetRef.Kind == "Pod" {
+					podName = targetRef.Name
+					podNamespace = targetRef.Namespace
+				}
 			}
-			for _, subset := range eps.Subsets {
-				var port uint32
-				for _, p := range subset.Ports {
-					// if the edpoint port is not named, it implies that
-					// the kube service only has a single unnamed port as well.
-					switch {
-					case singlePortService:
-						port = uint32(p.Port)
-					case p.Name == kubeServicePort.Name:
-						port = uint32(p.Port)
-						break
-					}
+			if addr.healthStatus == v1.HealthStatus_UNKNOWN {
+				// the source does not tell whether this not ready address is terminating: keep the addresses
+				// of terminating pods, so envoy can drain the requests in flight to them
+				pod, err := podsIndex.podForIp(addr.ip, podName, podNamespace)
+				if err != nil || pod.DeletionTimestamp == nil {
+					continue
 				}
-				if port == 0 {
-					logger.Warnf("upstream %v: port %v not found for service %v in endpoint %v", usRef.Key(), spec.ServicePort, spec.ServiceName, subset)
+				addr.healthStatus = v1.HealthStatus_DRAINING
+			}
+			if len(spec.Selector) != 0 {
+				// determine whether labels for the owner of this ip (pod) matches the spec
+				pod, err := podsIndex.podForIp(addr.ip, podName, podNamespace)
+				if err != nil {
+					// pod not found for ip? what's that about?
+					logger.Warnf("error for upstream %v service %v: %v", usRef.Key(), spec.ServiceName, err)
 					continue
 				}
-				for _, addr := range subset.Addresses {
-					var podName, podNamespace string
-					targetRef := addr.TargetRef
-					if targetRef != nil {
-						if targetRef.Kind == "Pod" {
-							podName = targetRef.Name
-							podNamespace = targetRef.Namespace
-						}
-					}
-					if len(spec.Selector) != 0 {
-						// determine whether labels for the owner of this ip (pod) matches the spec
-						podLabels, err := getPodLabelsForIp(addr.IP, podName, podNamespace, pods)
-						if err != nil {
-							// pod not found for ip? what's that about?
-							logger.Warnf("error for upstream %v service %v: %v", usRef.Key(), spec.ServiceName, err)
-							continue
-						}
-						if !labels.AreLabelsInWhiteList(spec.Selector, podLabels) {
-							continue
-						}
-						// pod hasn't been assigned address yet
-						if addr.IP == "" {
-							continue
-						}
+				if !labels.AreLabelsInWhiteList(spec.Selector, pod.Labels) {
+					continue
+				}
+				// pod hasn't been assigned address yet
+				if addr.ip == "" {
+					continue
+				}
+			}
+			if addr.locality == nil || addr.locality.Region == "" || addr.locality.Zone == "" {
+				nodeName := addr.nodeName
+				if nodeName == "" {
+					if pod, err := podsIndex.podForIp(addr.ip, podName, podNamespace); err == nil {
+						nodeName = pod.Spec.NodeName
 					}
-					key := Epkey{addr.IP, port, podName, podNamespace, usRef}
-					copyRef := usRef
-					endpointsMap[key] = append(endpointsMap[key], &copyRef)
 				}
+				addr.locality = completeLocality(addr.locality, nodesByName[nodeName])
+			}
+			key := Epkey{addr.ip, addr.port, podName, podNamespace, usRef}
+			if existing, ok := endpointAddresses[key]; ok {
+				// the address is listed more than once, e.g. in two EndpointSlices while they are being updated:
+				// keep a single endpoint for it, which is healthy if any of the listings is
+				if existing.healthStatus != v1.HealthStatus_HEALTHY {
+					endpointAddresses[key] = addr
+				}
+				continue
 			}
+			endpointAddresses[key] = addr
+			copyRef := usRef
+			endpointsMap[key] = append(endpointsMap[key], &copyRef)
 		}
 	}
 
@@ -274,8 +314,12 @@ func filterEndpoints(ctx context.Context, writeNamespace string, kubeEndpoints [
 			return '-'
 		}, addr.Address)
 		endpointName := fmt.Sprintf("ep-%v-%v-%x", dnsname, addr.Port, hasher.Sum64())
-		pod, _ := getPodForIp(addr.Address, addr.PodName, addr.PodNamespace, pods)
+		pod, _ := podsIndex.podForIp(addr.Address, addr.PodName, addr.PodNamespace)
 		ep := createEndpoint(writeNamespace, endpointName, refs, addr.Address, addr.Port, pod)
+		serviceAddr := endpointAddresses[addr]
+		ep.Locality = serviceAddr.locality
+		ep.HealthStatus = serviceAddr.healthStatus
+		ep.Hostname = serviceAddr.hostname
 		endpoints = append(endpoints, ep)
 	}
 
@@ -285,6 +329,205 @@ func filterEndpoints(ctx context.Context, writeNamespace string, kubeEndpoints [
 	return endpoints
 }
 
+// serviceAddress is an address of a service, read from either its Endpoints or its EndpointSlices
+type serviceAddress struct {
+	ip        string
+	port      uint32
+	hostname  string
+	targetRef *kubev1.ObjectReference
+	// the node the address is on, if its source tells
+	nodeName string
+	// read from the EndpointSlice, and completed with the labels of the node of the address
+	locality *v1.Locality
+	// HEALTHY or DRAINING, or UNKNOWN for a not ready address whose source does not report whether it is
+	// terminating, in which case it is drained if its pod is being deleted and ignored otherwise
+	healthStatus v1.HealthStatus
+}
+
+func addressesFromEndpoints(logger *zap.SugaredLogger, usRef core.ResourceRef, spec *kubeplugin.UpstreamSpec, kubeServicePort *kubev1.ServicePort,
+	singlePortService bool, kubeEndpoints []*kubev1.Endpoints) []serviceAddress {
+	var addresses []serviceAddress
+	for _, eps := range kubeEndpoints {
+		if eps.Namespace != spec.ServiceNamespace || eps.Name != spec.ServiceName {
+			continue
+		}
+		for _, subset := range eps.Subsets {
+			var port uint32
+			for _, p := range subset.Ports {
+				// if the edpoint port is not named, it implies that
+				// the kube service only has a single unnamed port as well.
+				switch {
+				case singlePortService:
+					port = uint32(p.Port)
+				case p.Name == kubeServicePort.Name:
+					port = uint32(p.Port)
+					break
+				}
+			}
+			if port == 0 {
+				logger.Warnf("upstream %v: port %v not found for service %v in endpoint %v", usRef.Key(), spec.ServicePort, spec.ServiceName, subset)
+				continue
+			}
+			for _, addr := range subset.Addresses {
+				addresses = append(addresses, serviceAddress{ip: addr.IP, port: port, hostname: addr.Hostname, targetRef: addr.TargetRef, nodeName: stringValue(addr.NodeName), healthStatus: v1.HealthStatus_HEALTHY})
+			}
+			for _, addr := range subset.NotReadyAddresses {
+				addresses = append(addresses, serviceAddress{ip: addr.IP, port: port, hostname: addr.Hostname, targetRef: addr.TargetRef, nodeName: stringValue(addr.NodeName)})
+			}
+		}
+	}
+	return addresses
+}
+
+func addressesFromEndpointSlices(logger *zap.SugaredLogger, usRef core.ResourceRef, spec *kubeplugin.UpstreamSpec, kubeServicePort *kubev1.ServicePort,
+	singlePortService bool, endpointSlices []*endpointSlice) []serviceAddress {
+	var addresses []serviceAddress
+	for _, slice := range endpointSlices {
+		if slice.Namespace != spec.ServiceNamespace || slice.Labels[discoveryv1alpha1.LabelServiceName] != spec.ServiceName {
+			continue
+		}
+		switch slice.AddressType {
+		case "", addressTypeIP, addressTypeIPv4, addressTypeIPv6:
+		default:
+			// e.g. FQDN
+			continue
+		}
+		var port uint32
+		for _, p := range slice.Ports {
+			if p.Port == nil {
+				continue
+			}
+			var name string
+			if p.Name != nil {
+				name = *p.Name
+			}
+			if singlePortService || name == kubeServicePort.Name {
+				port = uint32(*p.Port)
+			}
+		}
+		if port == 0 {
+			logger.Warnf("upstream %v: port %v not found for service %v in endpoint slice %v", usRef.Key(), spec.ServicePort, spec.ServiceName, slice.Name)
+			continue
+		}
+		for _, endpoint := range slice.Endpoints {
+			var hostname string
+			if endpoint.Hostname != nil {
+				hostname = *endpoint.Hostname
+			}
+			healthStatus, ok := endpointHealth(endpoint.Conditions)
+			if !ok {
+				continue
+			}
+			for _, ip := range endpoint.Addresses {
+				addresses = append(addresses, serviceAddress{
+					ip:           ip,
+					port:         port,
+					hostname:     hostname,
+					targetRef:    endpoint.TargetRef,
+					nodeName:     stringValue(endpoint.NodeName),
+					locality:     endpointLocality(endpoint),
+					healthStatus: healthStatus,
+				})
+			}
+		}
+	}
+	return addresses
+}
+
+// endpointHealth maps the conditions of an EndpointSlice endpoint to the health status of its addresses,
+// and returns false if they should not be used:
+//   - ready endpoints are HEALTHY. A missing ready condition means the state is unknown, which is treated as ready.
+//   - terminating endpoints that are still serving are DRAINING, so envoy lets the requests in flight to them finish.
+//   - terminating endpoints that are no longer serving, and not ready endpoints that are not terminating, are ignored.
+//
+// discovery.k8s.io/v1alpha1, and clusters that do not enable the conditions, do not report whether endpoints are
+// terminating; such not ready endpoints are UNKNOWN, and told apart by the deletion timestamp of their pod.
+func endpointHealth(conditions endpointConditions) (v1.HealthStatus, bool) {
+	switch {
+	case conditions.Ready == nil || *conditions.Ready:
+		return v1.HealthStatus_HEALTHY, true
+	case conditions.Terminating == nil:
+		return v1.HealthStatus_UNKNOWN, true
+	case *conditions.Terminating && (conditions.Serving == nil || *conditions.Serving):
+		return v1.HealthStatus_DRAINING, true
+	}
+	return v1.HealthStatus_UNKNOWN, false
+}
+
+// node topology labels that replace the deprecated kubev1.LabelZoneRegion and kubev1.LabelZoneFailureDomain
+const (
+	topologyRegionLabel = "topology.kubernetes.io/region"
+	topologyZoneLabel   = "topology.kubernetes.io/zone"
+)
+
+// endpointLocality reads the locality of an EndpointSlice endpoint from the topology labels of its node,
+// which the EndpointSlice controller copies onto it. discovery.k8s.io/v1 only keeps the zone, and moves
+// the labels to deprecatedTopology.
+func endpointLocality(endpoint endpointSliceItem) *v1.Locality {
+	topology := endpoint.Topology
+	if topology == nil {
+		topology = endpoint.DeprecatedTopology
+	}
+	locality := localityFromLabels(topology)
+	if endpoint.Zone != nil && *endpoint.Zone != "" {
+		if locality == nil {
+			locality = &v1.Locality{}
+		}
+		locality.Zone = *endpoint.Zone
+	}
+	return locality
+}
+
+// completeLocality fills the region and zone missing from the locality of an address
+// with the topology labels of its node, if the node is known
+func completeLocality(locality *v1.Locality, node *kubev1.Node) *v1.Locality {
+	if node == nil {
+		return locality
+	}
+	nodeLocality := localityFromLabels(node.Labels)
+	switch {
+	case nodeLocality == nil:
+		return locality
+	case locality == nil:
+		return nodeLocality
+	}
+	if locality.Region == "" {
+		locality.Region = nodeLocality.Region
+	}
+	if locality.Zone == "" {
+		locality.Zone = nodeLocality.Zone
+	}
+	return locality
+}
+
+// localityFromLabels reads a locality from node topology labels
+func localityFromLabels(labels map[string]string) *v1.Locality {
+	locality := &v1.Locality{
+		Region: topologyValue(labels, topologyRegionLabel, kubev1.LabelZoneRegion),
+		Zone:   topologyValue(labels, topologyZoneLabel, kubev1.LabelZoneFailureDomain),
+	}
+	if locality.Region == "" && locality.Zone == "" {
+		return nil
+	}
+	return locality
+}
+
+func topologyValue(topology map[string]string, keys ...string) string {
+	for _, key := range keys {
+		if value := topology[key]; value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 func createEndpoint(namespace, name string, upstreams []*core.ResourceRef, address string, port uint32, pod *kubev1.Pod) *v1.Endpoint {
 	ep := &v1.Endpoint{
 		Metadata: core.Metadata{
@@ -303,27 +546,20 @@ func createEndpoint(namespace, name string, upstreams []*core.ResourceRef, addre
 	return ep
 }
 
-func getPodLabelsForIp(ip string, podName, podNamespace string, pods []*kubev1.Pod) (map[string]string, error) {
-	pod, err := getPodForIp(ip, podName, podNamespace, pods)
-	if err != nil {
-		return nil, err
-	}
-	return pod.Labels, nil
+// podIndex looks up the pod behind an endpoint address without scanning every pod
+type podIndex struct {
+	byName map[types.NamespacedName]*kubev1.Pod
+	byIp   map[string]*kubev1.Pod
 }
 
-func getPodForIp(ip string, podName, podNamespace string, pods []*kubev1.Pod) (*kubev1.Pod, error) {
-
+func newPodIndex(pods []*kubev1.Pod) *podIndex {
+	index := &podIndex{
+		byName: make(map[types.NamespacedName]*kubev1.Pod, len(pods)),
+		byIp:   make(map[string]*kubev1.Pod, len(pods)),
+	}
 	for _, pod := range pods {
-		if podName != "" && podNamespace != "" {
-			// no need for hueristics!
-			if podName == pod.Name && podNamespace == pod.Namespace {
-				return pod, nil
-			}
-		}
+		index.byName[types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}] = pod
 
-		if pod.Status.PodIP != ip {
-			continue
-		}
 		if pod.Status.Phase != kubev1.PodRunning {
 			continue
 		}
@@ -331,8 +567,22 @@ func getPodForIp(ip string, podName, podNamespace string, pods []*kubev1.Pod) (*
 			// we cant tell pods apart if they are all on the host network.
 			continue
 		}
-		return pod, nil
+		if _, ok := index.byIp[pod.Status.PodIP]; !ok {
+			index.byIp[pod.Status.PodIP] = pod
+		}
 	}
+	return index
+}
 
+func (i *podIndex) podForIp(ip string, podName, podNamespace string) (*kubev1.Pod, error) {
+	if podName != "" && podNamespace != "" {
+		// no need for hueristics!
+		if pod, ok := i.byName[types.NamespacedName{Namespace: podNamespace, Name: podName}]; ok {
+			return pod, nil
+		}
+	}
+	if pod, ok := i.byIp[ip]; ok {
+		return pod, nil
+	}
 	return nil, errors.Errorf("running pod not found with ip %v", ip)
 }
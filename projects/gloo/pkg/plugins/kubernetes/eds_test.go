@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"context"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/solo-io/gloo/pkg/utils/settingsutil"
@@ -10,6 +11,17 @@ import (
 	mock_kubernetes "github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes/mocks"
 	mock_cache "github.com/solo-io/gloo/test/mocks/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	kubecache "github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1alpha1 "k8s.io/api/discovery/v1alpha1"
+	kubeerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -46,6 +58,7 @@ var _ = Describe("Eds", func() {
 		upstreamsToTrack := v1.UpstreamList{up}
 
 		mockCache.EXPECT().NamespacedServiceLister("bar").Return(nil)
+		mockSharedFactory.EXPECT().NodeLister().Return(nil)
 
 		watcher, err := newEndpointWatcherForUpstreams(func([]string) KubePluginSharedFactory { return mockSharedFactory }, mockCache, "foo", upstreamsToTrack, clients.WatchOpts{Ctx: ctx})
 		Expect(err).NotTo(HaveOccurred())
@@ -54,4 +67,470 @@ var _ = Describe("Eds", func() {
 
 	})
 
+	Context("with a fake clientset", func() {
+
+		var (
+			cancel    context.CancelFunc
+			upstreams v1.UpstreamList
+		)
+
+		boolPtr := func(b bool) *bool { return &b }
+		strPtr := func(s string) *string { return &s }
+		int32Ptr := func(i int32) *int32 { return &i }
+
+		service := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "svc"},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{{Name: "http", Port: 80}, {Name: "grpc", Port: 9090}},
+			},
+		}
+		pod := func(name, ip string, labels map[string]string) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: name, Labels: labels},
+				Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIP: ip},
+			}
+		}
+		terminatingPod := func(name, ip string) *corev1.Pod {
+			p := pod(name, ip, nil)
+			deletionTimestamp := metav1.Now()
+			p.DeletionTimestamp = &deletionTimestamp
+			return p
+		}
+		podRef := func(name string) *corev1.ObjectReference {
+			return &corev1.ObjectReference{Kind: "Pod", Namespace: "foo", Name: name}
+		}
+		podOnNode := func(name, ip, nodeName string) *corev1.Pod {
+			p := pod(name, ip, nil)
+			p.Spec.NodeName = nodeName
+			return p
+		}
+		node := func(name string, labels map[string]string) *corev1.Node {
+			return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+		}
+		// uses the deprecated labels, which older clusters still set
+		nodeEast1a := node("node-a", map[string]string{
+			corev1.LabelZoneRegion:        "us-east-1",
+			corev1.LabelZoneFailureDomain: "us-east-1a",
+		})
+		nodeEast1b := node("node-b", map[string]string{
+			"topology.kubernetes.io/region": "us-east-1",
+			"topology.kubernetes.io/zone":   "us-east-1b",
+		})
+
+		localities := func(endpoints v1.EndpointList) map[string]*v1.Locality {
+			localities := map[string]*v1.Locality{}
+			for _, ep := range endpoints {
+				localities[ep.Address] = ep.Locality
+			}
+			return localities
+		}
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(ctx)
+			up := v1.NewUpstream("gloo-system", "foo-svc-80")
+			up.UpstreamType = &v1.Upstream_Kube{
+				Kube: &kubev1.UpstreamSpec{
+					ServiceName:      "svc",
+					ServiceNamespace: "foo",
+					ServicePort:      80,
+				},
+			}
+			upstreams = v1.UpstreamList{up}
+		})
+
+		AfterEach(func() {
+			cancel()
+		})
+
+		list := func(client *fake.Clientset, dynamicClient dynamic.Interface) v1.EndpointList {
+			kubeCoreCache, err := kubecache.NewKubeCoreCacheWithOptions(ctx, client, time.Hour, []string{"foo"})
+			Expect(err).NotTo(HaveOccurred())
+			factory := startInformerFactory(ctx, client, dynamicClient, []string{"foo"})
+			Expect(factory.initError).NotTo(HaveOccurred())
+			endpoints, err := newEndpointsWatcher(kubeCoreCache, []string{"foo"}, factory, upstreams).List("gloo-system", clients.ListOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			return endpoints
+		}
+
+		addresses := func(endpoints v1.EndpointList) []string {
+			var addresses []string
+			for _, ep := range endpoints {
+				addresses = append(addresses, ep.Address)
+			}
+			return addresses
+		}
+
+		healthStatuses := func(endpoints v1.EndpointList) map[string]v1.HealthStatus {
+			statuses := map[string]v1.HealthStatus{}
+			for _, ep := range endpoints {
+				statuses[ep.Address] = ep.HealthStatus
+			}
+			return statuses
+		}
+
+		Context("when the cluster serves EndpointSlices", func() {
+
+			var version string
+
+			// served lists the EndpointSlice versions the cluster serves, the slices are created in the first one
+			listSlices := func(served []string, objects []runtime.Object, slices ...*endpointSlice) v1.EndpointList {
+				client := fake.NewSimpleClientset(objects...)
+				for _, version := range served {
+					client.Resources = append(client.Resources, &metav1.APIResourceList{
+						GroupVersion: "discovery.k8s.io/" + version,
+						APIResources: []metav1.APIResource{{Name: "endpointslices", Kind: "EndpointSlice"}},
+					})
+				}
+				var dynamicObjects []runtime.Object
+				for _, slice := range slices {
+					content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(slice)
+					Expect(err).NotTo(HaveOccurred())
+					obj := &unstructured.Unstructured{Object: content}
+					obj.SetAPIVersion("discovery.k8s.io/" + served[0])
+					obj.SetKind("EndpointSlice")
+					dynamicObjects = append(dynamicObjects, obj)
+				}
+				return list(client, dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), dynamicObjects...))
+			}
+
+			listVersion := func(objects []runtime.Object, slices ...*endpointSlice) v1.EndpointList {
+				return listSlices([]string{version}, objects, slices...)
+			}
+
+			slice := func(name string, endpoints ...endpointSliceItem) *endpointSlice {
+				return &endpointSlice{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "foo",
+						Name:      name,
+						Labels:    map[string]string{discoveryv1alpha1.LabelServiceName: "svc"},
+					},
+					AddressType: addressTypeIPv4,
+					Ports: []endpointSlicePort{
+						{Name: strPtr("http"), Port: int32Ptr(8080)},
+						{Name: strPtr("grpc"), Port: int32Ptr(9091)},
+					},
+					Endpoints: endpoints,
+				}
+			}
+
+			Context("of version v1alpha1", func() {
+
+				BeforeEach(func() {
+					version = "v1alpha1"
+				})
+
+				ipSlice := func(name string, endpoints ...endpointSliceItem) *endpointSlice {
+					s := slice(name, endpoints...)
+					s.AddressType = addressTypeIP
+					return s
+				}
+
+				It("only uses ready endpoints", func() {
+					endpoints := listVersion(
+						[]runtime.Object{service, pod("a", "1.1.1.1", nil), pod("b", "2.2.2.2", nil), pod("c", "3.3.3.3", nil)},
+						ipSlice("svc-1",
+							endpointSliceItem{Addresses: []string{"1.1.1.1"}, Conditions: endpointConditions{Ready: boolPtr(true)}, TargetRef: podRef("a")},
+							endpointSliceItem{Addresses: []string{"2.2.2.2"}, TargetRef: podRef("b")},
+						),
+						ipSlice("svc-2",
+							endpointSliceItem{Addresses: []string{"3.3.3.3"}, Conditions: endpointConditions{Ready: boolPtr(false)}, TargetRef: podRef("c")},
+						),
+					)
+
+					Expect(addresses(endpoints)).To(ConsistOf("1.1.1.1", "2.2.2.2"))
+					for _, ep := range endpoints {
+						Expect(ep.Port).To(Equal(uint32(8080)))
+					}
+				})
+
+				It("drains the endpoints of terminating pods", func() {
+					endpoints := listVersion(
+						[]runtime.Object{service, pod("a", "1.1.1.1", nil), terminatingPod("b", "2.2.2.2")},
+						ipSlice("svc-1",
+							endpointSliceItem{Addresses: []string{"1.1.1.1"}, Hostname: strPtr("a"), TargetRef: podRef("a")},
+							endpointSliceItem{Addresses: []string{"2.2.2.2"}, Conditions: endpointConditions{Ready: boolPtr(false)}, TargetRef: podRef("b")},
+						),
+					)
+
+					Expect(healthStatuses(endpoints)).To(Equal(map[string]v1.HealthStatus{
+						"1.1.1.1": v1.HealthStatus_HEALTHY,
+						"2.2.2.2": v1.HealthStatus_DRAINING,
+					}))
+					for _, ep := range endpoints {
+						if ep.Address == "1.1.1.1" {
+							Expect(ep.Hostname).To(Equal("a"))
+						}
+					}
+				})
+
+				It("matches the upstream selector against the labels of the target pod", func() {
+					upstreams[0].GetKube().Selector = map[string]string{"version": "v2"}
+					endpoints := listVersion(
+						[]runtime.Object{service,
+							pod("a", "1.1.1.1", map[string]string{"version": "v1"}),
+							pod("b", "2.2.2.2", map[string]string{"version": "v2"}),
+						},
+						ipSlice("svc-1",
+							endpointSliceItem{Addresses: []string{"1.1.1.1"}, TargetRef: podRef("a")},
+							endpointSliceItem{Addresses: []string{"2.2.2.2"}, TargetRef: podRef("b")},
+						),
+					)
+
+					Expect(addresses(endpoints)).To(ConsistOf("2.2.2.2"))
+					Expect(endpoints[0].Metadata.Labels).To(Equal(map[string]string{"version": "v2"}))
+				})
+
+				It("reads the locality of endpoints from their topology", func() {
+					endpoints := listVersion(
+						[]runtime.Object{service, pod("a", "1.1.1.1", nil), pod("b", "2.2.2.2", nil)},
+						ipSlice("svc-1",
+							endpointSliceItem{
+								Addresses: []string{"1.1.1.1"},
+								TargetRef: podRef("a"),
+								Topology: map[string]string{
+									"topology.kubernetes.io/region": "us-east-1",
+									"topology.kubernetes.io/zone":   "us-east-1a",
+								},
+							},
+							endpointSliceItem{
+								Addresses: []string{"2.2.2.2"},
+								TargetRef: podRef("b"),
+							},
+						),
+					)
+
+					Expect(endpoints).To(HaveLen(2))
+					for _, ep := range endpoints {
+						switch ep.Address {
+						case "1.1.1.1":
+							Expect(ep.Locality).To(Equal(&v1.Locality{Region: "us-east-1", Zone: "us-east-1a"}))
+						case "2.2.2.2":
+							Expect(ep.Locality).To(BeNil())
+						}
+					}
+				})
+
+				It("reads the locality of endpoints without topology from the node of their pod", func() {
+					endpoints := listVersion(
+						[]runtime.Object{service, nodeEast1a, podOnNode("a", "1.1.1.1", "node-a"), pod("b", "2.2.2.2", nil)},
+						ipSlice("svc-1",
+							endpointSliceItem{Addresses: []string{"1.1.1.1"}, TargetRef: podRef("a")},
+							endpointSliceItem{Addresses: []string{"2.2.2.2"}, TargetRef: podRef("b")},
+						),
+					)
+
+					Expect(localities(endpoints)).To(Equal(map[string]*v1.Locality{
+						"1.1.1.1": {Region: "us-east-1", Zone: "us-east-1a"},
+						"2.2.2.2": nil,
+					}))
+				})
+
+				It("ignores slices of other services", func() {
+					other := ipSlice("other-1", endpointSliceItem{Addresses: []string{"4.4.4.4"}})
+					other.Labels[discoveryv1alpha1.LabelServiceName] = "other"
+
+					Expect(listVersion([]runtime.Object{service}, other)).To(BeEmpty())
+				})
+			})
+
+			Context("of version v1beta1", func() {
+
+				BeforeEach(func() {
+					version = "v1beta1"
+				})
+
+				It("maps the serving and terminating conditions", func() {
+					endpoints := listVersion(
+						// none of the pods is being deleted, the conditions alone decide
+						[]runtime.Object{service,
+							pod("a", "1.1.1.1", nil), pod("b", "2.2.2.2", nil), pod("c", "3.3.3.3", nil),
+							pod("d", "4.4.4.4", nil), pod("e", "5.5.5.5", nil),
+						},
+						slice("svc-1",
+							endpointSliceItem{Addresses: []string{"1.1.1.1"}, TargetRef: podRef("a"),
+								Conditions: endpointConditions{Ready: boolPtr(true), Serving: boolPtr(true), Terminating: boolPtr(false)}},
+							endpointSliceItem{Addresses: []string{"2.2.2.2"}, TargetRef: podRef("b"),
+								Conditions: endpointConditions{Ready: boolPtr(false), Serving: boolPtr(true), Terminating: boolPtr(true)}},
+							endpointSliceItem{Addresses: []string{"3.3.3.3"}, TargetRef: podRef("c"),
+								Conditions: endpointConditions{Ready: boolPtr(false), Serving: boolPtr(false), Terminating: boolPtr(true)}},
+							endpointSliceItem{Addresses: []string{"4.4.4.4"}, TargetRef: podRef("d"),
+								Conditions: endpointConditions{Ready: boolPtr(false), Serving: boolPtr(false), Terminating: boolPtr(false)}},
+							endpointSliceItem{Addresses: []string{"5.5.5.5"}, TargetRef: podRef("e"),
+								Conditions: endpointConditions{Ready: boolPtr(false), Terminating: boolPtr(true)}},
+						),
+					)
+
+					Expect(healthStatuses(endpoints)).To(Equal(map[string]v1.HealthStatus{
+						"1.1.1.1": v1.HealthStatus_HEALTHY,
+						"2.2.2.2": v1.HealthStatus_DRAINING,
+						"5.5.5.5": v1.HealthStatus_DRAINING,
+					}))
+				})
+
+				It("creates a single endpoint for addresses listed in more than one slice", func() {
+					endpoints := listVersion(
+						[]runtime.Object{service, pod("a", "1.1.1.1", nil)},
+						slice("svc-1",
+							endpointSliceItem{Addresses: []string{"1.1.1.1"}, TargetRef: podRef("a"),
+								Conditions: endpointConditions{Ready: boolPtr(false), Serving: boolPtr(true), Terminating: boolPtr(true)}},
+						),
+						slice("svc-2",
+							endpointSliceItem{Addresses: []string{"1.1.1.1"}, TargetRef: podRef("a"),
+								Conditions: endpointConditions{Ready: boolPtr(true)}},
+						),
+					)
+
+					Expect(endpoints).To(HaveLen(1))
+					Expect(endpoints[0].Upstreams).To(HaveLen(1))
+					Expect(endpoints[0].HealthStatus).To(Equal(v1.HealthStatus_HEALTHY))
+				})
+
+				It("ignores slices of FQDN addresses", func() {
+					fqdn := slice("svc-1", endpointSliceItem{Addresses: []string{"a.example.com"}})
+					fqdn.AddressType = "FQDN"
+
+					Expect(listVersion([]runtime.Object{service}, fqdn)).To(BeEmpty())
+				})
+			})
+
+			Context("of version v1", func() {
+
+				BeforeEach(func() {
+					version = "v1"
+				})
+
+				It("reads the locality of endpoints from their zone and deprecated topology", func() {
+					endpoints := listVersion(
+						[]runtime.Object{service, pod("a", "1.1.1.1", nil)},
+						slice("svc-1",
+							endpointSliceItem{
+								Addresses:          []string{"1.1.1.1"},
+								TargetRef:          podRef("a"),
+								Zone:               strPtr("us-east-1a"),
+								DeprecatedTopology: map[string]string{"topology.kubernetes.io/region": "us-east-1"},
+							},
+						),
+					)
+
+					Expect(endpoints).To(HaveLen(1))
+					Expect(endpoints[0].Locality).To(Equal(&v1.Locality{Region: "us-east-1", Zone: "us-east-1a"}))
+				})
+
+				It("reads the region missing from the zone of endpoints from the labels of their node", func() {
+					endpoints := listVersion(
+						[]runtime.Object{service, nodeEast1b, pod("b", "2.2.2.2", nil)},
+						slice("svc-1",
+							endpointSliceItem{
+								Addresses: []string{"2.2.2.2"},
+								TargetRef: podRef("b"),
+								NodeName:  strPtr("node-b"),
+								Zone:      strPtr("us-east-1b"),
+							},
+						),
+					)
+
+					Expect(localities(endpoints)).To(Equal(map[string]*v1.Locality{
+						"2.2.2.2": {Region: "us-east-1", Zone: "us-east-1b"},
+					}))
+				})
+			})
+
+			It("uses the newest version the cluster serves", func() {
+				endpoints := listSlices([]string{"v1beta1", "v1alpha1"},
+					[]runtime.Object{service, pod("a", "1.1.1.1", nil)},
+					slice("svc-1",
+						endpointSliceItem{Addresses: []string{"1.1.1.1"}, TargetRef: podRef("a"),
+							Conditions: endpointConditions{Ready: boolPtr(false), Serving: boolPtr(true), Terminating: boolPtr(true)}},
+					),
+				)
+
+				// only the v1beta1 slice exists, and its conditions are known
+				Expect(healthStatuses(endpoints)).To(Equal(map[string]v1.HealthStatus{"1.1.1.1": v1.HealthStatus_DRAINING}))
+			})
+		})
+
+		It("falls back to Endpoints when the cluster does not serve EndpointSlices", func() {
+			client := fake.NewSimpleClientset(service,
+				pod("a", "1.1.1.1", nil),
+				&corev1.Endpoints{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "svc"},
+					Subsets: []corev1.EndpointSubset{{
+						Addresses:         []corev1.EndpointAddress{{IP: "1.1.1.1", TargetRef: podRef("a")}},
+						NotReadyAddresses: []corev1.EndpointAddress{{IP: "2.2.2.2"}},
+						Ports:             []corev1.EndpointPort{{Name: "http", Port: 8080}},
+					}},
+				},
+			)
+
+			endpoints := list(client, dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()))
+			Expect(addresses(endpoints)).To(ConsistOf("1.1.1.1"))
+			Expect(endpoints[0].Port).To(Equal(uint32(8080)))
+			Expect(endpoints[0].HealthStatus).To(Equal(v1.HealthStatus_HEALTHY))
+		})
+
+		It("drains the not ready Endpoints addresses of terminating pods", func() {
+			client := fake.NewSimpleClientset(service,
+				terminatingPod("a", "1.1.1.1"),
+				&corev1.Endpoints{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "svc"},
+					Subsets: []corev1.EndpointSubset{{
+						NotReadyAddresses: []corev1.EndpointAddress{{IP: "1.1.1.1", TargetRef: podRef("a")}},
+						Ports:             []corev1.EndpointPort{{Name: "http", Port: 8080}},
+					}},
+				},
+			)
+
+			endpoints := list(client, dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()))
+			Expect(addresses(endpoints)).To(ConsistOf("1.1.1.1"))
+			Expect(endpoints[0].HealthStatus).To(Equal(v1.HealthStatus_DRAINING))
+		})
+
+		It("reads the locality of Endpoints addresses from the labels of their node", func() {
+			nodeB := "node-b"
+			client := fake.NewSimpleClientset(service, nodeEast1a, nodeEast1b,
+				podOnNode("a", "1.1.1.1", "node-a"), pod("b", "2.2.2.2", nil), pod("c", "3.3.3.3", nil),
+				&corev1.Endpoints{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "svc"},
+					Subsets: []corev1.EndpointSubset{{
+						Addresses: []corev1.EndpointAddress{
+							// the node of the pod
+							{IP: "1.1.1.1", TargetRef: podRef("a")},
+							// the node of the address
+							{IP: "2.2.2.2", TargetRef: podRef("b"), NodeName: &nodeB},
+							// unknown node
+							{IP: "3.3.3.3", TargetRef: podRef("c")},
+						},
+						Ports: []corev1.EndpointPort{{Name: "http", Port: 8080}},
+					}},
+				},
+			)
+
+			endpoints := list(client, dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()))
+			Expect(localities(endpoints)).To(Equal(map[string]*v1.Locality{
+				"1.1.1.1": {Region: "us-east-1", Zone: "us-east-1a"},
+				"2.2.2.2": {Region: "us-east-1", Zone: "us-east-1b"},
+				"3.3.3.3": nil,
+			}))
+		})
+
+		It("does not read localities when nodes cannot be listed", func() {
+			client := fake.NewSimpleClientset(service, nodeEast1a,
+				podOnNode("a", "1.1.1.1", "node-a"),
+				&corev1.Endpoints{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "svc"},
+					Subsets: []corev1.EndpointSubset{{
+						Addresses: []corev1.EndpointAddress{{IP: "1.1.1.1", TargetRef: podRef("a")}},
+						Ports:     []corev1.EndpointPort{{Name: "http", Port: 8080}},
+					}},
+				},
+			)
+			client.PrependReactor("list", "nodes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, kubeerrors.NewForbidden(corev1.Resource("nodes"), "", nil)
+			})
+
+			endpoints := list(client, dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()))
+			Expect(addresses(endpoints)).To(ConsistOf("1.1.1.1"))
+			Expect(endpoints[0].Locality).To(BeNil())
+		})
+	})
 })
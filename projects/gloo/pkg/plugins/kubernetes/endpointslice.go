@@ -0,0 +1,70 @@
+package kubernetes
+
+import (
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// the discovery.k8s.io versions serving EndpointSlices, newest first.
+// v1beta1 and v1 report whether endpoints are serving and terminating, v1alpha1 only whether they are ready.
+var endpointSliceVersions = []schema.GroupVersionResource{
+	{Group: "discovery.k8s.io", Version: "v1", Resource: "endpointslices"},
+	{Group: "discovery.k8s.io", Version: "v1beta1", Resource: "endpointslices"},
+	{Group: "discovery.k8s.io", Version: "v1alpha1", Resource: "endpointslices"},
+}
+
+// address types of EndpointSlices. v1alpha1 only has "IP", later versions split it by family and add "FQDN".
+const (
+	addressTypeIP   = "IP"
+	addressTypeIPv4 = "IPv4"
+	addressTypeIPv6 = "IPv6"
+)
+
+// endpointSlice holds the fields of an EndpointSlice that are common to, or compatible between, the
+// discovery.k8s.io versions. EndpointSlices are watched as unstructured objects of the newest version the
+// cluster serves, as the vendored client-go only knows v1alpha1.
+type endpointSlice struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	AddressType string              `json:"addressType"`
+	Endpoints   []endpointSliceItem `json:"endpoints"`
+	Ports       []endpointSlicePort `json:"ports"`
+}
+
+type endpointSliceItem struct {
+	Addresses  []string                `json:"addresses"`
+	Conditions endpointConditions      `json:"conditions,omitempty"`
+	Hostname   *string                 `json:"hostname,omitempty"`
+	TargetRef  *kubev1.ObjectReference `json:"targetRef,omitempty"`
+	// v1alpha1 and v1beta1 only
+	Topology map[string]string `json:"topology,omitempty"`
+	// v1 only, replaces topology
+	DeprecatedTopology map[string]string `json:"deprecatedTopology,omitempty"`
+	// v1beta1 and v1
+	NodeName *string `json:"nodeName,omitempty"`
+	// v1 only
+	Zone *string `json:"zone,omitempty"`
+}
+
+type endpointConditions struct {
+	Ready *bool `json:"ready,omitempty"`
+	// v1beta1 and v1 only
+	Serving     *bool `json:"serving,omitempty"`
+	Terminating *bool `json:"terminating,omitempty"`
+}
+
+type endpointSlicePort struct {
+	Name *string `json:"name,omitempty"`
+	Port *int32  `json:"port,omitempty"`
+}
+
+func endpointSliceFromUnstructured(obj *unstructured.Unstructured) (*endpointSlice, error) {
+	var slice endpointSlice
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), &slice); err != nil {
+		return nil, err
+	}
+	return &slice, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	corecache "github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -18,6 +19,8 @@ var _ discovery.DiscoveryPlugin = new(plugin)
 
 type plugin struct {
 	kube kubernetes.Interface
+	// used to watch EndpointSlices of any version; Endpoints are watched when nil
+	kubeDynamic dynamic.Interface
 
 	UpstreamConverter UpstreamConverter
 
@@ -33,9 +36,10 @@ func (p *plugin) Resolve(u *v1.Upstream) (*url.URL, error) {
 	return url.Parse(fmt.Sprintf("tcp://%v.%v.svc.cluster.local:%v", kubeSpec.Kube.ServiceName, kubeSpec.Kube.ServiceNamespace, kubeSpec.Kube.ServicePort))
 }
 
-func NewPlugin(kube kubernetes.Interface, kubeCoreCache corecache.KubeCoreCache) plugins.Plugin {
+func NewPlugin(kube kubernetes.Interface, kubeDynamic dynamic.Interface, kubeCoreCache corecache.KubeCoreCache) plugins.Plugin {
 	return &plugin{
 		kube:              kube,
+		kubeDynamic:       kubeDynamic,
 		UpstreamConverter: DefaultUpstreamConverter(),
 		kubeCoreCache:     kubeCoreCache,
 	}
@@ -85,7 +85,7 @@ func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		return nil
 	}
 
-	if cfg.HealthyPanicThreshold != nil || cfg.UpdateMergeWindow != nil {
+	if cfg.HealthyPanicThreshold != nil || cfg.UpdateMergeWindow != nil || cfg.LocalityConfig != nil {
 		out.CommonLbConfig = &envoyapi.Cluster_CommonLbConfig{}
 		if cfg.HealthyPanicThreshold != nil {
 			out.CommonLbConfig.HealthyPanicThreshold = &envoytype.Percent{
@@ -95,6 +95,7 @@ func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		if cfg.UpdateMergeWindow != nil {
 			out.CommonLbConfig.UpdateMergeWindow = gogoutils.DurationStdToProto(cfg.UpdateMergeWindow)
 		}
+		setLocalityConfig(out.CommonLbConfig, cfg)
 	}
 
 	if cfg.Type != nil {
@@ -125,6 +126,32 @@ func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	return nil
 }
 
+func setLocalityConfig(out *envoyapi.Cluster_CommonLbConfig, cfg *v1.LoadBalancerConfig) {
+	switch localityConfig := cfg.LocalityConfig.(type) {
+	case *v1.LoadBalancerConfig_ZoneAwareLbConfig_:
+		zoneAware := &envoyapi.Cluster_CommonLbConfig_ZoneAwareLbConfig{
+			FailTrafficOnPanic: localityConfig.ZoneAwareLbConfig.FailTrafficOnPanic,
+		}
+		if routingEnabled := localityConfig.ZoneAwareLbConfig.RoutingEnabled; routingEnabled != nil {
+			zoneAware.RoutingEnabled = &envoytype.Percent{
+				Value: routingEnabled.Value,
+			}
+		}
+		if minClusterSize := localityConfig.ZoneAwareLbConfig.MinClusterSize; minClusterSize != nil {
+			zoneAware.MinClusterSize = &wrappers.UInt64Value{
+				Value: minClusterSize.Value,
+			}
+		}
+		out.LocalityConfigSpecifier = &envoyapi.Cluster_CommonLbConfig_ZoneAwareLbConfig_{
+			ZoneAwareLbConfig: zoneAware,
+		}
+	case *v1.LoadBalancerConfig_LocalityWeightedLbConfig_:
+		out.LocalityConfigSpecifier = &envoyapi.Cluster_CommonLbConfig_LocalityWeightedLbConfig_{
+			LocalityWeightedLbConfig: &envoyapi.Cluster_CommonLbConfig_LocalityWeightedLbConfig{},
+		}
+	}
+}
+
 func setRingHashLbConfig(out *envoyapi.Cluster, userConfig *v1.LoadBalancerConfig_RingHashConfig) {
 	cfg := &envoyapi.Cluster_RingHashLbConfig_{
 		RingHashLbConfig: &envoyapi.Cluster_RingHashLbConfig{},
@@ -61,6 +61,36 @@ var _ = Describe("Plugin", func() {
 		Expect(out.CommonLbConfig.UpdateMergeWindow.Nanos).To(BeEquivalentTo(0))
 	})
 
+	It("should set zone aware lb config", func() {
+		upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+			LocalityConfig: &v1.LoadBalancerConfig_ZoneAwareLbConfig_{
+				ZoneAwareLbConfig: &v1.LoadBalancerConfig_ZoneAwareLbConfig{
+					RoutingEnabled:     &types.DoubleValue{Value: 80},
+					MinClusterSize:     &types.UInt64Value{Value: 3},
+					FailTrafficOnPanic: true,
+				},
+			},
+		}
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		zoneAware := out.CommonLbConfig.GetZoneAwareLbConfig()
+		Expect(zoneAware).NotTo(BeNil())
+		Expect(zoneAware.RoutingEnabled.Value).To(BeEquivalentTo(80))
+		Expect(zoneAware.MinClusterSize.Value).To(BeEquivalentTo(3))
+		Expect(zoneAware.FailTrafficOnPanic).To(BeTrue())
+	})
+
+	It("should set locality weighted lb config", func() {
+		upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+			LocalityConfig: &v1.LoadBalancerConfig_LocalityWeightedLbConfig_{
+				LocalityWeightedLbConfig: &v1.LoadBalancerConfig_LocalityWeightedLbConfig{},
+			},
+		}
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.CommonLbConfig.GetLocalityWeightedLbConfig()).NotTo(BeNil())
+	})
+
 	It("should set lb policy random", func() {
 		upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
 			Type: &v1.LoadBalancerConfig_Random_{
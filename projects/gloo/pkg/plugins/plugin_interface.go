@@ -50,6 +50,12 @@ type UpstreamPlugin interface {
 	ProcessUpstream(params Params, in *v1.Upstream, out *envoyapi.Cluster) error
 }
 
+// EndpointPlugins modify the load assignment computed from the endpoints of an upstream
+type EndpointPlugin interface {
+	Plugin
+	ProcessEndpoints(params Params, in *v1.Upstream, out *envoyapi.ClusterLoadAssignment) error
+}
+
 /*
 	Routing Plugins
 */
@@ -6,10 +6,12 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/als"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws/ec2"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws/ecs"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/azure"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/basicroute"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/cors"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/dnssrv"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/extauth"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/faultinjection"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpc"
@@ -65,6 +67,8 @@ var globalRegistry = func(opts bootstrap.Opts, pluginExtensions ...func() plugin
 		linkerd.NewPlugin(),
 		stats.NewPlugin(),
 		ec2.NewPlugin(opts.Secrets),
+		ecs.NewPlugin(opts.Secrets),
+		dnssrv.NewPlugin(&dnssrv.DnsSrvResolver{}),
 		tracing.NewPlugin(),
 		shadowing.NewPlugin(),
 		headers.NewPlugin(),
@@ -75,10 +79,10 @@ var globalRegistry = func(opts bootstrap.Opts, pluginExtensions ...func() plugin
 		gzip.NewPlugin(),
 	)
 	if opts.KubeClient != nil {
-		reg.plugins = append(reg.plugins, kubernetes.NewPlugin(opts.KubeClient, opts.KubeCoreCache))
+		reg.plugins = append(reg.plugins, kubernetes.NewPlugin(opts.KubeClient, opts.KubeDynamicClient, opts.KubeCoreCache))
 	}
 	if opts.Consul.ConsulWatcher != nil {
-		reg.plugins = append(reg.plugins, consul.NewPlugin(opts.Consul.ConsulWatcher, &consul.ConsulDnsResolver{DnsAddress: opts.Consul.DnsServer}, opts.Consul.DnsPollingInterval))
+		reg.plugins = append(reg.plugins, consul.NewPlugin(opts.Consul.ConsulWatcher, &consul.ConsulDnsResolver{DnsAddress: opts.Consul.DnsServer}, opts.Consul.DnsPollingInterval, opts.Settings.GetConsul().GetServiceDiscovery(), opts.Consul.ConnectCertificates))
 	}
 	hcmPlugin.RegisterHcmPlugins(reg.plugins)
 
@@ -2,6 +2,8 @@ package static
 
 import (
 	"net"
+	"strconv"
+	"time"
 
 	"fmt"
 	"net/url"
@@ -10,12 +12,28 @@ import (
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	envoyendpoint "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
+	"github.com/golang/protobuf/proto"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/solo-io/gloo/pkg/utils/gogoutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 	"github.com/solo-io/solo-kit/pkg/errors"
 )
 
+const (
+	// Envoy matches endpoints against transport socket matches using metadata under this filter name
+	transportSocketMatchKey = "envoy.transport_socket_match"
+	sniMatchField           = "sni"
+
+	defaultDnsPort = 53
+
+	// envoy requires the dns refresh rate to be greater than this
+	minDnsRefreshRate = time.Millisecond
+)
+
 type plugin struct{}
 
 func NewPlugin() plugins.Plugin {
@@ -48,6 +66,7 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	spec := staticSpec.Static
 	var foundSslPort bool
 	var hostname string
+	var hostSnis []string
 
 	out.ClusterDiscoveryType = &envoyapi.Cluster_Type{
 		Type: envoyapi.Cluster_STATIC,
@@ -59,6 +78,10 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		if host.Port == 0 {
 			return errors.Errorf("port cannot be empty for host")
 		}
+		if weight := host.GetLoadBalancingWeight(); weight != nil && weight.GetValue() == 0 {
+			// envoy rejects the whole cluster for a weight of 0
+			return errors.Errorf("load balancing weight of host %v must be at least 1", host.Addr)
+		}
 		if host.Port == 443 {
 			foundSslPort = true
 		}
@@ -78,27 +101,35 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 			}
 		}
 
-		out.LoadAssignment.Endpoints[0].LbEndpoints = append(out.LoadAssignment.Endpoints[0].LbEndpoints,
-			&envoyendpoint.LbEndpoint{
-				HostIdentifier: &envoyendpoint.LbEndpoint_Endpoint{
-					Endpoint: &envoyendpoint.Endpoint{
-						Address: &envoycore.Address{
-							Address: &envoycore.Address_SocketAddress{
-								SocketAddress: &envoycore.SocketAddress{
-									Protocol: envoycore.SocketAddress_TCP,
-									Address:  host.Addr,
-									PortSpecifier: &envoycore.SocketAddress_PortValue{
-										PortValue: host.Port,
-									},
+		lbEndpoint := &envoyendpoint.LbEndpoint{
+			HostIdentifier: &envoyendpoint.LbEndpoint_Endpoint{
+				Endpoint: &envoyendpoint.Endpoint{
+					Address: &envoycore.Address{
+						Address: &envoycore.Address_SocketAddress{
+							SocketAddress: &envoycore.SocketAddress{
+								Protocol: envoycore.SocketAddress_TCP,
+								Address:  host.Addr,
+								PortSpecifier: &envoycore.SocketAddress_PortValue{
+									PortValue: host.Port,
 								},
 							},
 						},
 					},
 				},
-			})
+			},
+		}
+		if weight := host.GetLoadBalancingWeight(); weight != nil {
+			lbEndpoint.LoadBalancingWeight = &wrappers.UInt32Value{Value: weight.GetValue()}
+		}
+		if host.SniAddr != "" {
+			lbEndpoint.Metadata = sniMetadata(host.SniAddr)
+			hostSnis = appendUnique(hostSnis, host.SniAddr)
+		}
+		out.LoadAssignment.Endpoints[0].LbEndpoints = append(out.LoadAssignment.Endpoints[0].LbEndpoints, lbEndpoint)
 	}
 
-	// if host port is 443 or if the user wants it, we will use TLS
+	// if host port is 443 or if the user wants it, we will use TLS.
+	// the upstream may also have a transport socket from its ssl config already.
 	if spec.UseTls || foundSslPort {
 		// tell envoy to use TLS to connect to this upstream
 		// TODO: support client certificates
@@ -113,17 +144,152 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 			}
 		}
 	}
+	if len(hostSnis) > 0 && out.TransportSocket == nil {
+		return errors.Errorf("sni_addr of hosts %v requires TLS, set use_tls or an ssl config on the upstream", hostSnis)
+	}
+	if err := setSniTransportSocketMatches(out, hostSnis); err != nil {
+		return err
+	}
 
 	// the upstream has a DNS name. We need Envoy to resolve the DNS name
 	if hostname != "" {
-		// set the type to strict dns
+		if err := setDnsOptions(spec, out); err != nil {
+			return err
+		}
+	} else if hasDnsOptions(spec) {
+		return errors.Errorf("dns options only apply to hosts with a dns name, all hosts are ip addresses")
+	}
+
+	return nil
+}
+
+func setDnsOptions(spec *v1static.UpstreamSpec, out *envoyapi.Cluster) error {
+	switch spec.DnsDiscoveryType {
+	case v1static.UpstreamSpec_LOGICAL_DNS:
+		if len(spec.Hosts) != 1 {
+			return errors.Errorf("logical dns discovery requires exactly 1 host, found %v", len(spec.Hosts))
+		}
+		out.ClusterDiscoveryType = &envoyapi.Cluster_Type{
+			Type: envoyapi.Cluster_LOGICAL_DNS,
+		}
+	default:
 		out.ClusterDiscoveryType = &envoyapi.Cluster_Type{
 			Type: envoyapi.Cluster_STRICT_DNS,
 		}
+	}
 
+	switch spec.DnsLookupFamily {
+	case v1static.UpstreamSpec_V6_ONLY:
+		out.DnsLookupFamily = envoyapi.Cluster_V6_ONLY
+	case v1static.UpstreamSpec_AUTO:
+		out.DnsLookupFamily = envoyapi.Cluster_AUTO
+	default:
 		// fix issue where ipv6 addr cannot bind
 		out.DnsLookupFamily = envoyapi.Cluster_V4_ONLY
 	}
 
+	out.RespectDnsTtl = spec.RespectDnsTtl
+	if spec.DnsRefreshRate != nil {
+		if *spec.DnsRefreshRate <= minDnsRefreshRate {
+			return errors.Errorf("dns refresh rate must be greater than %v, found %v", minDnsRefreshRate, *spec.DnsRefreshRate)
+		}
+		out.DnsRefreshRate = gogoutils.DurationStdToProto(spec.DnsRefreshRate)
+	}
+
+	for _, resolver := range spec.DnsResolvers {
+		addr, err := dnsResolverAddress(resolver)
+		if err != nil {
+			return err
+		}
+		out.DnsResolvers = append(out.DnsResolvers, addr)
+	}
 	return nil
 }
+
+// hasDnsOptions returns whether any of the options only used to resolve hostnames differs from its default
+func hasDnsOptions(spec *v1static.UpstreamSpec) bool {
+	return spec.DnsDiscoveryType != v1static.UpstreamSpec_STRICT_DNS ||
+		spec.DnsLookupFamily != v1static.UpstreamSpec_V4_ONLY ||
+		spec.RespectDnsTtl ||
+		spec.DnsRefreshRate != nil ||
+		len(spec.DnsResolvers) > 0
+}
+
+func dnsResolverAddress(resolver string) (*envoycore.Address, error) {
+	host, port := resolver, uint32(defaultDnsPort)
+	if h, p, err := net.SplitHostPort(resolver); err == nil {
+		parsedPort, err := strconv.ParseUint(p, 10, 16)
+		if err != nil {
+			return nil, errors.Errorf("invalid port in dns resolver %v", resolver)
+		}
+		host, port = h, uint32(parsedPort)
+	}
+	if net.ParseIP(host) == nil {
+		return nil, errors.Errorf("dns resolver %v must be an ip address", resolver)
+	}
+	return &envoycore.Address{
+		Address: &envoycore.Address_SocketAddress{
+			SocketAddress: &envoycore.SocketAddress{
+				Protocol: envoycore.SocketAddress_UDP,
+				Address:  host,
+				PortSpecifier: &envoycore.SocketAddress_PortValue{
+					PortValue: port,
+				},
+			},
+		},
+	}, nil
+}
+
+// hosts with their own sni get a copy of the cluster's tls context with that sni, selected by endpoint metadata
+func setSniTransportSocketMatches(out *envoyapi.Cluster, snis []string) error {
+	if len(snis) == 0 || out.TransportSocket == nil {
+		return nil
+	}
+	msg, err := pluginutils.AnyToMessage(out.TransportSocket.GetTypedConfig())
+	if err != nil {
+		return err
+	}
+	tlsContext, ok := msg.(*envoyauth.UpstreamTlsContext)
+	if !ok {
+		return errors.Errorf("cannot set per-host sni on transport socket %v", out.TransportSocket.Name)
+	}
+
+	for _, sni := range snis {
+		hostTlsContext := proto.Clone(tlsContext).(*envoyauth.UpstreamTlsContext)
+		hostTlsContext.Sni = sni
+		out.TransportSocketMatches = append(out.TransportSocketMatches, &envoyapi.Cluster_TransportSocketMatch{
+			Name:  sniMatchField + "-" + sni,
+			Match: sniMatch(sni),
+			TransportSocket: &envoycore.TransportSocket{
+				Name:       out.TransportSocket.Name,
+				ConfigType: &envoycore.TransportSocket_TypedConfig{TypedConfig: pluginutils.MustMessageToAny(hostTlsContext)},
+			},
+		})
+	}
+	return nil
+}
+
+func sniMatch(sni string) *structpb.Struct {
+	return &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			sniMatchField: {Kind: &structpb.Value_StringValue{StringValue: sni}},
+		},
+	}
+}
+
+func sniMetadata(sni string) *envoycore.Metadata {
+	return &envoycore.Metadata{
+		FilterMetadata: map[string]*structpb.Struct{
+			transportSocketMatchKey: sniMatch(sni),
+		},
+	}
+}
+
+func appendUnique(list []string, s string) []string {
+	for _, existing := range list {
+		if existing == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
@@ -1,6 +1,9 @@
 package static
 
 import (
+	"time"
+
+	"github.com/gogo/protobuf/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -89,6 +92,104 @@ var _ = Describe("Plugin", func() {
 		})
 	})
 
+	Context("dns", func() {
+
+		It("should resolve v4 only by default", func() {
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.DnsLookupFamily).To(Equal(envoyapi.Cluster_V4_ONLY))
+			Expect(out.RespectDnsTtl).To(BeFalse())
+			Expect(out.DnsRefreshRate).To(BeNil())
+			Expect(out.DnsResolvers).To(BeEmpty())
+		})
+
+		It("should use logical dns", func() {
+			upstreamSpec.DnsDiscoveryType = v1static.UpstreamSpec_LOGICAL_DNS
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetType()).To(Equal(envoyapi.Cluster_LOGICAL_DNS))
+		})
+
+		It("should error for logical dns with multiple hosts", func() {
+			upstreamSpec.DnsDiscoveryType = v1static.UpstreamSpec_LOGICAL_DNS
+			upstreamSpec.Hosts = append(upstreamSpec.Hosts, &v1static.Host{Addr: "test.solo.io", Port: 1234})
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should error for dns options if only has ips", func() {
+			upstreamSpec.DnsLookupFamily = v1static.UpstreamSpec_AUTO
+			upstreamSpec.Hosts[0].Addr = "1.2.3.4"
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).To(MatchError(ContainSubstring("all hosts are ip addresses")))
+		})
+
+		It("should set dns behavior", func() {
+			refreshRate := 10 * time.Second
+			upstreamSpec.DnsLookupFamily = v1static.UpstreamSpec_V6_ONLY
+			upstreamSpec.RespectDnsTtl = true
+			upstreamSpec.DnsRefreshRate = &refreshRate
+			upstreamSpec.DnsResolvers = []string{"10.0.0.1", "10.0.0.2:5353", "[::1]:53"}
+
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.DnsLookupFamily).To(Equal(envoyapi.Cluster_V6_ONLY))
+			Expect(out.RespectDnsTtl).To(BeTrue())
+			Expect(out.DnsRefreshRate.GetSeconds()).To(BeEquivalentTo(10))
+			Expect(out.DnsResolvers).To(HaveLen(3))
+			Expect(out.DnsResolvers[0].GetSocketAddress().GetAddress()).To(Equal("10.0.0.1"))
+			Expect(out.DnsResolvers[0].GetSocketAddress().GetPortValue()).To(BeEquivalentTo(53))
+			Expect(out.DnsResolvers[1].GetSocketAddress().GetAddress()).To(Equal("10.0.0.2"))
+			Expect(out.DnsResolvers[1].GetSocketAddress().GetPortValue()).To(BeEquivalentTo(5353))
+			Expect(out.DnsResolvers[2].GetSocketAddress().GetAddress()).To(Equal("::1"))
+		})
+
+		It("should error for a refresh rate of 1ms or less", func() {
+			refreshRate := time.Millisecond
+			upstreamSpec.DnsRefreshRate = &refreshRate
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).To(MatchError(ContainSubstring("dns refresh rate must be greater than 1ms")))
+		})
+
+		It("should error for resolvers that are not ips", func() {
+			upstreamSpec.DnsResolvers = []string{"dns.solo.io"}
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("weights", func() {
+
+		It("should set the weights of hosts", func() {
+			upstreamSpec.Hosts = []*v1static.Host{{
+				Addr:                "1.2.3.4",
+				Port:                1234,
+				LoadBalancingWeight: &types.UInt32Value{Value: 3},
+			}, {
+				Addr: "1.2.3.5",
+				Port: 1234,
+			}}
+
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			lbEndpoints := out.LoadAssignment.Endpoints[0].LbEndpoints
+			Expect(lbEndpoints).To(HaveLen(2))
+			Expect(lbEndpoints[0].LoadBalancingWeight.GetValue()).To(BeEquivalentTo(3))
+			Expect(lbEndpoints[1].LoadBalancingWeight).To(BeNil())
+		})
+
+		It("should error for a weight of 0", func() {
+			upstreamSpec.Hosts = []*v1static.Host{{
+				Addr:                "1.2.3.4",
+				Port:                1234,
+				LoadBalancingWeight: &types.UInt32Value{Value: 0},
+			}}
+
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).To(MatchError(ContainSubstring("must be at least 1")))
+		})
+	})
+
 	Context("ssl", func() {
 		tlsContext := func() *envoyauth.UpstreamTlsContext {
 			if out.TransportSocket == nil {
@@ -123,5 +224,58 @@ var _ = Describe("Plugin", func() {
 			p.ProcessUpstream(params, upstream, out)
 			Expect(tlsContext()).To(Equal(existing))
 		})
+
+		It("should set per-host sni", func() {
+			upstreamSpec.UseTls = true
+			upstreamSpec.Hosts = []*v1static.Host{{
+				Addr:    "1.2.3.4",
+				Port:    1234,
+				SniAddr: "one.solo.io",
+			}, {
+				Addr:    "1.2.3.5",
+				Port:    1234,
+				SniAddr: "one.solo.io",
+			}, {
+				Addr:    "1.2.3.6",
+				Port:    1234,
+				SniAddr: "two.solo.io",
+			}, {
+				Addr: "1.2.3.7",
+				Port: 1234,
+			}}
+
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.TransportSocketMatches).To(HaveLen(2))
+			for i, sni := range []string{"one.solo.io", "two.solo.io"} {
+				match := out.TransportSocketMatches[i]
+				Expect(match.Match.Fields[sniMatchField].GetStringValue()).To(Equal(sni))
+				hostTlsContext := pluginutils.MustAnyToMessage(match.TransportSocket.GetTypedConfig()).(*envoyauth.UpstreamTlsContext)
+				Expect(hostTlsContext.Sni).To(Equal(sni))
+			}
+
+			lbEndpoints := out.LoadAssignment.Endpoints[0].LbEndpoints
+			Expect(lbEndpoints[0].Metadata.FilterMetadata[transportSocketMatchKey].Fields[sniMatchField].GetStringValue()).To(Equal("one.solo.io"))
+			Expect(lbEndpoints[2].Metadata.FilterMetadata[transportSocketMatchKey].Fields[sniMatchField].GetStringValue()).To(Equal("two.solo.io"))
+			Expect(lbEndpoints[3].Metadata).To(BeNil())
+		})
+
+		It("should set per-host sni with the tls config of the upstream", func() {
+			out.TransportSocket = &envoycore.TransportSocket{
+				Name:       pluginutils.TlsTransportSocket,
+				ConfigType: &envoycore.TransportSocket_TypedConfig{TypedConfig: pluginutils.MustMessageToAny(&envoyauth.UpstreamTlsContext{})},
+			}
+			upstreamSpec.Hosts[0].SniAddr = "one.solo.io"
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.TransportSocketMatches).To(HaveLen(1))
+		})
+
+		It("should error for per-host sni without tls", func() {
+			upstreamSpec.Hosts[0].SniAddr = "one.solo.io"
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).To(MatchError(ContainSubstring("requires TLS")))
+			Expect(out.TransportSocketMatches).To(BeEmpty())
+		})
 	})
 })
@@ -1,12 +1,15 @@
 package tcp
 
 import (
+	"net"
+
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
 	envoytcp "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/tcp_proxy/v2"
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/pkg/utils/gogoutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -17,11 +20,13 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/util"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 )
 
 const (
 	DefaultTcpStatPrefix = "tcp"
+	TlsTransportProtocol = "tls"
 )
 
 func NewPlugin() *Plugin {
@@ -39,6 +44,10 @@ var (
 	InvalidSecretsError = func(err error, name string) error {
 		return eris.Wrapf(err, "invalid secrets for listener %v", name)
 	}
+
+	InvalidSourcePrefixRangeError = func(err error, name string) error {
+		return eris.Wrapf(err, "invalid source prefix range for tcp host %v", name)
+	}
 )
 
 type Plugin struct {
@@ -46,7 +55,7 @@ type Plugin struct {
 }
 
 func (p *Plugin) Init(params plugins.InitParams) error {
-	p.sslConfigTranslator = utils.NewSslConfigTranslator()
+	p.sslConfigTranslator = utils.NewSslConfigTranslatorForSettings(params.Settings)
 	return nil
 }
 
@@ -56,7 +65,10 @@ func (p *Plugin) ProcessListenerFilterChain(params plugins.Params, in *v1.Listen
 	if tcpListener == nil {
 		return nil, nil
 	}
-	var filterChains []*envoylistener.FilterChain
+	var (
+		filterChains []*envoylistener.FilterChain
+		errs         error
+	)
 	for _, tcpHost := range tcpListener.TcpHosts {
 
 		var listenerFilters []*envoylistener.Filter
@@ -77,9 +89,56 @@ func (p *Plugin) ProcessListenerFilterChain(params plugins.Params, in *v1.Listen
 			logger.Errorw("could not compute tcp proxy filter", zap.Error(err), zap.Any("tcpHost", tcpHost))
 			continue
 		}
+		if err := applyTcpHostMatcher(tcpHost, filterChain); err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
 		filterChains = append(filterChains, filterChain)
 	}
-	return filterChains, nil
+	return filterChains, errs
+}
+
+// restrict the filter chain of a tcp host to the connections matched by its matcher
+func applyTcpHostMatcher(host *v1.TcpHost, filterChain *envoylistener.FilterChain) error {
+	matcher := host.GetMatcher()
+	if matcher == nil {
+		return nil
+	}
+	if filterChain.FilterChainMatch == nil {
+		filterChain.FilterChainMatch = &envoylistener.FilterChainMatch{}
+	}
+	for _, cidr := range matcher.GetSourcePrefixRanges() {
+		sourcePrefixRange, err := convertCidrRange(cidr)
+		if err != nil {
+			return InvalidSourcePrefixRangeError(err, host.GetName())
+		}
+		filterChain.FilterChainMatch.SourcePrefixRanges = append(filterChain.FilterChainMatch.SourcePrefixRanges, sourcePrefixRange)
+	}
+	filterChain.FilterChainMatch.DestinationPort = gogoutils.UInt32GogoToProto(matcher.GetDestinationPort())
+	filterChain.FilterChainMatch.ApplicationProtocols = matcher.GetApplicationProtocols()
+	return nil
+}
+
+func convertCidrRange(cidr *v1.CidrRange) (*envoycore.CidrRange, error) {
+	ip := net.ParseIP(cidr.GetAddressPrefix())
+	if ip == nil {
+		return nil, eris.Errorf("%v is not an ip address", cidr.GetAddressPrefix())
+	}
+	addressLen := uint32(net.IPv6len * 8)
+	if ip.To4() != nil {
+		addressLen = net.IPv4len * 8
+	}
+	prefixLen := addressLen
+	if cidr.GetPrefixLen() != nil {
+		prefixLen = cidr.GetPrefixLen().GetValue()
+	}
+	if prefixLen > addressLen {
+		return nil, eris.Errorf("prefix length %v is longer than the address", prefixLen)
+	}
+	return &envoycore.CidrRange{
+		AddressPrefix: cidr.GetAddressPrefix(),
+		PrefixLen:     &wrappers.UInt32Value{Value: prefixLen},
+	}, nil
 }
 
 func tcpProxyFilter(params plugins.Params, host *v1.TcpHost, plugins *v1.TcpListenerOptions, statPrefix string) (*envoylistener.Filter, error) {
@@ -175,6 +234,18 @@ func (p *Plugin) computerTcpFilterChain(snap *v1.ApiSnapshot, listener *v1.Liste
 		}, nil
 	}
 
+	// without a certificate to terminate with, pass the tls connection through untouched, matching on SNI only
+	if sslConfig.GetSslSecrets() == nil {
+		return &envoylistener.FilterChain{
+			FilterChainMatch: &envoylistener.FilterChainMatch{
+				ServerNames:       sslConfig.SniDomains,
+				TransportProtocol: TlsTransportProtocol,
+			},
+			Filters:       listenerFilters,
+			UseProxyProto: gogoutils.BoolGogoToProto(listener.UseProxyProto),
+		}, nil
+	}
+
 	downstreamConfig, err := p.sslConfigTranslator.ResolveDownstreamSslConfig(snap.Secrets, sslConfig)
 	if err != nil {
 		return nil, InvalidSecretsError(err, listener.Name)
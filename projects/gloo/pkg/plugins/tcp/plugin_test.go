@@ -12,8 +12,10 @@ import (
 	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/tcp"
 	translatorutil "github.com/solo-io/gloo/projects/gloo/pkg/translator"
 
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	envoytcp "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/tcp_proxy/v2"
 	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 )
@@ -130,6 +132,35 @@ var _ = Describe("Plugin", func() {
 			Expect(cfg.MaxConnectAttempts).To(Equal(gogoutils.UInt32GogoToProto(tcps.MaxConnectAttempts)))
 		})
 
+		It("passes tls through when the ssl config has no secrets", func() {
+			tcpListener.TcpHosts = append(tcpListener.TcpHosts, &v1.TcpHost{
+				Name: "one",
+				Destination: &v1.RouteAction{
+					Destination: &v1.RouteAction_Single{
+						Single: &v1.Destination{
+							DestinationType: &v1.Destination_Upstream{
+								Upstream: &core.ResourceRef{
+									Name:      "one",
+									Namespace: ns,
+								},
+							},
+						},
+					},
+				},
+				SslConfig: &v1.SslConfig{
+					SniDomains: []string{"passthrough.example.com"},
+				},
+			})
+
+			p := NewPlugin()
+			filterChains, err := p.ProcessListenerFilterChain(plugins.Params{Snapshot: snap}, in)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filterChains).To(HaveLen(1))
+			Expect(filterChains[0].TransportSocket).To(BeNil())
+			Expect(filterChains[0].FilterChainMatch.ServerNames).To(Equal([]string{"passthrough.example.com"}))
+			Expect(filterChains[0].FilterChainMatch.TransportProtocol).To(Equal(TlsTransportProtocol))
+		})
+
 		It("can transform a single destination", func() {
 			tcpListener.TcpHosts = append(tcpListener.TcpHosts, &v1.TcpHost{
 				Name: "one",
@@ -220,6 +251,91 @@ var _ = Describe("Plugin", func() {
 			Expect(clusters.Clusters[1].Name).To(Equal(translatorutil.UpstreamToClusterName(core.ResourceRef{Namespace: ns, Name: "two"})))
 			Expect(clusters.Clusters[1].Weight).To(Equal(uint32(1)))
 		})
+
+		Context("tcp host matchers", func() {
+			var (
+				tcpHost *v1.TcpHost
+			)
+
+			BeforeEach(func() {
+				tcpHost = &v1.TcpHost{
+					Name: "one",
+					Destination: &v1.RouteAction{
+						Destination: &v1.RouteAction_Multi{
+							Multi: &v1.MultiDestination{
+								Destinations: wd,
+							},
+						},
+					},
+				}
+				tcpListener.TcpHosts = append(tcpListener.TcpHosts, tcpHost)
+			})
+
+			It("matches on source prefix ranges, destination port and application protocols", func() {
+				tcpHost.Matcher = &v1.TcpHostMatcher{
+					SourcePrefixRanges: []*v1.CidrRange{
+						{AddressPrefix: "10.0.0.0", PrefixLen: &types.UInt32Value{Value: 8}},
+						{AddressPrefix: "2001:db8::1"},
+					},
+					DestinationPort:      &types.UInt32Value{Value: 1883},
+					ApplicationProtocols: []string{"mqtt"},
+				}
+
+				p := NewPlugin()
+				filterChains, err := p.ProcessListenerFilterChain(plugins.Params{Snapshot: snap}, in)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(filterChains).To(HaveLen(1))
+
+				match := filterChains[0].FilterChainMatch
+				Expect(match.SourcePrefixRanges).To(Equal([]*envoycore.CidrRange{
+					{AddressPrefix: "10.0.0.0", PrefixLen: &wrappers.UInt32Value{Value: 8}},
+					{AddressPrefix: "2001:db8::1", PrefixLen: &wrappers.UInt32Value{Value: 128}},
+				}))
+				Expect(match.DestinationPort).To(Equal(&wrappers.UInt32Value{Value: 1883}))
+				Expect(match.ApplicationProtocols).To(Equal([]string{"mqtt"}))
+			})
+
+			It("combines the matcher with the sni domains of the ssl config", func() {
+				tcpHost.SslConfig = &v1.SslConfig{
+					SniDomains: []string{"mqtt.example.com"},
+				}
+				tcpHost.Matcher = &v1.TcpHostMatcher{
+					ApplicationProtocols: []string{"mqtt"},
+				}
+
+				p := NewPlugin()
+				filterChains, err := p.ProcessListenerFilterChain(plugins.Params{Snapshot: snap}, in)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(filterChains).To(HaveLen(1))
+				Expect(filterChains[0].FilterChainMatch.ServerNames).To(Equal([]string{"mqtt.example.com"}))
+				Expect(filterChains[0].FilterChainMatch.ApplicationProtocols).To(Equal([]string{"mqtt"}))
+			})
+
+			It("errors on invalid source prefix ranges and keeps the valid tcp hosts", func() {
+				tcpHost.Matcher = &v1.TcpHostMatcher{
+					SourcePrefixRanges: []*v1.CidrRange{
+						{AddressPrefix: "10.0.0.0", PrefixLen: &types.UInt32Value{Value: 33}},
+					},
+				}
+				tcpListener.TcpHosts = append(tcpListener.TcpHosts, &v1.TcpHost{
+					Name:        "two",
+					Destination: tcpHost.Destination,
+					Matcher: &v1.TcpHostMatcher{
+						SourcePrefixRanges: []*v1.CidrRange{{AddressPrefix: "not-an-ip"}},
+					},
+				}, &v1.TcpHost{
+					Name:        "three",
+					Destination: tcpHost.Destination,
+				})
+
+				p := NewPlugin()
+				filterChains, err := p.ProcessListenerFilterChain(plugins.Params{Snapshot: snap}, in)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("invalid source prefix range for tcp host one"))
+				Expect(err.Error()).To(ContainSubstring("invalid source prefix range for tcp host two"))
+				Expect(filterChains).To(HaveLen(1))
+			})
+		})
 	})
 
 })
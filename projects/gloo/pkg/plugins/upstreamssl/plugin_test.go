@@ -81,6 +81,28 @@ var _ = Describe("Plugin", func() {
 		Expect(tlsContext().CommonTlsContext.GetValidationContext().TrustedCa.GetInlineString()).To(Equal("rootca"))
 	})
 
+	It("should reference the secret served by the gloo secret discovery service when enabled", func() {
+		tlsConf.PrivateKey = "private"
+		tlsConf.CertChain = "certchain"
+		err := plugin.Init(plugins.InitParams{
+			Settings: &v1.Settings{
+				Gloo: &v1.GlooOptions{
+					SecretDiscovery: &v1.GlooOptions_SecretDiscoveryOptions{XdsClusterName: "xds_cluster"},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tlsContext().CommonTlsContext.TlsCertificates).To(BeEmpty())
+		sdsConfigs := tlsContext().CommonTlsContext.TlsCertificateSdsSecretConfigs
+		Expect(sdsConfigs).To(HaveLen(1))
+		Expect(sdsConfigs[0].Name).To(Equal("namespace.name"))
+		grpcService := sdsConfigs[0].SdsConfig.GetApiConfigSource().GrpcServices[0]
+		Expect(grpcService.GetEnvoyGrpc().ClusterName).To(Equal("xds_cluster"))
+	})
+
 	Context("failure", func() {
 
 		It("should fail with only private key", func() {
@@ -3,6 +3,8 @@ package setup
 import (
 	"context"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/solo-io/gloo/pkg/version"
 
@@ -26,18 +28,31 @@ func Main(customCtx context.Context) error {
 		usageReporter = &usage.DefaultUsageReader{MetricsStorage: metricsStorage}
 	}
 
-	return startSetupLoop(customCtx, usageReporter)
+	setupFunc, shutdown := syncer.NewSetupFuncWithShutdown()
+	go shutdownOnSignal(customCtx, shutdown)
+
+	return startSetupLoop(customCtx, setupFunc, usageReporter)
 }
 
 func StartGlooInTest(customCtx context.Context) error {
-	return startSetupLoop(customCtx, nil)
+	return startSetupLoop(customCtx, syncer.NewSetupFunc(), nil)
+}
+
+// shutdownOnSignal lets Gloo clean up what it registered outside of the cluster before the process stops
+func shutdownOnSignal(ctx context.Context, shutdown func(ctx context.Context)) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT)
+	sig := <-signals
+	contextutils.LoggerFrom(ctx).Infof("received %v, shutting down", sig)
+	shutdown(ctx)
+	os.Exit(0)
 }
 
-func startSetupLoop(ctx context.Context, usageReporter client.UsagePayloadReader) error {
+func startSetupLoop(ctx context.Context, setupFunc setuputils.SetupFunc, usageReporter client.UsagePayloadReader) error {
 	return setuputils.Main(setuputils.SetupOpts{
 		LoggerName:    "gloo",
 		Version:       version.Version,
-		SetupFunc:     syncer.NewSetupFunc(),
+		SetupFunc:     setupFunc,
 		ExitOnError:   true,
 		CustomCtx:     ctx,
 		UsageReporter: usageReporter,
@@ -0,0 +1,233 @@
+package syncer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/hashicorp/go-multierror"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/hashutils"
+)
+
+const (
+	DefaultConsulCheckInterval                  = 10 * time.Second
+	DefaultConsulDeregisterCriticalServiceAfter = time.Minute
+)
+
+// ConsulAgent registers services with the Consul agent, it is implemented by *consulapi.Agent
+type ConsulAgent interface {
+	Self() (map[string]map[string]interface{}, error)
+	ServiceRegister(service *consulapi.AgentServiceRegistration) error
+	ServiceDeregister(serviceID string) error
+}
+
+// ConsulRegistration keeps track of the services registered in consul for the listeners of the proxies. It lives as
+// long as the process, across the setups that follow settings changes, so that each setup's syncer knows about the
+// services registered before it. Services left behind when Gloo is killed before it can deregister them are removed
+// by Consul once their health checks have been failing for a while.
+type ConsulRegistration struct {
+	lock  sync.Mutex
+	agent ConsulAgent
+	// the hash of each registered service, by service id
+	registered map[string]uint64
+	// the address of the agent, where listeners bound to every address are checked
+	agentAddress string
+}
+
+func NewConsulRegistration() *ConsulRegistration {
+	return &ConsulRegistration{registered: make(map[string]uint64)}
+}
+
+type consulRegistrationSyncer struct {
+	registration *ConsulRegistration
+	agent        ConsulAgent
+	options      *v1.Settings_ConsulConfiguration_ServiceRegistrationOptions
+}
+
+// NewConsulRegistrationSyncer registers every listener of the proxies in each snapshot as a service in the Consul catalog,
+// so that Consul-native services can find the gateway proxies.
+func NewConsulRegistrationSyncer(registration *ConsulRegistration, agent ConsulAgent, options *v1.Settings_ConsulConfiguration_ServiceRegistrationOptions) v1.ApiSyncer {
+	return &consulRegistrationSyncer{
+		registration: registration,
+		agent:        agent,
+		options:      options,
+	}
+}
+
+func (s *consulRegistrationSyncer) Sync(ctx context.Context, snap *v1.ApiSnapshot) error {
+	return s.registration.sync(ctx, s.agent, s.options, snap.Proxies)
+}
+
+func (r *ConsulRegistration) sync(ctx context.Context, agent ConsulAgent, options *v1.Settings_ConsulConfiguration_ServiceRegistrationOptions, proxies v1.ProxyList) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// the syncer of a previous setup must not undo what the current one registered
+	if ctx.Err() != nil {
+		return nil
+	}
+	r.agent = agent
+
+	var multiErr *multierror.Error
+	if options.GetAddress() == "" && r.agentAddress == "" {
+		address, err := consulAgentAddress(agent)
+		if err != nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("looking up the address of the consul agent: %v", err))
+		}
+		r.agentAddress = address
+	}
+
+	desired := make(map[string]bool)
+	for _, service := range consulServicesForProxies(proxies, options, r.agentAddress) {
+		desired[service.ID] = true
+		hash := hashutils.MustHash(service)
+		if previous, ok := r.registered[service.ID]; ok && previous == hash {
+			continue
+		}
+		if err := agent.ServiceRegister(service); err != nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("registering consul service %v: %v", service.ID, err))
+			continue
+		}
+		r.registered[service.ID] = hash
+	}
+
+	for id := range r.registered {
+		if desired[id] {
+			continue
+		}
+		if err := agent.ServiceDeregister(id); err != nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("deregistering consul service %v: %v", id, err))
+			continue
+		}
+		delete(r.registered, id)
+	}
+
+	return multiErr.ErrorOrNil()
+}
+
+// Deregister removes every registered service from consul, when Gloo stops or service registration is turned off
+func (r *ConsulRegistration) Deregister(ctx context.Context) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for id := range r.registered {
+		if err := r.agent.ServiceDeregister(id); err != nil {
+			contextutils.LoggerFrom(ctx).Warnf("failed to deregister consul service %v: %v", id, err)
+			continue
+		}
+		delete(r.registered, id)
+	}
+}
+
+// consulAgentAddress returns the address the agent advertises, which consul uses for services registered without one
+func consulAgentAddress(agent ConsulAgent) (string, error) {
+	self, err := agent.Self()
+	if err != nil {
+		return "", err
+	}
+	address, _ := self["Member"]["Addr"].(string)
+	if address == "" {
+		return "", fmt.Errorf("the agent does not report its address")
+	}
+	return address, nil
+}
+
+// consulServicesForProxies builds a consul service for each listener of the given proxies.
+// The services are named after their proxy, so the listeners of a proxy are the instances of the same service.
+func consulServicesForProxies(proxies v1.ProxyList, options *v1.Settings_ConsulConfiguration_ServiceRegistrationOptions, agentAddress string) []*consulapi.AgentServiceRegistration {
+	checkInterval := DefaultConsulCheckInterval
+	if interval := options.GetCheckInterval(); interval != nil {
+		if d, err := types.DurationFromProto(interval); err == nil {
+			checkInterval = d
+		}
+	}
+	deregisterAfter := DefaultConsulDeregisterCriticalServiceAfter
+	if after := options.GetDeregisterCriticalServiceAfter(); after != nil {
+		if d, err := types.DurationFromProto(after); err == nil {
+			deregisterAfter = d
+		}
+	}
+
+	var services []*consulapi.AgentServiceRegistration
+	for _, proxy := range proxies {
+		for _, listener := range proxy.GetListeners() {
+			service := &consulapi.AgentServiceRegistration{
+				ID:      consulServiceId(proxy, listener),
+				Name:    proxy.GetMetadata().Name,
+				Address: options.GetAddress(),
+				Port:    int(listener.GetBindPort()),
+				Tags:    consulServiceTags(listener, options.GetTags()),
+				Meta: map[string]string{
+					"gloo_proxy":           proxy.GetMetadata().Name,
+					"gloo_proxy_namespace": proxy.GetMetadata().Namespace,
+					"gloo_listener":        listener.GetName(),
+				},
+			}
+			if checkAddress := consulCheckAddress(listener, options.GetAddress(), agentAddress); checkAddress != "" {
+				service.Check = &consulapi.AgentServiceCheck{
+					Name:                           "gloo listener " + listener.GetName(),
+					TCP:                            net.JoinHostPort(checkAddress, strconv.Itoa(int(listener.GetBindPort()))),
+					Interval:                       checkInterval.String(),
+					DeregisterCriticalServiceAfter: deregisterAfter.String(),
+				}
+			}
+			services = append(services, service)
+		}
+	}
+	return services
+}
+
+func consulServiceId(proxy *v1.Proxy, listener *v1.Listener) string {
+	return fmt.Sprintf("gloo-%v-%v-%v", proxy.GetMetadata().Namespace, proxy.GetMetadata().Name, listener.GetName())
+}
+
+// the tags of a service are the configured tags followed by the domains of the listener's virtual hosts
+func consulServiceTags(listener *v1.Listener, configuredTags []string) []string {
+	var httpListeners []*v1.HttpListener
+	if httpListener := listener.GetHttpListener(); httpListener != nil {
+		httpListeners = append(httpListeners, httpListener)
+	}
+	if httpListener := listener.GetHybridListener().GetHttpListener(); httpListener != nil {
+		httpListeners = append(httpListeners, httpListener)
+	}
+
+	domains := make(map[string]bool)
+	for _, httpListener := range httpListeners {
+		for _, virtualHost := range httpListener.GetVirtualHosts() {
+			for _, domain := range virtualHost.GetDomains() {
+				// the wildcard domain does not tell consul-native services anything
+				if domain != "*" {
+					domains[domain] = true
+				}
+			}
+		}
+	}
+	var domainTags []string
+	for domain := range domains {
+		domainTags = append(domainTags, domain)
+	}
+	sort.Strings(domainTags)
+
+	return append(append([]string{}, configuredTags...), domainTags...)
+}
+
+// listeners are checked at the registered address, or else at their bind address. Listeners bound to every address
+// are checked at the address of the agent, which is the address consul gives services registered without one.
+func consulCheckAddress(listener *v1.Listener, address, agentAddress string) string {
+	if address != "" {
+		return address
+	}
+	bindAddress := listener.GetBindAddress()
+	if ip := net.ParseIP(bindAddress); bindAddress == "" || ip != nil && ip.IsUnspecified() {
+		return agentAddress
+	}
+	return bindAddress
+}
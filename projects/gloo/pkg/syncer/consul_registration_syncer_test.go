@@ -0,0 +1,233 @@
+package syncer_test
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gogo/protobuf/types"
+	consulapi "github.com/hashicorp/consul/api"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/syncer"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Consul registration syncer", func() {
+
+	var (
+		ctx     context.Context
+		cancel  context.CancelFunc
+		agent   *mockConsulAgent
+		options *v1.Settings_ConsulConfiguration_ServiceRegistrationOptions
+		proxy   *v1.Proxy
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		agent = &mockConsulAgent{address: "10.0.0.100", services: map[string]*consulapi.AgentServiceRegistration{}}
+		options = &v1.Settings_ConsulConfiguration_ServiceRegistrationOptions{}
+		proxy = &v1.Proxy{
+			Metadata: core.Metadata{Name: "gateway-proxy", Namespace: "gloo-system"},
+			Listeners: []*v1.Listener{
+				{
+					Name:        "listener-::-8080",
+					BindAddress: "::",
+					BindPort:    8080,
+					ListenerType: &v1.Listener_HttpListener{
+						HttpListener: &v1.HttpListener{
+							VirtualHosts: []*v1.VirtualHost{
+								{Domains: []string{"b.example.com", "a.example.com"}},
+								{Domains: []string{"*"}},
+							},
+						},
+					},
+				},
+				{
+					Name:         "tcp",
+					BindAddress:  "10.0.0.1",
+					BindPort:     9000,
+					ListenerType: &v1.Listener_TcpListener{TcpListener: &v1.TcpListener{}},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("registers a service for each listener of the proxies", func() {
+		options.Tags = []string{"gloo"}
+		syncer := NewConsulRegistrationSyncer(NewConsulRegistration(), agent, options)
+
+		err := syncer.Sync(ctx, &v1.ApiSnapshot{Proxies: v1.ProxyList{proxy}})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(agent.services).To(HaveLen(2))
+
+		httpService := agent.services["gloo-gloo-system-gateway-proxy-listener-::-8080"]
+		Expect(httpService).NotTo(BeNil())
+		Expect(httpService.Name).To(Equal("gateway-proxy"))
+		Expect(httpService.Port).To(Equal(8080))
+		Expect(httpService.Tags).To(Equal([]string{"gloo", "a.example.com", "b.example.com"}))
+		Expect(httpService.Meta).To(HaveKeyWithValue("gloo_listener", "listener-::-8080"))
+		Expect(httpService.Address).To(BeEmpty())
+		// the listener is bound to every address, so it is checked where consul sends clients of the service
+		Expect(httpService.Check.TCP).To(Equal("10.0.0.100:8080"))
+
+		tcpService := agent.services["gloo-gloo-system-gateway-proxy-tcp"]
+		Expect(tcpService).NotTo(BeNil())
+		Expect(tcpService.Name).To(Equal("gateway-proxy"))
+		Expect(tcpService.Tags).To(Equal([]string{"gloo"}))
+		Expect(tcpService.Check).To(Equal(&consulapi.AgentServiceCheck{
+			Name:                           "gloo listener tcp",
+			TCP:                            "10.0.0.1:9000",
+			Interval:                       "10s",
+			DeregisterCriticalServiceAfter: "1m0s",
+		}))
+	})
+
+	It("checks the listeners at the configured address", func() {
+		options.Address = "192.168.1.10"
+		options.CheckInterval = &types.Duration{Seconds: 30}
+		options.DeregisterCriticalServiceAfter = &types.Duration{Seconds: 300}
+		syncer := NewConsulRegistrationSyncer(NewConsulRegistration(), agent, options)
+
+		err := syncer.Sync(ctx, &v1.ApiSnapshot{Proxies: v1.ProxyList{proxy}})
+		Expect(err).NotTo(HaveOccurred())
+
+		httpService := agent.services["gloo-gloo-system-gateway-proxy-listener-::-8080"]
+		Expect(httpService.Address).To(Equal("192.168.1.10"))
+		Expect(httpService.Check.TCP).To(Equal("192.168.1.10:8080"))
+		Expect(httpService.Check.Interval).To(Equal("30s"))
+		Expect(httpService.Check.DeregisterCriticalServiceAfter).To(Equal("5m0s"))
+	})
+
+	It("registers listeners bound to every address without a check when the agent address is unknown", func() {
+		agent.address = ""
+		syncer := NewConsulRegistrationSyncer(NewConsulRegistration(), agent, options)
+
+		err := syncer.Sync(ctx, &v1.ApiSnapshot{Proxies: v1.ProxyList{proxy}})
+		Expect(err).To(HaveOccurred())
+		Expect(agent.services).To(HaveLen(2))
+		Expect(agent.services["gloo-gloo-system-gateway-proxy-listener-::-8080"].Check).To(BeNil())
+		Expect(agent.services["gloo-gloo-system-gateway-proxy-tcp"].Check.TCP).To(Equal("10.0.0.1:9000"))
+
+		agent.address = "10.0.0.100"
+		Expect(syncer.Sync(ctx, &v1.ApiSnapshot{Proxies: v1.ProxyList{proxy}})).NotTo(HaveOccurred())
+		Expect(agent.services["gloo-gloo-system-gateway-proxy-listener-::-8080"].Check.TCP).To(Equal("10.0.0.100:8080"))
+	})
+
+	It("only registers services again when they change", func() {
+		syncer := NewConsulRegistrationSyncer(NewConsulRegistration(), agent, options)
+
+		snap := &v1.ApiSnapshot{Proxies: v1.ProxyList{proxy}}
+		Expect(syncer.Sync(ctx, snap)).NotTo(HaveOccurred())
+		Expect(syncer.Sync(ctx, snap)).NotTo(HaveOccurred())
+		Expect(agent.registrations).To(Equal(2))
+
+		proxy.Listeners[1].BindPort = 9001
+		Expect(syncer.Sync(ctx, snap)).NotTo(HaveOccurred())
+		Expect(agent.registrations).To(Equal(3))
+		Expect(agent.services["gloo-gloo-system-gateway-proxy-tcp"].Port).To(Equal(9001))
+	})
+
+	It("deregisters the services of removed listeners", func() {
+		syncer := NewConsulRegistrationSyncer(NewConsulRegistration(), agent, options)
+
+		Expect(syncer.Sync(ctx, &v1.ApiSnapshot{Proxies: v1.ProxyList{proxy}})).NotTo(HaveOccurred())
+		proxy.Listeners = proxy.Listeners[:1]
+		Expect(syncer.Sync(ctx, &v1.ApiSnapshot{Proxies: v1.ProxyList{proxy}})).NotTo(HaveOccurred())
+
+		Expect(agent.services).To(HaveLen(1))
+		Expect(agent.services).To(HaveKey("gloo-gloo-system-gateway-proxy-listener-::-8080"))
+	})
+
+	It("retries failed registrations on the next sync", func() {
+		agent.err = eris.New("agent unavailable")
+		syncer := NewConsulRegistrationSyncer(NewConsulRegistration(), agent, options)
+
+		snap := &v1.ApiSnapshot{Proxies: v1.ProxyList{proxy}}
+		Expect(syncer.Sync(ctx, snap)).To(HaveOccurred())
+
+		agent.setErr(nil)
+		Expect(syncer.Sync(ctx, snap)).NotTo(HaveOccurred())
+		Expect(agent.services).To(HaveLen(2))
+	})
+
+	It("keeps the services registered across setups", func() {
+		registration := NewConsulRegistration()
+		snap := &v1.ApiSnapshot{Proxies: v1.ProxyList{proxy}}
+
+		previousCtx, previousCancel := context.WithCancel(ctx)
+		previous := NewConsulRegistrationSyncer(registration, agent, options)
+		Expect(previous.Sync(previousCtx, snap)).NotTo(HaveOccurred())
+		// the settings changed
+		previousCancel()
+
+		current := NewConsulRegistrationSyncer(registration, agent, options)
+		Expect(current.Sync(ctx, snap)).NotTo(HaveOccurred())
+		Expect(agent.registrations).To(Equal(2))
+
+		// a late sync of the previous setup does not remove what the current one registered
+		Expect(previous.Sync(previousCtx, &v1.ApiSnapshot{})).NotTo(HaveOccurred())
+		Expect(agent.services).To(HaveLen(2))
+	})
+
+	It("deregisters every service when asked to", func() {
+		registration := NewConsulRegistration()
+		syncer := NewConsulRegistrationSyncer(registration, agent, options)
+
+		Expect(syncer.Sync(ctx, &v1.ApiSnapshot{Proxies: v1.ProxyList{proxy}})).NotTo(HaveOccurred())
+		Expect(agent.services).To(HaveLen(2))
+
+		registration.Deregister(ctx)
+		Expect(agent.services).To(BeEmpty())
+	})
+})
+
+type mockConsulAgent struct {
+	lock          sync.Mutex
+	address       string
+	services      map[string]*consulapi.AgentServiceRegistration
+	registrations int
+	err           error
+}
+
+func (a *mockConsulAgent) Self() (map[string]map[string]interface{}, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if a.err != nil {
+		return nil, a.err
+	}
+	return map[string]map[string]interface{}{"Member": {"Addr": a.address}}, nil
+}
+
+func (a *mockConsulAgent) ServiceRegister(service *consulapi.AgentServiceRegistration) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if a.err != nil {
+		return a.err
+	}
+	a.registrations++
+	a.services[service.ID] = service
+	return nil
+}
+
+func (a *mockConsulAgent) ServiceDeregister(serviceID string) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if a.err != nil {
+		return a.err
+	}
+	delete(a.services, serviceID)
+	return nil
+}
+
+func (a *mockConsulAgent) setErr(err error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.err = err
+}
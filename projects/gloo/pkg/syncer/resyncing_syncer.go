@@ -0,0 +1,52 @@
+package syncer
+
+import (
+	"context"
+	"sync"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+type resyncingSyncer struct {
+	syncer v1.ApiSyncer
+
+	lock sync.Mutex
+	ctx  context.Context
+	snap *v1.ApiSnapshot
+}
+
+// NewResyncingSyncer syncs the last snapshot again whenever resync receives, for state the syncer reads from
+// outside of the snapshot, e.g. certificates watched in consul.
+func NewResyncingSyncer(ctx context.Context, syncer v1.ApiSyncer, resync <-chan struct{}) v1.ApiSyncer {
+	s := &resyncingSyncer{syncer: syncer}
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-resync:
+				s.resync()
+			}
+		}
+	}()
+	return s
+}
+
+func (s *resyncingSyncer) Sync(ctx context.Context, snap *v1.ApiSnapshot) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.ctx, s.snap = ctx, snap
+	return s.syncer.Sync(ctx, snap)
+}
+
+func (s *resyncingSyncer) resync() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.snap == nil || s.ctx.Err() != nil {
+		return
+	}
+	if err := s.syncer.Sync(s.ctx, s.snap); err != nil {
+		contextutils.LoggerFrom(s.ctx).Errorf("resyncing the last snapshot: %v", err)
+	}
+}
@@ -0,0 +1,64 @@
+package syncer_test
+
+import (
+	"context"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/syncer"
+)
+
+var _ = Describe("Resyncing syncer", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		inner  *countingSyncer
+		resync chan struct{}
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		inner = &countingSyncer{}
+		resync = make(chan struct{})
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("syncs the last snapshot again on resync", func() {
+		syncer := NewResyncingSyncer(ctx, inner, resync)
+
+		// nothing to resync before the first snapshot
+		resync <- struct{}{}
+		Consistently(inner.synced, "100ms").Should(BeEmpty())
+
+		first, second := &v1.ApiSnapshot{}, &v1.ApiSnapshot{}
+		Expect(syncer.Sync(ctx, first)).NotTo(HaveOccurred())
+		Expect(syncer.Sync(ctx, second)).NotTo(HaveOccurred())
+		resync <- struct{}{}
+		Eventually(inner.synced).Should(HaveLen(3))
+		Expect(inner.synced()[2]).To(BeIdenticalTo(second))
+	})
+})
+
+type countingSyncer struct {
+	lock  sync.Mutex
+	snaps []*v1.ApiSnapshot
+}
+
+func (s *countingSyncer) Sync(_ context.Context, snap *v1.ApiSnapshot) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.snaps = append(s.snaps, snap)
+	return nil
+}
+
+func (s *countingSyncer) synced() []*v1.ApiSnapshot {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]*v1.ApiSnapshot{}, s.snaps...)
+}
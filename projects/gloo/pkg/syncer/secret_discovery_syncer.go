@@ -0,0 +1,56 @@
+package syncer
+
+import (
+	"context"
+
+	"github.com/envoyproxy/go-control-plane/pkg/cache"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	sdsserver "github.com/solo-io/gloo/projects/sds/pkg/server"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+type secretDiscoverySyncer struct {
+	secretCache cache.SnapshotCache
+}
+
+// NewSecretDiscoverySyncer serves the secrets referenced by the ssl configs of every snapshot over SDS, so that
+// rotated certificates reach envoy without a listener update.
+func NewSecretDiscoverySyncer(secretCache cache.SnapshotCache) v1.ApiSyncer {
+	return &secretDiscoverySyncer{secretCache: secretCache}
+}
+
+func (s *secretDiscoverySyncer) Sync(ctx context.Context, snap *v1.ApiSnapshot) error {
+	return sdsserver.UpdateGlooSecrets(ctx, referencedSecrets(snap), s.secretCache)
+}
+
+// referencedSecrets returns the secrets referenced by the ssl configs of the listeners, tcp hosts and upstreams.
+// Any envoy connected to the xDS server can request every served secret, so the others must not be served.
+func referencedSecrets(snap *v1.ApiSnapshot) v1.SecretList {
+	refs := make(map[core.ResourceRef]bool)
+	addRef := func(ref *core.ResourceRef) {
+		if ref != nil {
+			refs[*ref] = true
+		}
+	}
+	for _, proxy := range snap.Proxies {
+		for _, listener := range proxy.GetListeners() {
+			for _, sslConfig := range listener.GetSslConfigurations() {
+				addRef(sslConfig.GetSecretRef())
+			}
+			for _, host := range listener.GetTcpListener().GetTcpHosts() {
+				addRef(host.GetSslConfig().GetSecretRef())
+			}
+		}
+	}
+	for _, upstream := range snap.Upstreams {
+		addRef(upstream.GetSslConfig().GetSecretRef())
+	}
+
+	var secrets v1.SecretList
+	for _, secret := range snap.Secrets {
+		if refs[secret.GetMetadata().Ref()] {
+			secrets = append(secrets, secret)
+		}
+	}
+	return secrets
+}
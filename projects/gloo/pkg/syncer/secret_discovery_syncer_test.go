@@ -0,0 +1,64 @@
+package syncer_test
+
+import (
+	"context"
+
+	"github.com/envoyproxy/go-control-plane/pkg/cache"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/syncer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	sdsserver "github.com/solo-io/gloo/projects/sds/pkg/server"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Secret discovery syncer", func() {
+
+	tlsSecret := func(name string) *v1.Secret {
+		return &v1.Secret{
+			Metadata: core.Metadata{Name: name, Namespace: "default"},
+			Kind:     &v1.Secret_Tls{Tls: &v1.TlsSecret{CertChain: "cert", PrivateKey: "key"}},
+		}
+	}
+
+	sslConfig := func(name string) *v1.SslConfig {
+		return &v1.SslConfig{SslSecrets: &v1.SslConfig_SecretRef{SecretRef: &core.ResourceRef{Name: name, Namespace: "default"}}}
+	}
+
+	It("only serves the secrets referenced by ssl configs", func() {
+		secretCache := cache.NewSnapshotCache(false, &sdsserver.EnvoyKey{}, nil)
+		snap := &v1.ApiSnapshot{
+			Proxies: v1.ProxyList{{
+				Metadata: core.Metadata{Name: "proxy", Namespace: "default"},
+				Listeners: []*v1.Listener{{
+					Name:              "http",
+					SslConfigurations: []*v1.SslConfig{sslConfig("http")},
+				}, {
+					Name: "tcp",
+					ListenerType: &v1.Listener_TcpListener{TcpListener: &v1.TcpListener{
+						TcpHosts: []*v1.TcpHost{{Name: "host", SslConfig: sslConfig("tcp")}},
+					}},
+				}},
+			}},
+			Upstreams: v1.UpstreamList{{
+				Metadata: core.Metadata{Name: "upstream", Namespace: "default"},
+				SslConfig: &v1.UpstreamSslConfig{
+					SslSecrets: &v1.UpstreamSslConfig_SecretRef{SecretRef: &core.ResourceRef{Name: "upstream", Namespace: "default"}},
+				},
+			}},
+			Secrets: v1.SecretList{tlsSecret("http"), tlsSecret("tcp"), tlsSecret("upstream"), tlsSecret("unreferenced")},
+		}
+
+		err := NewSecretDiscoverySyncer(secretCache).Sync(context.Background(), snap)
+		Expect(err).NotTo(HaveOccurred())
+
+		served, err := secretCache.GetSnapshot((&sdsserver.EnvoyKey{}).ID(nil))
+		Expect(err).NotTo(HaveOccurred())
+		items := served.GetResources(cache.SecretType)
+		Expect(items).To(HaveLen(3))
+		for _, name := range []string{"http", "tcp", "upstream"} {
+			Expect(items).To(HaveKey(utils.SdsCertificateSecretName(core.ResourceRef{Name: name, Namespace: "default"})))
+		}
+	})
+})
@@ -8,6 +8,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/gitsource"
+	sdsserver "github.com/solo-io/gloo/projects/sds/pkg/server"
+
 	consulplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
@@ -59,6 +62,7 @@ import (
 
 	envoyv2 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v2"
 	"google.golang.org/grpc"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 
@@ -67,6 +71,13 @@ import (
 
 type RunFunc func(opts bootstrap.Opts) error
 
+// NewSetupFuncWithShutdown is NewSetupFunc, and also returns the func to call once when Gloo stops,
+// which removes the services Gloo registered in consul
+func NewSetupFuncWithShutdown() (setuputils.SetupFunc, func(ctx context.Context)) {
+	s := newSetupSyncer(RunGloo, nil)
+	return s.Setup, s.consulRegistration.Deregister
+}
+
 func NewSetupFunc() setuputils.SetupFunc {
 	return NewSetupFuncWithRunAndExtensions(RunGloo, nil)
 }
@@ -86,7 +97,11 @@ func NewSetupFuncWithRun(runFunc RunFunc) setuputils.SetupFunc {
 }
 
 func NewSetupFuncWithRunAndExtensions(runFunc RunFunc, extensions *Extensions) setuputils.SetupFunc {
-	s := &setupSyncer{
+	return newSetupSyncer(runFunc, extensions).Setup
+}
+
+func newSetupSyncer(runFunc RunFunc, extensions *Extensions) *setupSyncer {
+	return &setupSyncer{
 		extensions: extensions,
 		makeGrpcServer: func(ctx context.Context) *grpc.Server {
 			return grpc.NewServer(grpc.StreamInterceptor(
@@ -100,9 +115,9 @@ func NewSetupFuncWithRunAndExtensions(runFunc RunFunc, extensions *Extensions) s
 				)),
 			)
 		},
-		runFunc: runFunc,
+		runFunc:            runFunc,
+		consulRegistration: NewConsulRegistration(),
 	}
-	return s.Setup
 }
 
 type grpcServer struct {
@@ -119,6 +134,8 @@ type setupSyncer struct {
 	controlPlane             bootstrap.ControlPlane
 	validationServer         bootstrap.ValidationServer
 	callbacks                xdsserver.Callbacks
+	// outlives the setups, so that the services registered by one are known to the next
+	consulRegistration *ConsulRegistration
 }
 
 func NewControlPlane(ctx context.Context, grpcServer *grpc.Server, bindAddr net.Addr, callbacks xdsserver.Callbacks, start bool) bootstrap.ControlPlane {
@@ -126,6 +143,7 @@ func NewControlPlane(ctx context.Context, grpcServer *grpc.Server, bindAddr net.
 	snapshotCache := cache.NewSnapshotCache(true, hasher, contextutils.LoggerFrom(ctx))
 	xdsServer := server.NewServer(snapshotCache, callbacks)
 	envoyv2.RegisterAggregatedDiscoveryServiceServer(grpcServer, xdsServer)
+	secretCache := sdsserver.RegisterEnvoySDS(grpcServer)
 	reflection.Register(grpcServer)
 
 	return bootstrap.ControlPlane{
@@ -137,6 +155,7 @@ func NewControlPlane(ctx context.Context, grpcServer *grpc.Server, bindAddr net.
 		},
 		SnapshotCache: snapshotCache,
 		XDSServer:     xdsServer,
+		SecretCache:   secretCache,
 	}
 }
 
@@ -254,12 +273,29 @@ func (s *setupSyncer) Setup(ctx context.Context, kubeCache kube.SharedCache, mem
 
 	var vaultClient *vaultapi.Client
 	if vaultSettings := settings.GetVaultSecretSource(); vaultSettings != nil {
-		vaultClient, err = bootstrap.VaultClientForSettings(vaultSettings)
+		settingsClient, err := setuputils.SettingsClient(ctx)
+		if err != nil {
+			return err
+		}
+		reportStatus := bootstrap.ReportVaultStatusOnSettings(settingsClient, settings.GetMetadata().Ref())
+		vaultClient, err = bootstrap.VaultClientForSettings(ctx, vaultSettings, reportStatus)
 		if err != nil {
 			return err
 		}
 	}
 
+	if gitSource := settings.GetGitConfigSource(); gitSource != nil {
+		checkout, err := gitsource.EnsureCheckout(gitSource, settings.GetRefreshRate())
+		if err != nil {
+			return err
+		}
+		settingsClient, err := setuputils.SettingsClient(ctx)
+		if err != nil {
+			return err
+		}
+		go gitsource.ReportCommits(ctx, checkout, settingsClient, settings.GetMetadata().Ref())
+	}
+
 	var clientset kubernetes.Interface
 	opts, err := constructOpts(ctx,
 		&clientset,
@@ -285,6 +321,7 @@ func (s *setupSyncer) Setup(ctx context.Context, kubeCache kube.SharedCache, mem
 	opts.DevMode = settings.DevMode
 	opts.Settings = settings
 
+	opts.Consul.ConsulClient = consulClient
 	opts.Consul.DnsServer = settings.GetConsul().GetDnsAddress()
 	if len(opts.Consul.DnsServer) == 0 {
 		opts.Consul.DnsServer = consulplugin.DefaultDnsAddress
@@ -305,6 +342,14 @@ func (s *setupSyncer) Setup(ctx context.Context, kubeCache kube.SharedCache, mem
 			return err
 		}
 		opts.Consul.ConsulWatcher = consulClientWrapper
+		opts.Consul.ConnectCertificates = consul.NewConnectCertificates(ctx, consulClientWrapper, consulplugin.ConnectServiceName(consulServiceDiscovery))
+	}
+
+	if consulServiceRegistration := settings.GetConsul().GetServiceRegistration(); consulServiceRegistration != nil && consulClient != nil {
+		opts.Consul.RegistrationSyncer = NewConsulRegistrationSyncer(s.consulRegistration, consulClient.Agent(), consulServiceRegistration)
+	} else {
+		// registration may have just been turned off
+		s.consulRegistration.Deregister(ctx)
 	}
 
 	err = s.runFunc(opts)
@@ -413,6 +458,14 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 		return err
 	}
 
+	referencePolicyClient, err := v1.NewReferencePolicyClient(opts.ReferencePolicies)
+	if err != nil {
+		return err
+	}
+	if err := referencePolicyClient.Register(); err != nil {
+		return err
+	}
+
 	// Register grpc endpoints to the grpc server
 	xds.SetupEnvoyXds(opts.ControlPlane.GrpcServer, opts.ControlPlane.XDSServer, opts.ControlPlane.SnapshotCache)
 	xdsHasher := xds.NewNodeHasher()
@@ -470,10 +523,10 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 
 	go errutils.AggregateErrs(watchOpts.Ctx, errs, edsErrs, "eds.gloo")
 
-	apiCache := v1.NewApiEmitter(artifactClient, endpointClient, proxyClient, upstreamGroupClient, secretClient, hybridUsClient, authConfigClient)
+	apiCache := v1.NewApiEmitter(artifactClient, endpointClient, proxyClient, upstreamGroupClient, secretClient, hybridUsClient, authConfigClient, referencePolicyClient)
 	rpt := reporter.NewReporter("gloo", hybridUsClient.BaseClient(), proxyClient.BaseClient(), upstreamGroupClient.BaseClient(), authConfigClient.BaseClient())
 
-	t := translator.NewTranslator(sslutils.NewSslConfigTranslator(), opts.Settings, getPlugins)
+	t := translator.NewTranslator(sslutils.NewSslConfigTranslatorForSettings(opts.Settings), opts.Settings, getPlugins)
 
 	validator := validation.NewValidator(watchOpts.Ctx, t)
 	if opts.ValidationServer.Server != nil {
@@ -492,10 +545,21 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 
 	translationSync := NewTranslatorSyncer(t, opts.ControlPlane.SnapshotCache, xdsHasher, xdsSanitizer, rpt, opts.DevMode, syncerExtensions, opts.Settings)
 
+	if opts.Consul.ConnectCertificates != nil {
+		// the clusters of Connect-enabled upstreams hold the certificates, so translate again when they are rotated
+		translationSync = NewResyncingSyncer(watchOpts.Ctx, translationSync, opts.Consul.ConnectCertificates.Changes())
+	}
+
 	syncers := v1.ApiSyncers{
 		translationSync,
 		validator,
 	}
+	if opts.Settings.GetGloo().GetSecretDiscovery() != nil && opts.ControlPlane.SecretCache != nil {
+		syncers = append(v1.ApiSyncers{NewSecretDiscoverySyncer(opts.ControlPlane.SecretCache)}, syncers...)
+	}
+	if opts.Consul.RegistrationSyncer != nil {
+		syncers = append(syncers, opts.Consul.RegistrationSyncer)
+	}
 
 	apiEventLoop := v1.NewApiEventLoop(apiCache, syncers)
 	apiEventLoopErrs, err := apiEventLoop.Run(opts.WatchNamespaces, watchOpts)
@@ -636,6 +700,7 @@ func constructOpts(ctx context.Context, clientset *kubernetes.Interface, kubeCac
 		&cfg,
 		clientset,
 		&kubeCoreCache,
+		consulClient,
 		vaultClient,
 		v1.SecretCrd.Plural,
 	)
@@ -667,6 +732,20 @@ func constructOpts(ctx context.Context, clientset *kubernetes.Interface, kubeCac
 		return bootstrap.Opts{}, err
 	}
 
+	referencePolicyFactory, err := bootstrap.ConfigFactoryForSettings(params, v1.ReferencePolicyCrd)
+	if err != nil {
+		return bootstrap.Opts{}, err
+	}
+
+	// used by the kube plugin to watch EndpointSlices of the version the cluster serves
+	var kubeDynamicClient dynamic.Interface
+	if cfg != nil {
+		kubeDynamicClient, err = dynamic.NewForConfig(cfg)
+		if err != nil {
+			return bootstrap.Opts{}, errors.Wrapf(err, "creating kube dynamic client")
+		}
+	}
+
 	return bootstrap.Opts{
 		Upstreams:         upstreamFactory,
 		KubeServiceClient: kubeServiceClient,
@@ -675,6 +754,8 @@ func constructOpts(ctx context.Context, clientset *kubernetes.Interface, kubeCac
 		Secrets:           secretFactory,
 		Artifacts:         artifactFactory,
 		AuthConfigs:       authConfigFactory,
+		ReferencePolicies: referencePolicyFactory,
 		KubeCoreCache:     kubeCoreCache,
+		KubeDynamicClient: kubeDynamicClient,
 	}, nil
 }
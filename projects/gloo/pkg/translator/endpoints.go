@@ -1,33 +1,47 @@
 package translator
 
 import (
-	"context"
+	"sort"
 
 	structpb "github.com/golang/protobuf/ptypes/struct"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"go.opencensus.io/trace"
 
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	envoyendpoints "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 )
 
 const EnvoyLb = "envoy.lb"
 const SoloAnnotations = "io.solo.annotations"
+const SoloEndpoint = "io.solo.endpoint"
 
 // Endpoints
 
-func computeClusterEndpoints(ctx context.Context, upstreams []*v1.Upstream, endpoints []*v1.Endpoint) []*envoyapi.ClusterLoadAssignment {
+func (t *translatorInstance) computeClusterEndpoints(params plugins.Params, reports reporter.ResourceReports) []*envoyapi.ClusterLoadAssignment {
 
-	_, span := trace.StartSpan(ctx, "gloo.translator.computeClusterEndpoints")
+	ctx, span := trace.StartSpan(params.Ctx, "gloo.translator.computeClusterEndpoints")
+	params.Ctx = ctx
 	defer span.End()
 
 	var clusterEndpointAssignments []*envoyapi.ClusterLoadAssignment
-	for _, upstream := range upstreams {
-		clusterEndpoints := endpointsForUpstream(upstream, endpoints)
+	for _, upstream := range params.Snapshot.Upstreams {
+		clusterEndpoints := endpointsForUpstream(upstream, params.Snapshot.Endpoints)
 		// if there are any endpoints for this upstream, it's using eds and we need to create a load assignment for it
 		if len(clusterEndpoints) > 0 {
 			loadAssignment := loadAssignmentForUpstream(upstream, clusterEndpoints)
+			for _, plug := range t.plugins {
+				endpointPlugin, ok := plug.(plugins.EndpointPlugin)
+				if !ok {
+					continue
+				}
+				if err := endpointPlugin.ProcessEndpoints(params, upstream, loadAssignment); err != nil {
+					reports.AddError(upstream, err)
+				}
+			}
 			clusterEndpointAssignments = append(clusterEndpointAssignments, loadAssignment)
 		}
 	}
@@ -36,12 +50,16 @@ func computeClusterEndpoints(ctx context.Context, upstreams []*v1.Upstream, endp
 
 func loadAssignmentForUpstream(upstream *v1.Upstream, clusterEndpoints []*v1.Endpoint) *envoyapi.ClusterLoadAssignment {
 	clusterName := UpstreamToClusterName(upstream.Metadata.Ref())
-	var endpoints []*envoyendpoints.LbEndpoint
+	priorities := contiguousPriorities(clusterEndpoints)
+	var localities []locality
+	endpointsByLocality := map[locality][]*envoyendpoints.LbEndpoint{}
 	for _, addr := range clusterEndpoints {
 		metadata := getLbMetadata(upstream, addr.Metadata.Labels, "")
 		metadata = addAnnotations(metadata, addr.Metadata.Annotations)
+		metadata = addHostname(metadata, addr.GetHostname())
 		lbEndpoint := envoyendpoints.LbEndpoint{
-			Metadata: metadata,
+			Metadata:     metadata,
+			HealthStatus: envoycore.HealthStatus(addr.GetHealthStatus()),
 			HostIdentifier: &envoyendpoints.LbEndpoint_Endpoint{
 				Endpoint: &envoyendpoints.Endpoint{
 					Address: &envoycore.Address{
@@ -58,15 +76,90 @@ func loadAssignmentForUpstream(upstream *v1.Upstream, clusterEndpoints []*v1.End
 				},
 			},
 		}
-		endpoints = append(endpoints, &lbEndpoint)
+		if weight := addr.GetLoadBalancingWeight(); weight != nil {
+			// a weight of 0 makes an endpoint unlikely to be chosen, but envoy requires weights of at least 1
+			value := weight.GetValue()
+			if value == 0 {
+				value = 1
+			}
+			lbEndpoint.LoadBalancingWeight = &wrappers.UInt32Value{Value: value}
+		}
+		loc := locality{
+			region:  addr.GetLocality().GetRegion(),
+			zone:    addr.GetLocality().GetZone(),
+			subZone: addr.GetLocality().GetSubZone(),
+			// endpoints of different priorities are in different groups, even within a locality
+			priority: priorities[addr.GetPriority()],
+		}
+		if _, ok := endpointsByLocality[loc]; !ok {
+			localities = append(localities, loc)
+		}
+		endpointsByLocality[loc] = append(endpointsByLocality[loc], &lbEndpoint)
+	}
+
+	// sort localities for idempotency
+	sort.SliceStable(localities, func(i, j int) bool {
+		if localities[i].priority != localities[j].priority {
+			return localities[i].priority < localities[j].priority
+		}
+		return localities[i].key() < localities[j].key()
+	})
+	// envoy ignores localities without a weight when locality weighted load balancing is enabled
+	_, localityWeighted := upstream.GetLoadBalancerConfig().GetLocalityConfig().(*v1.LoadBalancerConfig_LocalityWeightedLbConfig_)
+	var endpoints []*envoyendpoints.LocalityLbEndpoints
+	for _, loc := range localities {
+		localityEndpoints := &envoyendpoints.LocalityLbEndpoints{
+			LbEndpoints: endpointsByLocality[loc],
+			Priority:    loc.priority,
+		}
+		if loc.key() != (locality{}).key() {
+			localityEndpoints.Locality = &envoycore.Locality{
+				Region:  loc.region,
+				Zone:    loc.zone,
+				SubZone: loc.subZone,
+			}
+		}
+		if localityWeighted {
+			localityEndpoints.LoadBalancingWeight = &wrappers.UInt32Value{
+				Value: uint32(len(localityEndpoints.LbEndpoints)),
+			}
+		}
+		endpoints = append(endpoints, localityEndpoints)
 	}
 
 	return &envoyapi.ClusterLoadAssignment{
 		ClusterName: clusterName,
-		Endpoints: []*envoyendpoints.LocalityLbEndpoints{{
-			LbEndpoints: endpoints,
-		}},
+		Endpoints:   endpoints,
+	}
+}
+
+type locality struct {
+	region, zone, subZone string
+	priority              uint32
+}
+
+func (l locality) key() string {
+	return l.region + "/" + l.zone + "/" + l.subZone
+}
+
+// contiguousPriorities maps the priorities of the endpoints to contiguous envoy priorities, starting from 0
+func contiguousPriorities(endpoints []*v1.Endpoint) map[uint32]uint32 {
+	var priorities []uint32
+	seen := make(map[uint32]bool)
+	for _, ep := range endpoints {
+		if !seen[ep.GetPriority()] {
+			seen[ep.GetPriority()] = true
+			priorities = append(priorities, ep.GetPriority())
+		}
 	}
+	sort.Slice(priorities, func(i, j int) bool {
+		return priorities[i] < priorities[j]
+	})
+	contiguous := make(map[uint32]uint32, len(priorities))
+	for i, priority := range priorities {
+		contiguous[priority] = uint32(i)
+	}
+	return contiguous
 }
 
 func endpointsForUpstream(upstream *v1.Upstream, endpoints []*v1.Endpoint) []*v1.Endpoint {
@@ -110,6 +203,30 @@ func addAnnotations(metadata *envoycore.Metadata, annotations map[string]string)
 	return metadata
 }
 
+// the envoy api used by gloo has no endpoint hostname, so it is passed in the endpoint metadata instead
+func addHostname(metadata *envoycore.Metadata, hostname string) *envoycore.Metadata {
+	if hostname == "" {
+		return metadata
+	}
+	if metadata == nil {
+		metadata = &envoycore.Metadata{}
+	}
+	if metadata.FilterMetadata == nil {
+		metadata.FilterMetadata = map[string]*structpb.Struct{}
+	}
+
+	metadata.FilterMetadata[SoloEndpoint] = &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"hostname": {
+				Kind: &structpb.Value_StringValue{
+					StringValue: hostname,
+				},
+			},
+		},
+	}
+	return metadata
+}
+
 func getLbMetadata(upstream *v1.Upstream, labels map[string]string, zeroValue string) *envoycore.Metadata {
 
 	meta := &envoycore.Metadata{
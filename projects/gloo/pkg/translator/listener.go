@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 
@@ -17,6 +18,7 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 	"github.com/solo-io/go-utils/contextutils"
 )
@@ -36,19 +38,18 @@ func (t *translatorInstance) computeListener(params plugins.Params, proxy *v1.Pr
 		filterChains = t.computeFilterChainsFromSslConfig(params.Snapshot, listener, listenerFilters, listenerReport)
 	case *v1.Listener_TcpListener:
 		// run the tcp filter chain plugins
-		for _, plug := range t.plugins {
-			listenerPlugin, ok := plug.(plugins.ListenerFilterChainPlugin)
-			if !ok {
-				continue
-			}
-			result, err := listenerPlugin.ProcessListenerFilterChain(params, listener)
-			if err != nil {
-				validation.AppendListenerError(listenerReport,
-					validationapi.ListenerReport_Error_ProcessingError,
-					err.Error())
-				continue
-			}
-			filterChains = append(filterChains, result...)
+		filterChains = t.computeTcpFilterChains(params, listener, listenerReport)
+	case *v1.Listener_HybridListener:
+		// translate the http and tcp halves independently and serve all of their filter chains
+		// from a single listener; envoy picks the chain for a connection by its SNI
+		validateHybridListenerSniDomains(listener, listenerReport)
+		httpListener, tcpListener := splitHybridListener(listener)
+		if listenerFilters := t.computeListenerFilters(params, httpListener, listenerReport); len(listenerFilters) > 0 {
+			filterChains = t.computeFilterChainsFromSslConfig(params.Snapshot, httpListener, listenerFilters, listenerReport)
+		}
+		filterChains = append(filterChains, t.computeTcpFilterChains(params, tcpListener, listenerReport)...)
+		if len(filterChains) == 0 {
+			return nil
 		}
 	}
 
@@ -70,19 +71,98 @@ func (t *translatorInstance) computeListener(params plugins.Params, proxy *v1.Pr
 	}
 
 	// run the Listener Plugins
+	// plugins see each half of a hybrid listener as a listener of its own type
+	inputListeners := []*v1.Listener{listener}
+	if listener.GetHybridListener() != nil {
+		httpListener, tcpListener := splitHybridListener(listener)
+		inputListeners = []*v1.Listener{httpListener, tcpListener}
+	}
 	for _, plug := range t.plugins {
 		listenerPlugin, ok := plug.(plugins.ListenerPlugin)
 		if !ok {
 			continue
 		}
-		if err := listenerPlugin.ProcessListener(params, listener, out); err != nil {
+		for _, in := range inputListeners {
+			if err := listenerPlugin.ProcessListener(params, in, out); err != nil {
+				validation.AppendListenerError(listenerReport,
+					validationapi.ListenerReport_Error_ProcessingError,
+					err.Error())
+			}
+		}
+	}
+
+	return out
+}
+
+func (t *translatorInstance) computeTcpFilterChains(params plugins.Params, listener *v1.Listener, listenerReport *validationapi.ListenerReport) []*envoylistener.FilterChain {
+	var filterChains []*envoylistener.FilterChain
+	for _, plug := range t.plugins {
+		listenerPlugin, ok := plug.(plugins.ListenerFilterChainPlugin)
+		if !ok {
+			continue
+		}
+		// plugins may return the filter chains they could compute alongside an error for the rest
+		result, err := listenerPlugin.ProcessListenerFilterChain(params, listener)
+		if err != nil {
 			validation.AppendListenerError(listenerReport,
 				validationapi.ListenerReport_Error_ProcessingError,
 				err.Error())
 		}
+		filterChains = append(filterChains, result...)
 	}
+	return filterChains
+}
 
-	return out
+// splitHybridListener returns the http and tcp halves of a hybrid listener as standalone listeners
+// sharing its name, address and options. ssl configurations only apply to the http half;
+// tcp hosts carry their own.
+func splitHybridListener(listener *v1.Listener) (*v1.Listener, *v1.Listener) {
+	hybridListener := listener.GetHybridListener()
+	httpListener := &v1.Listener{
+		Name:              listener.Name,
+		BindAddress:       listener.BindAddress,
+		BindPort:          listener.BindPort,
+		SslConfigurations: listener.SslConfigurations,
+		UseProxyProto:     listener.UseProxyProto,
+		Options:           listener.Options,
+		Metadata:          listener.Metadata,
+		ListenerType: &v1.Listener_HttpListener{
+			HttpListener: hybridListener.GetHttpListener(),
+		},
+	}
+	tcpListener := &v1.Listener{
+		Name:          listener.Name,
+		BindAddress:   listener.BindAddress,
+		BindPort:      listener.BindPort,
+		UseProxyProto: listener.UseProxyProto,
+		Options:       listener.Options,
+		Metadata:      listener.Metadata,
+		ListenerType: &v1.Listener_TcpListener{
+			TcpListener: hybridListener.GetTcpListener(),
+		},
+	}
+	return httpListener, tcpListener
+}
+
+// envoy rejects listeners where two filter chains have the same match, so an SNI domain
+// may be claimed by either the http or the tcp half of a hybrid listener, not both
+func validateHybridListenerSniDomains(listener *v1.Listener, listenerReport *validationapi.ListenerReport) {
+	httpDomains := map[string]bool{}
+	for _, sslConfig := range listener.SslConfigurations {
+		for _, domain := range sslConfig.SniDomains {
+			httpDomains[domain] = true
+		}
+	}
+	for _, tcpHost := range listener.GetHybridListener().GetTcpListener().GetTcpHosts() {
+		for _, domain := range tcpHost.GetSslConfig().GetSniDomains() {
+			if httpDomains[domain] {
+				validation.AppendListenerError(listenerReport,
+					validationapi.ListenerReport_Error_SSLConfigError,
+					fmt.Sprintf("sni domain %v is used by both the http listener and tcp host %v", domain, tcpHost.Name),
+				)
+			}
+		}
+	}
 }
 
 func (t *translatorInstance) computeListenerFilters(params plugins.Params, listener *v1.Listener, listenerReport *validationapi.ListenerReport) []*envoylistener.Filter {
@@ -110,7 +190,7 @@ func (t *translatorInstance) computeListenerFilters(params plugins.Params, liste
 		return nil
 	}
 
-	httpListenerReport := listenerReport.GetHttpListenerReport()
+	httpListenerReport := validation.GetHttpListenerReport(listenerReport)
 	if httpListenerReport == nil {
 		contextutils.LoggerFrom(params.Ctx).DPanic("internal error: listener report was not http type")
 	}
@@ -160,12 +240,37 @@ func (t *translatorInstance) computeFilterChainsFromSslConfig(snap *v1.ApiSnapsh
 				validationapi.ListenerReport_Error_SSLConfigError, err.Error())
 			continue
 		}
+		t.warnOnExpiringCertificate(snap, sslConfig, listenerReport)
 		filterChain := newSslFilterChain(downstreamConfig, sslConfig.SniDomains, listener.UseProxyProto, listenerFilters)
 		secureFilterChains = append(secureFilterChains, filterChain)
 	}
 	return secureFilterChains
 }
 
+// warn on the listener report when the certificate of a tls secret expires within the configured window,
+// so that the warning can be surfaced on the virtual services referencing the secret
+func (t *translatorInstance) warnOnExpiringCertificate(snap *v1.ApiSnapshot, sslConfig *v1.SslConfig, listenerReport *validationapi.ListenerReport) {
+	ref := sslConfig.GetSecretRef()
+	httpListenerReport := validation.GetHttpListenerReport(listenerReport)
+	if ref == nil || httpListenerReport == nil {
+		return
+	}
+	window := utils.CertificateExpiryWarningWindow(t.settings)
+	if window <= 0 {
+		return
+	}
+	info, err := utils.GetCertificateInfo(snap.Secrets, *ref)
+	if err != nil {
+		return
+	}
+	if untilExpiry := info.TimeUntilExpiry(); untilExpiry < window {
+		validation.AppendHTTPListenerWarning(httpListenerReport,
+			validationapi.HttpListenerReport_Warning_SslCertificateExpiringWarning,
+			fmt.Sprintf("the certificate in secret %v expires on %v", ref.Key(), info.NotAfter.UTC().Format(time.RFC3339)),
+			ref)
+	}
+}
+
 func mergeSslConfigs(sslConfigs []*v1.SslConfig) []*v1.SslConfig {
 	// we can merge ssl config if:
 	// they have the same SslSecrets and VerifySubjectAltName
@@ -36,11 +36,15 @@ var (
 )
 
 func (t *translatorInstance) computeRouteConfig(params plugins.Params, proxy *v1.Proxy, listener *v1.Listener, routeCfgName string, listenerReport *validationapi.ListenerReport) *envoyapi.RouteConfiguration {
+	if listener.GetHybridListener() != nil {
+		// the http half of a hybrid listener is routed like any http listener
+		listener, _ = splitHybridListener(listener)
+	}
 	if listener.GetHttpListener() == nil {
 		return nil
 	}
 
-	httpListenerReport := listenerReport.GetHttpListenerReport()
+	httpListenerReport := validation.GetHttpListenerReport(listenerReport)
 	if httpListenerReport == nil {
 		contextutils.LoggerFrom(params.Ctx).DPanic("internal error: listener report was not http type")
 	}
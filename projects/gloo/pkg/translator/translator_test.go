@@ -3,6 +3,7 @@ package translator_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
@@ -32,6 +33,7 @@ import (
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/kubernetes"
 	sslutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/gloo/test/helpers"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
 	skkube "github.com/solo-io/solo-kit/pkg/api/v1/resources/common/kubernetes"
@@ -891,6 +893,28 @@ var _ = Describe("Translator", func() {
 			}
 			Expect(report).To(Equal(expectedReport))
 		})
+
+		It("should error on upstreams in upstream groups that are not allowed by reference policies", func() {
+			upstream2.Metadata.Namespace = "team-a"
+			upstreamGroup.Destinations[1].Destination.GetUpstream().Namespace = "team-a"
+			params.Snapshot.ReferencePolicies = v1.ReferencePolicyList{
+				{
+					Metadata: core.Metadata{
+						Name:      "policy",
+						Namespace: "team-a",
+					},
+					Rules: []*v1.ReferencePolicyRule{{
+						Namespaces: []string{"team-b"},
+					}},
+				},
+			}
+
+			_, errs, _, err := translator.Translate(params, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			err = errs.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("destination # 2: reference policy violation"))
+		})
 	})
 
 	Context("when handling endpoints", func() {
@@ -941,6 +965,111 @@ var _ = Describe("Translator", func() {
 			Expect(filterMetadata[SoloAnnotations].Fields).To(HaveKey("testkey"))
 			Expect(filterMetadata[SoloAnnotations].Fields["testkey"].GetStringValue()).To(Equal("testvalue"))
 		})
+
+		It("should transfer the health status, weight and hostname of endpoints", func() {
+			ep := params.Snapshot.Endpoints[0]
+			ep.HealthStatus = v1.HealthStatus_DRAINING
+			ep.LoadBalancingWeight = &types.UInt32Value{Value: 5}
+			ep.Hostname = "test.example.com"
+			translate()
+
+			clusterName := UpstreamToClusterName(upstream.Metadata.Ref())
+			claConfiguration = snapshot.GetResources(xds.EndpointType).Items[clusterName].ResourceProto().(*envoyapi.ClusterLoadAssignment)
+			lbEndpoint := claConfiguration.Endpoints[0].LbEndpoints[0]
+			Expect(lbEndpoint.HealthStatus).To(Equal(envoycore.HealthStatus_DRAINING))
+			Expect(lbEndpoint.LoadBalancingWeight.GetValue()).To(BeEquivalentTo(5))
+			filterMetadata := lbEndpoint.GetMetadata().GetFilterMetadata()
+			Expect(filterMetadata).To(HaveKey(SoloEndpoint))
+			Expect(filterMetadata[SoloEndpoint].Fields["hostname"].GetStringValue()).To(Equal("test.example.com"))
+		})
+
+		It("should use the lowest weight envoy accepts for endpoints with a weight of 0", func() {
+			params.Snapshot.Endpoints[0].LoadBalancingWeight = &types.UInt32Value{Value: 0}
+			translate()
+
+			clusterName := UpstreamToClusterName(upstream.Metadata.Ref())
+			claConfiguration = snapshot.GetResources(xds.EndpointType).Items[clusterName].ResourceProto().(*envoyapi.ClusterLoadAssignment)
+			Expect(claConfiguration.Endpoints[0].LbEndpoints[0].LoadBalancingWeight.GetValue()).To(BeEquivalentTo(1))
+		})
+
+		It("should group endpoints by locality", func() {
+			ref := upstream.Metadata.Ref()
+			for i, zone := range []string{"us-east-1b", "us-east-1a", "us-east-1b"} {
+				params.Snapshot.Endpoints = append(params.Snapshot.Endpoints, &v1.Endpoint{
+					Metadata:  core.Metadata{Name: fmt.Sprintf("zoned-%d", i), Namespace: "gloo-system"},
+					Upstreams: []*core.ResourceRef{&ref},
+					Address:   fmt.Sprintf("1.2.3.%d", 10+i),
+					Port:      1234,
+					Locality:  &v1.Locality{Region: "us-east-1", Zone: zone},
+				})
+			}
+			upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+				LocalityConfig: &v1.LoadBalancerConfig_LocalityWeightedLbConfig_{
+					LocalityWeightedLbConfig: &v1.LoadBalancerConfig_LocalityWeightedLbConfig{},
+				},
+			}
+			translate()
+
+			clusterName := UpstreamToClusterName(upstream.Metadata.Ref())
+			claConfiguration = snapshot.GetResources(xds.EndpointType).Items[clusterName].ResourceProto().(*envoyapi.ClusterLoadAssignment)
+			Expect(claConfiguration.Endpoints).To(HaveLen(3))
+
+			// the endpoint without a locality comes first
+			Expect(claConfiguration.Endpoints[0].Locality).To(BeNil())
+			Expect(claConfiguration.Endpoints[0].LbEndpoints).To(HaveLen(1))
+			Expect(claConfiguration.Endpoints[0].LoadBalancingWeight.GetValue()).To(BeEquivalentTo(1))
+
+			Expect(claConfiguration.Endpoints[1].Locality).To(Equal(&envoycore.Locality{Region: "us-east-1", Zone: "us-east-1a"}))
+			Expect(claConfiguration.Endpoints[1].LbEndpoints).To(HaveLen(1))
+			Expect(claConfiguration.Endpoints[1].LoadBalancingWeight.GetValue()).To(BeEquivalentTo(1))
+
+			Expect(claConfiguration.Endpoints[2].Locality).To(Equal(&envoycore.Locality{Region: "us-east-1", Zone: "us-east-1b"}))
+			Expect(claConfiguration.Endpoints[2].LbEndpoints).To(HaveLen(2))
+			Expect(claConfiguration.Endpoints[2].LoadBalancingWeight.GetValue()).To(BeEquivalentTo(2))
+		})
+
+		It("should group endpoints by priority, with contiguous priorities", func() {
+			ref := upstream.Metadata.Ref()
+			params.Snapshot.Endpoints[0].Priority = 10
+			for i, priority := range []uint32{30, 10, 20} {
+				params.Snapshot.Endpoints = append(params.Snapshot.Endpoints, &v1.Endpoint{
+					Metadata:  core.Metadata{Name: fmt.Sprintf("prioritized-%d", i), Namespace: "gloo-system"},
+					Upstreams: []*core.ResourceRef{&ref},
+					Address:   fmt.Sprintf("1.2.3.%d", 10+i),
+					Port:      1234,
+					Priority:  priority,
+				})
+			}
+			translate()
+
+			clusterName := UpstreamToClusterName(upstream.Metadata.Ref())
+			claConfiguration = snapshot.GetResources(xds.EndpointType).Items[clusterName].ResourceProto().(*envoyapi.ClusterLoadAssignment)
+			Expect(claConfiguration.Endpoints).To(HaveLen(3))
+
+			Expect(claConfiguration.Endpoints[0].Priority).To(BeEquivalentTo(0))
+			Expect(claConfiguration.Endpoints[0].LbEndpoints).To(HaveLen(2))
+			Expect(claConfiguration.Endpoints[1].Priority).To(BeEquivalentTo(1))
+			Expect(claConfiguration.Endpoints[1].LbEndpoints).To(HaveLen(1))
+			Expect(claConfiguration.Endpoints[2].Priority).To(BeEquivalentTo(2))
+			Expect(claConfiguration.Endpoints[2].LbEndpoints).To(HaveLen(1))
+		})
+
+		It("should let endpoint plugins process the load assignment", func() {
+			registeredPlugins = append(registeredPlugins, &endpointPluginMock{
+				ProcessEndpointsFunc: func(params plugins.Params, in *v1.Upstream, out *envoyapi.ClusterLoadAssignment) error {
+					for _, localityEndpoints := range out.Endpoints {
+						localityEndpoints.Priority = 1
+					}
+					return nil
+				},
+			})
+			translate()
+
+			clusterName := UpstreamToClusterName(upstream.Metadata.Ref())
+			claConfiguration = snapshot.GetResources(xds.EndpointType).Items[clusterName].ResourceProto().(*envoyapi.ClusterLoadAssignment)
+			Expect(claConfiguration.Endpoints).To(HaveLen(1))
+			Expect(claConfiguration.Endpoints[0].Priority).To(BeEquivalentTo(1))
+		})
 	})
 
 	Context("when handling subsets", func() {
@@ -1514,6 +1643,88 @@ var _ = Describe("Translator", func() {
 		})
 	})
 
+	Context("Hybrid", func() {
+		var (
+			tcpHost *v1.TcpHost
+		)
+
+		JustBeforeEach(func() {
+			tcpHost = &v1.TcpHost{
+				Name: "passthrough",
+				Destination: &v1.RouteAction{
+					Destination: &v1.RouteAction_Single{
+						Single: &v1.Destination{
+							DestinationType: &v1.Destination_Upstream{
+								Upstream: utils.ResourceRefPtr(upName.Ref()),
+							},
+						},
+					},
+				},
+				SslConfig: &v1.SslConfig{
+					SniDomains: []string{"passthrough.example.com"},
+				},
+			}
+			proxy.Listeners = []*v1.Listener{{
+				Name:        "http-listener",
+				BindAddress: "127.0.0.1",
+				BindPort:    443,
+				ListenerType: &v1.Listener_HybridListener{
+					HybridListener: &v1.HybridListener{
+						HttpListener: &v1.HttpListener{
+							VirtualHosts: []*v1.VirtualHost{{
+								Name:    "virt1",
+								Domains: []string{"terminated.example.com"},
+								Routes:  routes,
+							}},
+						},
+						TcpListener: &v1.TcpListener{
+							TcpHosts: []*v1.TcpHost{tcpHost},
+						},
+					},
+				},
+				SslConfigurations: []*v1.SslConfig{{
+					SslSecrets: &v1.SslConfig_SslFiles{
+						SslFiles: &v1.SSLFiles{
+							TlsCert: "cert",
+							TlsKey:  "key",
+						},
+					},
+					SniDomains: []string{"terminated.example.com"},
+				}},
+			}}
+		})
+
+		It("serves terminated http and passed through tls from the same listener", func() {
+			translate()
+
+			Expect(listener.GetFilterChains()).To(HaveLen(2))
+
+			httpChain := listener.GetFilterChains()[0]
+			Expect(httpChain.GetFilterChainMatch().GetServerNames()).To(Equal([]string{"terminated.example.com"}))
+			Expect(httpChain.GetTransportSocket()).NotTo(BeNil())
+			Expect(hcmCfg.GetRds().GetRouteConfigName()).To(Equal("http-listener-routes"))
+			Expect(routeConfiguration.GetVirtualHosts()).To(HaveLen(1))
+
+			tcpChain := listener.GetFilterChains()[1]
+			Expect(tcpChain.GetFilterChainMatch().GetServerNames()).To(Equal([]string{"passthrough.example.com"}))
+			Expect(tcpChain.GetFilterChainMatch().GetTransportProtocol()).To(Equal("tls"))
+			Expect(tcpChain.GetTransportSocket()).To(BeNil())
+			var tcpCfg envoytcp.TcpProxy
+			Expect(ParseConfig(tcpChain.GetFilters()[0], &tcpCfg)).NotTo(HaveOccurred())
+			Expect(tcpCfg.GetCluster()).To(Equal(UpstreamToClusterName(upName.Ref())))
+		})
+
+		It("errors when the http and tcp hosts share an sni domain", func() {
+			tcpHost.SslConfig.SniDomains = []string{"terminated.example.com"}
+
+			report := translateWithError()
+			Expect(report.GetListenerReports()[0].GetErrors()).To(ContainElement(&validation.ListenerReport_Error{
+				Type:   validation.ListenerReport_Error_SSLConfigError,
+				Reason: "sni domain terminated.example.com is used by both the http listener and tcp host passthrough",
+			}))
+		})
+	})
+
 	Context("Ssl", func() {
 
 		var (
@@ -1818,6 +2029,66 @@ var _ = Describe("Translator", func() {
 				Expect(fc.FilterChainMatch.ServerNames).To(Equal([]string{"c.com"}))
 			})
 		})
+		Context("certificate expiry", func() {
+			var secretRef core.ResourceRef
+
+			translateExpiringCertificate := func() *validation.ProxyReport {
+				validFor := 24 * time.Hour
+				cert, key := helpers.GetCerts(helpers.Params{
+					Hosts:      "gloo.solo.io",
+					ValidFor:   &validFor,
+					EcdsaCurve: "P256",
+				})
+				secret := &v1.Secret{
+					Metadata: core.Metadata{
+						Name:      "expiring",
+						Namespace: "solo.io",
+					},
+					Kind: &v1.Secret_Tls{
+						Tls: &v1.TlsSecret{
+							CertChain:  cert,
+							PrivateKey: key,
+						},
+					},
+				}
+				secretRef = secret.Metadata.Ref()
+				params.Snapshot.Secrets = append(params.Snapshot.Secrets, secret)
+				proxy.Listeners = []*v1.Listener{{
+					Name:        "http-listener",
+					BindAddress: "127.0.0.1",
+					BindPort:    80,
+					ListenerType: &v1.Listener_HttpListener{
+						HttpListener: &v1.HttpListener{
+							VirtualHosts: []*v1.VirtualHost{{
+								Name:    "virt1",
+								Domains: []string{"*"},
+								Routes:  routes,
+							}},
+						},
+					},
+					SslConfigurations: []*v1.SslConfig{{
+						SslSecrets: &v1.SslConfig_SecretRef{SecretRef: &secretRef},
+					}},
+				}}
+				_, _, report, err := translator.Translate(params, proxy)
+				Expect(err).NotTo(HaveOccurred())
+				return report
+			}
+
+			It("should warn about certificates expiring within the warning window", func() {
+				report := translateExpiringCertificate()
+				warnings := report.GetListenerReports()[0].GetHttpListenerReport().GetWarnings()
+				Expect(warnings).To(HaveLen(1))
+				Expect(warnings[0].Type).To(Equal(validation.HttpListenerReport_Warning_SslCertificateExpiringWarning))
+				Expect(*warnings[0].SecretRef).To(Equal(secretRef))
+			})
+
+			It("should not warn when the warning window is disabled", func() {
+				settings.Gloo = &v1.GlooOptions{CertificateExpiryWarningWindow: &types.Duration{}}
+				report := translateExpiringCertificate()
+				Expect(report.GetListenerReports()[0].GetHttpListenerReport().GetWarnings()).To(BeEmpty())
+			})
+		})
 	})
 
 	It("Should report an error for virtual services with empty domains", func() {
@@ -1859,3 +2130,15 @@ func (p *routePluginMock) Init(params plugins.InitParams) error {
 func (p *routePluginMock) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoyrouteapi.Route) error {
 	return p.ProcessRouteFunc(params, in, out)
 }
+
+type endpointPluginMock struct {
+	ProcessEndpointsFunc func(params plugins.Params, in *v1.Upstream, out *envoyapi.ClusterLoadAssignment) error
+}
+
+func (p *endpointPluginMock) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *endpointPluginMock) ProcessEndpoints(params plugins.Params, in *v1.Upstream, out *envoyapi.ClusterLoadAssignment) error {
+	return p.ProcessEndpointsFunc(params, in, out)
+}
@@ -4,6 +4,7 @@ import (
 	errors "github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	usconversions "github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/referencepolicy"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 )
 
@@ -29,6 +30,11 @@ func (t *translatorInstance) verifyUpstreamGroups(params plugins.Params, reports
 				reports.AddError(ug, errors.Wrapf(err, "destination # %d: upstream not found", i+1))
 				continue
 			}
+
+			if err := referencepolicy.ValidateDestination(params.Snapshot.ReferencePolicies, ug.Metadata.Namespace, dest.Destination); err != nil {
+				reports.AddError(ug, errors.Wrapf(err, "destination # %d", i+1))
+				continue
+			}
 		}
 
 	}
@@ -0,0 +1,145 @@
+package consul
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/avast/retry-go"
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+// ConnectCertificates keeps the Connect CA roots and the leaf certificate of a service up to date with blocking
+// queries, so that they are read from memory at every translation and changes are noticed when consul rotates them.
+// The queries start with the first read, so nothing is requested from consul unless Connect is used.
+type ConnectCertificates struct {
+	ctx     context.Context
+	client  ConsulClient
+	service string
+	changes chan struct{}
+
+	start    sync.Once
+	lock     sync.RWMutex
+	roots    *consulapi.CARootList
+	rootsErr error
+	leaf     *consulapi.LeafCert
+	leafErr  error
+}
+
+func NewConnectCertificates(ctx context.Context, client ConsulClient, service string) *ConnectCertificates {
+	return &ConnectCertificates{
+		ctx:     ctx,
+		client:  client,
+		service: service,
+		changes: make(chan struct{}, 1),
+	}
+}
+
+// Service is the service the leaf certificate is issued for
+func (c *ConnectCertificates) Service() string {
+	return c.service
+}
+
+// Roots returns the latest Connect CA roots, or the error of the first query if it has never succeeded
+func (c *ConnectCertificates) Roots() (*consulapi.CARootList, error) {
+	c.start.Do(c.watch)
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.roots, c.rootsErr
+}
+
+// Leaf returns the latest leaf certificate, or the error of the first query if it has never succeeded
+func (c *ConnectCertificates) Leaf() (*consulapi.LeafCert, error) {
+	c.start.Do(c.watch)
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.leaf, c.leafErr
+}
+
+// Changes receives when the roots or the leaf certificate change after they were first read
+func (c *ConnectCertificates) Changes() <-chan struct{} {
+	return c.changes
+}
+
+// watch reads the roots and the leaf certificate once, then keeps them up to date in the background
+func (c *ConnectCertificates) watch() {
+	roots, rootsMeta, rootsErr := c.client.ConnectCARoots((&consulapi.QueryOptions{}).WithContext(c.ctx))
+	leaf, leafMeta, leafErr := c.client.ConnectCALeaf(c.service, (&consulapi.QueryOptions{}).WithContext(c.ctx))
+
+	c.lock.Lock()
+	c.roots, c.rootsErr = roots, rootsErr
+	c.leaf, c.leafErr = leaf, leafErr
+	c.lock.Unlock()
+
+	go c.watchQuery("connect ca roots", lastIndex(rootsMeta, rootsErr), func(q *consulapi.QueryOptions) (uint64, error) {
+		roots, meta, err := c.client.ConnectCARoots(q)
+		if err != nil {
+			return 0, err
+		}
+		c.lock.Lock()
+		c.roots, c.rootsErr = roots, nil
+		c.lock.Unlock()
+		return meta.LastIndex, nil
+	})
+	go c.watchQuery("connect leaf certificate", lastIndex(leafMeta, leafErr), func(q *consulapi.QueryOptions) (uint64, error) {
+		leaf, meta, err := c.client.ConnectCALeaf(c.service, q)
+		if err != nil {
+			return 0, err
+		}
+		c.lock.Lock()
+		c.leaf, c.leafErr = leaf, nil
+		c.lock.Unlock()
+		return meta.LastIndex, nil
+	})
+}
+
+func lastIndex(meta *consulapi.QueryMeta, err error) uint64 {
+	if err != nil || meta == nil {
+		return 0
+	}
+	return meta.LastIndex
+}
+
+// watchQuery runs a blocking query until the context is done. The query stores its result when it succeeds and
+// returns the index of the result.
+func (c *ConnectCertificates) watchQuery(name string, index uint64, query func(q *consulapi.QueryOptions) (uint64, error)) {
+	for c.ctx.Err() == nil {
+		var newIndex uint64
+		// Use a back-off retry strategy to avoid flooding consul while it is unavailable
+		err := retry.Do(
+			func() error {
+				var err error
+				// This is a blocking query, it returns when the result changes
+				newIndex, err = query((&consulapi.QueryOptions{WaitIndex: index}).WithContext(c.ctx))
+				return err
+			},
+			retry.Attempts(6),
+			//  Last delay is 2^6 * 100ms = 3.2s
+			retry.Delay(100*time.Millisecond),
+			retry.DelayType(retry.BackOffDelay),
+			retry.RetryIf(func(error) bool { return c.ctx.Err() == nil }),
+		)
+		if err != nil {
+			if c.ctx.Err() == nil {
+				contextutils.LoggerFrom(c.ctx).Warnf("watching the %v of service %v: %v", name, c.service, err)
+			}
+			continue
+		}
+
+		// If index is the same, there have been no changes since last query
+		if newIndex == index {
+			continue
+		}
+		// consul may reset the index, in which case the next query must not block on the old one
+		if newIndex < index {
+			newIndex = 0
+		}
+		index = newIndex
+
+		select {
+		case c.changes <- struct{}{}:
+		default:
+		}
+	}
+}
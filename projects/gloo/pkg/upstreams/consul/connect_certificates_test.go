@@ -0,0 +1,96 @@
+package consul_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	consulapi "github.com/hashicorp/consul/api"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul/mocks"
+)
+
+var _ = Describe("ConnectCertificates", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		ctrl   *gomock.Controller
+		client *MockConsulClient
+		leaves chan *consulapi.LeafCert
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		ctrl = gomock.NewController(T)
+		client = NewMockConsulClient(ctrl)
+		// blocking queries return when the test sends a new leaf certificate
+		updates := make(chan *consulapi.LeafCert)
+		leaves = updates
+		client.EXPECT().ConnectCALeaf("gloo", gomock.Any()).DoAndReturn(func(service string, q *consulapi.QueryOptions) (*consulapi.LeafCert, *consulapi.QueryMeta, error) {
+			select {
+			case leaf := <-updates:
+				return leaf, &consulapi.QueryMeta{LastIndex: q.WaitIndex + 1}, nil
+			case <-q.Context().Done():
+				return nil, nil, q.Context().Err()
+			}
+		}).AnyTimes()
+		client.EXPECT().ConnectCARoots(gomock.Any()).DoAndReturn(func(q *consulapi.QueryOptions) (*consulapi.CARootList, *consulapi.QueryMeta, error) {
+			if q.WaitIndex == 0 {
+				return &consulapi.CARootList{TrustDomain: "trust.consul"}, &consulapi.QueryMeta{LastIndex: 1}, nil
+			}
+			<-q.Context().Done()
+			return nil, nil, q.Context().Err()
+		}).AnyTimes()
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("keeps the leaf certificate up to date and notifies of changes", func() {
+		certificates := NewConnectCertificates(ctx, client, "gloo")
+		go func() { leaves <- &consulapi.LeafCert{CertPEM: "leaf-1"} }()
+
+		leaf, err := certificates.Leaf()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaf.CertPEM).To(Equal("leaf-1"))
+		roots, err := certificates.Roots()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(roots.TrustDomain).To(Equal("trust.consul"))
+		Consistently(certificates.Changes(), "100ms").ShouldNot(Receive())
+
+		leaves <- &consulapi.LeafCert{CertPEM: "leaf-2"}
+		Eventually(certificates.Changes()).Should(Receive())
+		leaf, err = certificates.Leaf()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaf.CertPEM).To(Equal("leaf-2"))
+	})
+
+	It("returns the error of the first read until a query succeeds", func() {
+		failing := NewMockConsulClient(ctrl)
+		failing.EXPECT().ConnectCARoots(gomock.Any()).DoAndReturn(client.ConnectCARoots).AnyTimes()
+		gomock.InOrder(
+			failing.EXPECT().ConnectCALeaf("gloo", gomock.Any()).Return(nil, nil, eris.New("agent unavailable")),
+			failing.EXPECT().ConnectCALeaf("gloo", gomock.Any()).DoAndReturn(func(service string, q *consulapi.QueryOptions) (*consulapi.LeafCert, *consulapi.QueryMeta, error) {
+				return client.ConnectCALeaf(service, &consulapi.QueryOptions{WaitIndex: 0})
+			}),
+			failing.EXPECT().ConnectCALeaf("gloo", gomock.Any()).DoAndReturn(func(service string, q *consulapi.QueryOptions) (*consulapi.LeafCert, *consulapi.QueryMeta, error) {
+				<-q.Context().Done()
+				return nil, nil, q.Context().Err()
+			}).AnyTimes(),
+		)
+		certificates := NewConnectCertificates(ctx, failing, "gloo")
+
+		_, err := certificates.Leaf()
+		Expect(err).To(MatchError(ContainSubstring("agent unavailable")))
+
+		leaves <- &consulapi.LeafCert{CertPEM: "leaf-1"}
+		Eventually(certificates.Changes()).Should(Receive())
+		leaf, err := certificates.Leaf()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaf.CertPEM).To(Equal("leaf-1"))
+	})
+})
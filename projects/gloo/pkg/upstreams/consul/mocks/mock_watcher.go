@@ -99,6 +99,70 @@ func (mr *MockConsulWatcherMockRecorder) Connect(service, tag, q interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockConsulWatcher)(nil).Connect), service, tag, q)
 }
 
+// HealthService mocks base method
+func (m *MockConsulWatcher) HealthService(service, tag string, q *api.QueryOptions) ([]*api.ServiceEntry, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HealthService", service, tag, q)
+	ret0, _ := ret[0].([]*api.ServiceEntry)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// HealthService indicates an expected call of HealthService
+func (mr *MockConsulWatcherMockRecorder) HealthService(service, tag, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthService", reflect.TypeOf((*MockConsulWatcher)(nil).HealthService), service, tag, q)
+}
+
+// HealthConnect mocks base method
+func (m *MockConsulWatcher) HealthConnect(service, tag string, q *api.QueryOptions) ([]*api.ServiceEntry, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HealthConnect", service, tag, q)
+	ret0, _ := ret[0].([]*api.ServiceEntry)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// HealthConnect indicates an expected call of HealthConnect
+func (mr *MockConsulWatcherMockRecorder) HealthConnect(service, tag, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthConnect", reflect.TypeOf((*MockConsulWatcher)(nil).HealthConnect), service, tag, q)
+}
+
+// ConnectCARoots mocks base method
+func (m *MockConsulWatcher) ConnectCARoots(q *api.QueryOptions) (*api.CARootList, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectCARoots", q)
+	ret0, _ := ret[0].(*api.CARootList)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ConnectCARoots indicates an expected call of ConnectCARoots
+func (mr *MockConsulWatcherMockRecorder) ConnectCARoots(q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectCARoots", reflect.TypeOf((*MockConsulWatcher)(nil).ConnectCARoots), q)
+}
+
+// ConnectCALeaf mocks base method
+func (m *MockConsulWatcher) ConnectCALeaf(service string, q *api.QueryOptions) (*api.LeafCert, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectCALeaf", service, q)
+	ret0, _ := ret[0].(*api.LeafCert)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ConnectCALeaf indicates an expected call of ConnectCALeaf
+func (mr *MockConsulWatcherMockRecorder) ConnectCALeaf(service, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectCALeaf", reflect.TypeOf((*MockConsulWatcher)(nil).ConnectCALeaf), service, q)
+}
+
 // WatchServices mocks base method
 func (m *MockConsulWatcher) WatchServices(ctx context.Context, dataCenters []string) (<-chan []*consul.ServiceMeta, <-chan error) {
 	m.ctrl.T.Helper()
@@ -113,3 +177,18 @@ func (mr *MockConsulWatcherMockRecorder) WatchServices(ctx, dataCenters interfac
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchServices", reflect.TypeOf((*MockConsulWatcher)(nil).WatchServices), ctx, dataCenters)
 }
+
+// WatchServiceHealth mocks base method
+func (m *MockConsulWatcher) WatchServiceHealth(ctx context.Context, service, dataCenter string, connect bool) (<-chan []*api.ServiceEntry, <-chan error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchServiceHealth", ctx, service, dataCenter, connect)
+	ret0, _ := ret[0].(<-chan []*api.ServiceEntry)
+	ret1, _ := ret[1].(<-chan error)
+	return ret0, ret1
+}
+
+// WatchServiceHealth indicates an expected call of WatchServiceHealth
+func (mr *MockConsulWatcherMockRecorder) WatchServiceHealth(ctx, service, dataCenter, connect interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchServiceHealth", reflect.TypeOf((*MockConsulWatcher)(nil).WatchServiceHealth), ctx, service, dataCenter, connect)
+}
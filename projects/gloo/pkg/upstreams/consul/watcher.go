@@ -21,6 +21,9 @@ type ServiceMeta struct {
 type ConsulWatcher interface {
 	ConsulClient
 	WatchServices(ctx context.Context, dataCenters []string) (<-chan []*ServiceMeta, <-chan error)
+	// WatchServiceHealth watches the instances of a service in a data center, together with their health checks.
+	// If connect is true, the Connect-capable instances of the service (usually its Connect proxies) are watched instead.
+	WatchServiceHealth(ctx context.Context, service, dataCenter string, connect bool) (<-chan []*consulapi.ServiceEntry, <-chan error)
 }
 
 func NewConsulWatcher(client *consulapi.Client, dataCenters []string) (ConsulWatcher, error) {
@@ -183,6 +186,81 @@ func (c *consulWatcher) watchServicesInDataCenter(ctx context.Context, dataCente
 	return servicesChan, errsChan
 }
 
+// Honors the contract of Watch functions to open with an initial read.
+func (c *consulWatcher) WatchServiceHealth(ctx context.Context, service, dataCenter string, connect bool) (<-chan []*consulapi.ServiceEntry, <-chan error) {
+	entriesChan := make(chan []*consulapi.ServiceEntry)
+	errsChan := make(chan error)
+
+	query := c.HealthService
+	if connect {
+		query = c.HealthConnect
+	}
+
+	go func() {
+		defer close(entriesChan)
+		defer close(errsChan)
+		lastIndex := uint64(0)
+
+		for {
+			select {
+			default:
+
+				var (
+					entries   []*consulapi.ServiceEntry
+					queryMeta *consulapi.QueryMeta
+				)
+
+				// Use a back-off retry strategy to avoid flooding the error channel
+				err := retry.Do(
+					func() error {
+						var err error
+
+						// This is a blocking query, it returns when the instances or their health checks change
+						entries, queryMeta, err = query(service, "", (&consulapi.QueryOptions{
+							Datacenter:        dataCenter,
+							RequireConsistent: true,
+							WaitIndex:         lastIndex,
+						}).WithContext(ctx))
+
+						return err
+					},
+					retry.Attempts(6),
+					//  Last delay is 2^6 * 100ms = 3.2s
+					retry.Delay(100*time.Millisecond),
+					retry.DelayType(retry.BackOffDelay),
+				)
+
+				if err != nil {
+					select {
+					case errsChan <- err:
+					case <-ctx.Done():
+						return
+					}
+					continue
+				}
+
+				// If index is the same, there have been no changes since last query
+				if queryMeta.LastIndex == lastIndex {
+					continue
+				}
+
+				select {
+				case entriesChan <- entries:
+				case <-ctx.Done():
+					return
+				}
+				// Update the last index
+				lastIndex = queryMeta.LastIndex
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return entriesChan, errsChan
+}
+
 func aggregateServices(ctx context.Context, dest chan *dataCenterServicesTuple, src <-chan *dataCenterServicesTuple) {
 	for {
 		select {
@@ -0,0 +1,384 @@
+package secretsource
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	awsutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/aws"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	"go.uber.org/multierr"
+)
+
+const (
+	DefaultSecretsManagerPrefix = "gloo/"
+
+	currentVersionStage = "AWSCURRENT"
+)
+
+var (
+	ReadOnlyError = errors.Errorf("the aws secret source is read-only")
+
+	UnsupportedResourceError = func(resource resources.Resource) error {
+		return errors.Errorf("the aws secret source only supports secrets, not %v", resources.Kind(resource))
+	}
+
+	InvalidSecretValueError = func(name string, err error) error {
+		return errors.Wrapf(err, "parsing the gloo secret stored in %v", name)
+	}
+)
+
+// ClientFactory builds read-only secret clients. A nil SecretsManager or ParameterStore is not read.
+type ClientFactory struct {
+	SecretsManager       secretsmanageriface.SecretsManagerAPI
+	SecretsManagerPrefix string
+	ParameterStore       ssmiface.SSMAPI
+	ParameterStorePath   string
+}
+
+func NewClientFactory(settings *v1.Settings_AwsSecrets) (*ClientFactory, error) {
+	config := aws.NewConfig()
+	if region := settings.GetRegion(); region != "" {
+		config = config.WithRegion(region)
+	}
+	if endpoint := settings.GetEndpoint(); endpoint != "" {
+		config = config.WithEndpoint(endpoint)
+	}
+	sess, err := awsutils.GetAwsSession(nil, nil, config)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &ClientFactory{}
+	if !settings.GetDisableSecretsManager() {
+		f.SecretsManager = secretsmanager.New(sess)
+		f.SecretsManagerPrefix = settings.GetSecretsManagerPrefix()
+		if f.SecretsManagerPrefix == "" {
+			f.SecretsManagerPrefix = DefaultSecretsManagerPrefix
+		}
+	}
+	if path := settings.GetParameterStorePath(); path != "" {
+		f.ParameterStore = ssm.New(sess)
+		f.ParameterStorePath = path
+	}
+	return f, nil
+}
+
+func (f *ClientFactory) NewResourceClient(params factory.NewResourceClientParams) (clients.ResourceClient, error) {
+	if _, ok := params.ResourceType.(*v1.Secret); !ok {
+		return nil, UnsupportedResourceError(params.ResourceType)
+	}
+	return &resourceClient{
+		factory:              f,
+		secretsManagerPrefix: keyPrefix(f.SecretsManagerPrefix),
+		parameterStorePath:   keyPrefix(f.ParameterStorePath),
+		cache:                map[string]cachedSecret{},
+	}, nil
+}
+
+// keyPrefix ends the configured prefix with exactly one "/", so that "gloo", "gloo/" and "gloo//" all store
+// secrets under gloo/<namespace>/<name>
+func keyPrefix(prefix string) string {
+	return strings.TrimRight(prefix, "/") + "/"
+}
+
+// secrets are only fetched again when their version changes
+type cachedSecret struct {
+	version string
+	secret  *v1.Secret
+}
+
+type resourceClient struct {
+	factory              *ClientFactory
+	secretsManagerPrefix string
+	parameterStorePath   string
+
+	lock sync.Mutex
+	// pruned on every list, so that the secrets deleted from the stores are not kept
+	cache map[string]cachedSecret
+}
+
+func (rc *resourceClient) Kind() string {
+	return resources.Kind(&v1.Secret{})
+}
+
+func (rc *resourceClient) NewResource() resources.Resource {
+	return &v1.Secret{}
+}
+
+func (rc *resourceClient) Register() error {
+	return nil
+}
+
+func (rc *resourceClient) Read(namespace, name string, opts clients.ReadOpts) (resources.Resource, error) {
+	if err := resources.ValidateName(name); err != nil {
+		return nil, errors.Wrapf(err, "validation error")
+	}
+	opts = opts.WithDefaults()
+	namespace = clients.DefaultNamespaceIfEmpty(namespace)
+
+	if sm := rc.factory.SecretsManager; sm != nil {
+		key := rc.secretsManagerPrefix + namespace + "/" + name
+		out, err := sm.GetSecretValueWithContext(opts.Ctx, &secretsmanager.GetSecretValueInput{SecretId: aws.String(key)})
+		if err == nil {
+			return rc.parse(key, aws.StringValue(out.VersionId), aws.StringValue(out.SecretString))
+		}
+		if !isNotFound(err) {
+			return nil, errors.Wrapf(err, "reading secrets manager secret %v", key)
+		}
+	}
+	if ps := rc.factory.ParameterStore; ps != nil {
+		key := rc.parameterStorePath + namespace + "/" + name
+		out, err := ps.GetParameterWithContext(opts.Ctx, &ssm.GetParameterInput{Name: aws.String(key), WithDecryption: aws.Bool(true)})
+		if err == nil {
+			return rc.parse(key, strconv.FormatInt(aws.Int64Value(out.Parameter.Version), 10), aws.StringValue(out.Parameter.Value))
+		}
+		if !isNotFound(err) {
+			return nil, errors.Wrapf(err, "reading ssm parameter %v", key)
+		}
+	}
+	return nil, errors.NewNotExistErr(namespace, name)
+}
+
+func (rc *resourceClient) Write(resource resources.Resource, opts clients.WriteOpts) (resources.Resource, error) {
+	return nil, ReadOnlyError
+}
+
+func (rc *resourceClient) Delete(namespace, name string, opts clients.DeleteOpts) error {
+	return ReadOnlyError
+}
+
+func (rc *resourceClient) List(namespace string, opts clients.ListOpts) (resources.ResourceList, error) {
+	list, skipped, err := rc.list(namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+	if skipped != nil {
+		contextutils.LoggerFrom(opts.WithDefaults().Ctx).Warnf("skipped aws secrets: %v", skipped)
+	}
+	return list, nil
+}
+
+// list returns the secrets that can be read and parsed, and the errors of the secrets that were skipped
+func (rc *resourceClient) list(namespace string, opts clients.ListOpts) (resources.ResourceList, error, error) {
+	opts = opts.WithDefaults()
+
+	var (
+		list    resources.ResourceList
+		skipped error
+	)
+	seen := map[string]bool{}
+	// the keys of every gloo secret in the stores, in any namespace
+	listed := map[string]bool{}
+	add := func(key, version string, fetch func() (string, error)) {
+		listed[key] = true
+		secretNamespace, secretName := secretRef(key)
+		ref := secretNamespace + "/" + secretName
+		// secrets manager takes precedence over the parameter store
+		if (namespace != "" && secretNamespace != namespace) || seen[ref] {
+			return
+		}
+		secret, err := rc.cached(key, version, fetch)
+		if err != nil {
+			// one bad value must not hide the other secrets
+			skipped = multierr.Append(skipped, err)
+			return
+		}
+		seen[ref] = true
+		list = append(list, secret)
+	}
+
+	if sm := rc.factory.SecretsManager; sm != nil {
+		var entries []*secretsmanager.SecretListEntry
+		err := sm.ListSecretsPagesWithContext(opts.Ctx, &secretsmanager.ListSecretsInput{}, func(page *secretsmanager.ListSecretsOutput, _ bool) bool {
+			entries = append(entries, page.SecretList...)
+			return true
+		})
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "listing secrets manager secrets")
+		}
+		for _, entry := range entries {
+			key := aws.StringValue(entry.Name)
+			if !rc.isGlooSecret(key, rc.secretsManagerPrefix) || entry.DeletedDate != nil {
+				continue
+			}
+			arn := entry.ARN
+			add(key, currentVersion(entry), func() (string, error) {
+				out, err := sm.GetSecretValueWithContext(opts.Ctx, &secretsmanager.GetSecretValueInput{SecretId: arn})
+				if err != nil {
+					return "", errors.Wrapf(err, "reading secrets manager secret %v", key)
+				}
+				return aws.StringValue(out.SecretString), nil
+			})
+		}
+	}
+
+	if ps := rc.factory.ParameterStore; ps != nil {
+		var parameters []*ssm.Parameter
+		err := ps.GetParametersByPathPagesWithContext(opts.Ctx, &ssm.GetParametersByPathInput{
+			Path:           aws.String(rc.parameterStorePath),
+			Recursive:      aws.Bool(true),
+			WithDecryption: aws.Bool(true),
+		}, func(page *ssm.GetParametersByPathOutput, _ bool) bool {
+			parameters = append(parameters, page.Parameters...)
+			return true
+		})
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "listing ssm parameters")
+		}
+		for _, parameter := range parameters {
+			key := aws.StringValue(parameter.Name)
+			if !rc.isGlooSecret(key, rc.parameterStorePath) {
+				continue
+			}
+			value := aws.StringValue(parameter.Value)
+			add(key, strconv.FormatInt(aws.Int64Value(parameter.Version), 10), func() (string, error) {
+				return value, nil
+			})
+		}
+	}
+
+	rc.prune(listed)
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].GetMetadata().Ref().Key() < list[j].GetMetadata().Ref().Key()
+	})
+	return list, skipped, nil
+}
+
+func (rc *resourceClient) Watch(namespace string, opts clients.WatchOpts) (<-chan resources.ResourceList, <-chan error, error) {
+	opts = opts.WithDefaults()
+
+	resourcesChan := make(chan resources.ResourceList)
+	errs := make(chan error)
+	go func() {
+		defer close(resourcesChan)
+		defer close(errs)
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+		for {
+			select {
+			case <-timer.C:
+				list, skipped, err := rc.list(namespace, clients.ListOpts{
+					Ctx:      opts.Ctx,
+					Selector: opts.Selector,
+				})
+				if err == nil {
+					select {
+					case resourcesChan <- list:
+					case <-opts.Ctx.Done():
+						return
+					}
+					err = skipped
+				}
+				if err != nil {
+					select {
+					case errs <- err:
+					case <-opts.Ctx.Done():
+						return
+					}
+				}
+				timer.Reset(opts.RefreshRate)
+			case <-opts.Ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return resourcesChan, errs, nil
+}
+
+func (rc *resourceClient) cached(key, version string, fetch func() (string, error)) (*v1.Secret, error) {
+	rc.lock.Lock()
+	cached, ok := rc.cache[key]
+	rc.lock.Unlock()
+	if ok && version != "" && cached.version == version {
+		return resources.Clone(cached.secret).(*v1.Secret), nil
+	}
+	value, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	secret, err := rc.parse(key, version, value)
+	if err != nil {
+		return nil, err
+	}
+	rc.lock.Lock()
+	rc.cache[key] = cachedSecret{version: version, secret: resources.Clone(secret).(*v1.Secret)}
+	rc.lock.Unlock()
+	return secret, nil
+}
+
+// prune drops the cached secrets that are no longer listed. Parameter versions start at 1 again when a
+// parameter is deleted and created again, so a stale entry could otherwise be served for a new value.
+func (rc *resourceClient) prune(listed map[string]bool) {
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+	for key := range rc.cache {
+		if !listed[key] {
+			delete(rc.cache, key)
+		}
+	}
+}
+
+// parse reads the gloo secret stored under key, which ends with <namespace>/<name>
+func (rc *resourceClient) parse(key, version, value string) (*v1.Secret, error) {
+	var secret v1.Secret
+	if err := protoutils.UnmarshalYAML([]byte(value), &secret); err != nil {
+		return nil, InvalidSecretValueError(key, err)
+	}
+	secret.Metadata.Namespace, secret.Metadata.Name = secretRef(key)
+	secret.Metadata.ResourceVersion = version
+	return &secret, nil
+}
+
+func (rc *resourceClient) isGlooSecret(key, prefix string) bool {
+	if !strings.HasPrefix(key, prefix) {
+		return false
+	}
+	parts := strings.Split(strings.TrimPrefix(key, prefix), "/")
+	return len(parts) == 2 && parts[0] != "" && parts[1] != ""
+}
+
+func secretRef(key string) (namespace, name string) {
+	parts := strings.Split(key, "/")
+	if len(parts) < 2 {
+		return "", key
+	}
+	return parts[len(parts)-2], parts[len(parts)-1]
+}
+
+func currentVersion(entry *secretsmanager.SecretListEntry) string {
+	for version, stages := range entry.SecretVersionsToStages {
+		for _, stage := range stages {
+			if aws.StringValue(stage) == currentVersionStage {
+				return version
+			}
+		}
+	}
+	return ""
+}
+
+func isNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch awsErr.Code() {
+		case secretsmanager.ErrCodeResourceNotFoundException, ssm.ErrCodeParameterNotFound:
+			return true
+		}
+	}
+	return false
+}
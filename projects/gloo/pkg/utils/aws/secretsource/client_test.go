@@ -0,0 +1,295 @@
+package secretsource_test
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/utils/aws/secretsource"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+type fakeSecretsManager struct {
+	secretsmanageriface.SecretsManagerAPI
+	// secret name to value, versioned by the length of the value
+	secrets map[string]string
+	reads   int
+}
+
+func (f *fakeSecretsManager) ListSecretsPagesWithContext(_ aws.Context, _ *secretsmanager.ListSecretsInput, fn func(*secretsmanager.ListSecretsOutput, bool) bool, _ ...request.Option) error {
+	page := &secretsmanager.ListSecretsOutput{}
+	for name, value := range f.secrets {
+		page.SecretList = append(page.SecretList, &secretsmanager.SecretListEntry{
+			ARN:                    aws.String("arn:" + name),
+			Name:                   aws.String(name),
+			SecretVersionsToStages: map[string][]*string{strconv.Itoa(len(value)): {aws.String("AWSCURRENT")}},
+		})
+	}
+	fn(page, true)
+	return nil
+}
+
+func (f *fakeSecretsManager) GetSecretValueWithContext(_ aws.Context, input *secretsmanager.GetSecretValueInput, _ ...request.Option) (*secretsmanager.GetSecretValueOutput, error) {
+	f.reads++
+	name := aws.StringValue(input.SecretId)
+	if len(name) > 4 && name[:4] == "arn:" {
+		name = name[4:]
+	}
+	value, ok := f.secrets[name]
+	if !ok {
+		return nil, awserr.New(secretsmanager.ErrCodeResourceNotFoundException, "not found", nil)
+	}
+	return &secretsmanager.GetSecretValueOutput{
+		Name:         aws.String(name),
+		SecretString: aws.String(value),
+		VersionId:    aws.String(strconv.Itoa(len(value))),
+	}, nil
+}
+
+type fakeParameterStore struct {
+	ssmiface.SSMAPI
+	// guards parameters, which are polled concurrently
+	lock       sync.Mutex
+	parameters map[string]string
+}
+
+func (f *fakeParameterStore) GetParametersByPathPagesWithContext(_ aws.Context, input *ssm.GetParametersByPathInput, fn func(*ssm.GetParametersByPathOutput, bool) bool, _ ...request.Option) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	page := &ssm.GetParametersByPathOutput{}
+	for name, value := range f.parameters {
+		page.Parameters = append(page.Parameters, &ssm.Parameter{Name: aws.String(name), Value: aws.String(value), Version: aws.Int64(1)})
+	}
+	fn(page, true)
+	return nil
+}
+
+func (f *fakeParameterStore) GetParameterWithContext(_ aws.Context, input *ssm.GetParameterInput, _ ...request.Option) (*ssm.GetParameterOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	value, ok := f.parameters[aws.StringValue(input.Name)]
+	if !ok {
+		return nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil)
+	}
+	return &ssm.GetParameterOutput{Parameter: &ssm.Parameter{Name: input.Name, Value: aws.String(value), Version: aws.Int64(1)}}, nil
+}
+
+var _ = Describe("AWS secret source", func() {
+
+	var (
+		secretsManager *fakeSecretsManager
+		parameterStore *fakeParameterStore
+		secretClient   v1.SecretClient
+	)
+
+	BeforeEach(func() {
+		secretsManager = &fakeSecretsManager{secrets: map[string]string{
+			"gloo/default/tls":   `{"tls": {"certChain": "cert", "privateKey": "key"}}`,
+			"gloo/other/aws":     "aws:\n  accessKey: foo\n  secretKey: bar\n",
+			"unrelated/secret":   "not a gloo secret",
+			"gloo/too/many/dirs": "ignored",
+		}}
+		parameterStore = &fakeParameterStore{parameters: map[string]string{
+			"/gloo/default/apikey": `{"apiKey": {"apiKey": "secret"}}`,
+			"/gloo/default/tls":    `{"tls": {"certChain": "shadowed"}}`,
+		}}
+		var err error
+		secretClient, err = v1.NewSecretClient(&ClientFactory{
+			SecretsManager:       secretsManager,
+			SecretsManagerPrefix: DefaultSecretsManagerPrefix,
+			ParameterStore:       parameterStore,
+			ParameterStorePath:   "/gloo/",
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("lists the gloo secrets of both stores", func() {
+		list, err := secretClient.List("", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(HaveLen(3))
+		Expect(list[0].GetMetadata().Ref().Key()).To(Equal("default.apikey"))
+		Expect(list[0].GetApiKey().GetApiKey()).To(Equal("secret"))
+		Expect(list[1].GetMetadata().Ref().Key()).To(Equal("default.tls"))
+		Expect(list[1].GetTls().GetCertChain()).To(Equal("cert"))
+		Expect(list[2].GetAws().GetAccessKey()).To(Equal("foo"))
+
+		list, err = secretClient.List("other", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(HaveLen(1))
+	})
+
+	It("only fetches secrets again when their version changes", func() {
+		_, err := secretClient.List("default", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secretsManager.reads).To(Equal(1))
+
+		_, err = secretClient.List("default", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secretsManager.reads).To(Equal(1))
+
+		secretsManager.secrets["gloo/default/tls"] = `{"tls": {"certChain": "rotated", "privateKey": "key"}}`
+		list, err := secretClient.List("default", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secretsManager.reads).To(Equal(2))
+		Expect(list[1].GetTls().GetCertChain()).To(Equal("rotated"))
+	})
+
+	It("reads secrets by name", func() {
+		secret, err := secretClient.Read("default", "tls", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secret.GetTls().GetCertChain()).To(Equal("cert"))
+
+		secret, err = secretClient.Read("default", "apikey", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secret.GetApiKey().GetApiKey()).To(Equal("secret"))
+
+		_, err = secretClient.Read("default", "missing", clients.ReadOpts{})
+		Expect(errors.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("reads secrets under prefixes that do not end with a slash", func() {
+		var err error
+		secretClient, err = v1.NewSecretClient(&ClientFactory{
+			SecretsManager:       secretsManager,
+			SecretsManagerPrefix: "gloo",
+			ParameterStore:       parameterStore,
+			ParameterStorePath:   "/gloo",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		list, err := secretClient.List("default", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(HaveLen(2))
+
+		secret, err := secretClient.Read("default", "tls", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secret.GetTls().GetCertChain()).To(Equal("cert"))
+		secret, err = secretClient.Read("default", "apikey", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secret.GetApiKey().GetApiKey()).To(Equal("secret"))
+	})
+
+	It("does not serve a deleted secret that is created again with the same version", func() {
+		list, err := secretClient.List("default", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list[0].GetApiKey().GetApiKey()).To(Equal("secret"))
+
+		delete(parameterStore.parameters, "/gloo/default/apikey")
+		list, err = secretClient.List("default", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(HaveLen(1))
+
+		// parameters start at version 1 again
+		parameterStore.parameters["/gloo/default/apikey"] = `{"apiKey": {"apiKey": "recreated"}}`
+		list, err = secretClient.List("default", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list[0].GetApiKey().GetApiKey()).To(Equal("recreated"))
+	})
+
+	It("polls for changes", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		lists, errs, err := secretClient.Watch("default", clients.WatchOpts{Ctx: ctx, RefreshRate: 10 * time.Millisecond})
+		Expect(err).NotTo(HaveOccurred())
+
+		var list v1.SecretList
+		Eventually(lists).Should(Receive(&list))
+		Expect(list).To(HaveLen(2))
+
+		parameterStore.lock.Lock()
+		delete(parameterStore.parameters, "/gloo/default/apikey")
+		parameterStore.lock.Unlock()
+		Eventually(func() v1.SecretList {
+			Eventually(lists).Should(Receive(&list))
+			return list
+		}).Should(HaveLen(1))
+		Consistently(errs).ShouldNot(Receive())
+	})
+
+	Context("with a secret that cannot be parsed", func() {
+		BeforeEach(func() {
+			secretsManager.secrets["gloo/default/broken"] = "tls: [not a secret"
+		})
+
+		It("lists the other secrets", func() {
+			list, err := secretClient.List("default", clients.ListOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list).To(HaveLen(2))
+		})
+
+		It("watches the other secrets and reports the error", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			lists, errs, err := secretClient.Watch("default", clients.WatchOpts{Ctx: ctx, RefreshRate: time.Hour})
+			Expect(err).NotTo(HaveOccurred())
+
+			var list v1.SecretList
+			Eventually(lists).Should(Receive(&list))
+			Expect(list).To(HaveLen(2))
+			var watchErr error
+			Eventually(errs).Should(Receive(&watchErr))
+			Expect(watchErr.Error()).To(ContainSubstring("gloo/default/broken"))
+		})
+	})
+
+	It("is read-only", func() {
+		_, err := secretClient.Write(&v1.Secret{}, clients.WriteOpts{})
+		Expect(err).To(MatchError(ReadOnlyError))
+		Expect(secretClient.Delete("default", "tls", clients.DeleteOpts{})).To(MatchError(ReadOnlyError))
+	})
+
+	Context("with localstack", func() {
+		// e.g. docker run -p 4566:4566 localstack/localstack
+		//   LOCALSTACK_ENDPOINT=http://localhost:4566 go test ./projects/gloo/pkg/utils/aws/secretsource/...
+		It("reads secrets manager secrets and ssm parameters", func() {
+			endpoint := os.Getenv("LOCALSTACK_ENDPOINT")
+			if endpoint == "" {
+				Skip("LOCALSTACK_ENDPOINT is not set")
+			}
+			os.Setenv("AWS_ACCESS_KEY_ID", "test")
+			os.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+			settings := &v1.Settings_AwsSecrets{
+				Region:             "us-east-1",
+				Endpoint:           endpoint,
+				ParameterStorePath: "/gloo/",
+			}
+			f, err := NewClientFactory(settings)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = f.SecretsManager.CreateSecret(&secretsmanager.CreateSecretInput{
+				Name:         aws.String("gloo/localstack/tls"),
+				SecretString: aws.String(`{"tls": {"certChain": "cert"}}`),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			defer f.SecretsManager.DeleteSecret(&secretsmanager.DeleteSecretInput{SecretId: aws.String("gloo/localstack/tls"), ForceDeleteWithoutRecovery: aws.Bool(true)})
+			_, err = f.ParameterStore.PutParameter(&ssm.PutParameterInput{
+				Name:      aws.String("/gloo/localstack/apikey"),
+				Type:      aws.String(ssm.ParameterTypeSecureString),
+				Value:     aws.String(`{"apiKey": {"apiKey": "secret"}}`),
+				Overwrite: aws.Bool(true),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			defer f.ParameterStore.DeleteParameter(&ssm.DeleteParameterInput{Name: aws.String("/gloo/localstack/apikey")})
+
+			secretClient, err := v1.NewSecretClient(f)
+			Expect(err).NotTo(HaveOccurred())
+			list, err := secretClient.List("localstack", clients.ListOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list).To(HaveLen(2))
+			Expect(list[0].GetApiKey().GetApiKey()).To(Equal("secret"))
+			Expect(list[1].GetTls().GetCertChain()).To(Equal("cert"))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package secretsource_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestSecretSource(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "AWS Secret Source Suite")
+}
@@ -0,0 +1,153 @@
+package utils
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+const DefaultCertificateExpiryWarningWindow = 30 * 24 * time.Hour
+
+var (
+	NoCertificateInChainError = eris.New("no PEM encoded certificate found in the certificate chain")
+
+	mCertificateExpiryDays = stats.Float64("gloo.solo.io/ssl/certificate_expiry_days", "The number of days until the certificate of a TLS secret expires", "d")
+	mCertificateChainValid = stats.Int64("gloo.solo.io/ssl/certificate_chain_valid", "Whether the certificate chain of a TLS secret is valid (1) or not (0)", "1")
+
+	secretKey, _ = tag.NewKey("secret")
+	sniKey, _    = tag.NewKey("sni")
+	issuerKey, _ = tag.NewKey("issuer")
+
+	certificateExpiryDaysView = &view.View{
+		Name:        "gloo.solo.io/ssl/certificate_expiry_days",
+		Measure:     mCertificateExpiryDays,
+		Description: "The number of days until the certificate of a TLS secret expires",
+		Aggregation: view.LastValue(),
+		TagKeys:     []tag.Key{secretKey, sniKey, issuerKey},
+	}
+	certificateChainValidView = &view.View{
+		Name:        "gloo.solo.io/ssl/certificate_chain_valid",
+		Measure:     mCertificateChainValid,
+		Description: "Whether the certificate chain of a TLS secret is valid (1) or not (0)",
+		Aggregation: view.LastValue(),
+		TagKeys:     []tag.Key{secretKey, sniKey, issuerKey},
+	}
+)
+
+func init() {
+	_ = view.Register(certificateExpiryDaysView, certificateChainValidView)
+}
+
+// CertificateInfo describes the leaf certificate of a certificate chain
+type CertificateInfo struct {
+	NotAfter time.Time
+	Issuer   string
+	DNSNames []string
+	// every certificate in the chain is currently valid and signed by the certificate following it
+	ValidChain bool
+}
+
+// the time remaining until the certificate expires, negative if it already has
+func (c *CertificateInfo) TimeUntilExpiry() time.Duration {
+	return time.Until(c.NotAfter)
+}
+
+// ParseCertificateChain parses a PEM encoded certificate chain, leaf certificate first
+func ParseCertificateChain(certChain string) (*CertificateInfo, error) {
+	var certs []*x509.Certificate
+	rest := []byte(certChain)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, eris.Wrapf(err, "parsing certificate chain")
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, NoCertificateInChainError
+	}
+
+	now := time.Now()
+	validChain := true
+	for i, cert := range certs {
+		if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+			validChain = false
+		}
+		if i+1 < len(certs) && cert.CheckSignatureFrom(certs[i+1]) != nil {
+			validChain = false
+		}
+	}
+
+	return &CertificateInfo{
+		NotAfter:   certs[0].NotAfter,
+		Issuer:     certs[0].Issuer.String(),
+		DNSNames:   certs[0].DNSNames,
+		ValidChain: validChain,
+	}, nil
+}
+
+// GetCertificateInfo parses the certificate chain of the referenced TLS secret
+func GetCertificateInfo(secrets v1.SecretList, ref core.ResourceRef) (*CertificateInfo, error) {
+	tlsSecret, err := getSslSecrets(ref, secrets)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCertificateChain(tlsSecret.CertChain)
+}
+
+// CertificateExpiryWarningWindow returns how long before expiry certificates should be warned about.
+// A zero window disables the warnings.
+func CertificateExpiryWarningWindow(settings *v1.Settings) time.Duration {
+	window := settings.GetGloo().GetCertificateExpiryWarningWindow()
+	if window == nil {
+		return DefaultCertificateExpiryWarningWindow
+	}
+	duration, err := types.DurationFromProto(window)
+	if err != nil {
+		return DefaultCertificateExpiryWarningWindow
+	}
+	return duration
+}
+
+// records the certificate metrics of a TLS secret resolved for the given sni domains.
+// secrets that cannot be parsed are skipped, as they are reported by translation.
+func recordCertificateMetrics(secrets v1.SecretList, ref core.ResourceRef, sniDomains []string) {
+	info, err := GetCertificateInfo(secrets, ref)
+	if err != nil {
+		return
+	}
+	chainValid := int64(0)
+	if info.ValidChain {
+		chainValid = 1
+	}
+	ctx, err := tag.New(context.Background(),
+		tag.Upsert(secretKey, ref.Key()),
+		tag.Upsert(sniKey, strings.Join(sniDomains, ",")),
+		tag.Upsert(issuerKey, info.Issuer),
+	)
+	if err != nil {
+		return
+	}
+	stats.Record(ctx,
+		mCertificateExpiryDays.M(info.TimeUntilExpiry().Hours()/24),
+		mCertificateChainValid.M(chainValid),
+	)
+}
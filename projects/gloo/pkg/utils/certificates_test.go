@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/test/helpers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Certificates", func() {
+
+	getCert := func(validFrom time.Time, validFor time.Duration) string {
+		cert, _ := helpers.GetCerts(helpers.Params{
+			Hosts:      "gloo.solo.io",
+			ValidFrom:  &validFrom,
+			ValidFor:   &validFor,
+			EcdsaCurve: "P256",
+		})
+		return cert
+	}
+
+	It("parses the expiry and issuer of the leaf certificate", func() {
+		validFrom := time.Now().Add(-time.Hour)
+		info, err := ParseCertificateChain(getCert(validFrom, 48*time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.NotAfter).To(BeTemporally("~", validFrom.Add(48*time.Hour), time.Second))
+		Expect(info.Issuer).To(ContainSubstring("Acme Co"))
+		Expect(info.DNSNames).To(ConsistOf("gloo.solo.io"))
+		Expect(info.ValidChain).To(BeTrue())
+		Expect(info.TimeUntilExpiry()).To(BeNumerically(">", 46*time.Hour))
+	})
+
+	It("reports expired certificates as an invalid chain", func() {
+		info, err := ParseCertificateChain(getCert(time.Now().Add(-48*time.Hour), 24*time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.ValidChain).To(BeFalse())
+		Expect(info.TimeUntilExpiry()).To(BeNumerically("<", 0))
+	})
+
+	It("reports certificates not signed by the next one in the chain as an invalid chain", func() {
+		validFrom := time.Now().Add(-time.Hour)
+		info, err := ParseCertificateChain(getCert(validFrom, 48*time.Hour) + getCert(validFrom, 48*time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.ValidChain).To(BeFalse())
+	})
+
+	It("errors without a certificate", func() {
+		_, err := ParseCertificateChain("tlscert")
+		Expect(err).To(MatchError(NoCertificateInChainError))
+	})
+
+	It("parses the certificate of a tls secret", func() {
+		secret := &v1.Secret{
+			Kind: &v1.Secret_Tls{
+				Tls: &v1.TlsSecret{CertChain: getCert(time.Now(), time.Hour)},
+			},
+			Metadata: core.Metadata{Name: "secret", Namespace: "ns"},
+		}
+		info, err := GetCertificateInfo(v1.SecretList{secret}, secret.Metadata.Ref())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.ValidChain).To(BeTrue())
+	})
+
+	It("uses the configured expiry warning window", func() {
+		Expect(CertificateExpiryWarningWindow(nil)).To(Equal(DefaultCertificateExpiryWarningWindow))
+		settings := &v1.Settings{
+			Gloo: &v1.GlooOptions{CertificateExpiryWarningWindow: types.DurationProto(time.Hour)},
+		}
+		Expect(CertificateExpiryWarningWindow(settings)).To(Equal(time.Hour))
+	})
+})
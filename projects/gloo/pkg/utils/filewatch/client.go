@@ -0,0 +1,531 @@
+package filewatch
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/ghodss/yaml"
+	"github.com/hashicorp/go-multierror"
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/file"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	skerrors "github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// DefaultDebounce is how long a watch waits for the filesystem to settle
+// before it emits a new snapshot.
+const DefaultDebounce = 100 * time.Millisecond
+
+var documentSeparator = regexp.MustCompile(`(?m)^---\s*$`)
+
+var (
+	MalformedFileError = func(path string, err error) error {
+		return errors.Wrapf(err, "malformed resource file %v", path)
+	}
+)
+
+// ClientFactory creates resource clients that store resources in YAML files
+// under RootDir/<namespace>, like the solo-kit file clients, but watch the
+// directory with filesystem notifications instead of polling it.
+// A file may hold several resources separated by `---`.
+type ClientFactory struct {
+	RootDir string
+	// defaults to DefaultDebounce
+	Debounce time.Duration
+}
+
+func (f *ClientFactory) NewResourceClient(params factory.NewResourceClientParams) (clients.ResourceClient, error) {
+	return NewResourceClient(f.RootDir, params.ResourceType, f.Debounce), nil
+}
+
+type ResourceClient struct {
+	*file.ResourceClient
+	dir          string
+	resourceType resources.Resource
+	debounce     time.Duration
+}
+
+var _ clients.ResourceClient = &ResourceClient{}
+
+func NewResourceClient(dir string, resourceType resources.Resource, debounce time.Duration) *ResourceClient {
+	if debounce <= 0 {
+		debounce = DefaultDebounce
+	}
+	return &ResourceClient{
+		ResourceClient: file.NewResourceClient(dir, resourceType),
+		dir:            dir,
+		resourceType:   resourceType,
+		debounce:       debounce,
+	}
+}
+
+// Read reads the resource from the file that holds it. The file named after
+// the resource is tried first; the other files of the namespace are only
+// read if it does not hold the resource.
+func (rc *ResourceClient) Read(namespace, name string, opts clients.ReadOpts) (resources.Resource, error) {
+	if err := resources.ValidateName(name); err != nil {
+		return nil, errors.Wrapf(err, "validation error")
+	}
+	found, err := rc.find(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return resources.Clone(found.list[found.index]), nil
+}
+
+// Write writes the resource back to the file that holds it, keeping the other
+// resources of that file. New resources are written to <namespace>/<name>.yaml.
+func (rc *ResourceClient) Write(resource resources.Resource, opts clients.WriteOpts) (resources.Resource, error) {
+	if err := resources.Validate(resource); err != nil {
+		return nil, errors.Wrapf(err, "validation error")
+	}
+	meta := resource.GetMetadata()
+
+	found, err := rc.find(meta.Namespace, meta.Name)
+	switch {
+	case err == nil:
+		original := found.list[found.index]
+		if !opts.OverwriteExisting {
+			return nil, skerrors.NewExistErr(meta)
+		}
+		if meta.ResourceVersion != original.GetMetadata().ResourceVersion {
+			return nil, skerrors.NewResourceVersionErr(meta.Namespace, meta.Name, meta.ResourceVersion, original.GetMetadata().ResourceVersion)
+		}
+	case skerrors.IsNotExist(err):
+		found = &resourceFile{path: rc.filename(meta.Namespace, meta.Name), index: -1}
+	default:
+		return nil, err
+	}
+
+	clone := resources.Clone(resource)
+	meta.ResourceVersion = newOrIncrementResourceVer(meta.ResourceVersion)
+	clone.SetMetadata(meta)
+
+	list := append(resources.ResourceList{}, found.list...)
+	if found.index < 0 {
+		list = append(list, clone)
+	} else {
+		list[found.index] = clone
+	}
+	if err := os.MkdirAll(filepath.Dir(found.path), 0755); err != nil {
+		return nil, errors.Wrapf(err, "creating directory for %v", found.path)
+	}
+	if err := writeFile(found.path, list); err != nil {
+		return nil, errors.Wrapf(err, "writing %v", found.path)
+	}
+	return resources.Clone(clone), nil
+}
+
+// Delete removes the resource from the file that holds it, and removes the
+// file once it holds no resources.
+func (rc *ResourceClient) Delete(namespace, name string, opts clients.DeleteOpts) error {
+	found, err := rc.find(namespace, name)
+	if err != nil {
+		if skerrors.IsNotExist(err) && opts.IgnoreNotExist {
+			return nil
+		}
+		return err
+	}
+	var list resources.ResourceList
+	list = append(list, found.list[:found.index]...)
+	list = append(list, found.list[found.index+1:]...)
+	if len(list) == 0 {
+		if err := os.Remove(found.path); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "deleting %v", found.path)
+		}
+		return nil
+	}
+	if err := writeFile(found.path, list); err != nil {
+		return errors.Wrapf(err, "writing %v", found.path)
+	}
+	return nil
+}
+
+// List lists the resources of every file that can be read. Malformed files are
+// skipped and logged, so that one bad file does not hide the other resources.
+func (rc *ResourceClient) List(namespace string, opts clients.ListOpts) (resources.ResourceList, error) {
+	opts = opts.WithDefaults()
+	snap := newSnapshot(rc, rc.watchRoot(namespace), namespace == "")
+	fileErrs, err := snap.rescan()
+	if err != nil {
+		return nil, err
+	}
+	for _, err := range fileErrs {
+		contextutils.LoggerFrom(opts.Ctx).Warnf("listing resources in namespace %v: %v", namespace, err)
+	}
+	return snap.list(opts.Selector), nil
+}
+
+func (rc *ResourceClient) Watch(namespace string, opts clients.WatchOpts) (<-chan resources.ResourceList, <-chan error, error) {
+	opts = opts.WithDefaults()
+	root := rc.watchRoot(namespace)
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, nil, errors.Wrapf(err, "creating directory %v", root)
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "creating file watcher")
+	}
+	snap := newSnapshot(rc, root, namespace == "")
+	if err := snap.watchDirs(watcher); err != nil {
+		watcher.Close()
+		return nil, nil, err
+	}
+
+	lists := make(chan resources.ResourceList)
+	errs := make(chan error)
+	send := func() bool {
+		select {
+		case lists <- snap.list(opts.Selector):
+			return true
+		case <-opts.Ctx.Done():
+			return false
+		}
+	}
+	report := func(errList []error) bool {
+		for _, err := range errList {
+			select {
+			case errs <- err:
+			case <-opts.Ctx.Done():
+				return false
+			}
+		}
+		return true
+	}
+
+	go func() {
+		defer close(lists)
+		defer close(errs)
+		defer watcher.Close()
+
+		if !report(snap.rescanAll()) || !send() {
+			return
+		}
+
+		// changes are collected until the directory has been quiet for the
+		// debounce period, then applied together
+		var (
+			settled  <-chan time.Time
+			dirty    = map[string]struct{}{}
+			resync   bool
+			newDirty = func() { settled = time.After(rc.debounce) }
+		)
+		for {
+			select {
+			case <-opts.Ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+					// a new namespace directory, or one that was replaced
+					resync = true
+				}
+				dirty[event.Name] = struct{}{}
+				newDirty()
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				// events may have been dropped, so read everything again
+				if !report([]error{errors.Wrapf(err, "watching directory %v", root)}) {
+					return
+				}
+				resync = true
+				newDirty()
+			case <-settled:
+				settled = nil
+				var changed bool
+				var errList []error
+				if resync {
+					if err := snap.watchDirs(watcher); err != nil {
+						errList = append(errList, err)
+					}
+					errList = append(errList, snap.rescanAll()...)
+					changed = true
+				} else {
+					for path := range dirty {
+						updated, err := snap.update(path)
+						if err != nil {
+							errList = append(errList, err)
+						}
+						changed = changed || updated
+					}
+				}
+				dirty = map[string]struct{}{}
+				resync = false
+				if !report(errList) {
+					return
+				}
+				if changed && !send() {
+					return
+				}
+			}
+		}
+	}()
+
+	return lists, errs, nil
+}
+
+func (rc *ResourceClient) watchRoot(namespace string) string {
+	return filepath.Join(rc.dir, namespace)
+}
+
+// readFile reads every resource in the file at path. The file is only
+// accepted as a whole: if any of its documents is malformed, an error is
+// returned and none of its resources are.
+func (rc *ResourceClient) readFile(path string) (resources.ResourceList, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var list resources.ResourceList
+	for _, doc := range documentSeparator.Split(string(data), -1) {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+		jsn, err := yaml.YAMLToJSON([]byte(doc))
+		if err != nil {
+			return nil, MalformedFileError(path, err)
+		}
+		if string(jsn) == "null" {
+			continue
+		}
+		resource := resources.Clone(rc.resourceType)
+		if err := protoutils.UnmarshalBytes(jsn, resource); err != nil {
+			return nil, MalformedFileError(path, err)
+		}
+		list = append(list, resource)
+	}
+	return list, nil
+}
+
+// resourceFile is a file holding a resource, with every resource it holds
+type resourceFile struct {
+	path  string
+	list  resources.ResourceList
+	index int
+}
+
+// find returns the file holding the resource. Files that cannot be read are
+// skipped, unless none of the others holds the resource.
+func (rc *ResourceClient) find(namespace, name string) (*resourceFile, error) {
+	dir := rc.watchRoot(namespace)
+	candidates := []string{rc.filename(namespace, name), filepath.Join(dir, name+".yml")}
+	if entries, err := ioutil.ReadDir(dir); err == nil {
+		for _, entry := range entries {
+			path := filepath.Join(dir, entry.Name())
+			if !entry.IsDir() && isResourceFile(path) && path != candidates[0] && path != candidates[1] {
+				candidates = append(candidates, path)
+			}
+		}
+	}
+
+	var errList error
+	for _, path := range candidates {
+		list, err := rc.readFile(path)
+		switch {
+		case os.IsNotExist(err):
+			continue
+		case err != nil:
+			errList = multierror.Append(errList, errors.Wrapf(err, "reading %v", path))
+			continue
+		}
+		for i, resource := range list {
+			meta := resource.GetMetadata()
+			if meta.Namespace == namespace && meta.Name == name {
+				return &resourceFile{path: path, list: list, index: i}, nil
+			}
+		}
+	}
+	if errList == nil {
+		errList = errors.Errorf("no resource file in %v holds %v", dir, name)
+	}
+	return nil, skerrors.NewNotExistErr(namespace, name, errList)
+}
+
+func (rc *ResourceClient) filename(namespace, name string) string {
+	return filepath.Join(rc.dir, namespace, name) + ".yaml"
+}
+
+// writeFile writes the resources to the file at path as YAML documents
+// separated by `---`
+func writeFile(path string, list resources.ResourceList) error {
+	var docs []string
+	for _, resource := range list {
+		jsn, err := protoutils.MarshalBytes(resource)
+		if err != nil {
+			return err
+		}
+		data, err := yaml.JSONToYAML(jsn)
+		if err != nil {
+			return err
+		}
+		docs = append(docs, string(data))
+	}
+	return ioutil.WriteFile(path, []byte(strings.Join(docs, "---\n")), 0644)
+}
+
+func newOrIncrementResourceVer(resourceVersion string) string {
+	curr, err := strconv.Atoi(resourceVersion)
+	if err != nil {
+		curr = 1
+	}
+	return fmt.Sprintf("%v", curr+1)
+}
+
+func isResourceFile(path string) bool {
+	return strings.HasSuffix(path, ".yaml") || strings.HasSuffix(path, ".yml")
+}
+
+// snapshot holds the resources last read from each file under root.
+// When allNamespaces is set, root holds one directory per namespace,
+// otherwise it is the directory for a single namespace.
+type snapshot struct {
+	rc            *ResourceClient
+	root          string
+	allNamespaces bool
+	files         map[string]resources.ResourceList
+}
+
+func newSnapshot(rc *ResourceClient, root string, allNamespaces bool) *snapshot {
+	return &snapshot{
+		rc:            rc,
+		root:          root,
+		allNamespaces: allNamespaces,
+		files:         map[string]resources.ResourceList{},
+	}
+}
+
+func (s *snapshot) dirs() ([]string, error) {
+	if !s.allNamespaces {
+		return []string{s.root}, nil
+	}
+	dirs := []string{s.root}
+	entries, err := ioutil.ReadDir(s.root)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading namespace dir")
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			dirs = append(dirs, filepath.Join(s.root, entry.Name()))
+		}
+	}
+	return dirs, nil
+}
+
+func (s *snapshot) watchDirs(watcher *fsnotify.Watcher) error {
+	dirs, err := s.dirs()
+	if err != nil {
+		return err
+	}
+	for _, dir := range dirs {
+		if err := watcher.Add(dir); err != nil {
+			return errors.Wrapf(err, "watching directory %v", dir)
+		}
+	}
+	return nil
+}
+
+// holdsResources returns whether path is a resource file for this snapshot
+func (s *snapshot) holdsResources(path string) bool {
+	if !isResourceFile(path) {
+		return false
+	}
+	dir := filepath.Dir(path)
+	if s.allNamespaces {
+		return filepath.Dir(dir) == s.root
+	}
+	return dir == s.root
+}
+
+// rescan reads every file again. Files that cannot be read keep the
+// resources last read from them, and their errors are returned in fileErrs.
+// err is only returned if the root directory cannot be read.
+func (s *snapshot) rescan() (fileErrs []error, err error) {
+	dirs, err := s.dirs()
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]struct{}{}
+	var errList []error
+	for _, dir := range dirs {
+		entries, err := ioutil.ReadDir(dir)
+		if err != nil {
+			err = errors.Wrapf(err, "reading namespace dir")
+			if dir == s.root {
+				return nil, err
+			}
+			errList = append(errList, err)
+			continue
+		}
+		for _, entry := range entries {
+			path := filepath.Join(dir, entry.Name())
+			if entry.IsDir() || !s.holdsResources(path) {
+				continue
+			}
+			seen[path] = struct{}{}
+			if _, err := s.update(path); err != nil {
+				errList = append(errList, err)
+			}
+		}
+	}
+	for path := range s.files {
+		if _, ok := seen[path]; !ok {
+			delete(s.files, path)
+		}
+	}
+	return errList, nil
+}
+
+// rescanAll is rescan, with every error in one list
+func (s *snapshot) rescanAll() []error {
+	fileErrs, err := s.rescan()
+	if err != nil {
+		return append(fileErrs, err)
+	}
+	return fileErrs
+}
+
+// update reads the file at path again, and returns whether the snapshot changed
+func (s *snapshot) update(path string) (bool, error) {
+	if !s.holdsResources(path) {
+		return false, nil
+	}
+	list, err := s.rc.readFile(path)
+	switch {
+	case os.IsNotExist(err):
+		_, existed := s.files[path]
+		delete(s.files, path)
+		return existed, nil
+	case err != nil:
+		return false, errors.Wrapf(err, "reading %v", path)
+	}
+	s.files[path] = list
+	return true, nil
+}
+
+func (s *snapshot) list(selector map[string]string) resources.ResourceList {
+	var list resources.ResourceList
+	for _, fileResources := range s.files {
+		for _, resource := range fileResources {
+			if labels.SelectorFromSet(selector).Matches(labels.Set(resource.GetMetadata().Labels)) {
+				list = append(list, resource)
+			}
+		}
+	}
+	return list.Sort()
+}
@@ -0,0 +1,182 @@
+package filewatch_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/utils/filewatch"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("ResourceClient", func() {
+
+	var (
+		dir    string
+		client *ResourceClient
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	writeFile := func(namespace, name, content string) {
+		Expect(os.MkdirAll(filepath.Join(dir, namespace), 0755)).NotTo(HaveOccurred())
+		Expect(ioutil.WriteFile(filepath.Join(dir, namespace, name), []byte(content), 0644)).NotTo(HaveOccurred())
+	}
+
+	names := func(list resources.ResourceList) []string {
+		var names []string
+		for _, resource := range list {
+			names = append(names, resource.GetMetadata().Namespace+"."+resource.GetMetadata().Name)
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "filewatch")
+		Expect(err).NotTo(HaveOccurred())
+		client = NewResourceClient(dir, &v1.Upstream{}, 50*time.Millisecond)
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+
+	AfterEach(func() {
+		cancel()
+		_ = os.RemoveAll(dir)
+	})
+
+	It("reads several resources from one file", func() {
+		writeFile("default", "upstreams.yaml", `
+metadata:
+  name: a
+  namespace: default
+---
+metadata:
+  name: b
+  namespace: default
+`)
+		list, err := client.List("default", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(names(list)).To(Equal([]string{"default.a", "default.b"}))
+
+		us, err := client.Read("default", "b", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(us.GetMetadata().Name).To(Equal("b"))
+	})
+
+	It("reads resources written by the client", func() {
+		_, err := client.Write(&v1.Upstream{Metadata: core.Metadata{Name: "a", Namespace: "default"}}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		us, err := client.Read("default", "a", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(us.GetMetadata().Name).To(Equal("a"))
+	})
+
+	It("writes resources back to the file that holds them", func() {
+		writeFile("default", "upstreams.yaml", "metadata: {name: a, namespace: default}\n---\nmetadata: {name: b, namespace: default}")
+		us, err := client.Read("default", "b", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		us.(*v1.Upstream).Status = core.Status{State: core.Status_Accepted}
+		_, err = client.Write(us, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = os.Stat(filepath.Join(dir, "default", "b.yaml"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+		list, err := client.List("default", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(names(list)).To(Equal([]string{"default.a", "default.b"}))
+		Expect(list[1].(*v1.Upstream).Status.State).To(Equal(core.Status_Accepted))
+	})
+
+	It("deletes resources from the file that holds them", func() {
+		writeFile("default", "upstreams.yaml", "metadata: {name: a, namespace: default}\n---\nmetadata: {name: b, namespace: default}")
+		Expect(client.Delete("default", "a", clients.DeleteOpts{})).NotTo(HaveOccurred())
+		list, err := client.List("default", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(names(list)).To(Equal([]string{"default.b"}))
+
+		Expect(client.Delete("default", "b", clients.DeleteOpts{})).NotTo(HaveOccurred())
+		_, err = os.Stat(filepath.Join(dir, "default", "upstreams.yaml"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+
+		Expect(client.Delete("default", "b", clients.DeleteOpts{})).To(HaveOccurred())
+		Expect(client.Delete("default", "b", clients.DeleteOpts{IgnoreNotExist: true})).NotTo(HaveOccurred())
+	})
+
+	It("skips malformed files", func() {
+		writeFile("default", "a.yaml", "metadata: {name: a, namespace: default}")
+		writeFile("default", "b.yaml", "metadata: [")
+		list, err := client.List("default", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(names(list)).To(Equal([]string{"default.a"}))
+
+		us, err := client.Read("default", "a", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(us.GetMetadata().Name).To(Equal("a"))
+	})
+
+	Context("watching", func() {
+
+		var (
+			lists <-chan resources.ResourceList
+			errs  <-chan error
+		)
+
+		latest := func() []string {
+			var last resources.ResourceList
+			Eventually(lists, "5s").Should(Receive(&last))
+			return names(last)
+		}
+
+		BeforeEach(func() {
+			writeFile("default", "a.yaml", "metadata: {name: a, namespace: default}")
+			var err error
+			lists, errs, err = client.Watch("", clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(latest()).To(Equal([]string{"default.a"}))
+		})
+
+		It("picks up changes across namespaces", func() {
+			writeFile("default", "b.yaml", "metadata: {name: b, namespace: default}")
+			Expect(latest()).To(Equal([]string{"default.a", "default.b"}))
+
+			writeFile("other", "c.yml", "metadata: {name: c, namespace: other}")
+			Eventually(latest, "5s").Should(Equal([]string{"default.a", "default.b", "other.c"}))
+
+			Expect(os.Remove(filepath.Join(dir, "default", "a.yaml"))).NotTo(HaveOccurred())
+			Eventually(latest, "5s").Should(Equal([]string{"default.b", "other.c"}))
+		})
+
+		It("debounces bursts of changes", func() {
+			for _, name := range []string{"b", "c", "d"} {
+				writeFile("default", name+".yaml", "metadata: {name: "+name+", namespace: default}")
+			}
+			Expect(latest()).To(Equal([]string{"default.a", "default.b", "default.c", "default.d"}))
+			Consistently(lists, "100ms").ShouldNot(Receive())
+		})
+
+		It("keeps the last good resources of a malformed file", func() {
+			writeFile("default", "b.yaml", "metadata: {name: b, namespace: default}\n---\nmetadata: {name: c, namespace: default}")
+			Expect(latest()).To(Equal([]string{"default.a", "default.b", "default.c"}))
+
+			writeFile("default", "b.yaml", "metadata: {name: b, namespace: default}\n---\nmetadata: [")
+			var err error
+			Eventually(errs, "5s").Should(Receive(&err))
+			Expect(err.Error()).To(ContainSubstring("b.yaml"))
+
+			writeFile("default", "d.yaml", "metadata: {name: d, namespace: default}")
+			Expect(latest()).To(Equal([]string{"default.a", "default.b", "default.c", "default.d"}))
+		})
+
+		It("ignores files that do not hold resources", func() {
+			writeFile("default", "notes.txt", "not a resource")
+			Consistently(lists, "100ms").ShouldNot(Receive())
+		})
+	})
+})
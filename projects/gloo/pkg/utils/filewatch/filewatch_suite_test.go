@@ -0,0 +1,13 @@
+package filewatch_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestFilewatch(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Filewatch Suite")
+}
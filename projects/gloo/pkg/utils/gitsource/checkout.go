@@ -0,0 +1,235 @@
+package gitsource
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+const (
+	DefaultPollInterval = time.Minute
+
+	gitTimeout = 2 * time.Minute
+)
+
+var (
+	MissingUrlError = errors.Errorf("a url is required for the git config source")
+
+	UnknownRefError = func(ref string) error {
+		return errors.Errorf("%v is not a branch, tag or commit of the repository", ref)
+	}
+
+	GitCommandError = func(args []string, output []byte, err error) error {
+		return errors.Wrapf(err, "git %v: %s", strings.Join(args, " "), bytes.TrimSpace(output))
+	}
+)
+
+var (
+	checkoutsLock sync.Mutex
+	// checkouts live as long as the process, so they survive the setup loop restarting
+	checkouts = map[string]*Checkout{}
+)
+
+// A Checkout is a local clone of a repository which follows a branch, tag or commit
+type Checkout struct {
+	url          string
+	ref          string
+	path         string
+	dir          string
+	pollInterval time.Duration
+
+	lock     sync.RWMutex
+	commit   string
+	watchers []chan struct{}
+}
+
+// EnsureCheckout returns the checkout for the git config source, cloning the repository
+// and polling it for new commits on first use.
+func EnsureCheckout(source *v1.Settings_GitRepository, refreshRate *types.Duration) (*Checkout, error) {
+	if source.GetUrl() == "" {
+		return nil, MissingUrlError
+	}
+	key := source.String()
+
+	checkoutsLock.Lock()
+	defer checkoutsLock.Unlock()
+	if checkout, ok := checkouts[key]; ok {
+		return checkout, nil
+	}
+
+	pollInterval := DefaultPollInterval
+	for _, interval := range []*types.Duration{source.GetPollInterval(), refreshRate} {
+		if interval == nil {
+			continue
+		}
+		duration, err := types.DurationFromProto(interval)
+		if err != nil {
+			return nil, err
+		}
+		pollInterval = duration
+		break
+	}
+
+	dir := source.GetCheckoutDirectory()
+	if dir == "" {
+		hash := sha256.Sum256([]byte(key))
+		dir = filepath.Join(os.TempDir(), "gloo-git-"+hex.EncodeToString(hash[:8]))
+	}
+	checkout := &Checkout{
+		url:          source.GetUrl(),
+		ref:          source.GetRef(),
+		path:         source.GetPath(),
+		dir:          dir,
+		pollInterval: pollInterval,
+	}
+	if err := checkout.clone(); err != nil {
+		return nil, err
+	}
+	if err := checkout.Sync(); err != nil {
+		return nil, err
+	}
+	checkouts[key] = checkout
+
+	go checkout.poll(contextutils.WithLogger(context.Background(), "git_config_source"))
+	return checkout, nil
+}
+
+// Dir is the directory containing the resources
+func (c *Checkout) Dir() string {
+	return filepath.Join(c.dir, c.path)
+}
+
+// Commit is the SHA of the commit which is checked out
+func (c *Checkout) Commit() string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.commit
+}
+
+// Watch signals on the returned channel whenever a new commit is checked out, until ctx is done
+func (c *Checkout) Watch(ctx context.Context) <-chan struct{} {
+	watcher := make(chan struct{}, 1)
+	c.lock.Lock()
+	c.watchers = append(c.watchers, watcher)
+	c.lock.Unlock()
+	go func() {
+		<-ctx.Done()
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		for i, w := range c.watchers {
+			if w == watcher {
+				c.watchers = append(c.watchers[:i], c.watchers[i+1:]...)
+				break
+			}
+		}
+	}()
+	return watcher
+}
+
+// Sync fetches the repository and checks out the latest commit of the ref.
+// Files written to the checkout which are not part of the repository are kept.
+func (c *Checkout) Sync() error {
+	if _, err := c.git("fetch", "--force", "--tags", "origin"); err != nil {
+		return err
+	}
+	commit, err := c.resolve()
+	if err != nil {
+		return err
+	}
+	if commit == c.Commit() {
+		return nil
+	}
+	if _, err := c.git("checkout", "--force", "--detach", commit); err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.commit = commit
+	for _, watcher := range c.watchers {
+		select {
+		case watcher <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+func (c *Checkout) clone() error {
+	if _, err := os.Stat(filepath.Join(c.dir, ".git")); err == nil {
+		// reuse the checkout of a previous run
+		_, err := c.git("remote", "set-url", "origin", c.url)
+		return err
+	}
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return err
+	}
+	_, err := c.git("clone", "--no-checkout", c.url, ".")
+	return err
+}
+
+func (c *Checkout) resolve() (string, error) {
+	candidates := []string{"origin/HEAD"}
+	if c.ref != "" {
+		// branches first, then tags and commits
+		candidates = []string{"origin/" + c.ref, c.ref}
+	}
+	for _, candidate := range candidates {
+		out, err := c.git("rev-parse", "--verify", "--quiet", candidate+"^{commit}")
+		if err == nil {
+			return strings.TrimSpace(string(out)), nil
+		}
+	}
+	if c.ref == "" {
+		return "", UnknownRefError("HEAD")
+	}
+	return "", UnknownRefError(c.ref)
+}
+
+func (c *Checkout) poll(ctx context.Context) {
+	logger := contextutils.LoggerFrom(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(c.pollInterval):
+			previous := c.Commit()
+			if err := c.Sync(); err != nil {
+				logger.Errorf("syncing %v: %v", c.url, err)
+				continue
+			}
+			if commit := c.Commit(); commit != previous {
+				logger.Infof("serving commit %v of %v", commit, c.url)
+			}
+		}
+	}
+}
+
+func (c *Checkout) git(args ...string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), gitTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = c.dir
+	// never prompt for credentials
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	out, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return nil, GitCommandError(args, exitErr.Stderr, err)
+		}
+		return nil, GitCommandError(args, nil, err)
+	}
+	return out, nil
+}
@@ -0,0 +1,181 @@
+package gitsource_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/utils/gitsource"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Checkout", func() {
+
+	var (
+		origin string
+		source *v1.Settings_GitRepository
+	)
+
+	git := func(args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = origin
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+		out, err := cmd.CombinedOutput()
+		Expect(err).NotTo(HaveOccurred(), string(out))
+		return strings.TrimSpace(string(out))
+	}
+
+	commitFile := func(name, content string) string {
+		path := filepath.Join(origin, name)
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).NotTo(HaveOccurred())
+		Expect(ioutil.WriteFile(path, []byte(content), 0644)).NotTo(HaveOccurred())
+		git("add", "-A")
+		git("commit", "-m", "update "+name)
+		return git("rev-parse", "HEAD")
+	}
+
+	BeforeEach(func() {
+		if _, err := exec.LookPath("git"); err != nil {
+			Skip("git is not installed")
+		}
+		var err error
+		origin, err = ioutil.TempDir("", "origin")
+		Expect(err).NotTo(HaveOccurred())
+		checkoutDir, err := ioutil.TempDir("", "checkout")
+		Expect(err).NotTo(HaveOccurred())
+		git("init", "-q")
+		git("checkout", "-q", "-b", "main")
+
+		source = &v1.Settings_GitRepository{
+			Url:               origin,
+			Path:              "config",
+			CheckoutDirectory: checkoutDir,
+			PollInterval:      types.DurationProto(10 * time.Millisecond),
+		}
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(origin)
+		_ = os.RemoveAll(source.GetCheckoutDirectory())
+	})
+
+	It("follows the default branch", func() {
+		first := commitFile("config/upstreams/default/a.yaml", "first")
+
+		checkout, err := EnsureCheckout(source, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checkout.Commit()).To(Equal(first))
+		Expect(ioutil.ReadFile(filepath.Join(checkout.Dir(), "upstreams/default/a.yaml"))).To(BeEquivalentTo("first"))
+
+		By("keeping files written locally")
+		Expect(ioutil.WriteFile(filepath.Join(checkout.Dir(), "proxy.yaml"), []byte("proxy"), 0644)).NotTo(HaveOccurred())
+
+		second := commitFile("config/upstreams/default/a.yaml", "second")
+		Eventually(checkout.Commit, "5s").Should(Equal(second))
+		Expect(ioutil.ReadFile(filepath.Join(checkout.Dir(), "upstreams/default/a.yaml"))).To(BeEquivalentTo("second"))
+		Expect(filepath.Join(checkout.Dir(), "proxy.yaml")).To(BeAnExistingFile())
+
+		By("reusing the checkout")
+		again, err := EnsureCheckout(source, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(again).To(BeIdenticalTo(checkout))
+	})
+
+	It("serves a tag", func() {
+		tagged := commitFile("config/a.yaml", "tagged")
+		git("tag", "v1")
+		commitFile("config/a.yaml", "untagged")
+		source.Ref = "v1"
+
+		checkout, err := EnsureCheckout(source, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checkout.Commit()).To(Equal(tagged))
+	})
+
+	It("fails for unknown refs", func() {
+		commitFile("config/a.yaml", "a")
+		source.Ref = "missing"
+		_, err := EnsureCheckout(source, nil)
+		Expect(err).To(MatchError(UnknownRefError("missing")))
+	})
+
+	It("reports the served commit on the settings", func() {
+		commit := commitFile("config/a.yaml", "a")
+		checkout, err := EnsureCheckout(source, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		settingsClient, err := v1.NewSettingsClient(&factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
+		Expect(err).NotTo(HaveOccurred())
+		otherDetail := &types.Value{Kind: &types.Value_StringValue{StringValue: "value"}}
+		settings, err := settingsClient.Write(&v1.Settings{
+			Metadata: core.Metadata{Name: "default", Namespace: "gloo-system"},
+			Status: core.Status{
+				State:      core.Status_Warning,
+				ReportedBy: "other",
+				Details:    &types.Struct{Fields: map[string]*types.Value{"other": otherDetail}},
+			},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go ReportCommits(ctx, checkout, settingsClient, settings.GetMetadata().Ref())
+
+		reported := func() string {
+			settings, err := settingsClient.Read("gloo-system", "default", clients.ReadOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			return ReportedCommit(settings)
+		}
+		Eventually(reported, "5s").Should(Equal(commit))
+
+		next := commitFile("config/a.yaml", "b")
+		Eventually(reported, "5s").Should(Equal(next))
+
+		By("keeping the rest of the status")
+		settings, err = settingsClient.Read("gloo-system", "default", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(settings.Status.State).To(Equal(core.Status_Warning))
+		Expect(settings.Status.ReportedBy).To(Equal("other"))
+		Expect(settings.Status.Details.Fields["other"]).To(Equal(otherDetail))
+	})
+
+	It("reports the served commit again when the settings could not be written", func() {
+		retryInterval := CommitReportRetryInterval
+		CommitReportRetryInterval = 10 * time.Millisecond
+		defer func() { CommitReportRetryInterval = retryInterval }()
+
+		commit := commitFile("config/a.yaml", "a")
+		checkout, err := EnsureCheckout(source, nil)
+		Expect(err).NotTo(HaveOccurred())
+		settingsClient, err := v1.NewSettingsClient(&factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		// the settings do not exist yet, so the first reports fail
+		go ReportCommits(ctx, checkout, settingsClient, core.ResourceRef{Namespace: "gloo-system", Name: "default"})
+		time.Sleep(50 * time.Millisecond)
+
+		_, err = settingsClient.Write(&v1.Settings{Metadata: core.Metadata{Name: "default", Namespace: "gloo-system"}}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(func() string {
+			settings, err := settingsClient.Read("gloo-system", "default", clients.ReadOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			return ReportedCommit(settings)
+		}, "5s").Should(Equal(commit))
+	})
+})
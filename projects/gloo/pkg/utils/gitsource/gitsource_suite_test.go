@@ -0,0 +1,13 @@
+package gitsource_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestGitSource(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Git Source Suite")
+}
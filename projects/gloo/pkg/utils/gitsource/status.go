@@ -0,0 +1,49 @@
+package gitsource
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/solo-io/gloo/pkg/utils/settingsutil"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const (
+	// the field of the settings status details holding the served commit
+	CommitStatusField = "gitCommit"
+)
+
+// how long to wait before reporting a commit again when the settings could not be written, e.g. for lack of permissions
+var CommitReportRetryInterval = 30 * time.Second
+
+// ReportCommits writes the commit served by the checkout to the status of the settings, until ctx is done
+func ReportCommits(ctx context.Context, checkout *Checkout, settingsClient v1.SettingsClient, settingsRef core.ResourceRef) {
+	logger := contextutils.LoggerFrom(ctx)
+	commits := checkout.Watch(ctx)
+	for {
+		var retry <-chan time.Time
+		if err := reportCommit(ctx, checkout.Commit(), settingsClient, settingsRef); err != nil {
+			logger.Errorf("reporting the served git commit on settings %v: %v", settingsRef.Key(), err)
+			retry = time.After(CommitReportRetryInterval)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-commits:
+		case <-retry:
+		}
+	}
+}
+
+func reportCommit(ctx context.Context, commit string, settingsClient v1.SettingsClient, settingsRef core.ResourceRef) error {
+	value := &types.Value{Kind: &types.Value_StringValue{StringValue: commit}}
+	return settingsutil.ReportStatusDetail(ctx, settingsClient, settingsRef, CommitStatusField, value)
+}
+
+// ReportedCommit returns the commit reported on the status of the settings
+func ReportedCommit(settings *v1.Settings) string {
+	return settingsutil.StatusDetail(settings, CommitStatusField).GetStringValue()
+}
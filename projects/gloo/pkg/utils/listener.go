@@ -0,0 +1,21 @@
+package utils
+
+import (
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+// GetHttpListener returns the http listener of an http or hybrid listener
+func GetHttpListener(listener *v1.Listener) *v1.HttpListener {
+	if hybridListener := listener.GetHybridListener(); hybridListener != nil {
+		return hybridListener.GetHttpListener()
+	}
+	return listener.GetHttpListener()
+}
+
+// GetTcpListener returns the tcp listener of a tcp or hybrid listener
+func GetTcpListener(listener *v1.Listener) *v1.TcpListener {
+	if hybridListener := listener.GetHybridListener(); hybridListener != nil {
+		return hybridListener.GetTcpListener()
+	}
+	return listener.GetTcpListener()
+}
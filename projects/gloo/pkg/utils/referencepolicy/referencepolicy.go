@@ -0,0 +1,118 @@
+package referencepolicy
+
+import (
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// Reserved value for the namespaces of a ReferencePolicyRule.
+// If a rule contains this value, references from any namespace are allowed.
+const allNamespaces = "*"
+
+var (
+	ReferenceNotAllowedErr = func(kind v1.ReferencePolicyRule_Kind, fromNamespace string, ref core.ResourceRef) error {
+		return errors.Errorf("reference policy violation: resources in namespace [%s] are not allowed to reference %s %v",
+			fromNamespace, kindName(kind), ref.Key())
+	}
+)
+
+// Returns an error if a resource in `fromNamespace` is not allowed to reference the resource of the given kind
+// identified by `ref`, according to the reference policies published in the namespace of `ref`.
+// References within the same namespace and references into namespaces without any policy are always allowed.
+func ValidateReference(policies v1.ReferencePolicyList, fromNamespace string, kind v1.ReferencePolicyRule_Kind, ref core.ResourceRef) error {
+	if fromNamespace == ref.Namespace {
+		return nil
+	}
+
+	var policiesForNamespace v1.ReferencePolicyList
+	for _, policy := range policies {
+		if policy.Metadata.Namespace == ref.Namespace {
+			policiesForNamespace = append(policiesForNamespace, policy)
+		}
+	}
+	if len(policiesForNamespace) == 0 {
+		return nil
+	}
+
+	for _, policy := range policiesForNamespace {
+		for _, rule := range policy.Rules {
+			if ruleMatchesKind(rule, kind) && ruleMatchesNamespace(rule, fromNamespace) {
+				return nil
+			}
+		}
+	}
+
+	return ReferenceNotAllowedErr(kind, fromNamespace, ref)
+}
+
+// Validates the upstream targeted by the given destination. Consul destinations are not namespaced resources
+// and are always allowed.
+func ValidateDestination(policies v1.ReferencePolicyList, fromNamespace string, dest *v1.Destination) error {
+	var ref *core.ResourceRef
+	switch d := dest.GetDestinationType().(type) {
+	case *v1.Destination_Upstream:
+		ref = d.Upstream
+	case *v1.Destination_Kube:
+		// the upstream for a kubernetes service destination lives in the namespace of the service
+		ref = &d.Kube.Ref
+	default:
+		return nil
+	}
+	if ref == nil {
+		return nil
+	}
+	return ValidateReference(policies, fromNamespace, v1.ReferencePolicyRule_UPSTREAM, *ref)
+}
+
+// Validates all the upstreams and upstream groups referenced by the given route action.
+func ValidateRouteAction(policies v1.ReferencePolicyList, fromNamespace string, action *v1.RouteAction) error {
+	switch dest := action.GetDestination().(type) {
+	case *v1.RouteAction_Single:
+		return ValidateDestination(policies, fromNamespace, dest.Single)
+	case *v1.RouteAction_Multi:
+		for _, weightedDest := range dest.Multi.GetDestinations() {
+			if err := ValidateDestination(policies, fromNamespace, weightedDest.GetDestination()); err != nil {
+				return err
+			}
+		}
+	case *v1.RouteAction_UpstreamGroup:
+		if dest.UpstreamGroup != nil {
+			return ValidateReference(policies, fromNamespace, v1.ReferencePolicyRule_UPSTREAM_GROUP, *dest.UpstreamGroup)
+		}
+	}
+	return nil
+}
+
+func ruleMatchesKind(rule *v1.ReferencePolicyRule, kind v1.ReferencePolicyRule_Kind) bool {
+	if len(rule.Kinds) == 0 {
+		return true
+	}
+	for _, k := range rule.Kinds {
+		if k == v1.ReferencePolicyRule_ANY || k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func ruleMatchesNamespace(rule *v1.ReferencePolicyRule, namespace string) bool {
+	for _, ns := range rule.Namespaces {
+		if ns == allNamespaces || ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+func kindName(kind v1.ReferencePolicyRule_Kind) string {
+	switch kind {
+	case v1.ReferencePolicyRule_UPSTREAM:
+		return "upstream"
+	case v1.ReferencePolicyRule_UPSTREAM_GROUP:
+		return "upstream group"
+	case v1.ReferencePolicyRule_ROUTE_TABLE:
+		return "route table"
+	}
+	return "resource"
+}
@@ -0,0 +1,13 @@
+package referencepolicy_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestReferencePolicy(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Reference Policy Suite")
+}
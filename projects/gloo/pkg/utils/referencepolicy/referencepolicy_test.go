@@ -0,0 +1,110 @@
+package referencepolicy_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/utils/referencepolicy"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("ReferencePolicy", func() {
+
+	var (
+		upstreamRef = core.ResourceRef{Namespace: "backends", Name: "us"}
+		policies    v1.ReferencePolicyList
+	)
+
+	BeforeEach(func() {
+		policies = v1.ReferencePolicyList{
+			{
+				Metadata: core.Metadata{Namespace: "backends", Name: "policy"},
+				Rules: []*v1.ReferencePolicyRule{
+					{
+						Kinds:      []v1.ReferencePolicyRule_Kind{v1.ReferencePolicyRule_UPSTREAM},
+						Namespaces: []string{"team-a"},
+					},
+					{
+						Kinds:      []v1.ReferencePolicyRule_Kind{v1.ReferencePolicyRule_ROUTE_TABLE},
+						Namespaces: []string{"*"},
+					},
+				},
+			},
+		}
+	})
+
+	It("allows references within the same namespace", func() {
+		Expect(ValidateReference(policies, "backends", v1.ReferencePolicyRule_UPSTREAM, upstreamRef)).NotTo(HaveOccurred())
+	})
+
+	It("allows references into namespaces without policies", func() {
+		ref := core.ResourceRef{Namespace: "other", Name: "us"}
+		Expect(ValidateReference(policies, "team-b", v1.ReferencePolicyRule_UPSTREAM, ref)).NotTo(HaveOccurred())
+	})
+
+	It("allows references from namespaces listed in a rule for the kind", func() {
+		Expect(ValidateReference(policies, "team-a", v1.ReferencePolicyRule_UPSTREAM, upstreamRef)).NotTo(HaveOccurred())
+	})
+
+	It("allows references from any namespace with the wildcard", func() {
+		ref := core.ResourceRef{Namespace: "backends", Name: "rt"}
+		Expect(ValidateReference(policies, "team-b", v1.ReferencePolicyRule_ROUTE_TABLE, ref)).NotTo(HaveOccurred())
+	})
+
+	It("rejects references that are not allowed by any rule", func() {
+		err := ValidateReference(policies, "team-b", v1.ReferencePolicyRule_UPSTREAM, upstreamRef)
+		Expect(err).To(HaveOccurred())
+		Expect(err).To(MatchError(ReferenceNotAllowedErr(v1.ReferencePolicyRule_UPSTREAM, "team-b", upstreamRef)))
+
+		err = ValidateReference(policies, "team-a", v1.ReferencePolicyRule_UPSTREAM_GROUP, upstreamRef)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("applies rules without kinds to all kinds", func() {
+		policies[0].Rules = append(policies[0].Rules, &v1.ReferencePolicyRule{Namespaces: []string{"team-c"}})
+		Expect(ValidateReference(policies, "team-c", v1.ReferencePolicyRule_UPSTREAM_GROUP, upstreamRef)).NotTo(HaveOccurred())
+	})
+
+	Context("route actions", func() {
+
+		It("validates all the destinations of a multi destination", func() {
+			action := &v1.RouteAction{
+				Destination: &v1.RouteAction_Multi{
+					Multi: &v1.MultiDestination{
+						Destinations: []*v1.WeightedDestination{
+							{Destination: &v1.Destination{DestinationType: &v1.Destination_Upstream{Upstream: &core.ResourceRef{Namespace: "team-b", Name: "us"}}}},
+							{Destination: &v1.Destination{DestinationType: &v1.Destination_Upstream{Upstream: &upstreamRef}}},
+						},
+					},
+				},
+			}
+			Expect(ValidateRouteAction(policies, "team-b", action)).To(HaveOccurred())
+			Expect(ValidateRouteAction(policies, "team-a", action)).NotTo(HaveOccurred())
+		})
+
+		It("validates the namespace of kubernetes service destinations", func() {
+			action := &v1.RouteAction{
+				Destination: &v1.RouteAction_Single{
+					Single: &v1.Destination{
+						DestinationType: &v1.Destination_Kube{
+							Kube: &v1.KubernetesServiceDestination{
+								Ref:  core.ResourceRef{Namespace: "backends", Name: "svc"},
+								Port: 8080,
+							},
+						},
+					},
+				},
+			}
+			Expect(ValidateRouteAction(policies, "team-b", action)).To(HaveOccurred())
+		})
+
+		It("validates upstream group references", func() {
+			action := &v1.RouteAction{
+				Destination: &v1.RouteAction_UpstreamGroup{
+					UpstreamGroup: &core.ResourceRef{Namespace: "backends", Name: "ug"},
+				},
+			}
+			Expect(ValidateRouteAction(policies, "team-a", action)).To(HaveOccurred())
+		})
+	})
+})
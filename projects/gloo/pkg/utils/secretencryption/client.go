@@ -0,0 +1,141 @@
+package secretencryption
+
+import (
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"go.uber.org/multierr"
+)
+
+// ClientFactory wraps the clients of Base so that secrets are encrypted
+// before they are written, and decrypted when they are read.
+// Secrets stored in plaintext are read as they are.
+type ClientFactory struct {
+	Base factory.ResourceClientFactory
+	Key  []byte
+}
+
+func (f *ClientFactory) NewResourceClient(params factory.NewResourceClientParams) (clients.ResourceClient, error) {
+	base, err := f.Base.NewResourceClient(params)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := params.ResourceType.(*v1.Secret); !ok {
+		return base, nil
+	}
+	return &resourceClient{ResourceClient: base, key: f.Key}, nil
+}
+
+type resourceClient struct {
+	clients.ResourceClient
+	key []byte
+}
+
+func (rc *resourceClient) Read(namespace, name string, opts clients.ReadOpts) (resources.Resource, error) {
+	resource, err := rc.ResourceClient.Read(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return rc.decrypt(resource)
+}
+
+func (rc *resourceClient) Write(resource resources.Resource, opts clients.WriteOpts) (resources.Resource, error) {
+	if secret, ok := resource.(*v1.Secret); ok {
+		encrypted, err := Encrypt(secret, rc.key)
+		if err != nil {
+			return nil, err
+		}
+		resource = encrypted
+	}
+	written, err := rc.ResourceClient.Write(resource, opts)
+	if err != nil {
+		return nil, err
+	}
+	return rc.decrypt(written)
+}
+
+func (rc *resourceClient) List(namespace string, opts clients.ListOpts) (resources.ResourceList, error) {
+	list, err := rc.ResourceClient.List(namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+	decrypted, err := rc.decryptList(list)
+	if err != nil {
+		// one secret that cannot be decrypted must not hide the others
+		contextutils.LoggerFrom(opts.WithDefaults().Ctx).Warnf("listing secrets in namespace %v: %v", namespace, err)
+	}
+	return decrypted, nil
+}
+
+func (rc *resourceClient) Watch(namespace string, opts clients.WatchOpts) (<-chan resources.ResourceList, <-chan error, error) {
+	baseLists, baseErrs, err := rc.ResourceClient.Watch(namespace, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	opts = opts.WithDefaults()
+	lists := make(chan resources.ResourceList)
+	errs := make(chan error)
+	go func() {
+		defer close(lists)
+		defer close(errs)
+		for {
+			select {
+			case <-opts.Ctx.Done():
+				return
+			case err, ok := <-baseErrs:
+				if !ok {
+					return
+				}
+				select {
+				case errs <- err:
+				case <-opts.Ctx.Done():
+					return
+				}
+			case list, ok := <-baseLists:
+				if !ok {
+					return
+				}
+				decrypted, err := rc.decryptList(list)
+				select {
+				case lists <- decrypted:
+				case <-opts.Ctx.Done():
+					return
+				}
+				if err != nil {
+					select {
+					case errs <- err:
+					case <-opts.Ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+	return lists, errs, nil
+}
+
+func (rc *resourceClient) decrypt(resource resources.Resource) (resources.Resource, error) {
+	secret, ok := resource.(*v1.Secret)
+	if !ok {
+		return resource, nil
+	}
+	return Decrypt(secret, rc.key)
+}
+
+// decryptList returns the resources of the list that can be decrypted, and the errors of the others
+func (rc *resourceClient) decryptList(list resources.ResourceList) (resources.ResourceList, error) {
+	var errs error
+	decrypted := make(resources.ResourceList, 0, len(list))
+	for _, resource := range list {
+		decryptedResource, err := rc.decrypt(resource)
+		if err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, "decrypting secret %v", resource.GetMetadata().Ref().Key()))
+			continue
+		}
+		decrypted = append(decrypted, decryptedResource)
+	}
+	return decrypted, errs
+}
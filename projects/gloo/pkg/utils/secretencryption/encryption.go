@@ -0,0 +1,183 @@
+package secretencryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+)
+
+const (
+	// holds the envelope of an encrypted secret, whose kind is left empty
+	EncryptedAnnotation = "secrets.gloo.solo.io/encrypted"
+
+	envelopeVersion = "v1"
+	algorithm       = "AES-256-GCM"
+	keySize         = 32
+)
+
+var (
+	InvalidKeyError = errors.Errorf("secret encryption keys must be %d bytes, base64 encoded", keySize)
+
+	UnsupportedEnvelopeError = func(version, algorithm string) error {
+		return errors.Errorf("unsupported secret envelope %v with algorithm %v", version, algorithm)
+	}
+
+	DecryptionError = func(ref core.ResourceRef, err error) error {
+		return errors.Wrapf(err, "decrypting secret %v", ref.Key())
+	}
+)
+
+// an envelope holds the payload of a secret encrypted with a random data key,
+// and the data key encrypted with the key encryption key.
+// the secret ref is authenticated, so an envelope cannot be copied to another secret.
+type envelope struct {
+	Version      string `json:"version"`
+	Algorithm    string `json:"algorithm"`
+	EncryptedKey []byte `json:"encryptedKey"`
+	Ciphertext   []byte `json:"ciphertext"`
+}
+
+// LoadKey reads a base64 encoded key encryption key
+func LoadKey(path string) ([]byte, error) {
+	encoded, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading secret encryption key")
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encoded)))
+	if err != nil || len(key) != keySize {
+		return nil, InvalidKeyError
+	}
+	return key, nil
+}
+
+func IsEncrypted(secret *v1.Secret) bool {
+	_, ok := secret.GetMetadata().Annotations[EncryptedAnnotation]
+	return ok
+}
+
+// Encrypt returns a copy of secret with its kind encrypted into the EncryptedAnnotation
+func Encrypt(secret *v1.Secret, key []byte) (*v1.Secret, error) {
+	if IsEncrypted(secret) {
+		return secret, nil
+	}
+	payload, err := protoutils.MarshalBytes(&v1.Secret{Kind: secret.GetKind()})
+	if err != nil {
+		return nil, err
+	}
+	dataKey := make([]byte, keySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, err
+	}
+	additionalData := []byte(secret.GetMetadata().Ref().Key())
+	encryptedKey, err := seal(key, dataKey, additionalData)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := seal(dataKey, payload, additionalData)
+	if err != nil {
+		return nil, err
+	}
+	serialized, err := json.Marshal(envelope{
+		Version:      envelopeVersion,
+		Algorithm:    algorithm,
+		EncryptedKey: encryptedKey,
+		Ciphertext:   ciphertext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted := &v1.Secret{Metadata: secret.GetMetadata()}
+	encrypted.Metadata.Annotations = make(map[string]string, len(secret.GetMetadata().Annotations)+1)
+	for k, v := range secret.GetMetadata().Annotations {
+		encrypted.Metadata.Annotations[k] = v
+	}
+	encrypted.Metadata.Annotations[EncryptedAnnotation] = string(serialized)
+	return encrypted, nil
+}
+
+// Decrypt returns a copy of secret with the kind restored from the EncryptedAnnotation
+func Decrypt(secret *v1.Secret, key []byte) (*v1.Secret, error) {
+	if !IsEncrypted(secret) {
+		return secret, nil
+	}
+	ref := secret.GetMetadata().Ref()
+	var env envelope
+	if err := json.Unmarshal([]byte(secret.GetMetadata().Annotations[EncryptedAnnotation]), &env); err != nil {
+		return nil, DecryptionError(ref, err)
+	}
+	if env.Version != envelopeVersion || env.Algorithm != algorithm {
+		return nil, DecryptionError(ref, UnsupportedEnvelopeError(env.Version, env.Algorithm))
+	}
+	additionalData := []byte(ref.Key())
+	dataKey, err := open(key, env.EncryptedKey, additionalData)
+	if err != nil {
+		return nil, DecryptionError(ref, err)
+	}
+	payload, err := open(dataKey, env.Ciphertext, additionalData)
+	if err != nil {
+		return nil, DecryptionError(ref, err)
+	}
+	var decrypted v1.Secret
+	if err := protoutils.UnmarshalBytes(payload, &decrypted); err != nil {
+		return nil, DecryptionError(ref, err)
+	}
+
+	decrypted.Metadata = secret.GetMetadata()
+	decrypted.Metadata.Annotations = make(map[string]string, len(secret.GetMetadata().Annotations))
+	for k, v := range secret.GetMetadata().Annotations {
+		if k != EncryptedAnnotation {
+			decrypted.Metadata.Annotations[k] = v
+		}
+	}
+	if len(decrypted.Metadata.Annotations) == 0 {
+		decrypted.Metadata.Annotations = nil
+	}
+	return &decrypted, nil
+}
+
+// seal prepends the random nonce to the ciphertext
+func seal(key, plaintext, additionalData []byte) ([]byte, error) {
+	aead, err := newAead(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(key, sealed, additionalData []byte) ([]byte, error) {
+	aead, err := newAead(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.Errorf("ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, additionalData)
+}
+
+func newAead(key []byte) (cipher.AEAD, error) {
+	if len(key) != keySize {
+		return nil, InvalidKeyError
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,163 @@
+package secretencryption_test
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/utils/secretencryption"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Secret encryption", func() {
+
+	var (
+		key    []byte
+		secret *v1.Secret
+	)
+
+	BeforeEach(func() {
+		key = []byte("0123456789abcdef0123456789abcdef")
+		secret = &v1.Secret{
+			Metadata: core.Metadata{
+				Name:        "tls",
+				Namespace:   "default",
+				Annotations: map[string]string{"owner": "team"},
+			},
+			Kind: &v1.Secret_Tls{Tls: &v1.TlsSecret{CertChain: "cert", PrivateKey: "key"}},
+		}
+	})
+
+	It("encrypts and decrypts secrets", func() {
+		encrypted, err := Encrypt(secret, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(IsEncrypted(encrypted)).To(BeTrue())
+		Expect(encrypted.Kind).To(BeNil())
+		Expect(encrypted.Metadata.Annotations[EncryptedAnnotation]).NotTo(ContainSubstring("key"))
+		Expect(encrypted.Metadata.Annotations).To(HaveKeyWithValue("owner", "team"))
+		Expect(IsEncrypted(secret)).To(BeFalse())
+
+		decrypted, err := Decrypt(encrypted, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decrypted).To(Equal(secret))
+	})
+
+	It("returns plaintext secrets as they are", func() {
+		decrypted, err := Decrypt(secret, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decrypted).To(Equal(secret))
+	})
+
+	It("fails to decrypt with another key", func() {
+		encrypted, err := Encrypt(secret, key)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = Decrypt(encrypted, []byte("abcdef0123456789abcdef0123456789"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails to decrypt an envelope copied to another secret", func() {
+		encrypted, err := Encrypt(secret, key)
+		Expect(err).NotTo(HaveOccurred())
+		encrypted.Metadata.Name = "other"
+		_, err = Decrypt(encrypted, key)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("loads base64 encoded keys", func() {
+		keyFile, err := ioutil.TempFile("", "")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.Remove(keyFile.Name())
+		_, err = keyFile.WriteString(base64.StdEncoding.EncodeToString(key) + "\n")
+		Expect(err).NotTo(HaveOccurred())
+
+		loaded, err := LoadKey(keyFile.Name())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loaded).To(Equal(key))
+
+		Expect(ioutil.WriteFile(keyFile.Name(), []byte("c2hvcnQ="), 0644)).NotTo(HaveOccurred())
+		_, err = LoadKey(keyFile.Name())
+		Expect(err).To(MatchError(InvalidKeyError))
+	})
+
+	Context("client factory", func() {
+		var (
+			base         factory.ResourceClientFactory
+			secretClient v1.SecretClient
+		)
+
+		BeforeEach(func() {
+			base = &factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()}
+			var err error
+			secretClient, err = v1.NewSecretClient(&ClientFactory{Base: base, Key: key})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("stores secrets encrypted", func() {
+			written, err := secretClient.Write(secret, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(written.GetTls()).To(Equal(secret.GetTls()))
+
+			plainClient, err := v1.NewSecretClient(base)
+			Expect(err).NotTo(HaveOccurred())
+			stored, err := plainClient.Read("default", "tls", clients.ReadOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(IsEncrypted(stored)).To(BeTrue())
+
+			list, err := secretClient.List("default", clients.ListOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list).To(HaveLen(1))
+			Expect(list[0].GetTls()).To(Equal(secret.GetTls()))
+		})
+
+		It("decrypts watched secrets", func() {
+			_, err := secretClient.Write(secret, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+
+			lists, errs, err := secretClient.Watch("default", clients.WatchOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Consistently(errs).ShouldNot(Receive())
+			var list v1.SecretList
+			Eventually(lists).Should(Receive(&list))
+			Expect(list).To(HaveLen(1))
+			Expect(list[0].GetTls()).To(Equal(secret.GetTls()))
+		})
+
+		Context("with a secret encrypted with another key", func() {
+			BeforeEach(func() {
+				otherKeyClient, err := v1.NewSecretClient(&ClientFactory{Base: base, Key: []byte("fedcba9876543210fedcba9876543210")})
+				Expect(err).NotTo(HaveOccurred())
+				other := *secret
+				other.Metadata = core.Metadata{Name: "other", Namespace: "default"}
+				_, err = otherKeyClient.Write(&other, clients.WriteOpts{})
+				Expect(err).NotTo(HaveOccurred())
+				_, err = secretClient.Write(secret, clients.WriteOpts{})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("lists the other secrets", func() {
+				list, err := secretClient.List("default", clients.ListOpts{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(list).To(HaveLen(1))
+				Expect(list[0].Metadata.Name).To(Equal("tls"))
+			})
+
+			It("watches the other secrets and reports the error", func() {
+				lists, errs, err := secretClient.Watch("default", clients.WatchOpts{})
+				Expect(err).NotTo(HaveOccurred())
+				var list v1.SecretList
+				Eventually(lists).Should(Receive(&list))
+				Expect(list).To(HaveLen(1))
+				Expect(list[0].Metadata.Name).To(Equal("tls"))
+				var watchErr error
+				Eventually(errs).Should(Receive(&watchErr))
+				Expect(watchErr.Error()).To(ContainSubstring("default.other"))
+			})
+		})
+	})
+})
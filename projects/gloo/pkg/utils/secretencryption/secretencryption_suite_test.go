@@ -0,0 +1,13 @@
+package secretencryption_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestSecretEncryption(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Secret Encryption Suite")
+}
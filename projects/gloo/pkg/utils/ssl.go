@@ -1,6 +1,12 @@
 package utils
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	v2alpha "github.com/envoyproxy/go-control-plane/envoy/config/grpc_credential/v2alpha"
@@ -10,6 +16,7 @@ import (
 
 	"github.com/rotisserie/eris"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
@@ -31,8 +38,76 @@ var (
 	}
 
 	NoCertificateFoundError = eris.New("no certificate information found")
+
+	InvalidCipherSuiteError = func(cipher string) error {
+		return eris.Errorf("invalid cipher suite %v", cipher)
+	}
+
+	InvalidEcdhCurveError = func(curve string) error {
+		return eris.Errorf("invalid ecdh curve %v", curve)
+	}
+
+	InvalidAlpnProtocolError = func(protocol string) error {
+		return eris.Errorf("invalid alpn protocol %q: must be between 1 and 255 bytes", protocol)
+	}
+
+	InvalidTlsVersionRangeError = func(min, max v1.SslParameters_ProtocolVersion) error {
+		return eris.Errorf("minimum tls version %v is greater than maximum tls version %v", min, max)
+	}
+
+	OcspStapleWithoutCertificateError = eris.New("an ocsp staple requires a certificate chain and private key")
+
+	IncompleteCertificateError = eris.New("both or none of cert chain and private key must be provided")
+
+	VerifySanWithoutRootCaError = eris.New("a root_ca must be provided if verify_subject_alt_name is not empty")
+
+	CrlWithoutRootCaError = eris.New("a root_ca must be provided if a crl is provided")
+
+	ClientCertificatePinWithoutRootCaError = eris.New("a root_ca must be provided if verify_certificate_hash or verify_certificate_spki is not empty")
+
+	InvalidCertificateHashError = func(hash string) error {
+		return eris.Errorf("invalid certificate hash %v: must be a hex encoded SHA-256 hash", hash)
+	}
+
+	InvalidCertificateSpkiError = func(spki string) error {
+		return eris.Errorf("invalid certificate spki %v: must be a base64 encoded SHA-256 hash", spki)
+	}
 )
 
+// cipher suites supported by envoy's BoringSSL build
+var validCipherSuites = map[string]bool{
+	"ECDHE-ECDSA-AES128-GCM-SHA256": true,
+	"ECDHE-RSA-AES128-GCM-SHA256":   true,
+	"ECDHE-ECDSA-AES256-GCM-SHA384": true,
+	"ECDHE-RSA-AES256-GCM-SHA384":   true,
+	"ECDHE-ECDSA-CHACHA20-POLY1305": true,
+	"ECDHE-RSA-CHACHA20-POLY1305":   true,
+	"ECDHE-PSK-CHACHA20-POLY1305":   true,
+	"ECDHE-ECDSA-AES128-SHA":        true,
+	"ECDHE-RSA-AES128-SHA":          true,
+	"ECDHE-PSK-AES128-CBC-SHA":      true,
+	"ECDHE-ECDSA-AES256-SHA":        true,
+	"ECDHE-RSA-AES256-SHA":          true,
+	"ECDHE-PSK-AES256-CBC-SHA":      true,
+	"AES128-GCM-SHA256":             true,
+	"AES256-GCM-SHA384":             true,
+	"AES128-SHA":                    true,
+	"PSK-AES128-CBC-SHA":            true,
+	"AES256-SHA":                    true,
+	"PSK-AES256-CBC-SHA":            true,
+	"DES-CBC3-SHA":                  true,
+}
+
+// ecdh curves supported by envoy's BoringSSL build
+var validEcdhCurves = map[string]bool{
+	"X25519": true,
+	"P-224":  true,
+	"P-256":  true,
+	"P-384":  true,
+	"P-521":  true,
+	"CECPQ2": true,
+}
+
 type SslConfigTranslator interface {
 	ResolveUpstreamSslConfig(secrets v1.SecretList, uc *v1.UpstreamSslConfig) (*envoyauth.UpstreamTlsContext, error)
 	ResolveDownstreamSslConfig(secrets v1.SecretList, dc *v1.SslConfig) (*envoyauth.DownstreamTlsContext, error)
@@ -40,17 +115,53 @@ type SslConfigTranslator interface {
 }
 
 type sslConfigTranslator struct {
+	// if set, secret refs are served by the secret discovery service of gloo, reached through this cluster
+	secretDiscoveryCluster string
 }
 
 func NewSslConfigTranslator() *sslConfigTranslator {
 	return &sslConfigTranslator{}
 }
 
+// NewSslConfigTranslatorForSettings returns a translator that serves the secrets referenced by ssl configs over
+// the secret discovery service of gloo if the settings enable it
+func NewSslConfigTranslatorForSettings(settings *v1.Settings) *sslConfigTranslator {
+	secretDiscovery := settings.GetGloo().GetSecretDiscovery()
+	if secretDiscovery == nil {
+		return NewSslConfigTranslator()
+	}
+	clusterName := secretDiscovery.GetXdsClusterName()
+	if clusterName == "" {
+		clusterName = fmt.Sprintf("gloo.%s.svc.cluster.local:%d", settings.GetMetadata().Namespace, defaults.GlooXdsPort)
+	}
+	return &sslConfigTranslator{secretDiscoveryCluster: clusterName}
+}
+
+// the names under which the gloo secret discovery service serves the certificate and validation context of a TLS secret
+func SdsCertificateSecretName(ref core.ResourceRef) string {
+	return ref.Key()
+}
+
+func SdsValidationContextName(ref core.ResourceRef) string {
+	return ref.Key() + "-validation-context"
+}
+
 func (s *sslConfigTranslator) ResolveUpstreamSslConfig(secrets v1.SecretList, uc *v1.UpstreamSslConfig) (*envoyauth.UpstreamTlsContext, error) {
 	common, err := s.ResolveCommonSslConfig(uc, secrets)
 	if err != nil {
 		return nil, err
 	}
+	if err := validateAlpnProtocols(uc.AlpnProtocols); err != nil {
+		return nil, err
+	}
+	common.AlpnProtocols = uc.AlpnProtocols
+	if ref := uc.GetSecretRef(); ref != nil {
+		var sniDomains []string
+		if uc.Sni != "" {
+			sniDomains = []string{uc.Sni}
+		}
+		recordCertificateMetrics(secrets, *ref, sniDomains)
+	}
 	return &envoyauth.UpstreamTlsContext{
 		CommonTlsContext: common,
 		Sni:              uc.Sni,
@@ -61,16 +172,25 @@ func (s *sslConfigTranslator) ResolveDownstreamSslConfig(secrets v1.SecretList,
 	if err != nil {
 		return nil, err
 	}
+	if err := applyClientCertificatePins(common, dc); err != nil {
+		return nil, err
+	}
 	var requireClientCert *gogo_types.BoolValue
-	if common.ValidationContextType != nil {
+	if common.ValidationContextType != nil && !dc.OptionalClientCertificate {
 		requireClientCert = &gogo_types.BoolValue{Value: true}
 	}
 	// show alpn for downstreams.
-	// placing it on upstreams maybe problematic if they do not expose alpn.
+	// placing it on upstreams maybe problematic if they do not expose alpn, so it is only set there when requested.
+	if err := validateAlpnProtocols(dc.AlpnProtocols); err != nil {
+		return nil, err
+	}
 	common.AlpnProtocols = dc.AlpnProtocols
 	if len(common.AlpnProtocols) == 0 {
 		common.AlpnProtocols = []string{"h2", "http/1.1"}
 	}
+	if ref := dc.GetSecretRef(); ref != nil {
+		recordCertificateMetrics(secrets, *ref, dc.SniDomains)
+	}
 	return &envoyauth.DownstreamTlsContext{
 		CommonTlsContext:         common,
 		RequireClientCertificate: gogoutils.BoolGogoToProto(requireClientCert),
@@ -190,21 +310,28 @@ func (s *sslConfigTranslator) handleSds(sslSecrets *v1.SDSConfig, verifySan []st
 
 func (s *sslConfigTranslator) ResolveCommonSslConfig(cs CertSource, secrets v1.SecretList) (*envoyauth.CommonTlsContext, error) {
 	var (
-		certChain, privateKey, rootCa string
+		certChain, privateKey, rootCa, crl string
+		ocspStaple                         []byte
+		ocspStapleFile                     string
 		// if using a Secret ref, we will inline the certs in the tls config
 		inlineDataSource bool
 	)
 
 	if sslSecrets := cs.GetSecretRef(); sslSecrets != nil {
-		var err error
 		inlineDataSource = true
 		ref := sslSecrets
-		certChain, privateKey, rootCa, err = getSslSecrets(*ref, secrets)
+		tlsSecret, err := getSslSecrets(*ref, secrets)
 		if err != nil {
 			return nil, err
 		}
+		if s.secretDiscoveryCluster != "" {
+			return s.handleSecretDiscovery(*ref, tlsSecret, cs)
+		}
+		certChain, privateKey, rootCa, crl = tlsSecret.CertChain, tlsSecret.PrivateKey, tlsSecret.RootCa, tlsSecret.Crl
+		ocspStaple = tlsSecret.OcspStaple
 	} else if sslSecrets := cs.GetSslFiles(); sslSecrets != nil {
-		certChain, privateKey, rootCa = sslSecrets.TlsCert, sslSecrets.TlsKey, sslSecrets.RootCa
+		certChain, privateKey, rootCa, crl = sslSecrets.TlsCert, sslSecrets.TlsKey, sslSecrets.RootCa, sslSecrets.Crl
+		ocspStapleFile = sslSecrets.OcspStaple
 	} else if sslSecrets := cs.GetSds(); sslSecrets != nil {
 		return s.handleSds(sslSecrets, cs.GetVerifySubjectAltName())
 	} else {
@@ -213,7 +340,7 @@ func (s *sslConfigTranslator) ResolveCommonSslConfig(cs CertSource, secrets v1.S
 
 	dataSource := dataSourceGenerator(inlineDataSource)
 
-	var certChainData, privateKeyData, rootCaData *envoycore.DataSource
+	var certChainData, privateKeyData, rootCaData, crlData *envoycore.DataSource
 
 	if certChain != "" {
 		certChainData = dataSource(certChain)
@@ -224,6 +351,20 @@ func (s *sslConfigTranslator) ResolveCommonSslConfig(cs CertSource, secrets v1.S
 	if rootCa != "" {
 		rootCaData = dataSource(rootCa)
 	}
+	if crl != "" {
+		crlData = dataSource(crl)
+	}
+
+	var ocspStapleData *envoycore.DataSource
+	if len(ocspStaple) != 0 {
+		ocspStapleData = &envoycore.DataSource{
+			Specifier: &envoycore.DataSource_InlineBytes{
+				InlineBytes: ocspStaple,
+			},
+		}
+	} else if ocspStapleFile != "" {
+		ocspStapleData = dataSourceGenerator(false)(ocspStapleFile)
+	}
 
 	tlsContext := &envoyauth.CommonTlsContext{
 		// default params
@@ -235,10 +376,13 @@ func (s *sslConfigTranslator) ResolveCommonSslConfig(cs CertSource, secrets v1.S
 			{
 				CertificateChain: certChainData,
 				PrivateKey:       privateKeyData,
+				OcspStaple:       ocspStapleData,
 			},
 		}
 	} else if certChainData != nil || privateKeyData != nil {
-		return nil, eris.Errorf("both or none of cert chain and private key must be provided")
+		return nil, IncompleteCertificateError
+	} else if ocspStapleData != nil {
+		return nil, OcspStapleWithoutCertificateError
 	}
 
 	sanList := cs.GetVerifySubjectAltName()
@@ -247,6 +391,7 @@ func (s *sslConfigTranslator) ResolveCommonSslConfig(cs CertSource, secrets v1.S
 		validationCtx := &envoyauth.CommonTlsContext_ValidationContext{
 			ValidationContext: &envoyauth.CertificateValidationContext{
 				TrustedCa: rootCaData,
+				Crl:       crlData,
 			},
 		}
 		if len(sanList) != 0 {
@@ -255,8 +400,10 @@ func (s *sslConfigTranslator) ResolveCommonSslConfig(cs CertSource, secrets v1.S
 		tlsContext.ValidationContextType = validationCtx
 
 	} else if len(sanList) != 0 {
-		return nil, eris.Errorf("a root_ca must be provided if verify_subject_alt_name is not empty")
+		return nil, VerifySanWithoutRootCaError
 
+	} else if crlData != nil {
+		return nil, CrlWithoutRootCaError
 	}
 
 	var err error
@@ -265,21 +412,123 @@ func (s *sslConfigTranslator) ResolveCommonSslConfig(cs CertSource, secrets v1.S
 	return tlsContext, err
 }
 
-func getSslSecrets(ref core.ResourceRef, secrets v1.SecretList) (string, string, string, error) {
+// references the certificate and validation context of a TLS secret served by the gloo secret discovery service
+func (s *sslConfigTranslator) handleSecretDiscovery(ref core.ResourceRef, tlsSecret *v1.TlsSecret, cs CertSource) (*envoyauth.CommonTlsContext, error) {
+	tlsContext := &envoyauth.CommonTlsContext{}
+
+	hasCertChain, hasPrivateKey := tlsSecret.CertChain != "", tlsSecret.PrivateKey != ""
+	if hasCertChain != hasPrivateKey {
+		return nil, IncompleteCertificateError
+	} else if hasCertChain {
+		tlsContext.TlsCertificateSdsSecretConfigs = []*envoyauth.SdsSecretConfig{
+			buildSecretDiscoverySds(SdsCertificateSecretName(ref), s.secretDiscoveryCluster),
+		}
+	} else if len(tlsSecret.OcspStaple) != 0 {
+		return nil, OcspStapleWithoutCertificateError
+	}
+
+	sanList := cs.GetVerifySubjectAltName()
+	if tlsSecret.RootCa != "" {
+		validationSds := buildSecretDiscoverySds(SdsValidationContextName(ref), s.secretDiscoveryCluster)
+		if len(sanList) == 0 {
+			tlsContext.ValidationContextType = &envoyauth.CommonTlsContext_ValidationContextSdsSecretConfig{
+				ValidationContextSdsSecretConfig: validationSds,
+			}
+		} else {
+			tlsContext.ValidationContextType = &envoyauth.CommonTlsContext_CombinedValidationContext{
+				CombinedValidationContext: &envoyauth.CommonTlsContext_CombinedCertificateValidationContext{
+					DefaultValidationContext:         &envoyauth.CertificateValidationContext{VerifySubjectAltName: sanList},
+					ValidationContextSdsSecretConfig: validationSds,
+				},
+			}
+		}
+	} else if len(sanList) != 0 {
+		return nil, VerifySanWithoutRootCaError
+	} else if tlsSecret.Crl != "" {
+		return nil, CrlWithoutRootCaError
+	}
+
+	var err error
+	tlsContext.TlsParams, err = convertTlsParams(cs)
+	return tlsContext, err
+}
+
+func buildSecretDiscoverySds(name, clusterName string) *envoyauth.SdsSecretConfig {
+	return &envoyauth.SdsSecretConfig{
+		Name: name,
+		SdsConfig: &envoycore.ConfigSource{
+			ConfigSourceSpecifier: &envoycore.ConfigSource_ApiConfigSource{
+				ApiConfigSource: &envoycore.ApiConfigSource{
+					ApiType: envoycore.ApiConfigSource_GRPC,
+					GrpcServices: []*envoycore.GrpcService{
+						{
+							TargetSpecifier: &envoycore.GrpcService_EnvoyGrpc_{
+								EnvoyGrpc: &envoycore.GrpcService_EnvoyGrpc{ClusterName: clusterName},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getSslSecrets(ref core.ResourceRef, secrets v1.SecretList) (*v1.TlsSecret, error) {
 	secret, err := secrets.Find(ref.Strings())
 	if err != nil {
-		return "", "", "", SslSecretNotFoundError(err)
+		return nil, SslSecretNotFoundError(err)
 	}
 
 	sslSecret, ok := secret.Kind.(*v1.Secret_Tls)
 	if !ok {
-		return "", "", "", NotTlsSecretError(secret.GetMetadata().Ref())
+		return nil, NotTlsSecretError(secret.GetMetadata().Ref())
 	}
 
-	certChain := sslSecret.Tls.CertChain
-	privateKey := sslSecret.Tls.PrivateKey
-	rootCa := sslSecret.Tls.RootCa
-	return certChain, privateKey, rootCa, nil
+	return sslSecret.Tls, nil
+}
+
+// pin the client certificates accepted by a downstream to the given hashes
+func applyClientCertificatePins(common *envoyauth.CommonTlsContext, dc *v1.SslConfig) error {
+	if len(dc.VerifyCertificateHash) == 0 && len(dc.VerifyCertificateSpki) == 0 {
+		return nil
+	}
+	for _, hash := range dc.VerifyCertificateHash {
+		if !isSha256Hex(hash) {
+			return InvalidCertificateHashError(hash)
+		}
+	}
+	for _, spki := range dc.VerifyCertificateSpki {
+		if decoded, err := base64.StdEncoding.DecodeString(spki); err != nil || len(decoded) != sha256.Size {
+			return InvalidCertificateSpkiError(spki)
+		}
+	}
+
+	var validationCtx *envoyauth.CertificateValidationContext
+	switch ctx := common.ValidationContextType.(type) {
+	case *envoyauth.CommonTlsContext_ValidationContext:
+		validationCtx = ctx.ValidationContext
+	case *envoyauth.CommonTlsContext_CombinedValidationContext:
+		validationCtx = ctx.CombinedValidationContext.DefaultValidationContext
+	case *envoyauth.CommonTlsContext_ValidationContextSdsSecretConfig:
+		validationCtx = &envoyauth.CertificateValidationContext{}
+		common.ValidationContextType = &envoyauth.CommonTlsContext_CombinedValidationContext{
+			CombinedValidationContext: &envoyauth.CommonTlsContext_CombinedCertificateValidationContext{
+				DefaultValidationContext:         validationCtx,
+				ValidationContextSdsSecretConfig: ctx.ValidationContextSdsSecretConfig,
+			},
+		}
+	default:
+		return ClientCertificatePinWithoutRootCaError
+	}
+	validationCtx.VerifyCertificateHash = dc.VerifyCertificateHash
+	validationCtx.VerifyCertificateSpki = dc.VerifyCertificateSpki
+	return nil
+}
+
+// envoy accepts hashes with or without colons between the bytes
+func isSha256Hex(hash string) bool {
+	decoded, err := hex.DecodeString(strings.Replace(hash, ":", "", -1))
+	return err == nil && len(decoded) == sha256.Size
 }
 
 func convertTlsParams(cs CertSource) (*envoyauth.TlsParameters, error) {
@@ -296,6 +545,15 @@ func convertTlsParams(cs CertSource) (*envoyauth.TlsParameters, error) {
 	if err != nil {
 		return nil, err
 	}
+	if minver != envoyauth.TlsParameters_TLS_AUTO && maxver != envoyauth.TlsParameters_TLS_AUTO && minver > maxver {
+		return nil, InvalidTlsVersionRangeError(params.MinimumProtocolVersion, params.MaximumProtocolVersion)
+	}
+	if err := validateCipherSuites(params.CipherSuites); err != nil {
+		return nil, err
+	}
+	if err := validateEcdhCurves(params.EcdhCurves); err != nil {
+		return nil, err
+	}
 
 	return &envoyauth.TlsParameters{
 		CipherSuites:              params.CipherSuites,
@@ -325,3 +583,37 @@ func convertVersion(v v1.SslParameters_ProtocolVersion) (envoyauth.TlsParameters
 
 	return envoyauth.TlsParameters_TLS_AUTO, TlsVersionNotFoundError(v)
 }
+
+// cipher suites may be grouped with equal preference as [CIPHER-A|CIPHER-B]
+func validateCipherSuites(cipherSuites []string) error {
+	for _, cipherSuite := range cipherSuites {
+		ciphers := []string{cipherSuite}
+		if strings.HasPrefix(cipherSuite, "[") && strings.HasSuffix(cipherSuite, "]") {
+			ciphers = strings.Split(strings.TrimSuffix(strings.TrimPrefix(cipherSuite, "["), "]"), "|")
+		}
+		for _, cipher := range ciphers {
+			if !validCipherSuites[cipher] {
+				return InvalidCipherSuiteError(cipher)
+			}
+		}
+	}
+	return nil
+}
+
+func validateEcdhCurves(curves []string) error {
+	for _, curve := range curves {
+		if !validEcdhCurves[curve] {
+			return InvalidEcdhCurveError(curve)
+		}
+	}
+	return nil
+}
+
+func validateAlpnProtocols(protocols []string) error {
+	for _, protocol := range protocols {
+		if len(protocol) == 0 || len(protocol) > 255 {
+			return InvalidAlpnProtocolError(protocol)
+		}
+	}
+	return nil
+}
@@ -192,6 +192,92 @@ var _ = Describe("Ssl", func() {
 			Expect(cfg.RequireClientCertificate.GetValue()).To(BeFalse())
 		})
 
+		It("should set alpn for upstream config when provided", func() {
+			upstreamCfg.AlpnProtocols = []string{"h2"}
+			cfg, err := configTranslator.ResolveUpstreamSslConfig(secrets, upstreamCfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.CommonTlsContext.AlpnProtocols).To(Equal([]string{"h2"}))
+		})
+
+		It("should error on an invalid alpn protocol", func() {
+			downstreamCfg.AlpnProtocols = []string{""}
+			_, err := configTranslator.ResolveDownstreamSslConfig(secrets, downstreamCfg)
+			Expect(err).To(MatchError(InvalidAlpnProtocolError("")))
+		})
+
+		It("should inline the ocsp staple from the secret", func() {
+			tlsSecret.OcspStaple = []byte("staple")
+			c, err := configTranslator.ResolveCommonSslConfig(downstreamCfg, secrets)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.TlsCertificates[0].OcspStaple.GetInlineBytes()).To(Equal([]byte("staple")))
+		})
+
+		It("should error on an ocsp staple without a certificate", func() {
+			tlsSecret.OcspStaple = []byte("staple")
+			tlsSecret.CertChain = ""
+			tlsSecret.PrivateKey = ""
+			_, err := configTranslator.ResolveCommonSslConfig(downstreamCfg, secrets)
+			Expect(err).To(MatchError(OcspStapleWithoutCertificateError))
+		})
+
+		Context("client certificates", func() {
+			const (
+				certHash = "df6ff72fe9116521268f6f2dd4966f51df479883fe7037b39f75916ac3049d1a"
+				certSpki = "NvqYIYSbgK2vCJpQhObf77vv+bQWtc5ek5RIOwPiC9A="
+			)
+
+			It("should not require a client cert when optional", func() {
+				downstreamCfg.OptionalClientCertificate = true
+				cfg, err := configTranslator.ResolveDownstreamSslConfig(secrets, downstreamCfg)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.RequireClientCertificate).To(BeNil())
+				Expect(cfg.CommonTlsContext.GetValidationContext()).NotTo(BeNil())
+			})
+
+			It("should pin client certificates", func() {
+				downstreamCfg.VerifyCertificateHash = []string{certHash}
+				downstreamCfg.VerifyCertificateSpki = []string{certSpki}
+				cfg, err := configTranslator.ResolveDownstreamSslConfig(secrets, downstreamCfg)
+				Expect(err).NotTo(HaveOccurred())
+				vctx := cfg.CommonTlsContext.GetValidationContext()
+				Expect(vctx.VerifyCertificateHash).To(Equal([]string{certHash}))
+				Expect(vctx.VerifyCertificateSpki).To(Equal([]string{certSpki}))
+			})
+
+			It("should error on an invalid certificate hash", func() {
+				downstreamCfg.VerifyCertificateHash = []string{"abc"}
+				_, err := configTranslator.ResolveDownstreamSslConfig(secrets, downstreamCfg)
+				Expect(err).To(MatchError(InvalidCertificateHashError("abc")))
+			})
+
+			It("should error on an invalid certificate spki", func() {
+				downstreamCfg.VerifyCertificateSpki = []string{"abc"}
+				_, err := configTranslator.ResolveDownstreamSslConfig(secrets, downstreamCfg)
+				Expect(err).To(MatchError(InvalidCertificateSpkiError("abc")))
+			})
+
+			It("should error on pins without rootca", func() {
+				tlsSecret.RootCa = ""
+				downstreamCfg.VerifyCertificateHash = []string{certHash}
+				_, err := configTranslator.ResolveDownstreamSslConfig(secrets, downstreamCfg)
+				Expect(err).To(MatchError(ClientCertificatePinWithoutRootCaError))
+			})
+
+			It("should add the crl to the validation context", func() {
+				tlsSecret.Crl = "crl"
+				cfg, err := configTranslator.ResolveDownstreamSslConfig(secrets, downstreamCfg)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.CommonTlsContext.GetValidationContext().GetCrl().GetInlineString()).To(Equal("crl"))
+			})
+
+			It("should error on a crl without rootca", func() {
+				tlsSecret.RootCa = ""
+				tlsSecret.Crl = "crl"
+				_, err := configTranslator.ResolveDownstreamSslConfig(secrets, downstreamCfg)
+				Expect(err).To(MatchError(CrlWithoutRootCaError))
+			})
+		})
+
 		It("should set sni for upstream config", func() {
 			cfg, err := configTranslator.ResolveUpstreamSslConfig(secrets, upstreamCfg)
 			Expect(err).NotTo(HaveOccurred())
@@ -222,21 +308,81 @@ var _ = Describe("Ssl", func() {
 				upstreamCfg.Parameters = &v1.SslParameters{
 					MinimumProtocolVersion: v1.SslParameters_TLSv1_1,
 					MaximumProtocolVersion: v1.SslParameters_TLSv1_2,
-					CipherSuites:           []string{"cipher-test"},
-					EcdhCurves:             []string{"ec-dh-test"},
+					CipherSuites:           []string{"[ECDHE-ECDSA-AES128-GCM-SHA256|ECDHE-ECDSA-CHACHA20-POLY1305]", "AES128-SHA"},
+					EcdhCurves:             []string{"X25519", "P-256"},
 				}
 				c, err := configTranslator.ResolveCommonSslConfig(upstreamCfg, secrets)
 				Expect(err).NotTo(HaveOccurred())
 				expectParams := &envoyauth.TlsParameters{
 					TlsMinimumProtocolVersion: envoyauth.TlsParameters_TLSv1_1,
 					TlsMaximumProtocolVersion: envoyauth.TlsParameters_TLSv1_2,
-					CipherSuites:              []string{"cipher-test"},
-					EcdhCurves:                []string{"ec-dh-test"},
+					CipherSuites:              []string{"[ECDHE-ECDSA-AES128-GCM-SHA256|ECDHE-ECDSA-CHACHA20-POLY1305]", "AES128-SHA"},
+					EcdhCurves:                []string{"X25519", "P-256"},
 				}
 				Expect(c.TlsParams).To(Equal(expectParams))
 			})
+
+			DescribeTable("should reject invalid TLS Params",
+				func(params *v1.SslParameters, expectedErr error) {
+					upstreamCfg.Parameters = params
+					_, err := configTranslator.ResolveCommonSslConfig(upstreamCfg, secrets)
+					Expect(err).To(MatchError(expectedErr))
+				},
+				Entry("unknown cipher suite",
+					&v1.SslParameters{CipherSuites: []string{"AES128-SHA", "cipher-test"}},
+					InvalidCipherSuiteError("cipher-test")),
+				Entry("unknown cipher suite in a group",
+					&v1.SslParameters{CipherSuites: []string{"[AES128-SHA|cipher-test]"}},
+					InvalidCipherSuiteError("cipher-test")),
+				Entry("unknown ecdh curve",
+					&v1.SslParameters{EcdhCurves: []string{"ec-dh-test"}},
+					InvalidEcdhCurveError("ec-dh-test")),
+				Entry("minimum version above maximum version",
+					&v1.SslParameters{MinimumProtocolVersion: v1.SslParameters_TLSv1_3, MaximumProtocolVersion: v1.SslParameters_TLSv1_2},
+					InvalidTlsVersionRangeError(v1.SslParameters_TLSv1_3, v1.SslParameters_TLSv1_2)),
+			)
 		})
 
+		Context("secret discovery", func() {
+			BeforeEach(func() {
+				configTranslator = NewSslConfigTranslatorForSettings(&v1.Settings{
+					Metadata: core.Metadata{Namespace: "gloo-system"},
+					Gloo:     &v1.GlooOptions{SecretDiscovery: &v1.GlooOptions_SecretDiscoveryOptions{}},
+				})
+			})
+
+			It("should reference the secrets served by gloo instead of inlining them", func() {
+				cfg, err := configTranslator.ResolveDownstreamSslConfig(secrets, downstreamCfg)
+				Expect(err).NotTo(HaveOccurred())
+				common := cfg.CommonTlsContext
+				Expect(common.TlsCertificates).To(BeEmpty())
+				Expect(common.TlsCertificateSdsSecretConfigs).To(HaveLen(1))
+				Expect(common.TlsCertificateSdsSecretConfigs[0].Name).To(Equal(SdsCertificateSecretName(secret.Metadata.Ref())))
+				Expect(common.GetValidationContextSdsSecretConfig().GetName()).To(Equal(SdsValidationContextName(secret.Metadata.Ref())))
+				Expect(cfg.RequireClientCertificate.GetValue()).To(BeTrue())
+
+				grpcService := common.TlsCertificateSdsSecretConfigs[0].SdsConfig.GetApiConfigSource().GrpcServices[0]
+				Expect(grpcService.GetEnvoyGrpc().ClusterName).To(Equal("gloo.gloo-system.svc.cluster.local:9977"))
+			})
+
+			It("should combine SAN verification with the served validation context", func() {
+				upstreamCfg.VerifySubjectAltName = []string{"test"}
+				cfg, err := configTranslator.ResolveUpstreamSslConfig(secrets, upstreamCfg)
+				Expect(err).NotTo(HaveOccurred())
+				combined := cfg.CommonTlsContext.GetCombinedValidationContext()
+				Expect(combined.DefaultValidationContext.VerifySubjectAltName).To(Equal([]string{"test"}))
+				Expect(combined.ValidationContextSdsSecretConfig.Name).To(Equal(SdsValidationContextName(secret.Metadata.Ref())))
+			})
+
+			It("should still validate the secret", func() {
+				tlsSecret.PrivateKey = ""
+				_, err := configTranslator.ResolveCommonSslConfig(upstreamCfg, secrets)
+				Expect(err).To(MatchError(IncompleteCertificateError))
+
+				_, err = configTranslator.ResolveCommonSslConfig(upstreamCfg, nil)
+				Expect(err).To(HaveOccurred())
+			})
+		})
 	})
 
 	Context("sds", func() {
@@ -307,6 +453,20 @@ var _ = Describe("Ssl", func() {
 
 		})
 
+		It("should pin client certificates alongside the sds validation context", func() {
+			downstreamCfg = &v1.SslConfig{
+				SslSecrets: &v1.SslConfig_Sds{
+					Sds: sdsConfig,
+				},
+				VerifyCertificateHash: []string{"df:6f:f7:2f:e9:11:65:21:26:8f:6f:2d:d4:96:6f:51:df:47:98:83:fe:70:37:b3:9f:75:91:6a:c3:04:9d:1a"},
+			}
+			cfg, err := configTranslator.ResolveDownstreamSslConfig(nil, downstreamCfg)
+			Expect(err).NotTo(HaveOccurred())
+			combined := cfg.CommonTlsContext.GetCombinedValidationContext()
+			Expect(combined.ValidationContextSdsSecretConfig.Name).To(Equal("ValidationContextName"))
+			Expect(combined.DefaultValidationContext.VerifyCertificateHash).To(Equal(downstreamCfg.VerifyCertificateHash))
+		})
+
 		Context("san", func() {
 			It("should error with san and not rootca", func() {
 				sdsConfig.ValidationContextName = ""
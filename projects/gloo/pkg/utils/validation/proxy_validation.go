@@ -6,6 +6,7 @@ import (
 	errors "github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"go.uber.org/multierr"
 )
 
@@ -16,36 +17,23 @@ func MakeReport(proxy *v1.Proxy) *validation.ProxyReport {
 	for i, listener := range listeners {
 		switch listenerType := listener.GetListenerType().(type) {
 		case *v1.Listener_HttpListener:
-
-			vhostReports := make([]*validation.VirtualHostReport, len(listenerType.HttpListener.GetVirtualHosts()))
-
-			for j, vh := range listenerType.HttpListener.GetVirtualHosts() {
-				routeReports := make([]*validation.RouteReport, len(vh.GetRoutes()))
-				for k := range vh.GetRoutes() {
-					routeReports[k] = &validation.RouteReport{}
-				}
-
-				vhostReports[j] = &validation.VirtualHostReport{
-					RouteReports: routeReports,
-				}
-			}
-
 			listenerReports[i] = &validation.ListenerReport{
 				ListenerTypeReport: &validation.ListenerReport_HttpListenerReport{
-					HttpListenerReport: &validation.HttpListenerReport{
-						VirtualHostReports: vhostReports,
-					},
+					HttpListenerReport: makeHttpListenerReport(listenerType.HttpListener),
 				},
 			}
 		case *v1.Listener_TcpListener:
-			tcpHostReports := make([]*validation.TcpHostReport, len(listenerType.TcpListener.GetTcpHosts()))
-			for j := range listenerType.TcpListener.GetTcpHosts() {
-				tcpHostReports[j] = &validation.TcpHostReport{}
-			}
 			listenerReports[i] = &validation.ListenerReport{
 				ListenerTypeReport: &validation.ListenerReport_TcpListenerReport{
-					TcpListenerReport: &validation.TcpListenerReport{
-						TcpHostReports: tcpHostReports,
+					TcpListenerReport: makeTcpListenerReport(listenerType.TcpListener),
+				},
+			}
+		case *v1.Listener_HybridListener:
+			listenerReports[i] = &validation.ListenerReport{
+				ListenerTypeReport: &validation.ListenerReport_HybridListenerReport{
+					HybridListenerReport: &validation.HybridListenerReport{
+						HttpListenerReport: makeHttpListenerReport(listenerType.HybridListener.GetHttpListener()),
+						TcpListenerReport:  makeTcpListenerReport(listenerType.HybridListener.GetTcpListener()),
 					},
 				},
 			}
@@ -57,6 +45,51 @@ func MakeReport(proxy *v1.Proxy) *validation.ProxyReport {
 	}
 }
 
+func makeHttpListenerReport(httpListener *v1.HttpListener) *validation.HttpListenerReport {
+	vhostReports := make([]*validation.VirtualHostReport, len(httpListener.GetVirtualHosts()))
+
+	for j, vh := range httpListener.GetVirtualHosts() {
+		routeReports := make([]*validation.RouteReport, len(vh.GetRoutes()))
+		for k := range vh.GetRoutes() {
+			routeReports[k] = &validation.RouteReport{}
+		}
+
+		vhostReports[j] = &validation.VirtualHostReport{
+			RouteReports: routeReports,
+		}
+	}
+
+	return &validation.HttpListenerReport{
+		VirtualHostReports: vhostReports,
+	}
+}
+
+func makeTcpListenerReport(tcpListener *v1.TcpListener) *validation.TcpListenerReport {
+	tcpHostReports := make([]*validation.TcpHostReport, len(tcpListener.GetTcpHosts()))
+	for j := range tcpListener.GetTcpHosts() {
+		tcpHostReports[j] = &validation.TcpHostReport{}
+	}
+	return &validation.TcpListenerReport{
+		TcpHostReports: tcpHostReports,
+	}
+}
+
+// GetHttpListenerReport returns the report for the http listener of an http or hybrid listener
+func GetHttpListenerReport(listener *validation.ListenerReport) *validation.HttpListenerReport {
+	if hybridListener := listener.GetHybridListenerReport(); hybridListener != nil {
+		return hybridListener.GetHttpListenerReport()
+	}
+	return listener.GetHttpListenerReport()
+}
+
+// GetTcpListenerReport returns the report for the tcp listener of a tcp or hybrid listener
+func GetTcpListenerReport(listener *validation.ListenerReport) *validation.TcpListenerReport {
+	if hybridListener := listener.GetHybridListenerReport(); hybridListener != nil {
+		return hybridListener.GetTcpListenerReport()
+	}
+	return listener.GetTcpListenerReport()
+}
+
 func mkErr(level, errType, reason string) error {
 	return errors.Errorf("%v Error: %v. Reason: %v", level, errType, reason)
 }
@@ -93,19 +126,23 @@ func GetRouteErr(route *validation.RouteReport) []error {
 	return errs
 }
 
+func mkWarning(level, warningType, reason string) string {
+	return fmt.Sprintf("%v Warning: %v. Reason: %v", level, warningType, reason)
+}
+
 func GetRouteWarning(route *validation.RouteReport) []string {
 	var warnings []string
-	appendWarning := func(level, errType, reason string) {
-		warnings = append(warnings, fmt.Sprintf("%v Warning: %v. Reason: %v", level, errType, reason))
-	}
-
 	for _, warning := range route.GetWarnings() {
-		appendWarning("Route", warning.Type.String(), warning.Reason)
+		warnings = append(warnings, mkWarning("Route", warning.Type.String(), warning.Reason))
 	}
 
 	return warnings
 }
 
+func GetHttpListenerWarning(warning *validation.HttpListenerReport_Warning) string {
+	return mkWarning("HttpListener", warning.Type.String(), warning.Reason)
+}
+
 func GetTcpListenerErr(tcpListener *validation.TcpListenerReport) []error {
 	var errs []error
 	for _, errReport := range tcpListener.GetErrors() {
@@ -128,9 +165,7 @@ func GetProxyError(proxyRpt *validation.ProxyReport) error {
 		if err := GetListenerErr(listener); err != nil {
 			errs = append(errs, err...)
 		}
-		switch listenerType := listener.ListenerTypeReport.(type) {
-		case *validation.ListenerReport_HttpListenerReport:
-			httpListener := listenerType.HttpListenerReport
+		if httpListener := GetHttpListenerReport(listener); httpListener != nil {
 			if err := GetHttpListenerErr(httpListener); err != nil {
 				errs = append(errs, err...)
 			}
@@ -144,8 +179,8 @@ func GetProxyError(proxyRpt *validation.ProxyReport) error {
 					}
 				}
 			}
-		case *validation.ListenerReport_TcpListenerReport:
-			tcpListener := listenerType.TcpListenerReport
+		}
+		if tcpListener := GetTcpListenerReport(listener); tcpListener != nil {
 			if err := GetTcpListenerErr(tcpListener); err != nil {
 				errs = append(errs, err...)
 			}
@@ -169,14 +204,10 @@ func GetProxyWarning(proxyRpt *validation.ProxyReport) []string {
 	var warnings []string
 
 	for _, listener := range proxyRpt.GetListenerReports() {
-		switch listenerType := listener.ListenerTypeReport.(type) {
-		case *validation.ListenerReport_HttpListenerReport:
-			httpListener := listenerType.HttpListenerReport
-			for _, vhReport := range httpListener.GetVirtualHostReports() {
-				for _, routeReport := range vhReport.GetRouteReports() {
-					if warns := GetRouteWarning(routeReport); len(warns) > 0 {
-						warnings = append(warnings, warns...)
-					}
+		for _, vhReport := range GetHttpListenerReport(listener).GetVirtualHostReports() {
+			for _, routeReport := range vhReport.GetRouteReports() {
+				if warns := GetRouteWarning(routeReport); len(warns) > 0 {
+					warnings = append(warnings, warns...)
 				}
 			}
 		}
@@ -206,6 +237,14 @@ func AppendHTTPListenerError(httpListenerReport *validation.HttpListenerReport,
 	})
 }
 
+func AppendHTTPListenerWarning(httpListenerReport *validation.HttpListenerReport, warningType validation.HttpListenerReport_Warning_Type, reason string, secretRef *core.ResourceRef) {
+	httpListenerReport.Warnings = append(httpListenerReport.Warnings, &validation.HttpListenerReport_Warning{
+		Type:      warningType,
+		Reason:    reason,
+		SecretRef: secretRef,
+	})
+}
+
 func AppendRouteError(routeReport *validation.RouteReport, errType validation.RouteReport_Error_Type, reason string) {
 	routeReport.Errors = append(routeReport.Errors, &validation.RouteReport_Error{
 		Type:   errType,
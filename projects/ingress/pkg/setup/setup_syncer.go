@@ -80,6 +80,7 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 		&cfg,
 		&clientset,
 		&kubeCoreCache,
+		nil, // no consul client for ingress controller
 		nil, // ingress client does not support vault config
 		gloov1.SecretCrd.Plural,
 	)
@@ -7,6 +7,8 @@ import (
 	"io/ioutil"
 	"net"
 
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/go-utils/hashutils"
 	"go.uber.org/zap"
 
@@ -19,6 +21,7 @@ import (
 	sds "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v2"
 	"github.com/envoyproxy/go-control-plane/pkg/cache"
 	"github.com/envoyproxy/go-control-plane/pkg/server"
+	coresolo "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 // These values must match the values in the envoy sidecar's common_tls_context
@@ -40,13 +43,21 @@ func (h *EnvoyKey) ID(_ *core.Node) string {
 
 func SetupEnvoySDS() (*grpc.Server, cache.SnapshotCache) {
 	grpcServer := grpc.NewServer(grpcOptions...)
+	return grpcServer, RegisterEnvoySDS(grpcServer)
+}
+
+// RegisterEnvoySDS registers the secret discovery service on an existing gRPC server.
+// All envoy nodes are served the secrets of the returned cache under the same node ID, so any client that can
+// reach the server can read every secret in it, private keys included. Only put secrets in the cache that are
+// meant for every proxy, and restrict who can reach the server.
+func RegisterEnvoySDS(grpcServer *grpc.Server) cache.SnapshotCache {
 	hasher := &EnvoyKey{}
 	snapshotCache := cache.NewSnapshotCache(false, hasher, nil)
 	svr := server.NewServer(context.Background(), snapshotCache, nil)
 
 	// register services
 	sds.RegisterSecretDiscoveryServiceServer(grpcServer, svr)
-	return grpcServer, snapshotCache
+	return snapshotCache
 }
 
 func RunSDSServer(ctx context.Context, grpcServer *grpc.Server, serverAddress string) (<-chan struct{}, error) {
@@ -105,6 +116,81 @@ func UpdateSDSConfig(ctx context.Context, sslKeyFile, sslCertFile, sslCaFile str
 	return snapshotCache.SetSnapshot(sdsClient, secretSnapshot)
 }
 
+// UpdateGlooSecrets serves the certificates and validation contexts of the given Gloo TLS secrets,
+// named as referenced by the ssl config translator. Other kinds of secrets are ignored.
+// The secrets are readable by every client of the server (see RegisterEnvoySDS), callers should only pass the
+// secrets that ssl configs reference.
+func UpdateGlooSecrets(ctx context.Context, secrets v1.SecretList, snapshotCache cache.SnapshotCache) error {
+	var (
+		tlsSecrets []interface{}
+		items      []cache.Resource
+	)
+	for _, secret := range secrets {
+		tlsSecret := secret.GetTls()
+		if tlsSecret == nil {
+			continue
+		}
+		tlsSecrets = append(tlsSecrets, secret)
+		items = append(items, glooTlsSecrets(secret.GetMetadata().Ref(), tlsSecret)...)
+	}
+	hash, err := hashutils.HashAllSafe(fnv.New64(), tlsSecrets...)
+	if err != nil {
+		return err
+	}
+	snapshotVersion := fmt.Sprintf("%d", hash)
+	contextutils.LoggerFrom(ctx).Debugf("Updating SDS config with %d Gloo secrets. Snapshot version is %s", len(tlsSecrets), snapshotVersion)
+
+	secretSnapshot := cache.Snapshot{}
+	secretSnapshot.Resources[cache.Secret] = cache.NewResources(snapshotVersion, items)
+	return snapshotCache.SetSnapshot(sdsClient, secretSnapshot)
+}
+
+func glooTlsSecrets(ref coresolo.ResourceRef, tlsSecret *v1.TlsSecret) []cache.Resource {
+	var items []cache.Resource
+	if tlsSecret.CertChain != "" && tlsSecret.PrivateKey != "" {
+		tlsCertificate := &auth.TlsCertificate{
+			CertificateChain: inlineString(tlsSecret.CertChain),
+			PrivateKey:       inlineString(tlsSecret.PrivateKey),
+		}
+		if len(tlsSecret.OcspStaple) != 0 {
+			tlsCertificate.OcspStaple = &core.DataSource{
+				Specifier: &core.DataSource_InlineBytes{
+					InlineBytes: tlsSecret.OcspStaple,
+				},
+			}
+		}
+		items = append(items, &auth.Secret{
+			Name: utils.SdsCertificateSecretName(ref),
+			Type: &auth.Secret_TlsCertificate{
+				TlsCertificate: tlsCertificate,
+			},
+		})
+	}
+	if tlsSecret.RootCa != "" {
+		validationContext := &auth.CertificateValidationContext{
+			TrustedCa: inlineString(tlsSecret.RootCa),
+		}
+		if tlsSecret.Crl != "" {
+			validationContext.Crl = inlineString(tlsSecret.Crl)
+		}
+		items = append(items, &auth.Secret{
+			Name: utils.SdsValidationContextName(ref),
+			Type: &auth.Secret_ValidationContext{
+				ValidationContext: validationContext,
+			},
+		})
+	}
+	return items
+}
+
+func inlineString(s string) *core.DataSource {
+	return &core.DataSource{
+		Specifier: &core.DataSource_InlineString{
+			InlineString: s,
+		},
+	}
+}
+
 func serverCertSecret(certFile, keyFile string) cache.Resource {
 	return &auth.Secret{
 		Name: serverCert,
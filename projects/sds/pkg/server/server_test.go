@@ -3,7 +3,10 @@ package server_test
 import (
 	"context"
 
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/gloo/projects/sds/pkg/server"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 
 	envoy_api_v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoy_service_discovery_v2 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v2"
@@ -69,6 +72,44 @@ var _ = Describe("SDS Server", func() {
 		Expect(err).To(BeNil())
 	})
 
+	It("serves gloo tls secrets by name", func() {
+		hasher := &server.EnvoyKey{}
+		snapshotCache := cache.NewSnapshotCache(false, hasher, nil)
+		secrets := gloov1.SecretList{
+			{
+				Metadata: core.Metadata{Name: "tls", Namespace: "default"},
+				Kind: &gloov1.Secret_Tls{Tls: &gloov1.TlsSecret{
+					CertChain:  "cert",
+					PrivateKey: "key",
+					RootCa:     "ca",
+				}},
+			},
+			{
+				Metadata: core.Metadata{Name: "aws", Namespace: "default"},
+				Kind:     &gloov1.Secret_Aws{Aws: &gloov1.AwsSecret{}},
+			},
+		}
+		err := server.UpdateGlooSecrets(context.Background(), secrets, snapshotCache)
+		Expect(err).NotTo(HaveOccurred())
+
+		snap, err := snapshotCache.GetSnapshot(hasher.ID(nil))
+		Expect(err).NotTo(HaveOccurred())
+		ref := core.ResourceRef{Name: "tls", Namespace: "default"}
+		items := snap.GetResources(cache.SecretType)
+		Expect(items).To(HaveLen(2))
+		Expect(items).To(HaveKey(utils.SdsCertificateSecretName(ref)))
+		Expect(items).To(HaveKey(utils.SdsValidationContextName(ref)))
+		version := snap.GetVersion(cache.SecretType)
+
+		By("changing the version when a secret is rotated")
+		secrets[0].GetTls().CertChain = "rotated"
+		err = server.UpdateGlooSecrets(context.Background(), secrets, snapshotCache)
+		Expect(err).NotTo(HaveOccurred())
+		snap, err = snapshotCache.GetSnapshot(hasher.ID(nil))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(snap.GetVersion(cache.SecretType)).NotTo(Equal(version))
+	})
+
 	Context("Test gRPC Server", func() {
 		var (
 			ctx               context.Context
@@ -192,12 +192,13 @@ func defaultTestConstructOpts(ctx context.Context, runOptions *RunOptions) trans
 	}
 
 	return translator.Opts{
-		WriteNamespace:  runOptions.NsToWrite,
-		WatchNamespaces: runOptions.NsToWatch,
-		Gateways:        f,
-		VirtualServices: f,
-		RouteTables:     f,
-		Proxies:         f,
+		WriteNamespace:    runOptions.NsToWrite,
+		WatchNamespaces:   runOptions.NsToWatch,
+		Gateways:          f,
+		VirtualServices:   f,
+		RouteTables:       f,
+		ReferencePolicies: f,
+		Proxies:           f,
 		WatchOpts: clients.WatchOpts{
 			Ctx:         ctx,
 			RefreshRate: time.Minute,
@@ -247,6 +248,7 @@ func defaultGlooOpts(ctx context.Context, runOptions *RunOptions) bootstrap.Opts
 		Secrets:           f,
 		Artifacts:         f,
 		AuthConfigs:       f,
+		ReferencePolicies: f,
 		KubeServiceClient: newServiceClient(ctx, f, runOptions),
 		WatchNamespaces:   runOptions.NsToWatch,
 		WatchOpts: clients.WatchOpts{